@@ -90,7 +90,7 @@ const file_base_error_proto_rawDesc = "" +
 	"\x05Error\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
-	"\adetails\x18\x03 \x01(\tR\adetailsB:Z8tenant-service/api/baseb\x06proto3"
+	"\adetails\x18\x03 \x01(\tR\adetailsB\x19Z\x17tenant-service/api/baseb\x06proto3"
 
 var (
 	file_base_error_proto_rawDescOnce sync.Once
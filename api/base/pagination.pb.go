@@ -174,7 +174,7 @@ const file_base_pagination_proto_rawDesc = "" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPagesB:Z8tenant-service/api/baseb\x06proto3"
+	"totalPagesB\x19Z\x17tenant-service/api/baseb\x06proto3"
 
 var (
 	file_base_pagination_proto_rawDescOnce sync.Once
@@ -13,7 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
-	_ "tenant-service/api/base"
+	base "tenant-service/api/base"
 	unsafe "unsafe"
 )
 
@@ -239,36 +239,8774 @@ func (OperationType) EnumDescriptor() ([]byte, []int) {
 	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{3}
 }
 
+// 配额执行模式枚举
+type EnforcementMode int32
+
+const (
+	EnforcementMode_ENFORCEMENT_MODE_UNSPECIFIED EnforcementMode = 0
+	EnforcementMode_ENFORCEMENT_MODE_HARD        EnforcementMode = 1 // 超出硬限制时拒绝消费
+	EnforcementMode_ENFORCEMENT_MODE_SOFT_ONLY   EnforcementMode = 2 // 只做软限制告警，不拒绝消费
+	EnforcementMode_ENFORCEMENT_MODE_OVERAGE     EnforcementMode = 3 // 允许超出硬限制，超出部分记为计费超额
+)
+
+// Enum value maps for EnforcementMode.
+var (
+	EnforcementMode_name = map[int32]string{
+		0: "ENFORCEMENT_MODE_UNSPECIFIED",
+		1: "ENFORCEMENT_MODE_HARD",
+		2: "ENFORCEMENT_MODE_SOFT_ONLY",
+		3: "ENFORCEMENT_MODE_OVERAGE",
+	}
+	EnforcementMode_value = map[string]int32{
+		"ENFORCEMENT_MODE_UNSPECIFIED": 0,
+		"ENFORCEMENT_MODE_HARD":        1,
+		"ENFORCEMENT_MODE_SOFT_ONLY":   2,
+		"ENFORCEMENT_MODE_OVERAGE":     3,
+	}
+)
+
+func (x EnforcementMode) Enum() *EnforcementMode {
+	p := new(EnforcementMode)
+	*p = x
+	return p
+}
+
+func (x EnforcementMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnforcementMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[4].Descriptor()
+}
+
+func (EnforcementMode) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[4]
+}
+
+func (x EnforcementMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnforcementMode.Descriptor instead.
+func (EnforcementMode) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+// 配额匹配层级，数值越小优先级越高
+type QuotaMatchLevel int32
+
+const (
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_UNSPECIFIED       QuotaMatchLevel = 0 // 未匹配
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_TENANT_PRODUCT    QuotaMatchLevel = 1 // 租户配额，产品线在product_codes中
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_TENANT_WILDCARD   QuotaMatchLevel = 2 // 租户配额，适用全部产品线
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_ANCESTOR_PRODUCT  QuotaMatchLevel = 3 // 上级租户配额，产品线在product_codes中
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_ANCESTOR_WILDCARD QuotaMatchLevel = 4 // 上级租户配额，适用全部产品线
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_GLOBAL_PRODUCT    QuotaMatchLevel = 5 // 全局默认配额，产品线在product_codes中
+	QuotaMatchLevel_QUOTA_MATCH_LEVEL_GLOBAL_WILDCARD   QuotaMatchLevel = 6 // 全局默认配额，适用全部产品线
+)
+
+// Enum value maps for QuotaMatchLevel.
+var (
+	QuotaMatchLevel_name = map[int32]string{
+		0: "QUOTA_MATCH_LEVEL_UNSPECIFIED",
+		1: "QUOTA_MATCH_LEVEL_TENANT_PRODUCT",
+		2: "QUOTA_MATCH_LEVEL_TENANT_WILDCARD",
+		3: "QUOTA_MATCH_LEVEL_ANCESTOR_PRODUCT",
+		4: "QUOTA_MATCH_LEVEL_ANCESTOR_WILDCARD",
+		5: "QUOTA_MATCH_LEVEL_GLOBAL_PRODUCT",
+		6: "QUOTA_MATCH_LEVEL_GLOBAL_WILDCARD",
+	}
+	QuotaMatchLevel_value = map[string]int32{
+		"QUOTA_MATCH_LEVEL_UNSPECIFIED":       0,
+		"QUOTA_MATCH_LEVEL_TENANT_PRODUCT":    1,
+		"QUOTA_MATCH_LEVEL_TENANT_WILDCARD":   2,
+		"QUOTA_MATCH_LEVEL_ANCESTOR_PRODUCT":  3,
+		"QUOTA_MATCH_LEVEL_ANCESTOR_WILDCARD": 4,
+		"QUOTA_MATCH_LEVEL_GLOBAL_PRODUCT":    5,
+		"QUOTA_MATCH_LEVEL_GLOBAL_WILDCARD":   6,
+	}
+)
+
+func (x QuotaMatchLevel) Enum() *QuotaMatchLevel {
+	p := new(QuotaMatchLevel)
+	*p = x
+	return p
+}
+
+func (x QuotaMatchLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuotaMatchLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[5].Descriptor()
+}
+
+func (QuotaMatchLevel) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[5]
+}
+
+func (x QuotaMatchLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuotaMatchLevel.Descriptor instead.
+func (QuotaMatchLevel) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+// 已用量折算策略，硬限制变更时如何处理已用量
+type ProrationPolicy int32
+
+const (
+	ProrationPolicy_PRORATION_POLICY_UNSPECIFIED ProrationPolicy = 0 // 使用服务配置的默认策略
+	ProrationPolicy_PRORATION_POLICY_CARRY_OVER  ProrationPolicy = 1 // 保留已用量
+	ProrationPolicy_PRORATION_POLICY_SCALE       ProrationPolicy = 2 // 按新旧硬限制比例折算已用量
+	ProrationPolicy_PRORATION_POLICY_RESET       ProrationPolicy = 3 // 已用量清零
+)
+
+// Enum value maps for ProrationPolicy.
+var (
+	ProrationPolicy_name = map[int32]string{
+		0: "PRORATION_POLICY_UNSPECIFIED",
+		1: "PRORATION_POLICY_CARRY_OVER",
+		2: "PRORATION_POLICY_SCALE",
+		3: "PRORATION_POLICY_RESET",
+	}
+	ProrationPolicy_value = map[string]int32{
+		"PRORATION_POLICY_UNSPECIFIED": 0,
+		"PRORATION_POLICY_CARRY_OVER":  1,
+		"PRORATION_POLICY_SCALE":       2,
+		"PRORATION_POLICY_RESET":       3,
+	}
+)
+
+func (x ProrationPolicy) Enum() *ProrationPolicy {
+	p := new(ProrationPolicy)
+	*p = x
+	return p
+}
+
+func (x ProrationPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProrationPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[6].Descriptor()
+}
+
+func (ProrationPolicy) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[6]
+}
+
+func (x ProrationPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProrationPolicy.Descriptor instead.
+func (ProrationPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{6}
+}
+
+// 计划配额变更状态
+type QuotaChangeStatus int32
+
+const (
+	QuotaChangeStatus_QUOTA_CHANGE_STATUS_UNSPECIFIED QuotaChangeStatus = 0
+	QuotaChangeStatus_QUOTA_CHANGE_STATUS_PENDING     QuotaChangeStatus = 1 // 待生效
+	QuotaChangeStatus_QUOTA_CHANGE_STATUS_APPLIED     QuotaChangeStatus = 2 // 已生效
+	QuotaChangeStatus_QUOTA_CHANGE_STATUS_CANCELED    QuotaChangeStatus = 3 // 已取消
+	QuotaChangeStatus_QUOTA_CHANGE_STATUS_FAILED      QuotaChangeStatus = 4 // 生效失败
+)
+
+// Enum value maps for QuotaChangeStatus.
+var (
+	QuotaChangeStatus_name = map[int32]string{
+		0: "QUOTA_CHANGE_STATUS_UNSPECIFIED",
+		1: "QUOTA_CHANGE_STATUS_PENDING",
+		2: "QUOTA_CHANGE_STATUS_APPLIED",
+		3: "QUOTA_CHANGE_STATUS_CANCELED",
+		4: "QUOTA_CHANGE_STATUS_FAILED",
+	}
+	QuotaChangeStatus_value = map[string]int32{
+		"QUOTA_CHANGE_STATUS_UNSPECIFIED": 0,
+		"QUOTA_CHANGE_STATUS_PENDING":     1,
+		"QUOTA_CHANGE_STATUS_APPLIED":     2,
+		"QUOTA_CHANGE_STATUS_CANCELED":    3,
+		"QUOTA_CHANGE_STATUS_FAILED":      4,
+	}
+)
+
+func (x QuotaChangeStatus) Enum() *QuotaChangeStatus {
+	p := new(QuotaChangeStatus)
+	*p = x
+	return p
+}
+
+func (x QuotaChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuotaChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[7].Descriptor()
+}
+
+func (QuotaChangeStatus) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[7]
+}
+
+func (x QuotaChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuotaChangeStatus.Descriptor instead.
+func (QuotaChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{7}
+}
+
+// 导入导出数据格式枚举
+type DataFormat int32
+
+const (
+	DataFormat_DATA_FORMAT_UNSPECIFIED DataFormat = 0
+	DataFormat_DATA_FORMAT_CSV         DataFormat = 1 // CSV，每行一条记录，record_type区分TENANT/CHANNEL/PRODUCT/QUOTA
+	DataFormat_DATA_FORMAT_JSONL       DataFormat = 2 // JSON Lines，每行一个租户及其渠道信息、产品关联和配额
+)
+
+// Enum value maps for DataFormat.
+var (
+	DataFormat_name = map[int32]string{
+		0: "DATA_FORMAT_UNSPECIFIED",
+		1: "DATA_FORMAT_CSV",
+		2: "DATA_FORMAT_JSONL",
+	}
+	DataFormat_value = map[string]int32{
+		"DATA_FORMAT_UNSPECIFIED": 0,
+		"DATA_FORMAT_CSV":         1,
+		"DATA_FORMAT_JSONL":       2,
+	}
+)
+
+func (x DataFormat) Enum() *DataFormat {
+	p := new(DataFormat)
+	*p = x
+	return p
+}
+
+func (x DataFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[8].Descriptor()
+}
+
+func (DataFormat) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[8]
+}
+
+func (x DataFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataFormat.Descriptor instead.
+func (DataFormat) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{8}
+}
+
+// 钱包交易类型
+type WalletTransactionType int32
+
+const (
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_UNSPECIFIED WalletTransactionType = 0
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_TOPUP       WalletTransactionType = 1 // 充值
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_DEBIT       WalletTransactionType = 2 // 扣费
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND      WalletTransactionType = 3 // 退款
+)
+
+// Enum value maps for WalletTransactionType.
+var (
+	WalletTransactionType_name = map[int32]string{
+		0: "WALLET_TRANSACTION_TYPE_UNSPECIFIED",
+		1: "WALLET_TRANSACTION_TYPE_TOPUP",
+		2: "WALLET_TRANSACTION_TYPE_DEBIT",
+		3: "WALLET_TRANSACTION_TYPE_REFUND",
+	}
+	WalletTransactionType_value = map[string]int32{
+		"WALLET_TRANSACTION_TYPE_UNSPECIFIED": 0,
+		"WALLET_TRANSACTION_TYPE_TOPUP":       1,
+		"WALLET_TRANSACTION_TYPE_DEBIT":       2,
+		"WALLET_TRANSACTION_TYPE_REFUND":      3,
+	}
+)
+
+func (x WalletTransactionType) Enum() *WalletTransactionType {
+	p := new(WalletTransactionType)
+	*p = x
+	return p
+}
+
+func (x WalletTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[9].Descriptor()
+}
+
+func (WalletTransactionType) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[9]
+}
+
+func (x WalletTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletTransactionType.Descriptor instead.
+func (WalletTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{9}
+}
+
+// 记账分录方向
+type LedgerDirection int32
+
+const (
+	LedgerDirection_LEDGER_DIRECTION_UNSPECIFIED LedgerDirection = 0
+	LedgerDirection_LEDGER_DIRECTION_DEBIT       LedgerDirection = 1 // 借
+	LedgerDirection_LEDGER_DIRECTION_CREDIT      LedgerDirection = 2 // 贷
+)
+
+// Enum value maps for LedgerDirection.
+var (
+	LedgerDirection_name = map[int32]string{
+		0: "LEDGER_DIRECTION_UNSPECIFIED",
+		1: "LEDGER_DIRECTION_DEBIT",
+		2: "LEDGER_DIRECTION_CREDIT",
+	}
+	LedgerDirection_value = map[string]int32{
+		"LEDGER_DIRECTION_UNSPECIFIED": 0,
+		"LEDGER_DIRECTION_DEBIT":       1,
+		"LEDGER_DIRECTION_CREDIT":      2,
+	}
+)
+
+func (x LedgerDirection) Enum() *LedgerDirection {
+	p := new(LedgerDirection)
+	*p = x
+	return p
+}
+
+func (x LedgerDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[10].Descriptor()
+}
+
+func (LedgerDirection) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[10]
+}
+
+func (x LedgerDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerDirection.Descriptor instead.
+func (LedgerDirection) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{10}
+}
+
+// 配额租约状态
+type QuotaLeaseStatus int32
+
+const (
+	QuotaLeaseStatus_QUOTA_LEASE_STATUS_UNSPECIFIED QuotaLeaseStatus = 0
+	QuotaLeaseStatus_QUOTA_LEASE_STATUS_ACTIVE      QuotaLeaseStatus = 1 // 使用中
+	QuotaLeaseStatus_QUOTA_LEASE_STATUS_RETURNED    QuotaLeaseStatus = 2 // 客户端已归还
+	QuotaLeaseStatus_QUOTA_LEASE_STATUS_RECLAIMED   QuotaLeaseStatus = 3 // 过期后由服务端回收
+)
+
+// Enum value maps for QuotaLeaseStatus.
+var (
+	QuotaLeaseStatus_name = map[int32]string{
+		0: "QUOTA_LEASE_STATUS_UNSPECIFIED",
+		1: "QUOTA_LEASE_STATUS_ACTIVE",
+		2: "QUOTA_LEASE_STATUS_RETURNED",
+		3: "QUOTA_LEASE_STATUS_RECLAIMED",
+	}
+	QuotaLeaseStatus_value = map[string]int32{
+		"QUOTA_LEASE_STATUS_UNSPECIFIED": 0,
+		"QUOTA_LEASE_STATUS_ACTIVE":      1,
+		"QUOTA_LEASE_STATUS_RETURNED":    2,
+		"QUOTA_LEASE_STATUS_RECLAIMED":   3,
+	}
+)
+
+func (x QuotaLeaseStatus) Enum() *QuotaLeaseStatus {
+	p := new(QuotaLeaseStatus)
+	*p = x
+	return p
+}
+
+func (x QuotaLeaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuotaLeaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[11].Descriptor()
+}
+
+func (QuotaLeaseStatus) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[11]
+}
+
+func (x QuotaLeaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuotaLeaseStatus.Descriptor instead.
+func (QuotaLeaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{11}
+}
+
+// 租户成员角色枚举，权限由高到低
+type MemberRole int32
+
+const (
+	MemberRole_MEMBER_ROLE_UNSPECIFIED MemberRole = 0
+	MemberRole_MEMBER_ROLE_OWNER       MemberRole = 1 // 所有者
+	MemberRole_MEMBER_ROLE_ADMIN       MemberRole = 2 // 管理员
+	MemberRole_MEMBER_ROLE_OPERATOR    MemberRole = 3 // 运营
+	MemberRole_MEMBER_ROLE_VIEWER      MemberRole = 4 // 只读
+)
+
+// Enum value maps for MemberRole.
+var (
+	MemberRole_name = map[int32]string{
+		0: "MEMBER_ROLE_UNSPECIFIED",
+		1: "MEMBER_ROLE_OWNER",
+		2: "MEMBER_ROLE_ADMIN",
+		3: "MEMBER_ROLE_OPERATOR",
+		4: "MEMBER_ROLE_VIEWER",
+	}
+	MemberRole_value = map[string]int32{
+		"MEMBER_ROLE_UNSPECIFIED": 0,
+		"MEMBER_ROLE_OWNER":       1,
+		"MEMBER_ROLE_ADMIN":       2,
+		"MEMBER_ROLE_OPERATOR":    3,
+		"MEMBER_ROLE_VIEWER":      4,
+	}
+)
+
+func (x MemberRole) Enum() *MemberRole {
+	p := new(MemberRole)
+	*p = x
+	return p
+}
+
+func (x MemberRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[12].Descriptor()
+}
+
+func (MemberRole) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[12]
+}
+
+func (x MemberRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberRole.Descriptor instead.
+func (MemberRole) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{12}
+}
+
+// 邀请状态枚举
+type InvitationStatus int32
+
+const (
+	InvitationStatus_INVITATION_STATUS_UNSPECIFIED InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_PENDING     InvitationStatus = 1 // 待接受
+	InvitationStatus_INVITATION_STATUS_ACCEPTED    InvitationStatus = 2 // 已接受
+	InvitationStatus_INVITATION_STATUS_REVOKED     InvitationStatus = 3 // 已撤销
+	InvitationStatus_INVITATION_STATUS_EXPIRED     InvitationStatus = 4 // 已过期
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_UNSPECIFIED",
+		1: "INVITATION_STATUS_PENDING",
+		2: "INVITATION_STATUS_ACCEPTED",
+		3: "INVITATION_STATUS_REVOKED",
+		4: "INVITATION_STATUS_EXPIRED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_UNSPECIFIED": 0,
+		"INVITATION_STATUS_PENDING":     1,
+		"INVITATION_STATUS_ACCEPTED":    2,
+		"INVITATION_STATUS_REVOKED":     3,
+		"INVITATION_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[13].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[13]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{13}
+}
+
+// EntitlementSource 授权项取值来源
+type EntitlementSource int32
+
+const (
+	EntitlementSource_ENTITLEMENT_SOURCE_UNSPECIFIED EntitlementSource = 0 // 未指定
+	EntitlementSource_ENTITLEMENT_SOURCE_DEFAULT     EntitlementSource = 1 // 授权项定义的默认值
+	EntitlementSource_ENTITLEMENT_SOURCE_PLAN        EntitlementSource = 2 // 订阅的套餐
+	EntitlementSource_ENTITLEMENT_SOURCE_TENANT      EntitlementSource = 3 // 租户单独授予
+)
+
+// Enum value maps for EntitlementSource.
+var (
+	EntitlementSource_name = map[int32]string{
+		0: "ENTITLEMENT_SOURCE_UNSPECIFIED",
+		1: "ENTITLEMENT_SOURCE_DEFAULT",
+		2: "ENTITLEMENT_SOURCE_PLAN",
+		3: "ENTITLEMENT_SOURCE_TENANT",
+	}
+	EntitlementSource_value = map[string]int32{
+		"ENTITLEMENT_SOURCE_UNSPECIFIED": 0,
+		"ENTITLEMENT_SOURCE_DEFAULT":     1,
+		"ENTITLEMENT_SOURCE_PLAN":        2,
+		"ENTITLEMENT_SOURCE_TENANT":      3,
+	}
+)
+
+func (x EntitlementSource) Enum() *EntitlementSource {
+	p := new(EntitlementSource)
+	*p = x
+	return p
+}
+
+func (x EntitlementSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntitlementSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[14].Descriptor()
+}
+
+func (EntitlementSource) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[14]
+}
+
+func (x EntitlementSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntitlementSource.Descriptor instead.
+func (EntitlementSource) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{14}
+}
+
+// 哈希链问题类型枚举
+type LedgerIssueKind int32
+
+const (
+	LedgerIssueKind_LEDGER_ISSUE_KIND_UNSPECIFIED          LedgerIssueKind = 0
+	LedgerIssueKind_LEDGER_ISSUE_KIND_GAP                  LedgerIssueKind = 1 // 链序号不连续，记录被删除
+	LedgerIssueKind_LEDGER_ISSUE_KIND_HASH_MISMATCH        LedgerIssueKind = 2 // 记录内容与其哈希不符，记录被修改
+	LedgerIssueKind_LEDGER_ISSUE_KIND_LINK_MISMATCH        LedgerIssueKind = 3 // 前序哈希与上一条记录的哈希不符
+	LedgerIssueKind_LEDGER_ISSUE_KIND_HEAD_MISMATCH        LedgerIssueKind = 4 // 最后一条记录与链头不符，末尾记录被删除或修改
+	LedgerIssueKind_LEDGER_ISSUE_KIND_CHECKPOINT_MISMATCH  LedgerIssueKind = 5 // 检查点的哈希与对应记录不符或对应记录不存在
+	LedgerIssueKind_LEDGER_ISSUE_KIND_CHECKPOINT_SIGNATURE LedgerIssueKind = 6 // 检查点签名无效
+	LedgerIssueKind_LEDGER_ISSUE_KIND_UNSEQUENCED          LedgerIssueKind = 7 // 记录写入后超过max_sequence_lag仍未入链
+)
+
+// Enum value maps for LedgerIssueKind.
+var (
+	LedgerIssueKind_name = map[int32]string{
+		0: "LEDGER_ISSUE_KIND_UNSPECIFIED",
+		1: "LEDGER_ISSUE_KIND_GAP",
+		2: "LEDGER_ISSUE_KIND_HASH_MISMATCH",
+		3: "LEDGER_ISSUE_KIND_LINK_MISMATCH",
+		4: "LEDGER_ISSUE_KIND_HEAD_MISMATCH",
+		5: "LEDGER_ISSUE_KIND_CHECKPOINT_MISMATCH",
+		6: "LEDGER_ISSUE_KIND_CHECKPOINT_SIGNATURE",
+		7: "LEDGER_ISSUE_KIND_UNSEQUENCED",
+	}
+	LedgerIssueKind_value = map[string]int32{
+		"LEDGER_ISSUE_KIND_UNSPECIFIED":          0,
+		"LEDGER_ISSUE_KIND_GAP":                  1,
+		"LEDGER_ISSUE_KIND_HASH_MISMATCH":        2,
+		"LEDGER_ISSUE_KIND_LINK_MISMATCH":        3,
+		"LEDGER_ISSUE_KIND_HEAD_MISMATCH":        4,
+		"LEDGER_ISSUE_KIND_CHECKPOINT_MISMATCH":  5,
+		"LEDGER_ISSUE_KIND_CHECKPOINT_SIGNATURE": 6,
+		"LEDGER_ISSUE_KIND_UNSEQUENCED":          7,
+	}
+)
+
+func (x LedgerIssueKind) Enum() *LedgerIssueKind {
+	p := new(LedgerIssueKind)
+	*p = x
+	return p
+}
+
+func (x LedgerIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_tenant_service_v1_tenant_proto_enumTypes[15].Descriptor()
+}
+
+func (LedgerIssueKind) Type() protoreflect.EnumType {
+	return &file_platform_tenant_service_v1_tenant_proto_enumTypes[15]
+}
+
+func (x LedgerIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerIssueKind.Descriptor instead.
+func (LedgerIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{15}
+}
+
 // TenantInfo 租户信息
 type TenantInfo struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                                    // 租户ID
-	TenantName     string                 `protobuf:"bytes,2,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                                                                              // 租户名称
-	TenantType     TenantType             `protobuf:"varint,3,opt,name=tenant_type,json=tenantType,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_type,omitempty"`                                  // 租户类型
-	ParentTenantId string                 `protobuf:"bytes,4,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                                                                // 父租户ID
-	Status         bool                   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                                                                                       // 状态
-	QuotaConfig    map[string]string      `protobuf:"bytes,6,rep,name=quota_config,json=quotaConfig,proto3" json:"quota_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 配额配置
-	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                                                 // 创建时间
-	UpdatedAt      string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                                                 // 更新时间
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                                    // 租户ID
+	TenantName     string                 `protobuf:"bytes,2,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                                                                              // 租户名称
+	TenantType     TenantType             `protobuf:"varint,3,opt,name=tenant_type,json=tenantType,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_type,omitempty"`                                  // 租户类型
+	ParentTenantId string                 `protobuf:"bytes,4,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                                                                // 父租户ID
+	Status         bool                   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                                                                                       // 状态
+	QuotaConfig    map[string]string      `protobuf:"bytes,6,rep,name=quota_config,json=quotaConfig,proto3" json:"quota_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 配额配置
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                                                 // 创建时间
+	UpdatedAt      string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                                                 // 更新时间
+	Labels         map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                              // 标签
+	Attributes     map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                     // 自定义属性
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TenantInfo) Reset() {
+	*x = TenantInfo{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantInfo) ProtoMessage() {}
+
+func (x *TenantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantInfo.ProtoReflect.Descriptor instead.
+func (*TenantInfo) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TenantInfo) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetTenantName() string {
+	if x != nil {
+		return x.TenantName
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetTenantType() TenantType {
+	if x != nil {
+		return x.TenantType
+	}
+	return TenantType_TENANT_TYPE_UNSPECIFIED
+}
+
+func (x *TenantInfo) GetParentTenantId() string {
+	if x != nil {
+		return x.ParentTenantId
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *TenantInfo) GetQuotaConfig() map[string]string {
+	if x != nil {
+		return x.QuotaConfig
+	}
+	return nil
+}
+
+func (x *TenantInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *TenantInfo) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// QuotaInfo 配额信息
+type QuotaInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	QuotaId            int64                  `protobuf:"varint,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                                                          // 配额ID
+	TenantId           string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                        // 租户ID
+	QuotaType          QuotaType              `protobuf:"varint,3,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"`                          // 配额类型
+	LimitType          LimitType              `protobuf:"varint,4,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"`                          // 限制类型
+	HardLimit          int32                  `protobuf:"varint,5,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"`                                                                    // 硬限制
+	SoftLimit          int32                  `protobuf:"varint,6,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`                                                                    // 软限制
+	UsedCount          int32                  `protobuf:"varint,7,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`                                                                    // 已使用数量
+	ResetTime          string                 `protobuf:"bytes,8,opt,name=reset_time,json=resetTime,proto3" json:"reset_time,omitempty"`                                                                     // 重置时间
+	NextResetTime      string                 `protobuf:"bytes,9,opt,name=next_reset_time,json=nextResetTime,proto3" json:"next_reset_time,omitempty"`                                                       // 下次重置时间
+	EffectiveTime      string                 `protobuf:"bytes,10,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`                                                        // 生效时间
+	ExpireTime         string                 `protobuf:"bytes,11,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                                                                 // 过期时间
+	IsGlobal           bool                   `protobuf:"varint,12,opt,name=is_global,json=isGlobal,proto3" json:"is_global,omitempty"`                                                                      // 是否全局
+	ProductCodes       []string               `protobuf:"bytes,13,rep,name=product_codes,json=productCodes,proto3" json:"product_codes,omitempty"`                                                           // 产品代码列表
+	PlanCode           string                 `protobuf:"bytes,14,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                                                       // 来源套餐，为空表示单独配置
+	RolloverGranted    int32                  `protobuf:"varint,15,opt,name=rollover_granted,json=rolloverGranted,proto3" json:"rollover_granted,omitempty"`                                                 // 上次重置时结转的数量
+	RolloverUsed       int32                  `protobuf:"varint,16,opt,name=rollover_used,json=rolloverUsed,proto3" json:"rollover_used,omitempty"`                                                          // 已使用的结转数量
+	RolloverExpireTime string                 `protobuf:"bytes,17,opt,name=rollover_expire_time,json=rolloverExpireTime,proto3" json:"rollover_expire_time,omitempty"`                                       // 结转额度失效时间，未结转时为空
+	EnforcementMode    EnforcementMode        `protobuf:"varint,18,opt,name=enforcement_mode,json=enforcementMode,proto3,enum=platform.tenant_service.v1.EnforcementMode" json:"enforcement_mode,omitempty"` // 执行模式
+	MaxOverage         int32                  `protobuf:"varint,19,opt,name=max_overage,json=maxOverage,proto3" json:"max_overage,omitempty"`                                                                // OVERAGE模式下最多超出硬限制的数量，0表示不限制
+	Overage            int32                  `protobuf:"varint,20,opt,name=overage,proto3" json:"overage,omitempty"`                                                                                        // 当前周期的计费超额
+	Allocations        []*QuotaAllocation     `protobuf:"bytes,21,rep,name=allocations,proto3" json:"allocations,omitempty"`                                                                                 // 产品线划分额度
+	SharedLimit        int32                  `protobuf:"varint,22,opt,name=shared_limit,json=sharedLimit,proto3" json:"shared_limit,omitempty"`                                                             // 共享额度（硬限制减去产品线划分额度）
+	SharedUsed         int32                  `protobuf:"varint,23,opt,name=shared_used,json=sharedUsed,proto3" json:"shared_used,omitempty"`                                                                // 共享额度已用量
+	Shards             int32                  `protobuf:"varint,24,opt,name=shards,proto3" json:"shards,omitempty"`                                                                                          // 分片计数的分片数，0表示不分片
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *QuotaInfo) Reset() {
+	*x = QuotaInfo{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaInfo) ProtoMessage() {}
+
+func (x *QuotaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaInfo.ProtoReflect.Descriptor instead.
+func (*QuotaInfo) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuotaInfo) GetQuotaId() int64 {
+	if x != nil {
+		return x.QuotaId
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaInfo) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaInfo) GetHardLimit() int32 {
+	if x != nil {
+		return x.HardLimit
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetSoftLimit() int32 {
+	if x != nil {
+		return x.SoftLimit
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetResetTime() string {
+	if x != nil {
+		return x.ResetTime
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetNextResetTime() string {
+	if x != nil {
+		return x.NextResetTime
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetEffectiveTime() string {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetIsGlobal() bool {
+	if x != nil {
+		return x.IsGlobal
+	}
+	return false
+}
+
+func (x *QuotaInfo) GetProductCodes() []string {
+	if x != nil {
+		return x.ProductCodes
+	}
+	return nil
+}
+
+func (x *QuotaInfo) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetRolloverGranted() int32 {
+	if x != nil {
+		return x.RolloverGranted
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetRolloverUsed() int32 {
+	if x != nil {
+		return x.RolloverUsed
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetRolloverExpireTime() string {
+	if x != nil {
+		return x.RolloverExpireTime
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetEnforcementMode() EnforcementMode {
+	if x != nil {
+		return x.EnforcementMode
+	}
+	return EnforcementMode_ENFORCEMENT_MODE_UNSPECIFIED
+}
+
+func (x *QuotaInfo) GetMaxOverage() int32 {
+	if x != nil {
+		return x.MaxOverage
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetOverage() int32 {
+	if x != nil {
+		return x.Overage
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetAllocations() []*QuotaAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *QuotaInfo) GetSharedLimit() int32 {
+	if x != nil {
+		return x.SharedLimit
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetSharedUsed() int32 {
+	if x != nil {
+		return x.SharedUsed
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+// QuotaAllocation 配额内为产品线划出的额度
+type QuotaAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品线
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                               // 划分额度
+	UsedCount     int32                  `protobuf:"varint,3,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`      // 已使用数量
+	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`                       // 剩余量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaAllocation) Reset() {
+	*x = QuotaAllocation{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaAllocation) ProtoMessage() {}
+
+func (x *QuotaAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaAllocation.ProtoReflect.Descriptor instead.
+func (*QuotaAllocation) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuotaAllocation) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *QuotaAllocation) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QuotaAllocation) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *QuotaAllocation) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// Product 产品信息
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品代码
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 产品名称
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                    // 描述
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Product) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *Product) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CreateTenantRequest 创建租户请求
+type CreateTenantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantName     string                 `protobuf:"bytes,1,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                                                                              // 租户名称
+	TenantType     TenantType             `protobuf:"varint,2,opt,name=tenant_type,json=tenantType,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_type,omitempty"`                                  // 租户类型
+	ParentTenantId string                 `protobuf:"bytes,3,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                                                                // 父租户ID
+	QuotaConfig    map[string]string      `protobuf:"bytes,4,rep,name=quota_config,json=quotaConfig,proto3" json:"quota_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 配额配置
+	TenantId       string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                                    // 租户ID，仅custom生成策略下生效
+	Labels         map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                              // 标签
+	Attributes     map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                      // 自定义属性，按租户类型的属性定义校验
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTenantRequest) GetTenantName() string {
+	if x != nil {
+		return x.TenantName
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetTenantType() TenantType {
+	if x != nil {
+		return x.TenantType
+	}
+	return TenantType_TENANT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateTenantRequest) GetParentTenantId() string {
+	if x != nil {
+		return x.ParentTenantId
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetQuotaConfig() map[string]string {
+	if x != nil {
+		return x.QuotaConfig
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// CreateTenantReply 创建租户响应
+type CreateTenantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantInfo            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 租户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantReply) Reset() {
+	*x = CreateTenantReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantReply) ProtoMessage() {}
+
+func (x *CreateTenantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantReply.ProtoReflect.Descriptor instead.
+func (*CreateTenantReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTenantReply) GetTenant() *TenantInfo {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// GetTenantRequest 获取租户请求
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// GetTenantReply 获取租户响应
+type GetTenantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantInfo            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 租户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantReply) Reset() {
+	*x = GetTenantReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantReply) ProtoMessage() {}
+
+func (x *GetTenantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantReply.ProtoReflect.Descriptor instead.
+func (*GetTenantReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTenantReply) GetTenant() *TenantInfo {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// ListTenantsRequest 列出租户请求
+type ListTenantsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantType     TenantType             `protobuf:"varint,1,opt,name=tenant_type,json=tenantType,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_type,omitempty"`              // 租户类型
+	ParentTenantId string                 `protobuf:"bytes,2,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                                            // 父租户ID
+	Status         *bool                  `protobuf:"varint,3,opt,name=status,proto3,oneof" json:"status,omitempty"`                                                                             // 状态，不传表示不过滤
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                               // 页大小（已废弃，请使用page），最大100
+	PageNum        int32                  `protobuf:"varint,5,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`                                                                  // 页码（已废弃，请使用page）
+	TenantTypes    []TenantType           `protobuf:"varint,6,rep,packed,name=tenant_types,json=tenantTypes,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_types,omitempty"`    // 租户类型列表，与tenant_type取并集
+	Name           string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`                                                                                        // 租户名称模糊搜索
+	CreatedAfter   string                 `protobuf:"bytes,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`                                                    // 创建时间起（RFC3339，含）
+	CreatedBefore  string                 `protobuf:"bytes,9,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`                                                 // 创建时间止（RFC3339，不含）
+	Page           *base.PageRequest      `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`                                                                                       // 分页与排序，page_size最大100，sort_by支持tenant_id/tenant_name/tenant_type/created_at/updated_at
+	LabelSelector  string                 `protobuf:"bytes,11,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`                                                // 标签选择器，如 region=east,tier in (gold,silver),!deprecated
+	Attributes     map[string]string      `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 属性精确匹配，多个属性同时满足
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTenantsRequest) GetTenantType() TenantType {
+	if x != nil {
+		return x.TenantType
+	}
+	return TenantType_TENANT_TYPE_UNSPECIFIED
+}
+
+func (x *ListTenantsRequest) GetParentTenantId() string {
+	if x != nil {
+		return x.ParentTenantId
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetStatus() bool {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return false
+}
+
+func (x *ListTenantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTenantsRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListTenantsRequest) GetTenantTypes() []TenantType {
+	if x != nil {
+		return x.TenantTypes
+	}
+	return nil
+}
+
+func (x *ListTenantsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetPage() *base.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListTenantsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ListTenantsReply 列出租户响应
+type ListTenantsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*TenantInfo          `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"` // 租户列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`    // 总数
+	Page          *base.PageResponse     `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`       // 分页信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsReply) Reset() {
+	*x = ListTenantsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsReply) ProtoMessage() {}
+
+func (x *ListTenantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsReply.ProtoReflect.Descriptor instead.
+func (*ListTenantsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTenantsReply) GetTenants() []*TenantInfo {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *ListTenantsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTenantsReply) GetPage() *base.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// UpdateTenantRequest 更新租户请求
+type UpdateTenantRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                                    // 租户ID
+	TenantName       string                 `protobuf:"bytes,2,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                                                                              // 租户名称
+	Status           bool                   `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                                                                                                       // 状态
+	QuotaConfig      map[string]string      `protobuf:"bytes,4,rep,name=quota_config,json=quotaConfig,proto3" json:"quota_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 配额配置
+	Labels           map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                              // 新增或覆盖的标签
+	RemoveLabels     []string               `protobuf:"bytes,6,rep,name=remove_labels,json=removeLabels,proto3" json:"remove_labels,omitempty"`                                                                        // 删除的标签键
+	Attributes       map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                      // 新增或覆盖的属性
+	RemoveAttributes []string               `protobuf:"bytes,8,rep,name=remove_attributes,json=removeAttributes,proto3" json:"remove_attributes,omitempty"`                                                            // 删除的属性键
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetTenantName() string {
+	if x != nil {
+		return x.TenantName
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *UpdateTenantRequest) GetQuotaConfig() map[string]string {
+	if x != nil {
+		return x.QuotaConfig
+	}
+	return nil
+}
+
+func (x *UpdateTenantRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateTenantRequest) GetRemoveLabels() []string {
+	if x != nil {
+		return x.RemoveLabels
+	}
+	return nil
+}
+
+func (x *UpdateTenantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateTenantRequest) GetRemoveAttributes() []string {
+	if x != nil {
+		return x.RemoveAttributes
+	}
+	return nil
+}
+
+// UpdateTenantReply 更新租户响应
+type UpdateTenantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantInfo            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 租户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantReply) Reset() {
+	*x = UpdateTenantReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantReply) ProtoMessage() {}
+
+func (x *UpdateTenantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantReply.ProtoReflect.Descriptor instead.
+func (*UpdateTenantReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTenantReply) GetTenant() *TenantInfo {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// DeleteTenantRequest 删除租户请求
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// DeleteTenantReply 删除租户响应
+type DeleteTenantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantReply) Reset() {
+	*x = DeleteTenantReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantReply) ProtoMessage() {}
+
+func (x *DeleteTenantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantReply.ProtoReflect.Descriptor instead.
+func (*DeleteTenantReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteTenantReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// CheckQuotaRequest 检查配额请求
+type CheckQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	ProductCode   string                 `protobuf:"bytes,4,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckQuotaRequest) Reset() {
+	*x = CheckQuotaRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaRequest) ProtoMessage() {}
+
+func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CheckQuotaRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *CheckQuotaRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *CheckQuotaRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+// CheckQuotaReply 检查配额响应
+type CheckQuotaReply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Quota           *QuotaInfo             `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`                                             // 配额信息
+	HasQuota        bool                   `protobuf:"varint,2,opt,name=has_quota,json=hasQuota,proto3" json:"has_quota,omitempty"`                      // 是否有配额
+	AvailableQuota  int32                  `protobuf:"varint,3,opt,name=available_quota,json=availableQuota,proto3" json:"available_quota,omitempty"`    // 可用配额，产品线划分额度剩余量加共享额度剩余量
+	Allocation      *QuotaAllocation       `protobuf:"bytes,4,opt,name=allocation,proto3" json:"allocation,omitempty"`                                   // 产品线的划分额度，未划分时为空
+	SharedAvailable int32                  `protobuf:"varint,5,opt,name=shared_available,json=sharedAvailable,proto3" json:"shared_available,omitempty"` // 共享额度剩余量（含结转额度）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckQuotaReply) Reset() {
+	*x = CheckQuotaReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaReply) ProtoMessage() {}
+
+func (x *CheckQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaReply.ProtoReflect.Descriptor instead.
+func (*CheckQuotaReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckQuotaReply) GetQuota() *QuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *CheckQuotaReply) GetHasQuota() bool {
+	if x != nil {
+		return x.HasQuota
+	}
+	return false
+}
+
+func (x *CheckQuotaReply) GetAvailableQuota() int32 {
+	if x != nil {
+		return x.AvailableQuota
+	}
+	return 0
+}
+
+func (x *CheckQuotaReply) GetAllocation() *QuotaAllocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+func (x *CheckQuotaReply) GetSharedAvailable() int32 {
+	if x != nil {
+		return x.SharedAvailable
+	}
+	return 0
+}
+
+// QuotaCandidate 配额解析的候选配额
+type QuotaCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *QuotaInfo             `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`                                                  // 候选配额
+	Level         QuotaMatchLevel        `protobuf:"varint,2,opt,name=level,proto3,enum=platform.tenant_service.v1.QuotaMatchLevel" json:"level,omitempty"` // 匹配层级
+	Depth         int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`                                                 // 所属租户层级：0为租户本身，1为父租户，全局配额为-1
+	Selected      bool                   `protobuf:"varint,4,opt,name=selected,proto3" json:"selected,omitempty"`                                           // 是否被选中
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                // 选中或未选中的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaCandidate) Reset() {
+	*x = QuotaCandidate{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaCandidate) ProtoMessage() {}
+
+func (x *QuotaCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaCandidate.ProtoReflect.Descriptor instead.
+func (*QuotaCandidate) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuotaCandidate) GetQuota() *QuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *QuotaCandidate) GetLevel() QuotaMatchLevel {
+	if x != nil {
+		return x.Level
+	}
+	return QuotaMatchLevel_QUOTA_MATCH_LEVEL_UNSPECIFIED
+}
+
+func (x *QuotaCandidate) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *QuotaCandidate) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *QuotaCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ExplainQuotaRequest 配额解析解释请求
+type ExplainQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	ProductCode   string                 `protobuf:"bytes,4,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码，不传表示不按产品线过滤
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainQuotaRequest) Reset() {
+	*x = ExplainQuotaRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainQuotaRequest) ProtoMessage() {}
+
+func (x *ExplainQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainQuotaRequest.ProtoReflect.Descriptor instead.
+func (*ExplainQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExplainQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ExplainQuotaRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ExplainQuotaRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *ExplainQuotaRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+// ExplainQuotaReply 配额解析解释响应
+type ExplainQuotaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selected      *QuotaInfo             `protobuf:"bytes,1,opt,name=selected,proto3" json:"selected,omitempty"`     // 选中的配额，没有匹配时为空
+	Ancestors     []string               `protobuf:"bytes,2,rep,name=ancestors,proto3" json:"ancestors,omitempty"`   // 上级租户ID，由近及远
+	Candidates    []*QuotaCandidate      `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"` // 候选配额，按优先级排序，未匹配的排在最后
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainQuotaReply) Reset() {
+	*x = ExplainQuotaReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainQuotaReply) ProtoMessage() {}
+
+func (x *ExplainQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainQuotaReply.ProtoReflect.Descriptor instead.
+func (*ExplainQuotaReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExplainQuotaReply) GetSelected() *QuotaInfo {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+func (x *ExplainQuotaReply) GetAncestors() []string {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *ExplainQuotaReply) GetCandidates() []*QuotaCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// ConsumeQuotaRequest 消费配额请求
+type ConsumeQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 数量
+	ProductCode   string                 `protobuf:"bytes,5,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
+	BizId         string                 `protobuf:"bytes,6,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                        // 业务ID
+	BizType       string                 `protobuf:"bytes,7,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`                                                  // 业务类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeQuotaRequest) Reset() {
+	*x = ConsumeQuotaRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeQuotaRequest) ProtoMessage() {}
+
+func (x *ConsumeQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeQuotaRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConsumeQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ConsumeQuotaRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *ConsumeQuotaRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConsumeQuotaRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaRequest) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaRequest) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+// ConsumeQuotaReply 消费配额响应
+type ConsumeQuotaReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // 是否成功
+	RemainingQuota int32                  `protobuf:"varint,2,opt,name=remaining_quota,json=remainingQuota,proto3" json:"remaining_quota,omitempty"` // 剩余配额
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                      // 消息
+	Overage        int32                  `protobuf:"varint,4,opt,name=overage,proto3" json:"overage,omitempty"`                                     // 本次消费中超出硬限制计费的数量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConsumeQuotaReply) Reset() {
+	*x = ConsumeQuotaReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeQuotaReply) ProtoMessage() {}
+
+func (x *ConsumeQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeQuotaReply.ProtoReflect.Descriptor instead.
+func (*ConsumeQuotaReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConsumeQuotaReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConsumeQuotaReply) GetRemainingQuota() int32 {
+	if x != nil {
+		return x.RemainingQuota
+	}
+	return 0
+}
+
+func (x *ConsumeQuotaReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaReply) GetOverage() int32 {
+	if x != nil {
+		return x.Overage
+	}
+	return 0
+}
+
+// ReleaseQuotaRequest 释放配额请求
+type ReleaseQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 数量
+	ProductCode   string                 `protobuf:"bytes,5,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
+	BizId         string                 `protobuf:"bytes,6,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                        // 业务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuotaRequest) Reset() {
+	*x = ReleaseQuotaRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuotaRequest) ProtoMessage() {}
+
+func (x *ReleaseQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuotaRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReleaseQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ReleaseQuotaRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ReleaseQuotaRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *ReleaseQuotaRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReleaseQuotaRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ReleaseQuotaRequest) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+// ReleaseQuotaReply 释放配额响应
+type ReleaseQuotaReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // 是否成功
+	RemainingQuota int32                  `protobuf:"varint,2,opt,name=remaining_quota,json=remainingQuota,proto3" json:"remaining_quota,omitempty"` // 剩余配额
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                      // 消息
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseQuotaReply) Reset() {
+	*x = ReleaseQuotaReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuotaReply) ProtoMessage() {}
+
+func (x *ReleaseQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuotaReply.ProtoReflect.Descriptor instead.
+func (*ReleaseQuotaReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReleaseQuotaReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseQuotaReply) GetRemainingQuota() int32 {
+	if x != nil {
+		return x.RemainingQuota
+	}
+	return 0
+}
+
+func (x *ReleaseQuotaReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// QuotaUsageRecord 配额使用记录
+type QuotaUsageRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordId      int64                  `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`                                                              // 记录ID
+	QuotaId       int64                  `protobuf:"varint,2,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                                                 // 配额ID
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                               // 租户ID
+	OperationType OperationType          `protobuf:"varint,4,opt,name=operation_type,json=operationType,proto3,enum=platform.tenant_service.v1.OperationType" json:"operation_type,omitempty"` // 操作类型
+	DeltaValue    int32                  `protobuf:"varint,5,opt,name=delta_value,json=deltaValue,proto3" json:"delta_value,omitempty"`                                                        // 变更数值
+	CurrentUsed   int32                  `protobuf:"varint,6,opt,name=current_used,json=currentUsed,proto3" json:"current_used,omitempty"`                                                     // 变更后已用量
+	BizId         string                 `protobuf:"bytes,7,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                                        // 业务ID
+	BizType       string                 `protobuf:"bytes,8,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`                                                                  // 业务类型
+	Operator      string                 `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator,omitempty"`                                                                               // 操作人
+	OperationTime string                 `protobuf:"bytes,10,opt,name=operation_time,json=operationTime,proto3" json:"operation_time,omitempty"`                                               // 操作时间
+	Remark        string                 `protobuf:"bytes,11,opt,name=remark,proto3" json:"remark,omitempty"`                                                                                  // 备注
+	ChainSeq      int64                  `protobuf:"varint,12,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`                                                             // 配额内的哈希链序号，0表示尚未入链
+	PrevHash      string                 `protobuf:"bytes,13,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`                                                              // 前序记录哈希
+	RecordHash    string                 `protobuf:"bytes,14,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`                                                        // 记录哈希
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaUsageRecord) Reset() {
+	*x = QuotaUsageRecord{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsageRecord) ProtoMessage() {}
+
+func (x *QuotaUsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsageRecord.ProtoReflect.Descriptor instead.
+func (*QuotaUsageRecord) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuotaUsageRecord) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *QuotaUsageRecord) GetQuotaId() int64 {
+	if x != nil {
+		return x.QuotaId
+	}
+	return 0
+}
+
+func (x *QuotaUsageRecord) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaUsageRecord) GetDeltaValue() int32 {
+	if x != nil {
+		return x.DeltaValue
+	}
+	return 0
+}
+
+func (x *QuotaUsageRecord) GetCurrentUsed() int32 {
+	if x != nil {
+		return x.CurrentUsed
+	}
+	return 0
+}
+
+func (x *QuotaUsageRecord) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetOperationTime() string {
+	if x != nil {
+		return x.OperationTime
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetChainSeq() int64 {
+	if x != nil {
+		return x.ChainSeq
+	}
+	return 0
+}
+
+func (x *QuotaUsageRecord) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *QuotaUsageRecord) GetRecordHash() string {
+	if x != nil {
+		return x.RecordHash
+	}
+	return ""
+}
+
+// ListQuotasRequest 列出租户配额请求
+type ListQuotasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，不传表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotasRequest) Reset() {
+	*x = ListQuotasRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotasRequest) ProtoMessage() {}
+
+func (x *ListQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotasRequest.ProtoReflect.Descriptor instead.
+func (*ListQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListQuotasRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListQuotasRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+// ListQuotasReply 列出租户配额响应
+type ListQuotasReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotas        []*QuotaInfo           `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"` // 配额列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotasReply) Reset() {
+	*x = ListQuotasReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotasReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotasReply) ProtoMessage() {}
+
+func (x *ListQuotasReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotasReply.ProtoReflect.Descriptor instead.
+func (*ListQuotasReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListQuotasReply) GetQuotas() []*QuotaInfo {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// AdjustQuotaRequest 调整配额请求
+type AdjustQuotaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TenantId        string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                              // 租户ID
+	QuotaType       QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"`                                // 配额类型
+	LimitType       LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"`                                // 限制类型
+	HardLimit       *int32                 `protobuf:"varint,4,opt,name=hard_limit,json=hardLimit,proto3,oneof" json:"hard_limit,omitempty"`                                                                    // 硬限制，不传表示不修改
+	SoftLimit       *int32                 `protobuf:"varint,5,opt,name=soft_limit,json=softLimit,proto3,oneof" json:"soft_limit,omitempty"`                                                                    // 软限制，不传表示不修改
+	UsedCount       *int32                 `protobuf:"varint,6,opt,name=used_count,json=usedCount,proto3,oneof" json:"used_count,omitempty"`                                                                    // 已使用数量，不传表示不修改
+	Operator        string                 `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator,omitempty"`                                                                                              // 操作人
+	Remark          string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`                                                                                                  // 备注
+	ExtraConfig     *string                `protobuf:"bytes,9,opt,name=extra_config,json=extraConfig,proto3,oneof" json:"extra_config,omitempty"`                                                               // 额外配置JSON（如结转规则），不传表示不修改
+	EnforcementMode *EnforcementMode       `protobuf:"varint,10,opt,name=enforcement_mode,json=enforcementMode,proto3,enum=platform.tenant_service.v1.EnforcementMode,oneof" json:"enforcement_mode,omitempty"` // 执行模式，不传表示不修改
+	MaxOverage      *int32                 `protobuf:"varint,11,opt,name=max_overage,json=maxOverage,proto3,oneof" json:"max_overage,omitempty"`                                                                // 超额上限，不传表示不修改
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdjustQuotaRequest) Reset() {
+	*x = AdjustQuotaRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustQuotaRequest) ProtoMessage() {}
+
+func (x *AdjustQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustQuotaRequest.ProtoReflect.Descriptor instead.
+func (*AdjustQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AdjustQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *AdjustQuotaRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *AdjustQuotaRequest) GetHardLimit() int32 {
+	if x != nil && x.HardLimit != nil {
+		return *x.HardLimit
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetSoftLimit() int32 {
+	if x != nil && x.SoftLimit != nil {
+		return *x.SoftLimit
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetUsedCount() int32 {
+	if x != nil && x.UsedCount != nil {
+		return *x.UsedCount
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetExtraConfig() string {
+	if x != nil && x.ExtraConfig != nil {
+		return *x.ExtraConfig
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetEnforcementMode() EnforcementMode {
+	if x != nil && x.EnforcementMode != nil {
+		return *x.EnforcementMode
+	}
+	return EnforcementMode_ENFORCEMENT_MODE_UNSPECIFIED
+}
+
+func (x *AdjustQuotaRequest) GetMaxOverage() int32 {
+	if x != nil && x.MaxOverage != nil {
+		return *x.MaxOverage
+	}
+	return 0
+}
+
+// AdjustQuotaReply 调整配额响应
+type AdjustQuotaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *QuotaInfo             `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"` // 调整后的配额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustQuotaReply) Reset() {
+	*x = AdjustQuotaReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustQuotaReply) ProtoMessage() {}
+
+func (x *AdjustQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustQuotaReply.ProtoReflect.Descriptor instead.
+func (*AdjustQuotaReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AdjustQuotaReply) GetQuota() *QuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// ResetQuotaRequest 重置配额请求
+type ResetQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`                                                               // 操作人
+	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                                                                   // 备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetQuotaRequest) Reset() {
+	*x = ResetQuotaRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetQuotaRequest) ProtoMessage() {}
+
+func (x *ResetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*ResetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResetQuotaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ResetQuotaRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ResetQuotaRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *ResetQuotaRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ResetQuotaRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// ResetQuotaReply 重置配额响应
+type ResetQuotaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *QuotaInfo             `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"` // 重置后的配额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetQuotaReply) Reset() {
+	*x = ResetQuotaReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetQuotaReply) ProtoMessage() {}
+
+func (x *ResetQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetQuotaReply.ProtoReflect.Descriptor instead.
+func (*ResetQuotaReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResetQuotaReply) GetQuota() *QuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// ListUsageRecordsRequest 列出配额使用记录请求
+type ListUsageRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，不传表示全部
+	AfterRecordId int64                  `protobuf:"varint,3,opt,name=after_record_id,json=afterRecordId,proto3" json:"after_record_id,omitempty"`                             // 只返回记录ID大于该值的记录，用于增量拉取
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                                    // 返回条数，默认100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageRecordsRequest) Reset() {
+	*x = ListUsageRecordsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageRecordsRequest) ProtoMessage() {}
+
+func (x *ListUsageRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUsageRecordsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListUsageRecordsRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ListUsageRecordsRequest) GetAfterRecordId() int64 {
+	if x != nil {
+		return x.AfterRecordId
+	}
+	return 0
+}
+
+func (x *ListUsageRecordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListUsageRecordsReply 列出配额使用记录响应
+type ListUsageRecordsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*QuotaUsageRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // 使用记录，按记录ID升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageRecordsReply) Reset() {
+	*x = ListUsageRecordsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageRecordsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageRecordsReply) ProtoMessage() {}
+
+func (x *ListUsageRecordsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageRecordsReply.ProtoReflect.Descriptor instead.
+func (*ListUsageRecordsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListUsageRecordsReply) GetRecords() []*QuotaUsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// ListOveragesRequest 计费超额查询请求，按周期开始日期过滤
+type ListOveragesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID，不传表示全部租户
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，不传表示全部
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                            // 周期开始日期不早于YYYY-MM-DD，不传表示不限制
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                                  // 周期开始日期不晚于YYYY-MM-DD（含），不传表示不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOveragesRequest) Reset() {
+	*x = ListOveragesRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOveragesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOveragesRequest) ProtoMessage() {}
+
+func (x *ListOveragesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOveragesRequest.ProtoReflect.Descriptor instead.
+func (*ListOveragesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListOveragesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListOveragesRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ListOveragesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListOveragesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// QuotaOverage 配额在一个周期内的计费超额
+type QuotaOverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaId       int64                  `protobuf:"varint,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                                 // 配额ID
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,3,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,4,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	PeriodStart   string                 `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`                                      // 周期开始时间
+	PeriodEnd     string                 `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`                                            // 周期结束时间
+	Overage       int32                  `protobuf:"varint,7,opt,name=overage,proto3" json:"overage,omitempty"`                                                                // 超额数量
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                            // 最近更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaOverage) Reset() {
+	*x = QuotaOverage{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaOverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaOverage) ProtoMessage() {}
+
+func (x *QuotaOverage) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaOverage.ProtoReflect.Descriptor instead.
+func (*QuotaOverage) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QuotaOverage) GetQuotaId() int64 {
+	if x != nil {
+		return x.QuotaId
+	}
+	return 0
+}
+
+func (x *QuotaOverage) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *QuotaOverage) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaOverage) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaOverage) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *QuotaOverage) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *QuotaOverage) GetOverage() int32 {
+	if x != nil {
+		return x.Overage
+	}
+	return 0
+}
+
+func (x *QuotaOverage) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// ListOveragesReply 计费超额查询响应
+type ListOveragesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overages      []*QuotaOverage        `protobuf:"bytes,1,rep,name=overages,proto3" json:"overages,omitempty"` // 超额列表，按周期开始时间降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOveragesReply) Reset() {
+	*x = ListOveragesReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOveragesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOveragesReply) ProtoMessage() {}
+
+func (x *ListOveragesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOveragesReply.ProtoReflect.Descriptor instead.
+func (*ListOveragesReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListOveragesReply) GetOverages() []*QuotaOverage {
+	if x != nil {
+		return x.Overages
+	}
+	return nil
+}
+
+// GetUsageReportRequest 用量报表请求
+type GetUsageReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID，不传表示全部租户
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，不传表示全部
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                            // 开始日期YYYY-MM-DD，默认结束日期前29天
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                                  // 结束日期YYYY-MM-DD（含），默认今天
+	TopN          int32                  `protobuf:"varint,5,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`                                                          // 每种配额类型的消耗排行条数，默认10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUsageReportRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *GetUsageReportRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+// TopConsumer 消耗排行项
+type TopConsumer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`              // 租户ID
+	Consumed      int64                  `protobuf:"varint,2,opt,name=consumed,proto3" json:"consumed,omitempty"`                             // 消耗量
+	Released      int64                  `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`                             // 释放量
+	ConsumeCount  int64                  `protobuf:"varint,4,opt,name=consume_count,json=consumeCount,proto3" json:"consume_count,omitempty"` // 消费次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopConsumer) Reset() {
+	*x = TopConsumer{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopConsumer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopConsumer) ProtoMessage() {}
+
+func (x *TopConsumer) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopConsumer.ProtoReflect.Descriptor instead.
+func (*TopConsumer) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TopConsumer) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TopConsumer) GetConsumed() int64 {
+	if x != nil {
+		return x.Consumed
+	}
+	return 0
+}
+
+func (x *TopConsumer) GetReleased() int64 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
+func (x *TopConsumer) GetConsumeCount() int64 {
+	if x != nil {
+		return x.ConsumeCount
+	}
+	return 0
+}
+
+// QuotaTypeTopConsumers 单个配额类型的消耗排行
+type QuotaTypeTopConsumers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaType     QuotaType              `protobuf:"varint,1,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	Consumers     []*TopConsumer         `protobuf:"bytes,2,rep,name=consumers,proto3" json:"consumers,omitempty"`                                                             // 按消耗量降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaTypeTopConsumers) Reset() {
+	*x = QuotaTypeTopConsumers{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaTypeTopConsumers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaTypeTopConsumers) ProtoMessage() {}
+
+func (x *QuotaTypeTopConsumers) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaTypeTopConsumers.ProtoReflect.Descriptor instead.
+func (*QuotaTypeTopConsumers) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QuotaTypeTopConsumers) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaTypeTopConsumers) GetConsumers() []*TopConsumer {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+// UtilizationBucket 软限制利用率分布区间，利用率为当日峰值已用量/软限制
+type UtilizationBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LowerPercent  int32                  `protobuf:"varint,1,opt,name=lower_percent,json=lowerPercent,proto3" json:"lower_percent,omitempty"` // 区间下界（含），百分比
+	UpperPercent  int32                  `protobuf:"varint,2,opt,name=upper_percent,json=upperPercent,proto3" json:"upper_percent,omitempty"` // 区间上界（不含），百分比，0表示无上界
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`                                   // 落入该区间的配额日数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UtilizationBucket) Reset() {
+	*x = UtilizationBucket{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UtilizationBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtilizationBucket) ProtoMessage() {}
+
+func (x *UtilizationBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtilizationBucket.ProtoReflect.Descriptor instead.
+func (*UtilizationBucket) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UtilizationBucket) GetLowerPercent() int32 {
+	if x != nil {
+		return x.LowerPercent
+	}
+	return 0
+}
+
+func (x *UtilizationBucket) GetUpperPercent() int32 {
+	if x != nil {
+		return x.UpperPercent
+	}
+	return 0
+}
+
+func (x *UtilizationBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ExhaustionForecast 月配额耗尽预测
+type ExhaustionForecast struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TenantId           string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType          QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	HardLimit          int32                  `protobuf:"varint,3,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"`                                           // 硬限制
+	UsedCount          int32                  `protobuf:"varint,4,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`                                           // 当前已用量
+	DailyRate          float64                `protobuf:"fixed64,5,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`                                          // 近期日均净消耗
+	WillExhaust        bool                   `protobuf:"varint,6,opt,name=will_exhaust,json=willExhaust,proto3" json:"will_exhaust,omitempty"`                                     // 按当前速率是否会耗尽
+	ExhaustTime        string                 `protobuf:"bytes,7,opt,name=exhaust_time,json=exhaustTime,proto3" json:"exhaust_time,omitempty"`                                      // 预计耗尽时间，不会耗尽时为空
+	NextResetTime      string                 `protobuf:"bytes,8,opt,name=next_reset_time,json=nextResetTime,proto3" json:"next_reset_time,omitempty"`                              // 下次重置时间
+	ExhaustBeforeReset bool                   `protobuf:"varint,9,opt,name=exhaust_before_reset,json=exhaustBeforeReset,proto3" json:"exhaust_before_reset,omitempty"`              // 是否在下次重置前耗尽
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExhaustionForecast) Reset() {
+	*x = ExhaustionForecast{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExhaustionForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExhaustionForecast) ProtoMessage() {}
+
+func (x *ExhaustionForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExhaustionForecast.ProtoReflect.Descriptor instead.
+func (*ExhaustionForecast) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExhaustionForecast) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ExhaustionForecast) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ExhaustionForecast) GetHardLimit() int32 {
+	if x != nil {
+		return x.HardLimit
+	}
+	return 0
+}
+
+func (x *ExhaustionForecast) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *ExhaustionForecast) GetDailyRate() float64 {
+	if x != nil {
+		return x.DailyRate
+	}
+	return 0
+}
+
+func (x *ExhaustionForecast) GetWillExhaust() bool {
+	if x != nil {
+		return x.WillExhaust
+	}
+	return false
+}
+
+func (x *ExhaustionForecast) GetExhaustTime() string {
+	if x != nil {
+		return x.ExhaustTime
+	}
+	return ""
+}
+
+func (x *ExhaustionForecast) GetNextResetTime() string {
+	if x != nil {
+		return x.NextResetTime
+	}
+	return ""
+}
+
+func (x *ExhaustionForecast) GetExhaustBeforeReset() bool {
+	if x != nil {
+		return x.ExhaustBeforeReset
+	}
+	return false
+}
+
+// GetUsageReportReply 用量报表响应
+type GetUsageReportReply struct {
+	state                protoimpl.MessageState   `protogen:"open.v1"`
+	StartDate            string                   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                    // 开始日期
+	EndDate              string                   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                          // 结束日期
+	TopConsumers         []*QuotaTypeTopConsumers `protobuf:"bytes,3,rep,name=top_consumers,json=topConsumers,proto3" json:"top_consumers,omitempty"`                           // 各配额类型消耗排行
+	SoftLimitUtilization []*UtilizationBucket     `protobuf:"bytes,4,rep,name=soft_limit_utilization,json=softLimitUtilization,proto3" json:"soft_limit_utilization,omitempty"` // 软限制利用率分布
+	Forecasts            []*ExhaustionForecast    `protobuf:"bytes,5,rep,name=forecasts,proto3" json:"forecasts,omitempty"`                                                     // 月配额耗尽预测
+	RollupRecordId       int64                    `protobuf:"varint,6,opt,name=rollup_record_id,json=rollupRecordId,proto3" json:"rollup_record_id,omitempty"`                  // 汇总已处理到的使用记录ID
+	RollupTime           string                   `protobuf:"bytes,7,opt,name=rollup_time,json=rollupTime,proto3" json:"rollup_time,omitempty"`                                 // 最近一次汇总时间
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetUsageReportReply) Reset() {
+	*x = GetUsageReportReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportReply) ProtoMessage() {}
+
+func (x *GetUsageReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportReply.ProtoReflect.Descriptor instead.
+func (*GetUsageReportReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUsageReportReply) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetUsageReportReply) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetUsageReportReply) GetTopConsumers() []*QuotaTypeTopConsumers {
+	if x != nil {
+		return x.TopConsumers
+	}
+	return nil
+}
+
+func (x *GetUsageReportReply) GetSoftLimitUtilization() []*UtilizationBucket {
+	if x != nil {
+		return x.SoftLimitUtilization
+	}
+	return nil
+}
+
+func (x *GetUsageReportReply) GetForecasts() []*ExhaustionForecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
+}
+
+func (x *GetUsageReportReply) GetRollupRecordId() int64 {
+	if x != nil {
+		return x.RollupRecordId
+	}
+	return 0
+}
+
+func (x *GetUsageReportReply) GetRollupTime() string {
+	if x != nil {
+		return x.RollupTime
+	}
+	return ""
+}
+
+// GetUsageTimeSeriesRequest 用量序列请求
+type GetUsageTimeSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，不传表示全部
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型，不传表示全部
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                            // 开始日期YYYY-MM-DD，默认结束日期前29天
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                                  // 结束日期YYYY-MM-DD（含），默认今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageTimeSeriesRequest) Reset() {
+	*x = GetUsageTimeSeriesRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetUsageTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUsageTimeSeriesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *GetUsageTimeSeriesRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *GetUsageTimeSeriesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// UsagePoint 单日用量
+type UsagePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                      // 日期YYYY-MM-DD
+	Consumed      int64                  `protobuf:"varint,2,opt,name=consumed,proto3" json:"consumed,omitempty"`                             // 消耗量
+	Released      int64                  `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`                             // 释放量
+	ConsumeCount  int64                  `protobuf:"varint,4,opt,name=consume_count,json=consumeCount,proto3" json:"consume_count,omitempty"` // 消费次数
+	PeakUsed      int32                  `protobuf:"varint,5,opt,name=peak_used,json=peakUsed,proto3" json:"peak_used,omitempty"`             // 当日峰值已用量
+	EndUsed       int32                  `protobuf:"varint,6,opt,name=end_used,json=endUsed,proto3" json:"end_used,omitempty"`                // 当日结束时已用量
+	HardLimit     int32                  `protobuf:"varint,7,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"`          // 硬限制
+	SoftLimit     int32                  `protobuf:"varint,8,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`          // 软限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePoint) Reset() {
+	*x = UsagePoint{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePoint) ProtoMessage() {}
+
+func (x *UsagePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePoint.ProtoReflect.Descriptor instead.
+func (*UsagePoint) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UsagePoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UsagePoint) GetConsumed() int64 {
+	if x != nil {
+		return x.Consumed
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetReleased() int64 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetConsumeCount() int64 {
+	if x != nil {
+		return x.ConsumeCount
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetPeakUsed() int32 {
+	if x != nil {
+		return x.PeakUsed
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetEndUsed() int32 {
+	if x != nil {
+		return x.EndUsed
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetHardLimit() int32 {
+	if x != nil {
+		return x.HardLimit
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetSoftLimit() int32 {
+	if x != nil {
+		return x.SoftLimit
+	}
+	return 0
+}
+
+// UsageSeries 单个配额的按日用量序列，无记录的日期补零并沿用前一日的已用量
+type UsageSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaId       int64                  `protobuf:"varint,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                                 // 配额ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	Points        []*UsagePoint          `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`                                                                   // 按日期升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageSeries) Reset() {
+	*x = UsageSeries{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSeries) ProtoMessage() {}
+
+func (x *UsageSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSeries.ProtoReflect.Descriptor instead.
+func (*UsageSeries) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UsageSeries) GetQuotaId() int64 {
+	if x != nil {
+		return x.QuotaId
+	}
+	return 0
+}
+
+func (x *UsageSeries) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *UsageSeries) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *UsageSeries) GetPoints() []*UsagePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// GetUsageTimeSeriesReply 用量序列响应
+type GetUsageTimeSeriesReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StartDate      string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                   // 开始日期
+	EndDate        string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                         // 结束日期
+	Series         []*UsageSeries         `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`                                          // 用量序列
+	RollupRecordId int64                  `protobuf:"varint,4,opt,name=rollup_record_id,json=rollupRecordId,proto3" json:"rollup_record_id,omitempty"` // 汇总已处理到的使用记录ID
+	RollupTime     string                 `protobuf:"bytes,5,opt,name=rollup_time,json=rollupTime,proto3" json:"rollup_time,omitempty"`                // 最近一次汇总时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsageTimeSeriesReply) Reset() {
+	*x = GetUsageTimeSeriesReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageTimeSeriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageTimeSeriesReply) ProtoMessage() {}
+
+func (x *GetUsageTimeSeriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageTimeSeriesReply.ProtoReflect.Descriptor instead.
+func (*GetUsageTimeSeriesReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetUsageTimeSeriesReply) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesReply) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesReply) GetSeries() []*UsageSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *GetUsageTimeSeriesReply) GetRollupRecordId() int64 {
+	if x != nil {
+		return x.RollupRecordId
+	}
+	return 0
+}
+
+func (x *GetUsageTimeSeriesReply) GetRollupTime() string {
+	if x != nil {
+		return x.RollupTime
+	}
+	return ""
+}
+
+// PlanQuota 套餐中的配额定义
+type PlanQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaType     QuotaType              `protobuf:"varint,1,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,2,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	HardLimit     int32                  `protobuf:"varint,3,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"`                                           // 硬限制
+	SoftLimit     int32                  `protobuf:"varint,4,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`                                           // 软限制，0表示不设置
+	ProductCodes  []string               `protobuf:"bytes,5,rep,name=product_codes,json=productCodes,proto3" json:"product_codes,omitempty"`                                   // 适用产品线，为空表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanQuota) Reset() {
+	*x = PlanQuota{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanQuota) ProtoMessage() {}
+
+func (x *PlanQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanQuota.ProtoReflect.Descriptor instead.
+func (*PlanQuota) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PlanQuota) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *PlanQuota) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *PlanQuota) GetHardLimit() int32 {
+	if x != nil {
+		return x.HardLimit
+	}
+	return 0
+}
+
+func (x *PlanQuota) GetSoftLimit() int32 {
+	if x != nil {
+		return x.SoftLimit
+	}
+	return 0
+}
+
+func (x *PlanQuota) GetProductCodes() []string {
+	if x != nil {
+		return x.ProductCodes
+	}
+	return nil
+}
+
+// QuotaPlan 配额套餐
+type QuotaPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanCode      string                 `protobuf:"bytes,1,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`    // 套餐编码
+	PlanName      string                 `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`    // 套餐名称
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`              // 描述
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                     // 版本，每次更新递增
+	Quotas        []*PlanQuota           `protobuf:"bytes,5,rep,name=quotas,proto3" json:"quotas,omitempty"`                        // 配额定义
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 更新时间
+	Entitlements  []*PlanEntitlement     `protobuf:"bytes,8,rep,name=entitlements,proto3" json:"entitlements,omitempty"`            // 授权项
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaPlan) Reset() {
+	*x = QuotaPlan{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaPlan) ProtoMessage() {}
+
+func (x *QuotaPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaPlan.ProtoReflect.Descriptor instead.
+func (*QuotaPlan) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *QuotaPlan) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *QuotaPlan) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *QuotaPlan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuotaPlan) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QuotaPlan) GetQuotas() []*PlanQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *QuotaPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *QuotaPlan) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *QuotaPlan) GetEntitlements() []*PlanEntitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+// TenantPlan 租户套餐订阅
+type TenantPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`           // 租户ID
+	PlanCode      string                 `protobuf:"bytes,2,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`           // 套餐编码
+	PlanVersion   int32                  `protobuf:"varint,3,opt,name=plan_version,json=planVersion,proto3" json:"plan_version,omitempty"` // 已应用的套餐版本
+	AssignedBy    string                 `protobuf:"bytes,4,opt,name=assigned_by,json=assignedBy,proto3" json:"assigned_by,omitempty"`     // 操作人
+	AssignedAt    string                 `protobuf:"bytes,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`     // 最近一次应用时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPlan) Reset() {
+	*x = TenantPlan{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPlan) ProtoMessage() {}
+
+func (x *TenantPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPlan.ProtoReflect.Descriptor instead.
+func (*TenantPlan) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TenantPlan) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantPlan) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *TenantPlan) GetPlanVersion() int32 {
+	if x != nil {
+		return x.PlanVersion
+	}
+	return 0
+}
+
+func (x *TenantPlan) GetAssignedBy() string {
+	if x != nil {
+		return x.AssignedBy
+	}
+	return ""
+}
+
+func (x *TenantPlan) GetAssignedAt() string {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return ""
+}
+
+// SavePlanRequest 保存配额套餐请求
+type SavePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanCode      string                 `protobuf:"bytes,1,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"` // 套餐编码
+	PlanName      string                 `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"` // 套餐名称
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`           // 描述
+	Quotas        []*PlanQuota           `protobuf:"bytes,4,rep,name=quotas,proto3" json:"quotas,omitempty"`                     // 配额定义
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`                 // 操作人
+	Entitlements  []*PlanEntitlement     `protobuf:"bytes,6,rep,name=entitlements,proto3" json:"entitlements,omitempty"`         // 授权项
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePlanRequest) Reset() {
+	*x = SavePlanRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePlanRequest) ProtoMessage() {}
+
+func (x *SavePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePlanRequest.ProtoReflect.Descriptor instead.
+func (*SavePlanRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SavePlanRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *SavePlanRequest) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *SavePlanRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SavePlanRequest) GetQuotas() []*PlanQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *SavePlanRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *SavePlanRequest) GetEntitlements() []*PlanEntitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+// PlanPropagationFailure 套餐同步失败的租户
+type PlanPropagationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                       // 错误信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPropagationFailure) Reset() {
+	*x = PlanPropagationFailure{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPropagationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPropagationFailure) ProtoMessage() {}
+
+func (x *PlanPropagationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPropagationFailure.ProtoReflect.Descriptor instead.
+func (*PlanPropagationFailure) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PlanPropagationFailure) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PlanPropagationFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SavePlanReply 保存配额套餐响应
+type SavePlanReply struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Plan          *QuotaPlan                `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`              // 保存后的套餐
+	Propagated    int32                     `protobuf:"varint,2,opt,name=propagated,proto3" json:"propagated,omitempty"` // 已同步的租户数
+	Failures      []*PlanPropagationFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`      // 同步失败的租户，再次保存套餐时重试
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePlanReply) Reset() {
+	*x = SavePlanReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePlanReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePlanReply) ProtoMessage() {}
+
+func (x *SavePlanReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePlanReply.ProtoReflect.Descriptor instead.
+func (*SavePlanReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SavePlanReply) GetPlan() *QuotaPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *SavePlanReply) GetPropagated() int32 {
+	if x != nil {
+		return x.Propagated
+	}
+	return 0
+}
+
+func (x *SavePlanReply) GetFailures() []*PlanPropagationFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// GetPlanRequest 获取配额套餐请求
+type GetPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanCode      string                 `protobuf:"bytes,1,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"` // 套餐编码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanRequest) Reset() {
+	*x = GetPlanRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanRequest) ProtoMessage() {}
+
+func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPlanRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+// GetPlanReply 获取配额套餐响应
+type GetPlanReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *QuotaPlan             `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // 套餐
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanReply) Reset() {
+	*x = GetPlanReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanReply) ProtoMessage() {}
+
+func (x *GetPlanReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanReply.ProtoReflect.Descriptor instead.
+func (*GetPlanReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPlanReply) GetPlan() *QuotaPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// ListPlansRequest 列出配额套餐请求
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{53}
+}
+
+// ListPlansReply 列出配额套餐响应
+type ListPlansReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*QuotaPlan           `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"` // 套餐列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansReply) Reset() {
+	*x = ListPlansReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansReply) ProtoMessage() {}
+
+func (x *ListPlansReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansReply.ProtoReflect.Descriptor instead.
+func (*ListPlansReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListPlansReply) GetPlans() []*QuotaPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// AssignPlanRequest 分配套餐请求
+type AssignPlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                    // 租户ID
+	PlanCode       string                 `protobuf:"bytes,2,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                    // 套餐编码
+	Operator       string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                                                    // 操作人
+	ClearOverrides bool                   `protobuf:"varint,4,opt,name=clear_overrides,json=clearOverrides,proto3" json:"clear_overrides,omitempty"`                 // 清除租户级覆盖，完全按套餐配置
+	Proration      ProrationPolicy        `protobuf:"varint,5,opt,name=proration,proto3,enum=platform.tenant_service.v1.ProrationPolicy" json:"proration,omitempty"` // 硬限制变化时的已用量折算策略，默认保留已用量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignPlanRequest) Reset() {
+	*x = AssignPlanRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignPlanRequest) ProtoMessage() {}
+
+func (x *AssignPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignPlanRequest.ProtoReflect.Descriptor instead.
+func (*AssignPlanRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AssignPlanRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AssignPlanRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *AssignPlanRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AssignPlanRequest) GetClearOverrides() bool {
+	if x != nil {
+		return x.ClearOverrides
+	}
+	return false
+}
+
+func (x *AssignPlanRequest) GetProration() ProrationPolicy {
+	if x != nil {
+		return x.Proration
+	}
+	return ProrationPolicy_PRORATION_POLICY_UNSPECIFIED
+}
+
+// AssignPlanReply 分配套餐响应
+type AssignPlanReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *TenantPlan            `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"` // 套餐订阅
+	Quotas        []*QuotaInfo           `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"`         // 套餐管理的租户配额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignPlanReply) Reset() {
+	*x = AssignPlanReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignPlanReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignPlanReply) ProtoMessage() {}
+
+func (x *AssignPlanReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignPlanReply.ProtoReflect.Descriptor instead.
+func (*AssignPlanReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AssignPlanReply) GetAssignment() *TenantPlan {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *AssignPlanReply) GetQuotas() []*QuotaInfo {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// BindProductRequest 关联产品线请求
+type BindProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`          // 租户ID
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品代码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindProductRequest) Reset() {
+	*x = BindProductRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindProductRequest) ProtoMessage() {}
+
+func (x *BindProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindProductRequest.ProtoReflect.Descriptor instead.
+func (*BindProductRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BindProductRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *BindProductRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+// BindProductReply 关联产品线响应
+type BindProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindProductReply) Reset() {
+	*x = BindProductReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindProductReply) ProtoMessage() {}
+
+func (x *BindProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindProductReply.ProtoReflect.Descriptor instead.
+func (*BindProductReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BindProductReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListProductsRequest 列出产品线请求
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListProductsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListProductsReply 列出产品线响应
+type ListProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // 产品列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsReply) Reset() {
+	*x = ListProductsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsReply) ProtoMessage() {}
+
+func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
+func (*ListProductsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListProductsReply) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// QuotaChange 计划配额变更，按配额维度修改限制或切换套餐
+type QuotaChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId      int64                  `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`                                              // 变更ID
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,3,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，套餐变更时为空
+	LimitType     LimitType              `protobuf:"varint,4,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型，套餐变更时为空
+	HardLimit     *int32                 `protobuf:"varint,5,opt,name=hard_limit,json=hardLimit,proto3,oneof" json:"hard_limit,omitempty"`                                     // 新硬限制
+	SoftLimit     *int32                 `protobuf:"varint,6,opt,name=soft_limit,json=softLimit,proto3,oneof" json:"soft_limit,omitempty"`                                     // 新软限制
+	PlanCode      string                 `protobuf:"bytes,7,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                               // 切换到的套餐，为空表示限制变更
+	ApplyAt       string                 `protobuf:"bytes,8,opt,name=apply_at,json=applyAt,proto3" json:"apply_at,omitempty"`                                                  // 生效时间
+	Proration     ProrationPolicy        `protobuf:"varint,9,opt,name=proration,proto3,enum=platform.tenant_service.v1.ProrationPolicy" json:"proration,omitempty"`            // 已用量折算策略
+	Status        QuotaChangeStatus      `protobuf:"varint,10,opt,name=status,proto3,enum=platform.tenant_service.v1.QuotaChangeStatus" json:"status,omitempty"`               // 状态
+	Operator      string                 `protobuf:"bytes,11,opt,name=operator,proto3" json:"operator,omitempty"`                                                              // 操作人
+	Remark        string                 `protobuf:"bytes,12,opt,name=remark,proto3" json:"remark,omitempty"`                                                                  // 备注
+	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`                                                                    // 生效失败原因
+	AppliedAt     string                 `protobuf:"bytes,14,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`                                           // 实际生效时间
+	CreatedAt     string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                           // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaChange) Reset() {
+	*x = QuotaChange{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaChange) ProtoMessage() {}
+
+func (x *QuotaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaChange.ProtoReflect.Descriptor instead.
+func (*QuotaChange) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QuotaChange) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *QuotaChange) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaChange) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaChange) GetHardLimit() int32 {
+	if x != nil && x.HardLimit != nil {
+		return *x.HardLimit
+	}
+	return 0
+}
+
+func (x *QuotaChange) GetSoftLimit() int32 {
+	if x != nil && x.SoftLimit != nil {
+		return *x.SoftLimit
+	}
+	return 0
+}
+
+func (x *QuotaChange) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetApplyAt() string {
+	if x != nil {
+		return x.ApplyAt
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetProration() ProrationPolicy {
+	if x != nil {
+		return x.Proration
+	}
+	return ProrationPolicy_PRORATION_POLICY_UNSPECIFIED
+}
+
+func (x *QuotaChange) GetStatus() QuotaChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuotaChangeStatus_QUOTA_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *QuotaChange) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetAppliedAt() string {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return ""
+}
+
+func (x *QuotaChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ScheduleQuotaChangeRequest 计划配额变更请求，plan_code与配额维度二选一
+type ScheduleQuotaChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	HardLimit     *int32                 `protobuf:"varint,4,opt,name=hard_limit,json=hardLimit,proto3,oneof" json:"hard_limit,omitempty"`                                     // 新硬限制，不传表示不修改
+	SoftLimit     *int32                 `protobuf:"varint,5,opt,name=soft_limit,json=softLimit,proto3,oneof" json:"soft_limit,omitempty"`                                     // 新软限制，不传表示不修改
+	PlanCode      string                 `protobuf:"bytes,6,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                               // 切换到的套餐
+	ApplyAt       string                 `protobuf:"bytes,7,opt,name=apply_at,json=applyAt,proto3" json:"apply_at,omitempty"`                                                  // 生效时间RFC3339，为空且未指定at_next_reset时立即生效
+	AtNextReset   bool                   `protobuf:"varint,8,opt,name=at_next_reset,json=atNextReset,proto3" json:"at_next_reset,omitempty"`                                   // 在下次重置时生效，套餐变更为下月1日
+	Proration     ProrationPolicy        `protobuf:"varint,9,opt,name=proration,proto3,enum=platform.tenant_service.v1.ProrationPolicy" json:"proration,omitempty"`            // 已用量折算策略
+	Operator      string                 `protobuf:"bytes,10,opt,name=operator,proto3" json:"operator,omitempty"`                                                              // 操作人
+	Remark        string                 `protobuf:"bytes,11,opt,name=remark,proto3" json:"remark,omitempty"`                                                                  // 备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleQuotaChangeRequest) Reset() {
+	*x = ScheduleQuotaChangeRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleQuotaChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleQuotaChangeRequest) ProtoMessage() {}
+
+func (x *ScheduleQuotaChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleQuotaChangeRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleQuotaChangeRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ScheduleQuotaChangeRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ScheduleQuotaChangeRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduleQuotaChangeRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduleQuotaChangeRequest) GetHardLimit() int32 {
+	if x != nil && x.HardLimit != nil {
+		return *x.HardLimit
+	}
+	return 0
+}
+
+func (x *ScheduleQuotaChangeRequest) GetSoftLimit() int32 {
+	if x != nil && x.SoftLimit != nil {
+		return *x.SoftLimit
+	}
+	return 0
+}
+
+func (x *ScheduleQuotaChangeRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *ScheduleQuotaChangeRequest) GetApplyAt() string {
+	if x != nil {
+		return x.ApplyAt
+	}
+	return ""
+}
+
+func (x *ScheduleQuotaChangeRequest) GetAtNextReset() bool {
+	if x != nil {
+		return x.AtNextReset
+	}
+	return false
+}
+
+func (x *ScheduleQuotaChangeRequest) GetProration() ProrationPolicy {
+	if x != nil {
+		return x.Proration
+	}
+	return ProrationPolicy_PRORATION_POLICY_UNSPECIFIED
+}
+
+func (x *ScheduleQuotaChangeRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ScheduleQuotaChangeRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// ScheduleQuotaChangeReply 计划配额变更响应
+type ScheduleQuotaChangeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *QuotaChange           `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 变更
+	Quotas        []*QuotaInfo           `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"` // 立即生效时变更后的配额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleQuotaChangeReply) Reset() {
+	*x = ScheduleQuotaChangeReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleQuotaChangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleQuotaChangeReply) ProtoMessage() {}
+
+func (x *ScheduleQuotaChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleQuotaChangeReply.ProtoReflect.Descriptor instead.
+func (*ScheduleQuotaChangeReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ScheduleQuotaChangeReply) GetChange() *QuotaChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *ScheduleQuotaChangeReply) GetQuotas() []*QuotaInfo {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// ListQuotaChangesRequest 列出计划配额变更请求
+type ListQuotaChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                // 租户ID
+	Status        QuotaChangeStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=platform.tenant_service.v1.QuotaChangeStatus" json:"status,omitempty"` // 状态，不传表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotaChangesRequest) Reset() {
+	*x = ListQuotaChangesRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotaChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotaChangesRequest) ProtoMessage() {}
+
+func (x *ListQuotaChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotaChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuotaChangesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListQuotaChangesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListQuotaChangesRequest) GetStatus() QuotaChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuotaChangeStatus_QUOTA_CHANGE_STATUS_UNSPECIFIED
+}
+
+// ListQuotaChangesReply 列出计划配额变更响应
+type ListQuotaChangesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*QuotaChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // 变更列表，按创建时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotaChangesReply) Reset() {
+	*x = ListQuotaChangesReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotaChangesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotaChangesReply) ProtoMessage() {}
+
+func (x *ListQuotaChangesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotaChangesReply.ProtoReflect.Descriptor instead.
+func (*ListQuotaChangesReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListQuotaChangesReply) GetChanges() []*QuotaChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// CancelQuotaChangeRequest 取消配额变更请求
+type CancelQuotaChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`  // 租户ID
+	ChangeId      int64                  `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"` // 变更ID
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                  // 操作人
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelQuotaChangeRequest) Reset() {
+	*x = CancelQuotaChangeRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelQuotaChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelQuotaChangeRequest) ProtoMessage() {}
+
+func (x *CancelQuotaChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelQuotaChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelQuotaChangeRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CancelQuotaChangeRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CancelQuotaChangeRequest) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *CancelQuotaChangeRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// CancelQuotaChangeReply 取消配额变更响应
+type CancelQuotaChangeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *QuotaChange           `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 变更
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelQuotaChangeReply) Reset() {
+	*x = CancelQuotaChangeReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelQuotaChangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelQuotaChangeReply) ProtoMessage() {}
+
+func (x *CancelQuotaChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelQuotaChangeReply.ProtoReflect.Descriptor instead.
+func (*CancelQuotaChangeReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CancelQuotaChangeReply) GetChange() *QuotaChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// ImportOptions 导入选项
+type ImportOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        DataFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=platform.tenant_service.v1.DataFormat" json:"format,omitempty"` // 数据格式
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                              // 仅校验不写入
+	Upsert        bool                   `protobuf:"varint,3,opt,name=upsert,proto3" json:"upsert,omitempty"`                                            // 租户已存在时更新，否则报错
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ImportOptions) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DataFormat_DATA_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+// ImportTenantsRequest 批量导入租户请求
+type ImportTenantsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportTenantsRequest_Options
+	//	*ImportTenantsRequest_Chunk
+	Payload       isImportTenantsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTenantsRequest) Reset() {
+	*x = ImportTenantsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantsRequest) ProtoMessage() {}
+
+func (x *ImportTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ImportTenantsRequest) GetPayload() isImportTenantsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportTenantsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTenantsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportTenantsRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTenantsRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportTenantsRequest_Payload interface {
+	isImportTenantsRequest_Payload()
+}
+
+type ImportTenantsRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"` // 导入选项，必须为首个消息
+}
+
+type ImportTenantsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // 文件内容分片
+}
+
+func (*ImportTenantsRequest_Options) isImportTenantsRequest_Payload() {}
+
+func (*ImportTenantsRequest_Chunk) isImportTenantsRequest_Payload() {}
+
+// ImportRowResult 导入行结果
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`                        // 行号
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                     // 动作：CREATE/UPDATE/SKIP
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                       // 错误信息，为空表示成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ImportTenantsReply 批量导入租户响应
+type ImportTenantsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 是否仅校验
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                 // 租户总数
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`             // 新建数
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`             // 更新数
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`               // 失败数
+	Results       []*ImportRowResult     `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`              // 行结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTenantsReply) Reset() {
+	*x = ImportTenantsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantsReply) ProtoMessage() {}
+
+func (x *ImportTenantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantsReply.ProtoReflect.Descriptor instead.
+func (*ImportTenantsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportTenantsReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTenantsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportTenantsReply) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportTenantsReply) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportTenantsReply) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportTenantsReply) GetResults() []*ImportRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ExportTenantsRequest 批量导出租户请求
+type ExportTenantsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Format         DataFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=platform.tenant_service.v1.DataFormat" json:"format,omitempty"`                                     // 数据格式
+	TenantTypes    []TenantType           `protobuf:"varint,2,rep,packed,name=tenant_types,json=tenantTypes,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_types,omitempty"` // 租户类型
+	ParentTenantId string                 `protobuf:"bytes,3,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                                         // 父租户ID
+	Status         *bool                  `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`                                                                          // 状态
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportTenantsRequest) Reset() {
+	*x = ExportTenantsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantsRequest) ProtoMessage() {}
+
+func (x *ExportTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExportTenantsRequest) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DataFormat_DATA_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportTenantsRequest) GetTenantTypes() []TenantType {
+	if x != nil {
+		return x.TenantTypes
+	}
+	return nil
+}
+
+func (x *ExportTenantsRequest) GetParentTenantId() string {
+	if x != nil {
+		return x.ParentTenantId
+	}
+	return ""
+}
+
+func (x *ExportTenantsRequest) GetStatus() bool {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return false
+}
+
+// ExportTenantsReply 批量导出租户响应分片
+type ExportTenantsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // 文件内容分片
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTenantsReply) Reset() {
+	*x = ExportTenantsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTenantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantsReply) ProtoMessage() {}
+
+func (x *ExportTenantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantsReply.ProtoReflect.Descriptor instead.
+func (*ExportTenantsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportTenantsReply) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Wallet 租户预付费钱包，金额单位为最小计价单位
+type Wallet struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TenantId            string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                     // 租户ID
+	Balance             int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`                                                      // 余额
+	LowBalanceThreshold int64                  `protobuf:"varint,3,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"` // 低余额阈值，0表示不告警
+	LowBalance          bool                   `protobuf:"varint,4,opt,name=low_balance,json=lowBalance,proto3" json:"low_balance,omitempty"`                              // 余额是否低于阈值
+	CreatedAt           string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                  // 创建时间
+	UpdatedAt           string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                  // 更新时间
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Wallet) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Wallet) GetLowBalanceThreshold() int64 {
+	if x != nil {
+		return x.LowBalanceThreshold
+	}
+	return 0
+}
+
+func (x *Wallet) GetLowBalance() bool {
+	if x != nil {
+		return x.LowBalance
+	}
+	return false
+}
+
+func (x *Wallet) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Wallet) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// LedgerEntry 复式记账分录
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int64                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`                                      // 分录ID
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`                                                      // 科目：funding、wallet:<租户ID>、revenue:<配额类型>:<产品线>
+	Direction     LedgerDirection        `protobuf:"varint,3,opt,name=direction,proto3,enum=platform.tenant_service.v1.LedgerDirection" json:"direction,omitempty"` // 方向
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                       // 金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *LedgerEntry) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDirection() LedgerDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return LedgerDirection_LEDGER_DIRECTION_UNSPECIFIED
+}
+
+func (x *LedgerEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// WalletTransaction 钱包交易
+type WalletTransaction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TxId           int64                  `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`                                                          // 交易ID
+	TenantId       string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	Type           WalletTransactionType  `protobuf:"varint,3,opt,name=type,proto3,enum=platform.tenant_service.v1.WalletTransactionType" json:"type,omitempty"`                // 交易类型
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 金额
+	BalanceAfter   int64                  `protobuf:"varint,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`                                  // 交易后余额
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                             // 幂等键
+	QuotaType      QuotaType              `protobuf:"varint,7,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 扣费/退款的计费项
+	ProductCode    string                 `protobuf:"bytes,8,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 扣费/退款的产品线
+	Quantity       int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                              // 扣费数量
+	UnitPrice      int64                  `protobuf:"varint,10,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`                                          // 扣费单价
+	RefTxId        int64                  `protobuf:"varint,11,opt,name=ref_tx_id,json=refTxId,proto3" json:"ref_tx_id,omitempty"`                                              // 退款对应的扣费交易ID
+	BizId          string                 `protobuf:"bytes,12,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                       // 业务ID
+	Operator       string                 `protobuf:"bytes,13,opt,name=operator,proto3" json:"operator,omitempty"`                                                              // 操作人
+	Remark         string                 `protobuf:"bytes,14,opt,name=remark,proto3" json:"remark,omitempty"`                                                                  // 备注
+	CreatedAt      string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                           // 创建时间
+	Entries        []*LedgerEntry         `protobuf:"bytes,16,rep,name=entries,proto3" json:"entries,omitempty"`                                                                // 记账分录
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *WalletTransaction) GetTxId() int64 {
+	if x != nil {
+		return x.TxId
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetType() WalletTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return WalletTransactionType_WALLET_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *WalletTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *WalletTransaction) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetRefTxId() int64 {
+	if x != nil {
+		return x.RefTxId
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// GetWalletRequest 获取钱包请求
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetWalletRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// GetWalletReply 获取钱包响应
+type GetWalletReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"` // 钱包
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletReply) Reset() {
+	*x = GetWalletReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletReply) ProtoMessage() {}
+
+func (x *GetWalletReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletReply.ProtoReflect.Descriptor instead.
+func (*GetWalletReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetWalletReply) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// SetWalletThresholdRequest 设置低余额阈值请求
+type SetWalletThresholdRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TenantId            string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                     // 租户ID
+	LowBalanceThreshold int64                  `protobuf:"varint,2,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"` // 低余额阈值，0表示不告警
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetWalletThresholdRequest) Reset() {
+	*x = SetWalletThresholdRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWalletThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWalletThresholdRequest) ProtoMessage() {}
+
+func (x *SetWalletThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWalletThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetWalletThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetWalletThresholdRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SetWalletThresholdRequest) GetLowBalanceThreshold() int64 {
+	if x != nil {
+		return x.LowBalanceThreshold
+	}
+	return 0
+}
+
+// SetWalletThresholdReply 设置低余额阈值响应
+type SetWalletThresholdReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"` // 钱包
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWalletThresholdReply) Reset() {
+	*x = SetWalletThresholdReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWalletThresholdReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWalletThresholdReply) ProtoMessage() {}
+
+func (x *SetWalletThresholdReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWalletThresholdReply.ProtoReflect.Descriptor instead.
+func (*SetWalletThresholdReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetWalletThresholdReply) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// TopUpWalletRequest 钱包充值请求
+type TopUpWalletRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                   // 租户ID
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                      // 充值金额
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键
+	Operator       string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`                                   // 操作人
+	Remark         string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                                       // 备注
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TopUpWalletRequest) Reset() {
+	*x = TopUpWalletRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpWalletRequest) ProtoMessage() {}
+
+func (x *TopUpWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpWalletRequest.ProtoReflect.Descriptor instead.
+func (*TopUpWalletRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TopUpWalletRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopUpWalletRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// TopUpWalletReply 钱包充值响应
+type TopUpWalletReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"` // 交易
+	Wallet        *Wallet                `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`           // 充值后的钱包
+	Replayed      bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`      // 幂等键重放，返回的是已有交易
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpWalletReply) Reset() {
+	*x = TopUpWalletReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpWalletReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpWalletReply) ProtoMessage() {}
+
+func (x *TopUpWalletReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpWalletReply.ProtoReflect.Descriptor instead.
+func (*TopUpWalletReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TopUpWalletReply) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TopUpWalletReply) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *TopUpWalletReply) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// DebitWalletRequest 钱包扣费请求
+type DebitWalletRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType      QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 计费项
+	ProductCode    string                 `protobuf:"bytes,3,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品线，决定单价
+	Quantity       int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                              // 数量
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                             // 幂等键
+	BizId          string                 `protobuf:"bytes,6,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                        // 业务ID
+	Remark         string                 `protobuf:"bytes,7,opt,name=remark,proto3" json:"remark,omitempty"`                                                                   // 备注
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DebitWalletRequest) Reset() {
+	*x = DebitWalletRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitWalletRequest) ProtoMessage() {}
+
+func (x *DebitWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitWalletRequest.ProtoReflect.Descriptor instead.
+func (*DebitWalletRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DebitWalletRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DebitWalletRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *DebitWalletRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *DebitWalletRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DebitWalletRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DebitWalletRequest) GetBizId() string {
+	if x != nil {
+		return x.BizId
+	}
+	return ""
+}
+
+func (x *DebitWalletRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// DebitWalletReply 钱包扣费响应
+type DebitWalletReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`                  // 交易
+	Wallet        *Wallet                `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`                            // 扣费后的钱包
+	Replayed      bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`                       // 幂等键重放，返回的是已有交易
+	LowBalance    bool                   `protobuf:"varint,4,opt,name=low_balance,json=lowBalance,proto3" json:"low_balance,omitempty"` // 扣费后余额是否低于阈值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebitWalletReply) Reset() {
+	*x = DebitWalletReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitWalletReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitWalletReply) ProtoMessage() {}
+
+func (x *DebitWalletReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitWalletReply.ProtoReflect.Descriptor instead.
+func (*DebitWalletReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DebitWalletReply) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *DebitWalletReply) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *DebitWalletReply) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *DebitWalletReply) GetLowBalance() bool {
+	if x != nil {
+		return x.LowBalance
+	}
+	return false
+}
+
+// RefundWalletRequest 钱包退款请求
+type RefundWalletRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                   // 租户ID
+	DebitTxId      int64                  `protobuf:"varint,2,opt,name=debit_tx_id,json=debitTxId,proto3" json:"debit_tx_id,omitempty"`             // 扣费交易ID
+	Amount         int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                      // 退款金额，0表示退回扣费金额
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键
+	Operator       string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`                                   // 操作人
+	Remark         string                 `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark,omitempty"`                                       // 备注
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *TenantInfo) Reset() {
-	*x = TenantInfo{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[0]
+func (x *RefundWalletRequest) Reset() {
+	*x = RefundWalletRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundWalletRequest) ProtoMessage() {}
+
+func (x *RefundWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundWalletRequest.ProtoReflect.Descriptor instead.
+func (*RefundWalletRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RefundWalletRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RefundWalletRequest) GetDebitTxId() int64 {
+	if x != nil {
+		return x.DebitTxId
+	}
+	return 0
+}
+
+func (x *RefundWalletRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundWalletRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RefundWalletRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RefundWalletRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// RefundWalletReply 钱包退款响应
+type RefundWalletReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"` // 交易
+	Wallet        *Wallet                `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`           // 退款后的钱包
+	Replayed      bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`      // 幂等键重放，返回的是已有交易
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundWalletReply) Reset() {
+	*x = RefundWalletReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundWalletReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundWalletReply) ProtoMessage() {}
+
+func (x *RefundWalletReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundWalletReply.ProtoReflect.Descriptor instead.
+func (*RefundWalletReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RefundWalletReply) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *RefundWalletReply) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *RefundWalletReply) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// ListWalletTransactionsRequest 列出钱包交易请求
+type ListWalletTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                // 租户ID
+	Type          WalletTransactionType  `protobuf:"varint,2,opt,name=type,proto3,enum=platform.tenant_service.v1.WalletTransactionType" json:"type,omitempty"` // 交易类型，不传表示全部
+	AfterTxId     int64                  `protobuf:"varint,3,opt,name=after_tx_id,json=afterTxId,proto3" json:"after_tx_id,omitempty"`                          // 只返回交易ID大于该值的交易
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                     // 返回条数，默认100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletTransactionsRequest) Reset() {
+	*x = ListWalletTransactionsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsRequest) ProtoMessage() {}
+
+func (x *ListWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListWalletTransactionsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListWalletTransactionsRequest) GetType() WalletTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return WalletTransactionType_WALLET_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ListWalletTransactionsRequest) GetAfterTxId() int64 {
+	if x != nil {
+		return x.AfterTxId
+	}
+	return 0
+}
+
+func (x *ListWalletTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListWalletTransactionsReply 列出钱包交易响应
+type ListWalletTransactionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*WalletTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // 交易列表，按交易ID升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletTransactionsReply) Reset() {
+	*x = ListWalletTransactionsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletTransactionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsReply) ProtoMessage() {}
+
+func (x *ListWalletTransactionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsReply.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListWalletTransactionsReply) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// QuotaLease 配额租约
+type QuotaLease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`                                                  // 租约ID
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaId       int64                  `protobuf:"varint,3,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                                 // 消费的配额ID
+	QuotaType     QuotaType              `protobuf:"varint,4,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,5,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	ProductCode   string                 `protobuf:"bytes,6,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品线
+	Holder        string                 `protobuf:"bytes,7,opt,name=holder,proto3" json:"holder,omitempty"`                                                                   // 持有方
+	Amount        int32                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 租约额度
+	UsedCount     int32                  `protobuf:"varint,9,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`                                           // 客户端上报的已用量
+	Returned      int32                  `protobuf:"varint,10,opt,name=returned,proto3" json:"returned,omitempty"`                                                             // 关闭时退回配额的数量
+	Status        QuotaLeaseStatus       `protobuf:"varint,11,opt,name=status,proto3,enum=platform.tenant_service.v1.QuotaLeaseStatus" json:"status,omitempty"`                // 状态
+	ExpireTime    string                 `protobuf:"bytes,12,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                                        // 过期时间
+	ClosedAt      string                 `protobuf:"bytes,13,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`                                              // 归还或回收时间，未关闭时为空
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                           // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaLease) Reset() {
+	*x = QuotaLease{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLease) ProtoMessage() {}
+
+func (x *QuotaLease) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLease.ProtoReflect.Descriptor instead.
+func (*QuotaLease) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *QuotaLease) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *QuotaLease) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *QuotaLease) GetQuotaId() int64 {
+	if x != nil {
+		return x.QuotaId
+	}
+	return 0
+}
+
+func (x *QuotaLease) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaLease) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *QuotaLease) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *QuotaLease) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *QuotaLease) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuotaLease) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *QuotaLease) GetReturned() int32 {
+	if x != nil {
+		return x.Returned
+	}
+	return 0
+}
+
+func (x *QuotaLease) GetStatus() QuotaLeaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuotaLeaseStatus_QUOTA_LEASE_STATUS_UNSPECIFIED
+}
+
+func (x *QuotaLease) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+func (x *QuotaLease) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *QuotaLease) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// LeaseQuotaBlockRequest 租用配额请求
+type LeaseQuotaBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
+	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
+	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 租约额度
+	ProductCode   string                 `protobuf:"bytes,5,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
+	Holder        string                 `protobuf:"bytes,6,opt,name=holder,proto3" json:"holder,omitempty"`                                                                   // 持有方，如服务名和实例
+	TtlSeconds    int32                  `protobuf:"varint,7,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`                                        // 有效期秒数，0表示默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseQuotaBlockRequest) Reset() {
+	*x = LeaseQuotaBlockRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseQuotaBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseQuotaBlockRequest) ProtoMessage() {}
+
+func (x *LeaseQuotaBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseQuotaBlockRequest.ProtoReflect.Descriptor instead.
+func (*LeaseQuotaBlockRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LeaseQuotaBlockRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *LeaseQuotaBlockRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *LeaseQuotaBlockRequest) GetLimitType() LimitType {
+	if x != nil {
+		return x.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (x *LeaseQuotaBlockRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LeaseQuotaBlockRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *LeaseQuotaBlockRequest) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *LeaseQuotaBlockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// LeaseQuotaBlockReply 租用配额响应
+type LeaseQuotaBlockReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lease         *QuotaLease            `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"` // 租约
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseQuotaBlockReply) Reset() {
+	*x = LeaseQuotaBlockReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseQuotaBlockReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseQuotaBlockReply) ProtoMessage() {}
+
+func (x *LeaseQuotaBlockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseQuotaBlockReply.ProtoReflect.Descriptor instead.
+func (*LeaseQuotaBlockReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *LeaseQuotaBlockReply) GetLease() *QuotaLease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+// RenewQuotaLeaseRequest 续约请求
+type RenewQuotaLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`           // 租约ID
+	UsedCount     int32                  `protobuf:"varint,2,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`    // 累计已用量
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 从现在起的有效期秒数，0表示默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewQuotaLeaseRequest) Reset() {
+	*x = RenewQuotaLeaseRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewQuotaLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewQuotaLeaseRequest) ProtoMessage() {}
+
+func (x *RenewQuotaLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewQuotaLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewQuotaLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RenewQuotaLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *RenewQuotaLeaseRequest) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *RenewQuotaLeaseRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// RenewQuotaLeaseReply 续约响应
+type RenewQuotaLeaseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lease         *QuotaLease            `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"` // 租约
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewQuotaLeaseReply) Reset() {
+	*x = RenewQuotaLeaseReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewQuotaLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewQuotaLeaseReply) ProtoMessage() {}
+
+func (x *RenewQuotaLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewQuotaLeaseReply.ProtoReflect.Descriptor instead.
+func (*RenewQuotaLeaseReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RenewQuotaLeaseReply) GetLease() *QuotaLease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+// ReturnQuotaLeaseRequest 归还租约请求
+type ReturnQuotaLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`        // 租约ID
+	UsedCount     int32                  `protobuf:"varint,2,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"` // 累计已用量，其余额度退回配额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnQuotaLeaseRequest) Reset() {
+	*x = ReturnQuotaLeaseRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnQuotaLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnQuotaLeaseRequest) ProtoMessage() {}
+
+func (x *ReturnQuotaLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnQuotaLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReturnQuotaLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReturnQuotaLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *ReturnQuotaLeaseRequest) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+// ReturnQuotaLeaseReply 归还租约响应
+type ReturnQuotaLeaseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lease         *QuotaLease            `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"` // 租约
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnQuotaLeaseReply) Reset() {
+	*x = ReturnQuotaLeaseReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnQuotaLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnQuotaLeaseReply) ProtoMessage() {}
+
+func (x *ReturnQuotaLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnQuotaLeaseReply.ProtoReflect.Descriptor instead.
+func (*ReturnQuotaLeaseReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ReturnQuotaLeaseReply) GetLease() *QuotaLease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+// ListQuotaLeasesRequest 列出配额租约请求
+type ListQuotaLeasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
+	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型，不传表示全部
+	Status        QuotaLeaseStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=platform.tenant_service.v1.QuotaLeaseStatus" json:"status,omitempty"`                 // 状态，不传表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotaLeasesRequest) Reset() {
+	*x = ListQuotaLeasesRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotaLeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotaLeasesRequest) ProtoMessage() {}
+
+func (x *ListQuotaLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotaLeasesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuotaLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListQuotaLeasesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListQuotaLeasesRequest) GetQuotaType() QuotaType {
+	if x != nil {
+		return x.QuotaType
+	}
+	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+}
+
+func (x *ListQuotaLeasesRequest) GetStatus() QuotaLeaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuotaLeaseStatus_QUOTA_LEASE_STATUS_UNSPECIFIED
+}
+
+// ListQuotaLeasesReply 列出配额租约响应
+type ListQuotaLeasesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leases        []*QuotaLease          `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"` // 租约列表，按创建时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotaLeasesReply) Reset() {
+	*x = ListQuotaLeasesReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotaLeasesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotaLeasesReply) ProtoMessage() {}
+
+func (x *ListQuotaLeasesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotaLeasesReply.ProtoReflect.Descriptor instead.
+func (*ListQuotaLeasesReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListQuotaLeasesReply) GetLeases() []*QuotaLease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+// TenantMember 租户成员
+type TenantMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                     // 租户ID，继承的成员为所在的父租户
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                           // 用户ID
+	Role          MemberRole             `protobuf:"varint,3,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"` // 角色
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`            // 显示名称
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`                                           // 邮箱
+	Inherit       bool                   `protobuf:"varint,6,opt,name=inherit,proto3" json:"inherit,omitempty"`                                      // 角色是否同时作用于子租户
+	InvitedBy     string                 `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`                  // 邀请人或添加人
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                  // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantMember) Reset() {
+	*x = TenantMember{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantMember) ProtoMessage() {}
+
+func (x *TenantMember) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantMember.ProtoReflect.Descriptor instead.
+func (*TenantMember) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TenantMember) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TenantMember) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *TenantMember) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *TenantMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantMember) GetInherit() bool {
+	if x != nil {
+		return x.Inherit
+	}
+	return false
+}
+
+func (x *TenantMember) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *TenantMember) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TenantMember) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// TenantInvitation 租户邀请
+type TenantInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`                   // 邀请ID
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                               // 租户ID
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                                     // 被邀请人邮箱
+	Role          MemberRole             `protobuf:"varint,4,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"`           // 接受后的角色
+	Inherit       bool                   `protobuf:"varint,5,opt,name=inherit,proto3" json:"inherit,omitempty"`                                                // 角色是否同时作用于子租户
+	Status        InvitationStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=platform.tenant_service.v1.InvitationStatus" json:"status,omitempty"` // 状态
+	InvitedBy     string                 `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`                            // 邀请人
+	ExpireTime    string                 `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                         // 过期时间
+	AcceptedBy    string                 `protobuf:"bytes,9,opt,name=accepted_by,json=acceptedBy,proto3" json:"accepted_by,omitempty"`                         // 接受邀请的用户ID
+	AcceptedAt    string                 `protobuf:"bytes,10,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`                        // 接受时间，未接受时为空
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                           // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantInvitation) Reset() {
+	*x = TenantInvitation{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantInvitation) ProtoMessage() {}
+
+func (x *TenantInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantInvitation.ProtoReflect.Descriptor instead.
+func (*TenantInvitation) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TenantInvitation) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *TenantInvitation) GetInherit() bool {
+	if x != nil {
+		return x.Inherit
+	}
+	return false
+}
+
+func (x *TenantInvitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *TenantInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetAcceptedBy() string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetAcceptedAt() string {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// AddTenantMemberRequest 添加租户成员请求
+type AddTenantMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                     // 租户ID
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                           // 用户ID
+	Role          MemberRole             `protobuf:"varint,3,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"` // 角色
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`            // 显示名称
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`                                           // 邮箱
+	Inherit       bool                   `protobuf:"varint,6,opt,name=inherit,proto3" json:"inherit,omitempty"`                                      // 角色是否同时作用于子租户
+	InvitedBy     string                 `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`                  // 添加人
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTenantMemberRequest) Reset() {
+	*x = AddTenantMemberRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantMemberRequest) ProtoMessage() {}
+
+func (x *AddTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AddTenantMemberRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AddTenantMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddTenantMemberRequest) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *AddTenantMemberRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AddTenantMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddTenantMemberRequest) GetInherit() bool {
+	if x != nil {
+		return x.Inherit
+	}
+	return false
+}
+
+func (x *AddTenantMemberRequest) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+// AddTenantMemberReply 添加租户成员响应
+type AddTenantMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *TenantMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"` // 成员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTenantMemberReply) Reset() {
+	*x = AddTenantMemberReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTenantMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantMemberReply) ProtoMessage() {}
+
+func (x *AddTenantMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantMemberReply.ProtoReflect.Descriptor instead.
+func (*AddTenantMemberReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AddTenantMemberReply) GetMember() *TenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// UpdateTenantMemberRequest 更新租户成员请求
+type UpdateTenantMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                     // 租户ID
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                           // 用户ID
+	Role          MemberRole             `protobuf:"varint,3,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"` // 角色，不传表示不修改
+	Inherit       *bool                  `protobuf:"varint,4,opt,name=inherit,proto3,oneof" json:"inherit,omitempty"`                                // 角色是否同时作用于子租户，不传表示不修改
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantMemberRequest) Reset() {
+	*x = UpdateTenantMemberRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantMemberRequest) ProtoMessage() {}
+
+func (x *UpdateTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateTenantMemberRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTenantMemberRequest) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *UpdateTenantMemberRequest) GetInherit() bool {
+	if x != nil && x.Inherit != nil {
+		return *x.Inherit
+	}
+	return false
+}
+
+// UpdateTenantMemberReply 更新租户成员响应
+type UpdateTenantMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *TenantMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"` // 成员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantMemberReply) Reset() {
+	*x = UpdateTenantMemberReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantMemberReply) ProtoMessage() {}
+
+func (x *UpdateTenantMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantMemberReply.ProtoReflect.Descriptor instead.
+func (*UpdateTenantMemberReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateTenantMemberReply) GetMember() *TenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// RemoveTenantMemberRequest 移除租户成员请求
+type RemoveTenantMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTenantMemberRequest) Reset() {
+	*x = RemoveTenantMemberRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RemoveTenantMemberRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RemoveTenantMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RemoveTenantMemberReply 移除租户成员响应
+type RemoveTenantMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTenantMemberReply) Reset() {
+	*x = RemoveTenantMemberReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantMemberReply) ProtoMessage() {}
+
+func (x *RemoveTenantMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantMemberReply.ProtoReflect.Descriptor instead.
+func (*RemoveTenantMemberReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RemoveTenantMemberReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListTenantMembersRequest 列出租户成员请求
+type ListTenantMembersRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                          // 租户ID
+	Role             MemberRole             `protobuf:"varint,2,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"`      // 角色，不传表示全部
+	IncludeInherited bool                   `protobuf:"varint,3,opt,name=include_inherited,json=includeInherited,proto3" json:"include_inherited,omitempty"` // 同时返回父租户中作用于子租户的成员
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersRequest) Reset() {
+	*x = ListTenantMembersRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersRequest) ProtoMessage() {}
+
+func (x *ListTenantMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListTenantMembersRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListTenantMembersRequest) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *ListTenantMembersRequest) GetIncludeInherited() bool {
+	if x != nil {
+		return x.IncludeInherited
+	}
+	return false
+}
+
+// ListTenantMembersReply 列出租户成员响应
+type ListTenantMembersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*TenantMember        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // 成员列表，租户自身的成员在前
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersReply) Reset() {
+	*x = ListTenantMembersReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersReply) ProtoMessage() {}
+
+func (x *ListTenantMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersReply.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListTenantMembersReply) GetMembers() []*TenantMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// CreateTenantInvitationRequest 邀请用户加入租户请求
+type CreateTenantInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                     // 租户ID
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                           // 被邀请人邮箱
+	Role          MemberRole             `protobuf:"varint,3,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"` // 接受后的角色
+	Inherit       bool                   `protobuf:"varint,4,opt,name=inherit,proto3" json:"inherit,omitempty"`                                      // 角色是否同时作用于子租户
+	InvitedBy     string                 `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`                  // 邀请人
+	TtlSeconds    int32                  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`              // 有效期秒数，0表示默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantInvitationRequest) Reset() {
+	*x = CreateTenantInvitationRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantInvitationRequest) ProtoMessage() {}
+
+func (x *CreateTenantInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateTenantInvitationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateTenantInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateTenantInvitationRequest) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *CreateTenantInvitationRequest) GetInherit() bool {
+	if x != nil {
+		return x.Inherit
+	}
+	return false
+}
+
+func (x *CreateTenantInvitationRequest) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *CreateTenantInvitationRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// CreateTenantInvitationReply 邀请用户加入租户响应
+type CreateTenantInvitationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *TenantInvitation      `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"` // 邀请
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`           // 邀请令牌，仅在此返回一次
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantInvitationReply) Reset() {
+	*x = CreateTenantInvitationReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantInvitationReply) ProtoMessage() {}
+
+func (x *CreateTenantInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantInvitationReply.ProtoReflect.Descriptor instead.
+func (*CreateTenantInvitationReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateTenantInvitationReply) GetInvitation() *TenantInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *CreateTenantInvitationReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AcceptTenantInvitationRequest 接受邀请请求
+type AcceptTenantInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // 邀请令牌
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 接受邀请的用户ID
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 显示名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTenantInvitationRequest) Reset() {
+	*x = AcceptTenantInvitationRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTenantInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTenantInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptTenantInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTenantInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTenantInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AcceptTenantInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptTenantInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptTenantInvitationRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// AcceptTenantInvitationReply 接受邀请响应
+type AcceptTenantInvitationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *TenantMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"` // 加入后的成员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTenantInvitationReply) Reset() {
+	*x = AcceptTenantInvitationReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTenantInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTenantInvitationReply) ProtoMessage() {}
+
+func (x *AcceptTenantInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTenantInvitationReply.ProtoReflect.Descriptor instead.
+func (*AcceptTenantInvitationReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AcceptTenantInvitationReply) GetMember() *TenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// RevokeTenantInvitationRequest 撤销邀请请求
+type RevokeTenantInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"` // 邀请ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTenantInvitationRequest) Reset() {
+	*x = RevokeTenantInvitationRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTenantInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTenantInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeTenantInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTenantInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTenantInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RevokeTenantInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// RevokeTenantInvitationReply 撤销邀请响应
+type RevokeTenantInvitationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *TenantInvitation      `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"` // 邀请
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTenantInvitationReply) Reset() {
+	*x = RevokeTenantInvitationReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTenantInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTenantInvitationReply) ProtoMessage() {}
+
+func (x *RevokeTenantInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTenantInvitationReply.ProtoReflect.Descriptor instead.
+func (*RevokeTenantInvitationReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RevokeTenantInvitationReply) GetInvitation() *TenantInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// ListTenantInvitationsRequest 列出租户邀请请求
+type ListTenantInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                               // 租户ID
+	Status        InvitationStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=platform.tenant_service.v1.InvitationStatus" json:"status,omitempty"` // 状态，不传表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantInvitationsRequest) Reset() {
+	*x = ListTenantInvitationsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantInvitationsRequest) ProtoMessage() {}
+
+func (x *ListTenantInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListTenantInvitationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListTenantInvitationsRequest) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+}
+
+// ListTenantInvitationsReply 列出租户邀请响应
+type ListTenantInvitationsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*TenantInvitation    `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"` // 邀请列表，按创建时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantInvitationsReply) Reset() {
+	*x = ListTenantInvitationsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantInfo) String() string {
+func (x *ListTenantInvitationsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantInfo) ProtoMessage() {}
+func (*ListTenantInvitationsReply) ProtoMessage() {}
 
-func (x *TenantInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[0]
+func (x *ListTenantInvitationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,102 +9017,189 @@ func (x *TenantInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantInfo.ProtoReflect.Descriptor instead.
-func (*TenantInfo) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListTenantInvitationsReply.ProtoReflect.Descriptor instead.
+func (*ListTenantInvitationsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *TenantInfo) GetTenantId() string {
+func (x *ListTenantInvitationsReply) GetInvitations() []*TenantInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// CheckPermissionRequest 权限检查请求
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`             // 权限，如 quota.adjust
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CheckPermissionRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *TenantInfo) GetTenantName() string {
+func (x *CheckPermissionRequest) GetUserId() string {
 	if x != nil {
-		return x.TenantName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *TenantInfo) GetTenantType() TenantType {
+func (x *CheckPermissionRequest) GetPermission() string {
 	if x != nil {
-		return x.TenantType
+		return x.Permission
 	}
-	return TenantType_TENANT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *TenantInfo) GetParentTenantId() string {
+// CheckPermissionReply 权限检查响应
+type CheckPermissionReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Allowed        bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`                                      // 是否允许
+	Role           MemberRole             `protobuf:"varint,2,opt,name=role,proto3,enum=platform.tenant_service.v1.MemberRole" json:"role,omitempty"` // 生效的角色，非成员时为空
+	SourceTenantId string                 `protobuf:"bytes,3,opt,name=source_tenant_id,json=sourceTenantId,proto3" json:"source_tenant_id,omitempty"` // 角色所在的租户，继承时为父租户
+	Inherited      bool                   `protobuf:"varint,4,opt,name=inherited,proto3" json:"inherited,omitempty"`                                  // 角色是否继承自父租户
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                         // 不允许的原因
+	Permissions    []string               `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`                               // 生效角色拥有的全部权限
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckPermissionReply) Reset() {
+	*x = CheckPermissionReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionReply) ProtoMessage() {}
+
+func (x *CheckPermissionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[117]
 	if x != nil {
-		return x.ParentTenantId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TenantInfo) GetStatus() bool {
+// Deprecated: Use CheckPermissionReply.ProtoReflect.Descriptor instead.
+func (*CheckPermissionReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CheckPermissionReply) GetAllowed() bool {
 	if x != nil {
-		return x.Status
+		return x.Allowed
 	}
 	return false
 }
 
-func (x *TenantInfo) GetQuotaConfig() map[string]string {
+func (x *CheckPermissionReply) GetRole() MemberRole {
 	if x != nil {
-		return x.QuotaConfig
+		return x.Role
 	}
-	return nil
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
 }
 
-func (x *TenantInfo) GetCreatedAt() string {
+func (x *CheckPermissionReply) GetSourceTenantId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.SourceTenantId
 	}
 	return ""
 }
 
-func (x *TenantInfo) GetUpdatedAt() string {
+func (x *CheckPermissionReply) GetInherited() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Inherited
+	}
+	return false
+}
+
+func (x *CheckPermissionReply) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-// QuotaInfo 配额信息
-type QuotaInfo struct {
+func (x *CheckPermissionReply) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// PlanEntitlement 套餐中的授权项
+type PlanEntitlement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QuotaId       int64                  `protobuf:"varint,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                                 // 配额ID
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
-	QuotaType     QuotaType              `protobuf:"varint,3,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
-	LimitType     LimitType              `protobuf:"varint,4,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
-	HardLimit     int32                  `protobuf:"varint,5,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"`                                           // 硬限制
-	SoftLimit     int32                  `protobuf:"varint,6,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`                                           // 软限制
-	UsedCount     int32                  `protobuf:"varint,7,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`                                           // 已使用数量
-	ResetTime     string                 `protobuf:"bytes,8,opt,name=reset_time,json=resetTime,proto3" json:"reset_time,omitempty"`                                            // 重置时间
-	NextResetTime string                 `protobuf:"bytes,9,opt,name=next_reset_time,json=nextResetTime,proto3" json:"next_reset_time,omitempty"`                              // 下次重置时间
-	EffectiveTime string                 `protobuf:"bytes,10,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`                               // 生效时间
-	ExpireTime    string                 `protobuf:"bytes,11,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                                        // 过期时间
-	IsGlobal      bool                   `protobuf:"varint,12,opt,name=is_global,json=isGlobal,proto3" json:"is_global,omitempty"`                                             // 是否全局
-	ProductCodes  []string               `protobuf:"bytes,13,rep,name=product_codes,json=productCodes,proto3" json:"product_codes,omitempty"`                                  // 产品代码列表
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                    // 授权项键
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品线，为空表示全部产品线
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                                // 取值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuotaInfo) Reset() {
-	*x = QuotaInfo{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[1]
+func (x *PlanEntitlement) Reset() {
+	*x = PlanEntitlement{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuotaInfo) String() string {
+func (x *PlanEntitlement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuotaInfo) ProtoMessage() {}
+func (*PlanEntitlement) ProtoMessage() {}
 
-func (x *QuotaInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[1]
+func (x *PlanEntitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,127 +9210,166 @@ func (x *QuotaInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuotaInfo.ProtoReflect.Descriptor instead.
-func (*QuotaInfo) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PlanEntitlement.ProtoReflect.Descriptor instead.
+func (*PlanEntitlement) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *QuotaInfo) GetQuotaId() int64 {
+func (x *PlanEntitlement) GetKey() string {
 	if x != nil {
-		return x.QuotaId
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *QuotaInfo) GetTenantId() string {
+func (x *PlanEntitlement) GetProductCode() string {
 	if x != nil {
-		return x.TenantId
+		return x.ProductCode
 	}
 	return ""
 }
 
-func (x *QuotaInfo) GetQuotaType() QuotaType {
+func (x *PlanEntitlement) GetValue() string {
 	if x != nil {
-		return x.QuotaType
+		return x.Value
 	}
-	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *QuotaInfo) GetLimitType() LimitType {
+// Entitlement 租户生效的授权项
+type Entitlement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                          // 授权项键
+	Value          string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                                                      // 取值
+	ProductCode    string                 `protobuf:"bytes,3,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                       // 授权记录的产品线，为空表示全部产品线
+	Source         EntitlementSource      `protobuf:"varint,4,opt,name=source,proto3,enum=platform.tenant_service.v1.EntitlementSource" json:"source,omitempty"` // 取值来源
+	SourceTenantId string                 `protobuf:"bytes,5,opt,name=source_tenant_id,json=sourceTenantId,proto3" json:"source_tenant_id,omitempty"`            // 授权记录所在的租户，继承时为父租户
+	Inherited      bool                   `protobuf:"varint,6,opt,name=inherited,proto3" json:"inherited,omitempty"`                                             // 是否继承自父租户
+	PlanCode       string                 `protobuf:"bytes,7,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                // 来自套餐时为套餐编码
+	UpdatedBy      string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`                             // 操作人
+	UpdatedAt      string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                             // 更新时间，默认值时为空
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Entitlement) Reset() {
+	*x = Entitlement{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entitlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entitlement) ProtoMessage() {}
+
+func (x *Entitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[119]
 	if x != nil {
-		return x.LimitType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return LimitType_LIMIT_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *QuotaInfo) GetHardLimit() int32 {
+// Deprecated: Use Entitlement.ProtoReflect.Descriptor instead.
+func (*Entitlement) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *Entitlement) GetKey() string {
 	if x != nil {
-		return x.HardLimit
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *QuotaInfo) GetSoftLimit() int32 {
+func (x *Entitlement) GetValue() string {
 	if x != nil {
-		return x.SoftLimit
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-func (x *QuotaInfo) GetUsedCount() int32 {
+func (x *Entitlement) GetProductCode() string {
 	if x != nil {
-		return x.UsedCount
+		return x.ProductCode
 	}
-	return 0
+	return ""
 }
 
-func (x *QuotaInfo) GetResetTime() string {
+func (x *Entitlement) GetSource() EntitlementSource {
 	if x != nil {
-		return x.ResetTime
+		return x.Source
 	}
-	return ""
+	return EntitlementSource_ENTITLEMENT_SOURCE_UNSPECIFIED
 }
 
-func (x *QuotaInfo) GetNextResetTime() string {
+func (x *Entitlement) GetSourceTenantId() string {
 	if x != nil {
-		return x.NextResetTime
+		return x.SourceTenantId
 	}
 	return ""
 }
 
-func (x *QuotaInfo) GetEffectiveTime() string {
+func (x *Entitlement) GetInherited() bool {
 	if x != nil {
-		return x.EffectiveTime
+		return x.Inherited
 	}
-	return ""
+	return false
 }
 
-func (x *QuotaInfo) GetExpireTime() string {
+func (x *Entitlement) GetPlanCode() string {
 	if x != nil {
-		return x.ExpireTime
+		return x.PlanCode
 	}
 	return ""
 }
 
-func (x *QuotaInfo) GetIsGlobal() bool {
+func (x *Entitlement) GetUpdatedBy() string {
 	if x != nil {
-		return x.IsGlobal
+		return x.UpdatedBy
 	}
-	return false
+	return ""
 }
 
-func (x *QuotaInfo) GetProductCodes() []string {
+func (x *Entitlement) GetUpdatedAt() string {
 	if x != nil {
-		return x.ProductCodes
+		return x.UpdatedAt
 	}
-	return nil
+	return ""
 }
 
-// Product 产品信息
-type Product struct {
+// GetEntitlementsRequest 获取租户授权项请求
+type GetEntitlementsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品代码
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 产品名称
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                    // 描述
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`          // 租户ID
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品线，为空时只计算适用全部产品线的授权记录
+	Keys          []string               `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`                                  // 授权项键，为空表示全部
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[2]
+func (x *GetEntitlementsRequest) Reset() {
+	*x = GetEntitlementsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *GetEntitlementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*GetEntitlementsRequest) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[2]
+func (x *GetEntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,58 +9380,105 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *Product) GetProductCode() string {
+func (x *GetEntitlementsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetEntitlementsRequest) GetProductCode() string {
 	if x != nil {
 		return x.ProductCode
 	}
-	return ""
+	return ""
+}
+
+func (x *GetEntitlementsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// GetEntitlementsReply 获取租户授权项响应
+type GetEntitlementsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entitlements  []*Entitlement         `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements,omitempty"` // 生效的授权项，按键排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntitlementsReply) Reset() {
+	*x = GetEntitlementsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntitlementsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitlementsReply) ProtoMessage() {}
+
+func (x *GetEntitlementsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Product) GetProductName() string {
-	if x != nil {
-		return x.ProductName
-	}
-	return ""
+// Deprecated: Use GetEntitlementsReply.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *Product) GetDescription() string {
+func (x *GetEntitlementsReply) GetEntitlements() []*Entitlement {
 	if x != nil {
-		return x.Description
+		return x.Entitlements
 	}
-	return ""
+	return nil
 }
 
-// CreateTenantRequest 创建租户请求
-type CreateTenantRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TenantName     string                 `protobuf:"bytes,1,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                                                                              // 租户名称
-	TenantType     TenantType             `protobuf:"varint,2,opt,name=tenant_type,json=tenantType,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_type,omitempty"`                                  // 租户类型
-	ParentTenantId string                 `protobuf:"bytes,3,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                                                                // 父租户ID
-	QuotaConfig    map[string]string      `protobuf:"bytes,4,rep,name=quota_config,json=quotaConfig,proto3" json:"quota_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 配额配置
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// SetEntitlementRequest 设置租户授权项请求
+type SetEntitlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`          // 租户ID
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                                    // 授权项键
+	ProductCode   string                 `protobuf:"bytes,3,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品线，为空表示全部产品线
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`                                // 取值，unset为true时忽略
+	Unset         bool                   `protobuf:"varint,5,opt,name=unset,proto3" json:"unset,omitempty"`                               // 删除单独授予的记录，恢复为继承、套餐或默认值
+	Operator      string                 `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`                          // 操作人
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantRequest) Reset() {
-	*x = CreateTenantRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[3]
+func (x *SetEntitlementRequest) Reset() {
+	*x = SetEntitlementRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTenantRequest) String() string {
+func (x *SetEntitlementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantRequest) ProtoMessage() {}
+func (*SetEntitlementRequest) ProtoMessage() {}
 
-func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[3]
+func (x *SetEntitlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,62 +9489,76 @@ func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
-func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SetEntitlementRequest.ProtoReflect.Descriptor instead.
+func (*SetEntitlementRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *CreateTenantRequest) GetTenantName() string {
+func (x *SetEntitlementRequest) GetTenantId() string {
 	if x != nil {
-		return x.TenantName
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *CreateTenantRequest) GetTenantType() TenantType {
+func (x *SetEntitlementRequest) GetKey() string {
 	if x != nil {
-		return x.TenantType
+		return x.Key
 	}
-	return TenantType_TENANT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateTenantRequest) GetParentTenantId() string {
+func (x *SetEntitlementRequest) GetProductCode() string {
 	if x != nil {
-		return x.ParentTenantId
+		return x.ProductCode
 	}
 	return ""
 }
 
-func (x *CreateTenantRequest) GetQuotaConfig() map[string]string {
+func (x *SetEntitlementRequest) GetValue() string {
 	if x != nil {
-		return x.QuotaConfig
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-// CreateTenantReply 创建租户响应
-type CreateTenantReply struct {
+func (x *SetEntitlementRequest) GetUnset() bool {
+	if x != nil {
+		return x.Unset
+	}
+	return false
+}
+
+func (x *SetEntitlementRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// SetEntitlementReply 设置租户授权项响应
+type SetEntitlementReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenant        *TenantInfo            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 租户信息
+	Entitlement   *Entitlement           `protobuf:"bytes,1,opt,name=entitlement,proto3" json:"entitlement,omitempty"` // 设置后生效的授权项
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantReply) Reset() {
-	*x = CreateTenantReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[4]
+func (x *SetEntitlementReply) Reset() {
+	*x = SetEntitlementReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTenantReply) String() string {
+func (x *SetEntitlementReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantReply) ProtoMessage() {}
+func (*SetEntitlementReply) ProtoMessage() {}
 
-func (x *CreateTenantReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[4]
+func (x *SetEntitlementReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,41 +9569,43 @@ func (x *CreateTenantReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantReply.ProtoReflect.Descriptor instead.
-func (*CreateTenantReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SetEntitlementReply.ProtoReflect.Descriptor instead.
+func (*SetEntitlementReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *CreateTenantReply) GetTenant() *TenantInfo {
+func (x *SetEntitlementReply) GetEntitlement() *Entitlement {
 	if x != nil {
-		return x.Tenant
+		return x.Entitlement
 	}
 	return nil
 }
 
-// GetTenantRequest 获取租户请求
-type GetTenantRequest struct {
+// AuditChange 审计事件的字段变更
+type AuditChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`   // 字段，快照不是对象时为空
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // 变更前的值（JSON），新增时为空
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`   // 变更后的值（JSON），删除时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTenantRequest) Reset() {
-	*x = GetTenantRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[5]
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTenantRequest) String() string {
+func (x *AuditChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantRequest) ProtoMessage() {}
+func (*AuditChange) ProtoMessage() {}
 
-func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[5]
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,41 +9616,67 @@ func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
-func (*GetTenantRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GetTenantRequest) GetTenantId() string {
+func (x *AuditChange) GetField() string {
 	if x != nil {
-		return x.TenantId
+		return x.Field
 	}
 	return ""
 }
 
-// GetTenantReply 获取租户响应
-type GetTenantReply struct {
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// AuditEvent 审计事件
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenant        *TenantInfo            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 租户信息
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`         // 事件ID，递增
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`                             // 操作人，无操作人时为system
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                           // 动作，如tenant.update
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // 对象类型
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`       // 对象ID
+	TenantId      string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`       // 所属租户，套餐等全局对象为空
+	Before        string                 `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`                           // 变更前的快照（JSON），新建时为空
+	After         string                 `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`                             // 变更后的快照（JSON），删除时为空
+	Changes       []*AuditChange         `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`                         // 字段变更
+	RequestId     string                 `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`   // 请求ID
+	SourceIp      string                 `protobuf:"bytes,11,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`      // 来源IP
+	Remark        string                 `protobuf:"bytes,12,opt,name=remark,proto3" json:"remark,omitempty"`                          // 备注
+	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // 记录时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTenantReply) Reset() {
-	*x = GetTenantReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[6]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTenantReply) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantReply) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *GetTenantReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[6]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,119 +9687,133 @@ func (x *GetTenantReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantReply.ProtoReflect.Descriptor instead.
-func (*GetTenantReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetTenantReply) GetTenant() *TenantInfo {
+func (x *AuditEvent) GetEventId() int64 {
 	if x != nil {
-		return x.Tenant
+		return x.EventId
 	}
-	return nil
+	return 0
 }
 
-// ListTenantsRequest 列出租户请求
-type ListTenantsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TenantType     TenantType             `protobuf:"varint,1,opt,name=tenant_type,json=tenantType,proto3,enum=platform.tenant_service.v1.TenantType" json:"tenant_type,omitempty"` // 租户类型
-	ParentTenantId string                 `protobuf:"bytes,2,opt,name=parent_tenant_id,json=parentTenantId,proto3" json:"parent_tenant_id,omitempty"`                               // 父租户ID
-	Status         bool                   `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                                                                      // 状态
-	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                  // 页大小
-	PageNum        int32                  `protobuf:"varint,5,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`                                                     // 页码
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
 }
 
-func (x *ListTenantsRequest) Reset() {
-	*x = ListTenantsRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *ListTenantsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
 }
 
-func (*ListTenantsRequest) ProtoMessage() {}
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
 
-func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[7]
+func (x *AuditEvent) GetTenantId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TenantId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
-func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{7}
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
 }
 
-func (x *ListTenantsRequest) GetTenantType() TenantType {
+func (x *AuditEvent) GetAfter() string {
 	if x != nil {
-		return x.TenantType
+		return x.After
 	}
-	return TenantType_TENANT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ListTenantsRequest) GetParentTenantId() string {
+func (x *AuditEvent) GetChanges() []*AuditChange {
 	if x != nil {
-		return x.ParentTenantId
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ListTenantsRequest) GetStatus() bool {
+func (x *AuditEvent) GetSourceIp() string {
 	if x != nil {
-		return x.Status
+		return x.SourceIp
 	}
-	return false
+	return ""
 }
 
-func (x *ListTenantsRequest) GetPageSize() int32 {
+func (x *AuditEvent) GetRemark() string {
 	if x != nil {
-		return x.PageSize
+		return x.Remark
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTenantsRequest) GetPageNum() int32 {
+func (x *AuditEvent) GetCreatedAt() string {
 	if x != nil {
-		return x.PageNum
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-// ListTenantsReply 列出租户响应
-type ListTenantsReply struct {
+// ListAuditEventsRequest 查询审计事件请求
+type ListAuditEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenants       []*TenantInfo          `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"` // 租户列表
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`    // 总数
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                // 所属租户，不传表示全部
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`                                      // 操作人
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                                    // 动作
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`          // 对象类型
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                // 对象ID
+	StartTime     string                 `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`             // 记录时间起（RFC3339，含）
+	EndTime       string                 `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                   // 记录时间止（RFC3339，不含）
+	AfterEventId  int64                  `protobuf:"varint,8,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"` // 只返回事件ID大于该值的事件，用于增量拉取
+	Limit         int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`                                     // 返回条数，默认100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTenantsReply) Reset() {
-	*x = ListTenantsReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[8]
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTenantsReply) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTenantsReply) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *ListTenantsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[8]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,117 +9824,97 @@ func (x *ListTenantsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTenantsReply.ProtoReflect.Descriptor instead.
-func (*ListTenantsReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *ListTenantsReply) GetTenants() []*TenantInfo {
+func (x *ListAuditEventsRequest) GetTenantId() string {
 	if x != nil {
-		return x.Tenants
+		return x.TenantId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListTenantsReply) GetTotal() int32 {
+func (x *ListAuditEventsRequest) GetActor() string {
 	if x != nil {
-		return x.Total
+		return x.Actor
 	}
-	return 0
-}
-
-// UpdateTenantRequest 更新租户请求
-type UpdateTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                                                                    // 租户ID
-	TenantName    string                 `protobuf:"bytes,2,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                                                                              // 租户名称
-	Status        bool                   `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                                                                                                       // 状态
-	QuotaConfig   map[string]string      `protobuf:"bytes,4,rep,name=quota_config,json=quotaConfig,proto3" json:"quota_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 配额配置
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateTenantRequest) Reset() {
-	*x = UpdateTenantRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *UpdateTenantRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (*UpdateTenantRequest) ProtoMessage() {}
-
-func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[9]
+func (x *ListAuditEventsRequest) GetTargetType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TargetType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{9}
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
 }
 
-func (x *UpdateTenantRequest) GetTenantId() string {
+func (x *ListAuditEventsRequest) GetStartTime() string {
 	if x != nil {
-		return x.TenantId
+		return x.StartTime
 	}
 	return ""
 }
 
-func (x *UpdateTenantRequest) GetTenantName() string {
+func (x *ListAuditEventsRequest) GetEndTime() string {
 	if x != nil {
-		return x.TenantName
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *UpdateTenantRequest) GetStatus() bool {
+func (x *ListAuditEventsRequest) GetAfterEventId() int64 {
 	if x != nil {
-		return x.Status
+		return x.AfterEventId
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateTenantRequest) GetQuotaConfig() map[string]string {
+func (x *ListAuditEventsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.QuotaConfig
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// UpdateTenantReply 更新租户响应
-type UpdateTenantReply struct {
+// ListAuditEventsReply 查询审计事件响应
+type ListAuditEventsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenant        *TenantInfo            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 租户信息
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // 审计事件，按事件ID升序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTenantReply) Reset() {
-	*x = UpdateTenantReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[10]
+func (x *ListAuditEventsReply) Reset() {
+	*x = ListAuditEventsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTenantReply) String() string {
+func (x *ListAuditEventsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTenantReply) ProtoMessage() {}
+func (*ListAuditEventsReply) ProtoMessage() {}
 
-func (x *UpdateTenantReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[10]
+func (x *ListAuditEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,41 +9925,48 @@ func (x *UpdateTenantReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTenantReply.ProtoReflect.Descriptor instead.
-func (*UpdateTenantReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListAuditEventsReply.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *UpdateTenantReply) GetTenant() *TenantInfo {
+func (x *ListAuditEventsReply) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Tenant
+		return x.Events
 	}
 	return nil
 }
 
-// DeleteTenantRequest 删除租户请求
-type DeleteTenantRequest struct {
+// ExportAuditEventsRequest 导出审计事件请求，过滤条件同ListAuditEventsRequest
+type ExportAuditEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	Format        DataFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=platform.tenant_service.v1.DataFormat" json:"format,omitempty"` // 数据格式
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                         // 所属租户
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                                               // 操作人
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                                             // 动作
+	TargetType    string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`                   // 对象类型
+	TargetId      string                 `protobuf:"bytes,6,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                         // 对象ID
+	StartTime     string                 `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                      // 记录时间起（RFC3339，含）
+	EndTime       string                 `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                            // 记录时间止（RFC3339，不含）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTenantRequest) Reset() {
-	*x = DeleteTenantRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[11]
+func (x *ExportAuditEventsRequest) Reset() {
+	*x = ExportAuditEventsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTenantRequest) String() string {
+func (x *ExportAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantRequest) ProtoMessage() {}
+func (*ExportAuditEventsRequest) ProtoMessage() {}
 
-func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[11]
+func (x *ExportAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,41 +9977,90 @@ func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ExportAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *DeleteTenantRequest) GetTenantId() string {
+func (x *ExportAuditEventsRequest) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DataFormat_DATA_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportAuditEventsRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-// DeleteTenantReply 删除租户响应
-type DeleteTenantReply struct {
+func (x *ExportAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// ExportAuditEventsReply 导出审计事件响应分片
+type ExportAuditEventsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // 文件内容分片
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTenantReply) Reset() {
-	*x = DeleteTenantReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[12]
+func (x *ExportAuditEventsReply) Reset() {
+	*x = ExportAuditEventsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTenantReply) String() string {
+func (x *ExportAuditEventsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantReply) ProtoMessage() {}
+func (*ExportAuditEventsReply) ProtoMessage() {}
 
-func (x *DeleteTenantReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[12]
+func (x *ExportAuditEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,44 +10071,44 @@ func (x *DeleteTenantReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantReply.ProtoReflect.Descriptor instead.
-func (*DeleteTenantReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExportAuditEventsReply.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *DeleteTenantReply) GetSuccess() bool {
+func (x *ExportAuditEventsReply) GetChunk() []byte {
 	if x != nil {
-		return x.Success
+		return x.Chunk
 	}
-	return false
+	return nil
 }
 
-// CheckQuotaRequest 检查配额请求
-type CheckQuotaRequest struct {
+// LedgerIssue 哈希链校验发现的问题
+type LedgerIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
-	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
-	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
-	ProductCode   string                 `protobuf:"bytes,4,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
+	Kind          LedgerIssueKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=platform.tenant_service.v1.LedgerIssueKind" json:"kind,omitempty"` // 问题类型
+	ChainSeq      int64                  `protobuf:"varint,2,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`                         // 相关的链序号
+	RecordId      int64                  `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`                         // 相关的记录ID，记录不存在时为0
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`                                              // 说明
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckQuotaRequest) Reset() {
-	*x = CheckQuotaRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[13]
+func (x *LedgerIssue) Reset() {
+	*x = LedgerIssue{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckQuotaRequest) String() string {
+func (x *LedgerIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckQuotaRequest) ProtoMessage() {}
+func (*LedgerIssue) ProtoMessage() {}
 
-func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[13]
+func (x *LedgerIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,64 +10119,67 @@ func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
-func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use LedgerIssue.ProtoReflect.Descriptor instead.
+func (*LedgerIssue) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *CheckQuotaRequest) GetTenantId() string {
+func (x *LedgerIssue) GetKind() LedgerIssueKind {
 	if x != nil {
-		return x.TenantId
+		return x.Kind
 	}
-	return ""
+	return LedgerIssueKind_LEDGER_ISSUE_KIND_UNSPECIFIED
 }
 
-func (x *CheckQuotaRequest) GetQuotaType() QuotaType {
+func (x *LedgerIssue) GetChainSeq() int64 {
 	if x != nil {
-		return x.QuotaType
+		return x.ChainSeq
 	}
-	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *CheckQuotaRequest) GetLimitType() LimitType {
+func (x *LedgerIssue) GetRecordId() int64 {
 	if x != nil {
-		return x.LimitType
+		return x.RecordId
 	}
-	return LimitType_LIMIT_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *CheckQuotaRequest) GetProductCode() string {
+func (x *LedgerIssue) GetDetail() string {
 	if x != nil {
-		return x.ProductCode
+		return x.Detail
 	}
 	return ""
 }
 
-// CheckQuotaReply 检查配额响应
-type CheckQuotaReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Quota          *QuotaInfo             `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`                                          // 配额信息
-	HasQuota       bool                   `protobuf:"varint,2,opt,name=has_quota,json=hasQuota,proto3" json:"has_quota,omitempty"`                   // 是否有配额
-	AvailableQuota int32                  `protobuf:"varint,3,opt,name=available_quota,json=availableQuota,proto3" json:"available_quota,omitempty"` // 可用配额
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// LedgerVerification 单个配额的哈希链校验结果
+type LedgerVerification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaId       int64                  `protobuf:"varint,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`   // 配额ID
+	Records       int32                  `protobuf:"varint,2,opt,name=records,proto3" json:"records,omitempty"`                  // 校验的记录数
+	HeadSeq       int64                  `protobuf:"varint,3,opt,name=head_seq,json=headSeq,proto3" json:"head_seq,omitempty"`   // 校验时的链头序号
+	HeadHash      string                 `protobuf:"bytes,4,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"` // 校验时的链头哈希
+	Checkpoints   int32                  `protobuf:"varint,5,opt,name=checkpoints,proto3" json:"checkpoints,omitempty"`          // 核对的检查点数
+	Issues        []*LedgerIssue         `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"`                     // 发现的问题
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckQuotaReply) Reset() {
-	*x = CheckQuotaReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[14]
+func (x *LedgerVerification) Reset() {
+	*x = LedgerVerification{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckQuotaReply) String() string {
+func (x *LedgerVerification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckQuotaReply) ProtoMessage() {}
+func (*LedgerVerification) ProtoMessage() {}
 
-func (x *CheckQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[14]
+func (x *LedgerVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,61 +10190,77 @@ func (x *CheckQuotaReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckQuotaReply.ProtoReflect.Descriptor instead.
-func (*CheckQuotaReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use LedgerVerification.ProtoReflect.Descriptor instead.
+func (*LedgerVerification) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *CheckQuotaReply) GetQuota() *QuotaInfo {
+func (x *LedgerVerification) GetQuotaId() int64 {
 	if x != nil {
-		return x.Quota
+		return x.QuotaId
 	}
-	return nil
+	return 0
 }
 
-func (x *CheckQuotaReply) GetHasQuota() bool {
+func (x *LedgerVerification) GetRecords() int32 {
 	if x != nil {
-		return x.HasQuota
+		return x.Records
 	}
-	return false
+	return 0
 }
 
-func (x *CheckQuotaReply) GetAvailableQuota() int32 {
+func (x *LedgerVerification) GetHeadSeq() int64 {
 	if x != nil {
-		return x.AvailableQuota
+		return x.HeadSeq
 	}
 	return 0
 }
 
-// ConsumeQuotaRequest 消费配额请求
-type ConsumeQuotaRequest struct {
+func (x *LedgerVerification) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *LedgerVerification) GetCheckpoints() int32 {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return 0
+}
+
+func (x *LedgerVerification) GetIssues() []*LedgerIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// VerifyLedgerRequest 校验哈希链请求
+type VerifyLedgerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
-	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
-	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
-	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 数量
-	ProductCode   string                 `protobuf:"bytes,5,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
-	BizId         string                 `protobuf:"bytes,6,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                        // 业务ID
-	BizType       string                 `protobuf:"bytes,7,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`                                                  // 业务类型
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	QuotaId       int64                  `protobuf:"varint,2,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`   // 配额ID，0表示租户的全部配额
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsumeQuotaRequest) Reset() {
-	*x = ConsumeQuotaRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[15]
+func (x *VerifyLedgerRequest) Reset() {
+	*x = VerifyLedgerRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsumeQuotaRequest) String() string {
+func (x *VerifyLedgerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeQuotaRequest) ProtoMessage() {}
+func (*VerifyLedgerRequest) ProtoMessage() {}
 
-func (x *ConsumeQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[15]
+func (x *VerifyLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,85 +10271,49 @@ func (x *ConsumeQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeQuotaRequest.ProtoReflect.Descriptor instead.
-func (*ConsumeQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use VerifyLedgerRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *ConsumeQuotaRequest) GetTenantId() string {
+func (x *VerifyLedgerRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ConsumeQuotaRequest) GetQuotaType() QuotaType {
-	if x != nil {
-		return x.QuotaType
-	}
-	return QuotaType_QUOTA_TYPE_UNSPECIFIED
-}
-
-func (x *ConsumeQuotaRequest) GetLimitType() LimitType {
-	if x != nil {
-		return x.LimitType
-	}
-	return LimitType_LIMIT_TYPE_UNSPECIFIED
-}
-
-func (x *ConsumeQuotaRequest) GetAmount() int32 {
+func (x *VerifyLedgerRequest) GetQuotaId() int64 {
 	if x != nil {
-		return x.Amount
+		return x.QuotaId
 	}
 	return 0
 }
 
-func (x *ConsumeQuotaRequest) GetProductCode() string {
-	if x != nil {
-		return x.ProductCode
-	}
-	return ""
-}
-
-func (x *ConsumeQuotaRequest) GetBizId() string {
-	if x != nil {
-		return x.BizId
-	}
-	return ""
-}
-
-func (x *ConsumeQuotaRequest) GetBizType() string {
-	if x != nil {
-		return x.BizType
-	}
-	return ""
-}
-
-// ConsumeQuotaReply 消费配额响应
-type ConsumeQuotaReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // 是否成功
-	RemainingQuota int32                  `protobuf:"varint,2,opt,name=remaining_quota,json=remainingQuota,proto3" json:"remaining_quota,omitempty"` // 剩余配额
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                      // 消息
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// VerifyLedgerReply 校验哈希链响应
+type VerifyLedgerReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`          // 是否未发现问题
+	Results       []*LedgerVerification  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // 各配额的校验结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsumeQuotaReply) Reset() {
-	*x = ConsumeQuotaReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[16]
+func (x *VerifyLedgerReply) Reset() {
+	*x = VerifyLedgerReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsumeQuotaReply) String() string {
+func (x *VerifyLedgerReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeQuotaReply) ProtoMessage() {}
+func (*VerifyLedgerReply) ProtoMessage() {}
 
-func (x *ConsumeQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[16]
+func (x *VerifyLedgerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,60 +10324,55 @@ func (x *ConsumeQuotaReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeQuotaReply.ProtoReflect.Descriptor instead.
-func (*ConsumeQuotaReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use VerifyLedgerReply.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ConsumeQuotaReply) GetSuccess() bool {
+func (x *VerifyLedgerReply) GetOk() bool {
 	if x != nil {
-		return x.Success
+		return x.Ok
 	}
 	return false
 }
 
-func (x *ConsumeQuotaReply) GetRemainingQuota() int32 {
-	if x != nil {
-		return x.RemainingQuota
-	}
-	return 0
-}
-
-func (x *ConsumeQuotaReply) GetMessage() string {
+func (x *VerifyLedgerReply) GetResults() []*LedgerVerification {
 	if x != nil {
-		return x.Message
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-// ReleaseQuotaRequest 释放配额请求
-type ReleaseQuotaRequest struct {
+// LedgerCheckpoint 签名检查点，渠道方可用公钥离线核对
+type LedgerCheckpoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                               // 租户ID
-	QuotaType     QuotaType              `protobuf:"varint,2,opt,name=quota_type,json=quotaType,proto3,enum=platform.tenant_service.v1.QuotaType" json:"quota_type,omitempty"` // 配额类型
-	LimitType     LimitType              `protobuf:"varint,3,opt,name=limit_type,json=limitType,proto3,enum=platform.tenant_service.v1.LimitType" json:"limit_type,omitempty"` // 限制类型
-	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                  // 数量
-	ProductCode   string                 `protobuf:"bytes,5,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                      // 产品代码
-	BizId         string                 `protobuf:"bytes,6,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`                                                        // 业务ID
+	CheckpointId  int64                  `protobuf:"varint,1,opt,name=checkpoint_id,json=checkpointId,proto3" json:"checkpoint_id,omitempty"` // 检查点ID，递增
+	QuotaId       int64                  `protobuf:"varint,2,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                // 配额ID
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`              // 租户ID
+	ChainSeq      int64                  `protobuf:"varint,4,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`             // 链头序号
+	RecordHash    string                 `protobuf:"bytes,5,opt,name=record_hash,json=recordHash,proto3" json:"record_hash,omitempty"`        // 链头记录哈希
+	KeyId         string                 `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                       // 签名密钥ID
+	Signature     string                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                            // Ed25519签名（base64）
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // 签发时间，签名内容使用其Unix秒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ReleaseQuotaRequest) Reset() {
-	*x = ReleaseQuotaRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[17]
+}
+
+func (x *LedgerCheckpoint) Reset() {
+	*x = LedgerCheckpoint{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseQuotaRequest) String() string {
+func (x *LedgerCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseQuotaRequest) ProtoMessage() {}
+func (*LedgerCheckpoint) ProtoMessage() {}
 
-func (x *ReleaseQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[17]
+func (x *LedgerCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,78 +10383,93 @@ func (x *ReleaseQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseQuotaRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LedgerCheckpoint.ProtoReflect.Descriptor instead.
+func (*LedgerCheckpoint) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *ReleaseQuotaRequest) GetTenantId() string {
+func (x *LedgerCheckpoint) GetCheckpointId() int64 {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return 0
+}
+
+func (x *LedgerCheckpoint) GetQuotaId() int64 {
+	if x != nil {
+		return x.QuotaId
+	}
+	return 0
+}
+
+func (x *LedgerCheckpoint) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ReleaseQuotaRequest) GetQuotaType() QuotaType {
+func (x *LedgerCheckpoint) GetChainSeq() int64 {
 	if x != nil {
-		return x.QuotaType
+		return x.ChainSeq
 	}
-	return QuotaType_QUOTA_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *ReleaseQuotaRequest) GetLimitType() LimitType {
+func (x *LedgerCheckpoint) GetRecordHash() string {
 	if x != nil {
-		return x.LimitType
+		return x.RecordHash
 	}
-	return LimitType_LIMIT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ReleaseQuotaRequest) GetAmount() int32 {
+func (x *LedgerCheckpoint) GetKeyId() string {
 	if x != nil {
-		return x.Amount
+		return x.KeyId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReleaseQuotaRequest) GetProductCode() string {
+func (x *LedgerCheckpoint) GetSignature() string {
 	if x != nil {
-		return x.ProductCode
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *ReleaseQuotaRequest) GetBizId() string {
+func (x *LedgerCheckpoint) GetCreatedAt() string {
 	if x != nil {
-		return x.BizId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-// ReleaseQuotaReply 释放配额响应
-type ReleaseQuotaReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // 是否成功
-	RemainingQuota int32                  `protobuf:"varint,2,opt,name=remaining_quota,json=remainingQuota,proto3" json:"remaining_quota,omitempty"` // 剩余配额
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                      // 消息
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ListLedgerCheckpointsRequest 查询签名检查点请求
+type ListLedgerCheckpointsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TenantId          string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                               // 租户ID
+	QuotaId           int64                  `protobuf:"varint,2,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`                                 // 配额ID，0表示全部
+	AfterCheckpointId int64                  `protobuf:"varint,3,opt,name=after_checkpoint_id,json=afterCheckpointId,proto3" json:"after_checkpoint_id,omitempty"` // 只返回检查点ID大于该值的检查点，用于增量拉取
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // 返回条数，默认100
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ReleaseQuotaReply) Reset() {
-	*x = ReleaseQuotaReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[18]
+func (x *ListLedgerCheckpointsRequest) Reset() {
+	*x = ListLedgerCheckpointsRequest{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseQuotaReply) String() string {
+func (x *ListLedgerCheckpointsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseQuotaReply) ProtoMessage() {}
+func (*ListLedgerCheckpointsRequest) ProtoMessage() {}
 
-func (x *ReleaseQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[18]
+func (x *ListLedgerCheckpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,55 +10480,64 @@ func (x *ReleaseQuotaReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseQuotaReply.ProtoReflect.Descriptor instead.
-func (*ReleaseQuotaReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListLedgerCheckpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *ReleaseQuotaReply) GetSuccess() bool {
+func (x *ListLedgerCheckpointsRequest) GetTenantId() string {
 	if x != nil {
-		return x.Success
+		return x.TenantId
 	}
-	return false
+	return ""
 }
 
-func (x *ReleaseQuotaReply) GetRemainingQuota() int32 {
+func (x *ListLedgerCheckpointsRequest) GetQuotaId() int64 {
 	if x != nil {
-		return x.RemainingQuota
+		return x.QuotaId
 	}
 	return 0
 }
 
-func (x *ReleaseQuotaReply) GetMessage() string {
+func (x *ListLedgerCheckpointsRequest) GetAfterCheckpointId() int64 {
 	if x != nil {
-		return x.Message
+		return x.AfterCheckpointId
 	}
-	return ""
+	return 0
 }
 
-// ListProductsRequest 列出产品线请求
-type ListProductsRequest struct {
+func (x *ListLedgerCheckpointsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListLedgerCheckpointsReply 查询签名检查点响应
+type ListLedgerCheckpointsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 租户ID
+	Checkpoints   []*LedgerCheckpoint    `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`              // 检查点，按检查点ID升序
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // 当前签名公钥（base64），未配置签名密钥时为空
+	KeyId         string                 `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // 当前签名密钥ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[19]
+func (x *ListLedgerCheckpointsReply) Reset() {
+	*x = ListLedgerCheckpointsReply{}
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ListLedgerCheckpointsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ListLedgerCheckpointsReply) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[19]
+func (x *ListLedgerCheckpointsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,68 +10548,37 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListLedgerCheckpointsReply.ProtoReflect.Descriptor instead.
+func (*ListLedgerCheckpointsReply) Descriptor() ([]byte, []int) {
+	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ListProductsRequest) GetTenantId() string {
+func (x *ListLedgerCheckpointsReply) GetCheckpoints() []*LedgerCheckpoint {
 	if x != nil {
-		return x.TenantId
+		return x.Checkpoints
 	}
-	return ""
-}
-
-// ListProductsReply 列出产品线响应
-type ListProductsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // 产品列表
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListProductsReply) Reset() {
-	*x = ListProductsReply{}
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProductsReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ListProductsReply) ProtoMessage() {}
-
-func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_tenant_service_v1_tenant_proto_msgTypes[20]
+func (x *ListLedgerCheckpointsReply) GetPublicKey() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PublicKey
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
-func (*ListProductsReply) Descriptor() ([]byte, []int) {
-	return file_platform_tenant_service_v1_tenant_proto_rawDescGZIP(), []int{20}
+	return ""
 }
 
-func (x *ListProductsReply) GetProducts() []*Product {
+func (x *ListLedgerCheckpointsReply) GetKeyId() string {
 	if x != nil {
-		return x.Products
+		return x.KeyId
 	}
-	return nil
+	return ""
 }
 
 var File_platform_tenant_service_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\n" +
-	"'platform/tenant_service/v1/tenant.proto\x12\x1aplatform.tenant_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x10base/error.proto\x1a\x15base/pagination.proto\"\xaf\x03\n" +
+	"'platform/tenant_service/v1/tenant.proto\x12\x1aplatform.tenant_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x10base/error.proto\x1a\x15base/pagination.proto\"\xcd\x05\n" +
 	"\n" +
 	"TenantInfo\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1f\n" +
@@ -1618,10 +10592,21 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\x1a>\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12J\n" +
+	"\x06labels\x18\t \x03(\v22.platform.tenant_service.v1.TenantInfo.LabelsEntryR\x06labels\x12V\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v26.platform.tenant_service.v1.TenantInfo.AttributesEntryR\n" +
+	"attributes\x1a>\n" +
 	"\x10QuotaConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfd\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\a\n" +
 	"\tQuotaInfo\x12\x19\n" +
 	"\bquota_id\x18\x01 \x01(\x03R\aquotaId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12D\n" +
@@ -1643,45 +10628,102 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\vexpire_time\x18\v \x01(\tR\n" +
 	"expireTime\x12\x1b\n" +
 	"\tis_global\x18\f \x01(\bR\bisGlobal\x12#\n" +
-	"\rproduct_codes\x18\r \x03(\tR\fproductCodes\"q\n" +
+	"\rproduct_codes\x18\r \x03(\tR\fproductCodes\x12\x1b\n" +
+	"\tplan_code\x18\x0e \x01(\tR\bplanCode\x12)\n" +
+	"\x10rollover_granted\x18\x0f \x01(\x05R\x0frolloverGranted\x12#\n" +
+	"\rrollover_used\x18\x10 \x01(\x05R\frolloverUsed\x120\n" +
+	"\x14rollover_expire_time\x18\x11 \x01(\tR\x12rolloverExpireTime\x12V\n" +
+	"\x10enforcement_mode\x18\x12 \x01(\x0e2+.platform.tenant_service.v1.EnforcementModeR\x0fenforcementMode\x12\x1f\n" +
+	"\vmax_overage\x18\x13 \x01(\x05R\n" +
+	"maxOverage\x12\x18\n" +
+	"\aoverage\x18\x14 \x01(\x05R\aoverage\x12M\n" +
+	"\vallocations\x18\x15 \x03(\v2+.platform.tenant_service.v1.QuotaAllocationR\vallocations\x12!\n" +
+	"\fshared_limit\x18\x16 \x01(\x05R\vsharedLimit\x12\x1f\n" +
+	"\vshared_used\x18\x17 \x01(\x05R\n" +
+	"sharedUsed\x12\x16\n" +
+	"\x06shards\x18\x18 \x01(\x05R\x06shards\"\x87\x01\n" +
+	"\x0fQuotaAllocation\x12!\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\x03 \x01(\x05R\tusedCount\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x05R\tremaining\"q\n" +
 	"\aProduct\x12!\n" +
 	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xe3\x02\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xcb\x05\n" +
 	"\x13CreateTenantRequest\x12*\n" +
 	"\vtenant_name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
 	"tenantName\x12Q\n" +
 	"\vtenant_type\x18\x02 \x01(\x0e2&.platform.tenant_service.v1.TenantTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
 	"tenantType\x12(\n" +
 	"\x10parent_tenant_id\x18\x03 \x01(\tR\x0eparentTenantId\x12c\n" +
-	"\fquota_config\x18\x04 \x03(\v2@.platform.tenant_service.v1.CreateTenantRequest.QuotaConfigEntryR\vquotaConfig\x1a>\n" +
+	"\fquota_config\x18\x04 \x03(\v2@.platform.tenant_service.v1.CreateTenantRequest.QuotaConfigEntryR\vquotaConfig\x126\n" +
+	"\ttenant_id\x18\x05 \x01(\tB\x19\xfaB\x16r\x14\x18 2\x10^[A-Za-z0-9_-]*$R\btenantId\x12S\n" +
+	"\x06labels\x18\x06 \x03(\v2;.platform.tenant_service.v1.CreateTenantRequest.LabelsEntryR\x06labels\x12_\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v2?.platform.tenant_service.v1.CreateTenantRequest.AttributesEntryR\n" +
+	"attributes\x1a>\n" +
 	"\x10QuotaConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
 	"\x11CreateTenantReply\x12>\n" +
 	"\x06tenant\x18\x01 \x01(\v2&.platform.tenant_service.v1.TenantInfoR\x06tenant\"8\n" +
 	"\x10GetTenantRequest\x12$\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\"P\n" +
 	"\x0eGetTenantReply\x12>\n" +
-	"\x06tenant\x18\x01 \x01(\v2&.platform.tenant_service.v1.TenantInfoR\x06tenant\"\xd7\x01\n" +
+	"\x06tenant\x18\x01 \x01(\v2&.platform.tenant_service.v1.TenantInfoR\x06tenant\"\x9d\x05\n" +
 	"\x12ListTenantsRequest\x12G\n" +
 	"\vtenant_type\x18\x01 \x01(\x0e2&.platform.tenant_service.v1.TenantTypeR\n" +
 	"tenantType\x12(\n" +
-	"\x10parent_tenant_id\x18\x02 \x01(\tR\x0eparentTenantId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\bR\x06status\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x19\n" +
-	"\bpage_num\x18\x05 \x01(\x05R\apageNum\"j\n" +
+	"\x10parent_tenant_id\x18\x02 \x01(\tR\x0eparentTenantId\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\bH\x00R\x06status\x88\x01\x01\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x19\n" +
+	"\bpage_num\x18\x05 \x01(\x05R\apageNum\x12I\n" +
+	"\ftenant_types\x18\x06 \x03(\x0e2&.platform.tenant_service.v1.TenantTypeR\vtenantTypes\x12\x1b\n" +
+	"\x04name\x18\a \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\x12#\n" +
+	"\rcreated_after\x18\b \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\t \x01(\tR\rcreatedBefore\x12%\n" +
+	"\x04page\x18\n" +
+	" \x01(\v2\x11.base.PageRequestR\x04page\x12/\n" +
+	"\x0elabel_selector\x18\v \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rlabelSelector\x12^\n" +
+	"\n" +
+	"attributes\x18\f \x03(\v2>.platform.tenant_service.v1.ListTenantsRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\a_status\"\x92\x01\n" +
 	"\x10ListTenantsReply\x12@\n" +
 	"\atenants\x18\x01 \x03(\v2&.platform.tenant_service.v1.TenantInfoR\atenants\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa4\x02\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x04page\x18\x03 \x01(\v2\x12.base.PageResponseR\x04page\"\xa6\x05\n" +
 	"\x13UpdateTenantRequest\x12$\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12*\n" +
 	"\vtenant_name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\n" +
 	"tenantName\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\bR\x06status\x12c\n" +
-	"\fquota_config\x18\x04 \x03(\v2@.platform.tenant_service.v1.UpdateTenantRequest.QuotaConfigEntryR\vquotaConfig\x1a>\n" +
+	"\fquota_config\x18\x04 \x03(\v2@.platform.tenant_service.v1.UpdateTenantRequest.QuotaConfigEntryR\vquotaConfig\x12S\n" +
+	"\x06labels\x18\x05 \x03(\v2;.platform.tenant_service.v1.UpdateTenantRequest.LabelsEntryR\x06labels\x12#\n" +
+	"\rremove_labels\x18\x06 \x03(\tR\fremoveLabels\x12_\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v2?.platform.tenant_service.v1.UpdateTenantRequest.AttributesEntryR\n" +
+	"attributes\x12+\n" +
+	"\x11remove_attributes\x18\b \x03(\tR\x10removeAttributes\x1a>\n" +
 	"\x10QuotaConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
 	"\x11UpdateTenantReply\x12>\n" +
 	"\x06tenant\x18\x01 \x01(\v2&.platform.tenant_service.v1.TenantInfoR\x06tenant\";\n" +
@@ -1695,11 +10737,36 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tquotaType\x12N\n" +
 	"\n" +
 	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tlimitType\x12!\n" +
-	"\fproduct_code\x18\x04 \x01(\tR\vproductCode\"\x94\x01\n" +
+	"\fproduct_code\x18\x04 \x01(\tR\vproductCode\"\x8c\x02\n" +
 	"\x0fCheckQuotaReply\x12;\n" +
 	"\x05quota\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaInfoR\x05quota\x12\x1b\n" +
 	"\thas_quota\x18\x02 \x01(\bR\bhasQuota\x12'\n" +
-	"\x0favailable_quota\x18\x03 \x01(\x05R\x0eavailableQuota\"\xd1\x02\n" +
+	"\x0favailable_quota\x18\x03 \x01(\x05R\x0eavailableQuota\x12K\n" +
+	"\n" +
+	"allocation\x18\x04 \x01(\v2+.platform.tenant_service.v1.QuotaAllocationR\n" +
+	"allocation\x12)\n" +
+	"\x10shared_available\x18\x05 \x01(\x05R\x0fsharedAvailable\"\xda\x01\n" +
+	"\x0eQuotaCandidate\x12;\n" +
+	"\x05quota\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaInfoR\x05quota\x12A\n" +
+	"\x05level\x18\x02 \x01(\x0e2+.platform.tenant_service.v1.QuotaMatchLevelR\x05level\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x05R\x05depth\x12\x1a\n" +
+	"\bselected\x18\x04 \x01(\bR\bselected\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x82\x02\n" +
+	"\x13ExplainQuotaRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12P\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tquotaType\x12P\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tlimitType\x12!\n" +
+	"\fproduct_code\x18\x04 \x01(\tR\vproductCode\"\xc0\x01\n" +
+	"\x11ExplainQuotaReply\x12A\n" +
+	"\bselected\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaInfoR\bselected\x12\x1c\n" +
+	"\tancestors\x18\x02 \x03(\tR\tancestors\x12J\n" +
+	"\n" +
+	"candidates\x18\x03 \x03(\v2*.platform.tenant_service.v1.QuotaCandidateR\n" +
+	"candidates\"\xd1\x02\n" +
 	"\x13ConsumeQuotaRequest\x12$\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
 	"\n" +
@@ -1709,11 +10776,12 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\x06amount\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06amount\x12!\n" +
 	"\fproduct_code\x18\x05 \x01(\tR\vproductCode\x12\x15\n" +
 	"\x06biz_id\x18\x06 \x01(\tR\x05bizId\x12\x19\n" +
-	"\bbiz_type\x18\a \x01(\tR\abizType\"p\n" +
+	"\bbiz_type\x18\a \x01(\tR\abizType\"\x8a\x01\n" +
 	"\x11ConsumeQuotaReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
 	"\x0fremaining_quota\x18\x02 \x01(\x05R\x0eremainingQuota\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xb6\x02\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\aoverage\x18\x04 \x01(\x05R\aoverage\"\xb6\x02\n" +
 	"\x13ReleaseQuotaRequest\x12$\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
 	"\n" +
@@ -1726,11 +10794,723 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\x11ReleaseQuotaReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
 	"\x0fremaining_quota\x18\x02 \x01(\x05R\x0eremainingQuota\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"2\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xe5\x03\n" +
+	"\x10QuotaUsageRecord\x12\x1b\n" +
+	"\trecord_id\x18\x01 \x01(\x03R\brecordId\x12\x19\n" +
+	"\bquota_id\x18\x02 \x01(\x03R\aquotaId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12P\n" +
+	"\x0eoperation_type\x18\x04 \x01(\x0e2).platform.tenant_service.v1.OperationTypeR\roperationType\x12\x1f\n" +
+	"\vdelta_value\x18\x05 \x01(\x05R\n" +
+	"deltaValue\x12!\n" +
+	"\fcurrent_used\x18\x06 \x01(\x05R\vcurrentUsed\x12\x15\n" +
+	"\x06biz_id\x18\a \x01(\tR\x05bizId\x12\x19\n" +
+	"\bbiz_type\x18\b \x01(\tR\abizType\x12\x1a\n" +
+	"\boperator\x18\t \x01(\tR\boperator\x12%\n" +
+	"\x0eoperation_time\x18\n" +
+	" \x01(\tR\roperationTime\x12\x16\n" +
+	"\x06remark\x18\v \x01(\tR\x06remark\x12\x1b\n" +
+	"\tchain_seq\x18\f \x01(\x03R\bchainSeq\x12\x1b\n" +
+	"\tprev_hash\x18\r \x01(\tR\bprevHash\x12\x1f\n" +
+	"\vrecord_hash\x18\x0e \x01(\tR\n" +
+	"recordHash\"\x7f\n" +
+	"\x11ListQuotasRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\"P\n" +
+	"\x0fListQuotasReply\x12=\n" +
+	"\x06quotas\x18\x01 \x03(\v2%.platform.tenant_service.v1.QuotaInfoR\x06quotas\"\xc0\x05\n" +
+	"\x12AdjustQuotaRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tquotaType\x12N\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tlimitType\x12+\n" +
+	"\n" +
+	"hard_limit\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x00R\thardLimit\x88\x01\x01\x12+\n" +
+	"\n" +
+	"soft_limit\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x01R\tsoftLimit\x88\x01\x01\x12+\n" +
+	"\n" +
+	"used_count\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x02R\tusedCount\x88\x01\x01\x12\x1a\n" +
+	"\boperator\x18\a \x01(\tR\boperator\x12 \n" +
+	"\x06remark\x18\b \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06remark\x12&\n" +
+	"\fextra_config\x18\t \x01(\tH\x03R\vextraConfig\x88\x01\x01\x12e\n" +
+	"\x10enforcement_mode\x18\n" +
+	" \x01(\x0e2+.platform.tenant_service.v1.EnforcementModeB\b\xfaB\x05\x82\x01\x02\x10\x01H\x04R\x0fenforcementMode\x88\x01\x01\x12-\n" +
+	"\vmax_overage\x18\v \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x05R\n" +
+	"maxOverage\x88\x01\x01B\r\n" +
+	"\v_hard_limitB\r\n" +
+	"\v_soft_limitB\r\n" +
+	"\v_used_countB\x0f\n" +
+	"\r_extra_configB\x13\n" +
+	"\x11_enforcement_modeB\x0e\n" +
+	"\f_max_overage\"O\n" +
+	"\x10AdjustQuotaReply\x12;\n" +
+	"\x05quota\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaInfoR\x05quota\"\x97\x02\n" +
+	"\x11ResetQuotaRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tquotaType\x12N\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tlimitType\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\x12 \n" +
+	"\x06remark\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06remark\"N\n" +
+	"\x0fResetQuotaReply\x12;\n" +
+	"\x05quota\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaInfoR\x05quota\"\xcf\x01\n" +
+	"\x17ListUsageRecordsRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12&\n" +
+	"\x0fafter_record_id\x18\x03 \x01(\x03R\rafterRecordId\x12 \n" +
+	"\x05limit\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"_\n" +
+	"\x15ListUsageRecordsReply\x12F\n" +
+	"\arecords\x18\x01 \x03(\v2,.platform.tenant_service.v1.QuotaUsageRecordR\arecords\"\xb2\x01\n" +
+	"\x13ListOveragesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xcd\x02\n" +
+	"\fQuotaOverage\x12\x19\n" +
+	"\bquota_id\x18\x01 \x01(\x03R\aquotaId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12D\n" +
+	"\n" +
+	"limit_type\x18\x04 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeR\tlimitType\x12!\n" +
+	"\fperiod_start\x18\x05 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\tR\tperiodEnd\x12\x18\n" +
+	"\aoverage\x18\a \x01(\x05R\aoverage\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"Y\n" +
+	"\x11ListOveragesReply\x12D\n" +
+	"\boverages\x18\x01 \x03(\v2(.platform.tenant_service.v1.QuotaOverageR\boverages\"\xd4\x01\n" +
+	"\x15GetUsageReportRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1e\n" +
+	"\x05top_n\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x04topN\"\x87\x01\n" +
+	"\vTopConsumer\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bconsumed\x18\x02 \x01(\x03R\bconsumed\x12\x1a\n" +
+	"\breleased\x18\x03 \x01(\x03R\breleased\x12#\n" +
+	"\rconsume_count\x18\x04 \x01(\x03R\fconsumeCount\"\xa4\x01\n" +
+	"\x15QuotaTypeTopConsumers\x12D\n" +
+	"\n" +
+	"quota_type\x18\x01 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12E\n" +
+	"\tconsumers\x18\x02 \x03(\v2'.platform.tenant_service.v1.TopConsumerR\tconsumers\"s\n" +
+	"\x11UtilizationBucket\x12#\n" +
+	"\rlower_percent\x18\x01 \x01(\x05R\flowerPercent\x12#\n" +
+	"\rupper_percent\x18\x02 \x01(\x05R\fupperPercent\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xf4\x02\n" +
+	"\x12ExhaustionForecast\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12\x1d\n" +
+	"\n" +
+	"hard_limit\x18\x03 \x01(\x05R\thardLimit\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\x04 \x01(\x05R\tusedCount\x12\x1d\n" +
+	"\n" +
+	"daily_rate\x18\x05 \x01(\x01R\tdailyRate\x12!\n" +
+	"\fwill_exhaust\x18\x06 \x01(\bR\vwillExhaust\x12!\n" +
+	"\fexhaust_time\x18\a \x01(\tR\vexhaustTime\x12&\n" +
+	"\x0fnext_reset_time\x18\b \x01(\tR\rnextResetTime\x120\n" +
+	"\x14exhaust_before_reset\x18\t \x01(\bR\x12exhaustBeforeReset\"\xa5\x03\n" +
+	"\x13GetUsageReportReply\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12V\n" +
+	"\rtop_consumers\x18\x03 \x03(\v21.platform.tenant_service.v1.QuotaTypeTopConsumersR\ftopConsumers\x12c\n" +
+	"\x16soft_limit_utilization\x18\x04 \x03(\v2-.platform.tenant_service.v1.UtilizationBucketR\x14softLimitUtilization\x12L\n" +
+	"\tforecasts\x18\x05 \x03(\v2..platform.tenant_service.v1.ExhaustionForecastR\tforecasts\x12(\n" +
+	"\x10rollup_record_id\x18\x06 \x01(\x03R\x0erollupRecordId\x12\x1f\n" +
+	"\vrollup_time\x18\a \x01(\tR\n" +
+	"rollupTime\"\x87\x02\n" +
+	"\x19GetUsageTimeSeriesRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12D\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeR\tlimitType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"\xf3\x01\n" +
+	"\n" +
+	"UsagePoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1a\n" +
+	"\bconsumed\x18\x02 \x01(\x03R\bconsumed\x12\x1a\n" +
+	"\breleased\x18\x03 \x01(\x03R\breleased\x12#\n" +
+	"\rconsume_count\x18\x04 \x01(\x03R\fconsumeCount\x12\x1b\n" +
+	"\tpeak_used\x18\x05 \x01(\x05R\bpeakUsed\x12\x19\n" +
+	"\bend_used\x18\x06 \x01(\x05R\aendUsed\x12\x1d\n" +
+	"\n" +
+	"hard_limit\x18\a \x01(\x05R\thardLimit\x12\x1d\n" +
+	"\n" +
+	"soft_limit\x18\b \x01(\x05R\tsoftLimit\"\xf4\x01\n" +
+	"\vUsageSeries\x12\x19\n" +
+	"\bquota_id\x18\x01 \x01(\x03R\aquotaId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12D\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeR\tlimitType\x12>\n" +
+	"\x06points\x18\x04 \x03(\v2&.platform.tenant_service.v1.UsagePointR\x06points\"\xdf\x01\n" +
+	"\x17GetUsageTimeSeriesReply\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12?\n" +
+	"\x06series\x18\x03 \x03(\v2'.platform.tenant_service.v1.UsageSeriesR\x06series\x12(\n" +
+	"\x10rollup_record_id\x18\x04 \x01(\x03R\x0erollupRecordId\x12\x1f\n" +
+	"\vrollup_time\x18\x05 \x01(\tR\n" +
+	"rollupTime\"\xa4\x02\n" +
+	"\tPlanQuota\x12P\n" +
+	"\n" +
+	"quota_type\x18\x01 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tquotaType\x12P\n" +
+	"\n" +
+	"limit_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tlimitType\x12&\n" +
+	"\n" +
+	"hard_limit\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\thardLimit\x12&\n" +
+	"\n" +
+	"soft_limit\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tsoftLimit\x12#\n" +
+	"\rproduct_codes\x18\x05 \x03(\tR\fproductCodes\"\xcf\x02\n" +
+	"\tQuotaPlan\x12\x1b\n" +
+	"\tplan_code\x18\x01 \x01(\tR\bplanCode\x12\x1b\n" +
+	"\tplan_name\x18\x02 \x01(\tR\bplanName\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12=\n" +
+	"\x06quotas\x18\x05 \x03(\v2%.platform.tenant_service.v1.PlanQuotaR\x06quotas\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12O\n" +
+	"\fentitlements\x18\b \x03(\v2+.platform.tenant_service.v1.PlanEntitlementR\fentitlements\"\xab\x01\n" +
+	"\n" +
+	"TenantPlan\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tplan_code\x18\x02 \x01(\tR\bplanCode\x12!\n" +
+	"\fplan_version\x18\x03 \x01(\x05R\vplanVersion\x12\x1f\n" +
+	"\vassigned_by\x18\x04 \x01(\tR\n" +
+	"assignedBy\x12\x1f\n" +
+	"\vassigned_at\x18\x05 \x01(\tR\n" +
+	"assignedAt\"\xd5\x02\n" +
+	"\x0fSavePlanRequest\x128\n" +
+	"\tplan_code\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18 2\x10^[A-Za-z0-9_-]+$R\bplanCode\x12&\n" +
+	"\tplan_name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\bplanName\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x12G\n" +
+	"\x06quotas\x18\x04 \x03(\v2%.platform.tenant_service.v1.PlanQuotaB\b\xfaB\x05\x92\x01\x02\b\x01R\x06quotas\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x12O\n" +
+	"\fentitlements\x18\x06 \x03(\v2+.platform.tenant_service.v1.PlanEntitlementR\fentitlements\"K\n" +
+	"\x16PlanPropagationFailure\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xba\x01\n" +
+	"\rSavePlanReply\x129\n" +
+	"\x04plan\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaPlanR\x04plan\x12\x1e\n" +
+	"\n" +
+	"propagated\x18\x02 \x01(\x05R\n" +
+	"propagated\x12N\n" +
+	"\bfailures\x18\x03 \x03(\v22.platform.tenant_service.v1.PlanPropagationFailureR\bfailures\"6\n" +
+	"\x0eGetPlanRequest\x12$\n" +
+	"\tplan_code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bplanCode\"I\n" +
+	"\fGetPlanReply\x129\n" +
+	"\x04plan\x18\x01 \x01(\v2%.platform.tenant_service.v1.QuotaPlanR\x04plan\"\x12\n" +
+	"\x10ListPlansRequest\"M\n" +
+	"\x0eListPlansReply\x12;\n" +
+	"\x05plans\x18\x01 \x03(\v2%.platform.tenant_service.v1.QuotaPlanR\x05plans\"\xf9\x01\n" +
+	"\x11AssignPlanRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12$\n" +
+	"\tplan_code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bplanCode\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12'\n" +
+	"\x0fclear_overrides\x18\x04 \x01(\bR\x0eclearOverrides\x12S\n" +
+	"\tproration\x18\x05 \x01(\x0e2+.platform.tenant_service.v1.ProrationPolicyB\b\xfaB\x05\x82\x01\x02\x10\x01R\tproration\"\x98\x01\n" +
+	"\x0fAssignPlanReply\x12F\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2&.platform.tenant_service.v1.TenantPlanR\n" +
+	"assignment\x12=\n" +
+	"\x06quotas\x18\x02 \x03(\v2%.platform.tenant_service.v1.QuotaInfoR\x06quotas\"f\n" +
+	"\x12BindProductRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12*\n" +
+	"\fproduct_code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vproductCode\",\n" +
+	"\x10BindProductReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
 	"\x13ListProductsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"T\n" +
 	"\x11ListProductsReply\x12?\n" +
-	"\bproducts\x18\x01 \x03(\v2#.platform.tenant_service.v1.ProductR\bproducts*x\n" +
+	"\bproducts\x18\x01 \x03(\v2#.platform.tenant_service.v1.ProductR\bproducts\"\x8b\x05\n" +
+	"\vQuotaChange\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\x03R\bchangeId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12D\n" +
+	"\n" +
+	"limit_type\x18\x04 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeR\tlimitType\x12\"\n" +
+	"\n" +
+	"hard_limit\x18\x05 \x01(\x05H\x00R\thardLimit\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"soft_limit\x18\x06 \x01(\x05H\x01R\tsoftLimit\x88\x01\x01\x12\x1b\n" +
+	"\tplan_code\x18\a \x01(\tR\bplanCode\x12\x19\n" +
+	"\bapply_at\x18\b \x01(\tR\aapplyAt\x12I\n" +
+	"\tproration\x18\t \x01(\x0e2+.platform.tenant_service.v1.ProrationPolicyR\tproration\x12E\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2-.platform.tenant_service.v1.QuotaChangeStatusR\x06status\x12\x1a\n" +
+	"\boperator\x18\v \x01(\tR\boperator\x12\x16\n" +
+	"\x06remark\x18\f \x01(\tR\x06remark\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"applied_at\x18\x0e \x01(\tR\tappliedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAtB\r\n" +
+	"\v_hard_limitB\r\n" +
+	"\v_soft_limit\"\xc9\x04\n" +
+	"\x1aScheduleQuotaChangeRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tquotaType\x12N\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tlimitType\x12+\n" +
+	"\n" +
+	"hard_limit\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x00R\thardLimit\x88\x01\x01\x12+\n" +
+	"\n" +
+	"soft_limit\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x01R\tsoftLimit\x88\x01\x01\x12\x1b\n" +
+	"\tplan_code\x18\x06 \x01(\tR\bplanCode\x12\x19\n" +
+	"\bapply_at\x18\a \x01(\tR\aapplyAt\x12\"\n" +
+	"\rat_next_reset\x18\b \x01(\bR\vatNextReset\x12S\n" +
+	"\tproration\x18\t \x01(\x0e2+.platform.tenant_service.v1.ProrationPolicyB\b\xfaB\x05\x82\x01\x02\x10\x01R\tproration\x12\x1a\n" +
+	"\boperator\x18\n" +
+	" \x01(\tR\boperator\x12 \n" +
+	"\x06remark\x18\v \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06remarkB\r\n" +
+	"\v_hard_limitB\r\n" +
+	"\v_soft_limit\"\x9a\x01\n" +
+	"\x18ScheduleQuotaChangeReply\x12?\n" +
+	"\x06change\x18\x01 \x01(\v2'.platform.tenant_service.v1.QuotaChangeR\x06change\x12=\n" +
+	"\x06quotas\x18\x02 \x03(\v2%.platform.tenant_service.v1.QuotaInfoR\x06quotas\"\x90\x01\n" +
+	"\x17ListQuotaChangesRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12O\n" +
+	"\x06status\x18\x02 \x01(\x0e2-.platform.tenant_service.v1.QuotaChangeStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"Z\n" +
+	"\x15ListQuotaChangesReply\x12A\n" +
+	"\achanges\x18\x01 \x03(\v2'.platform.tenant_service.v1.QuotaChangeR\achanges\"\x82\x01\n" +
+	"\x18CancelQuotaChangeRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12$\n" +
+	"\tchange_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bchangeId\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\"Y\n" +
+	"\x16CancelQuotaChangeReply\x12?\n" +
+	"\x06change\x18\x01 \x01(\v2'.platform.tenant_service.v1.QuotaChangeR\x06change\"\x8c\x01\n" +
+	"\rImportOptions\x12J\n" +
+	"\x06format\x18\x01 \x01(\x0e2&.platform.tenant_service.v1.DataFormatB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06upsert\x18\x03 \x01(\bR\x06upsert\"\x80\x01\n" +
+	"\x14ImportTenantsRequest\x12E\n" +
+	"\aoptions\x18\x01 \x01(\v2).platform.tenant_service.v1.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"p\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xd6\x01\n" +
+	"\x12ImportTenantsReply\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12E\n" +
+	"\aresults\x18\x06 \x03(\v2+.platform.tenant_service.v1.ImportRowResultR\aresults\"\xff\x01\n" +
+	"\x14ExportTenantsRequest\x12J\n" +
+	"\x06format\x18\x01 \x01(\x0e2&.platform.tenant_service.v1.DataFormatB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06format\x12I\n" +
+	"\ftenant_types\x18\x02 \x03(\x0e2&.platform.tenant_service.v1.TenantTypeR\vtenantTypes\x12(\n" +
+	"\x10parent_tenant_id\x18\x03 \x01(\tR\x0eparentTenantId\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\bH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"*\n" +
+	"\x12ExportTenantsReply\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xd2\x01\n" +
+	"\x06Wallet\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x122\n" +
+	"\x15low_balance_threshold\x18\x03 \x01(\x03R\x13lowBalanceThreshold\x12\x1f\n" +
+	"\vlow_balance\x18\x04 \x01(\bR\n" +
+	"lowBalance\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xa5\x01\n" +
+	"\vLedgerEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x03R\aentryId\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12I\n" +
+	"\tdirection\x18\x03 \x01(\x0e2+.platform.tenant_service.v1.LedgerDirectionR\tdirection\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\"\xdf\x04\n" +
+	"\x11WalletTransaction\x12\x13\n" +
+	"\x05tx_id\x18\x01 \x01(\x03R\x04txId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12E\n" +
+	"\x04type\x18\x03 \x01(\x0e21.platform.tenant_service.v1.WalletTransactionTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x05 \x01(\x03R\fbalanceAfter\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12D\n" +
+	"\n" +
+	"quota_type\x18\a \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12!\n" +
+	"\fproduct_code\x18\b \x01(\tR\vproductCode\x12\x1a\n" +
+	"\bquantity\x18\t \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\n" +
+	" \x01(\x03R\tunitPrice\x12\x1a\n" +
+	"\tref_tx_id\x18\v \x01(\x03R\arefTxId\x12\x15\n" +
+	"\x06biz_id\x18\f \x01(\tR\x05bizId\x12\x1a\n" +
+	"\boperator\x18\r \x01(\tR\boperator\x12\x16\n" +
+	"\x06remark\x18\x0e \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12A\n" +
+	"\aentries\x18\x10 \x03(\v2'.platform.tenant_service.v1.LedgerEntryR\aentries\"8\n" +
+	"\x10GetWalletRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\"L\n" +
+	"\x0eGetWalletReply\x12:\n" +
+	"\x06wallet\x18\x01 \x01(\v2\".platform.tenant_service.v1.WalletR\x06wallet\"~\n" +
+	"\x19SetWalletThresholdRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12;\n" +
+	"\x15low_balance_threshold\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x13lowBalanceThreshold\"U\n" +
+	"\x17SetWalletThresholdReply\x12:\n" +
+	"\x06wallet\x18\x01 \x01(\v2\".platform.tenant_service.v1.WalletR\x06wallet\"\xcd\x01\n" +
+	"\x12TopUpWalletRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12\x1f\n" +
+	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\x122\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x0eidempotencyKey\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\x12 \n" +
+	"\x06remark\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06remark\"\xbb\x01\n" +
+	"\x10TopUpWalletReply\x12O\n" +
+	"\vtransaction\x18\x01 \x01(\v2-.platform.tenant_service.v1.WalletTransactionR\vtransaction\x12:\n" +
+	"\x06wallet\x18\x02 \x01(\v2\".platform.tenant_service.v1.WalletR\x06wallet\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\"\xc1\x02\n" +
+	"\x12DebitWalletRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12P\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tquotaType\x12!\n" +
+	"\fproduct_code\x18\x03 \x01(\tR\vproductCode\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\x122\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x0eidempotencyKey\x12\x15\n" +
+	"\x06biz_id\x18\x06 \x01(\tR\x05bizId\x12 \n" +
+	"\x06remark\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06remark\"\xdc\x01\n" +
+	"\x10DebitWalletReply\x12O\n" +
+	"\vtransaction\x18\x01 \x01(\v2-.platform.tenant_service.v1.WalletTransactionR\vtransaction\x12:\n" +
+	"\x06wallet\x18\x02 \x01(\v2\".platform.tenant_service.v1.WalletR\x06wallet\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\x12\x1f\n" +
+	"\vlow_balance\x18\x04 \x01(\bR\n" +
+	"lowBalance\"\xf7\x01\n" +
+	"\x13RefundWalletRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12'\n" +
+	"\vdebit_tx_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tdebitTxId\x12\x1f\n" +
+	"\x06amount\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06amount\x122\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x0eidempotencyKey\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x12 \n" +
+	"\x06remark\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06remark\"\xbc\x01\n" +
+	"\x11RefundWalletReply\x12O\n" +
+	"\vtransaction\x18\x01 \x01(\v2-.platform.tenant_service.v1.WalletTransactionR\vtransaction\x12:\n" +
+	"\x06wallet\x18\x02 \x01(\v2\".platform.tenant_service.v1.WalletR\x06wallet\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\"\xd8\x01\n" +
+	"\x1dListWalletTransactionsRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12O\n" +
+	"\x04type\x18\x02 \x01(\x0e21.platform.tenant_service.v1.WalletTransactionTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x12\x1e\n" +
+	"\vafter_tx_id\x18\x03 \x01(\x03R\tafterTxId\x12 \n" +
+	"\x05limit\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"p\n" +
+	"\x1bListWalletTransactionsReply\x12Q\n" +
+	"\ftransactions\x18\x01 \x03(\v2-.platform.tenant_service.v1.WalletTransactionR\ftransactions\"\x9c\x04\n" +
+	"\n" +
+	"QuotaLease\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x19\n" +
+	"\bquota_id\x18\x03 \x01(\x03R\aquotaId\x12D\n" +
+	"\n" +
+	"quota_type\x18\x04 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeR\tquotaType\x12D\n" +
+	"\n" +
+	"limit_type\x18\x05 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeR\tlimitType\x12!\n" +
+	"\fproduct_code\x18\x06 \x01(\tR\vproductCode\x12\x16\n" +
+	"\x06holder\x18\a \x01(\tR\x06holder\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x05R\x06amount\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\t \x01(\x05R\tusedCount\x12\x1a\n" +
+	"\breturned\x18\n" +
+	" \x01(\x05R\breturned\x12D\n" +
+	"\x06status\x18\v \x01(\x0e2,.platform.tenant_service.v1.QuotaLeaseStatusR\x06status\x12\x1f\n" +
+	"\vexpire_time\x18\f \x01(\tR\n" +
+	"expireTime\x12\x1b\n" +
+	"\tclosed_at\x18\r \x01(\tR\bclosedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\"\xf2\x02\n" +
+	"\x16LeaseQuotaBlockRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12P\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tquotaType\x12P\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\x0e2%.platform.tenant_service.v1.LimitTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tlimitType\x12\x1f\n" +
+	"\x06amount\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06amount\x12!\n" +
+	"\fproduct_code\x18\x05 \x01(\tR\vproductCode\x12 \n" +
+	"\x06holder\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x06holder\x12(\n" +
+	"\vttl_seconds\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"ttlSeconds\"T\n" +
+	"\x14LeaseQuotaBlockReply\x12<\n" +
+	"\x05lease\x18\x01 \x01(\v2&.platform.tenant_service.v1.QuotaLeaseR\x05lease\"\x8e\x01\n" +
+	"\x16RenewQuotaLeaseRequest\x12\"\n" +
+	"\blease_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aleaseId\x12&\n" +
+	"\n" +
+	"used_count\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tusedCount\x12(\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"ttlSeconds\"T\n" +
+	"\x14RenewQuotaLeaseReply\x12<\n" +
+	"\x05lease\x18\x01 \x01(\v2&.platform.tenant_service.v1.QuotaLeaseR\x05lease\"e\n" +
+	"\x17ReturnQuotaLeaseRequest\x12\"\n" +
+	"\blease_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aleaseId\x12&\n" +
+	"\n" +
+	"used_count\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tusedCount\"U\n" +
+	"\x15ReturnQuotaLeaseReply\x12<\n" +
+	"\x05lease\x18\x01 \x01(\v2&.platform.tenant_service.v1.QuotaLeaseR\x05lease\"\xde\x01\n" +
+	"\x16ListQuotaLeasesRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
+	"\n" +
+	"quota_type\x18\x02 \x01(\x0e2%.platform.tenant_service.v1.QuotaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tquotaType\x12N\n" +
+	"\x06status\x18\x03 \x01(\x0e2,.platform.tenant_service.v1.QuotaLeaseStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"V\n" +
+	"\x14ListQuotaLeasesReply\x12>\n" +
+	"\x06leases\x18\x01 \x03(\v2&.platform.tenant_service.v1.QuotaLeaseR\x06leases\"\xb0\x02\n" +
+	"\fTenantMember\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12:\n" +
+	"\x04role\x18\x03 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleR\x04role\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x18\n" +
+	"\ainherit\x18\x06 \x01(\bR\ainherit\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\a \x01(\tR\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xa7\x03\n" +
+	"\x10TenantInvitation\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12:\n" +
+	"\x04role\x18\x04 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleR\x04role\x12\x18\n" +
+	"\ainherit\x18\x05 \x01(\bR\ainherit\x12D\n" +
+	"\x06status\x18\x06 \x01(\x0e2,.platform.tenant_service.v1.InvitationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\a \x01(\tR\tinvitedBy\x12\x1f\n" +
+	"\vexpire_time\x18\b \x01(\tR\n" +
+	"expireTime\x12\x1f\n" +
+	"\vaccepted_by\x18\t \x01(\tR\n" +
+	"acceptedBy\x12\x1f\n" +
+	"\vaccepted_at\x18\n" +
+	" \x01(\tR\n" +
+	"acceptedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\xb8\x02\n" +
+	"\x16AddTenantMemberRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12\"\n" +
+	"\auser_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x06userId\x12F\n" +
+	"\x04role\x18\x03 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04role\x12*\n" +
+	"\fdisplay_name\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\vdisplayName\x12\x1e\n" +
+	"\x05email\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x05email\x12\x18\n" +
+	"\ainherit\x18\x06 \x01(\bR\ainherit\x12&\n" +
+	"\n" +
+	"invited_by\x18\a \x01(\tB\a\xfaB\x04r\x02\x18@R\tinvitedBy\"X\n" +
+	"\x14AddTenantMemberReply\x12@\n" +
+	"\x06member\x18\x01 \x01(\v2(.platform.tenant_service.v1.TenantMemberR\x06member\"\xd4\x01\n" +
+	"\x19UpdateTenantMemberRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12D\n" +
+	"\x04role\x18\x03 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04role\x12\x1d\n" +
+	"\ainherit\x18\x04 \x01(\bH\x00R\ainherit\x88\x01\x01B\n" +
+	"\n" +
+	"\b_inherit\"[\n" +
+	"\x17UpdateTenantMemberReply\x12@\n" +
+	"\x06member\x18\x01 \x01(\v2(.platform.tenant_service.v1.TenantMemberR\x06member\"c\n" +
+	"\x19RemoveTenantMemberRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"3\n" +
+	"\x17RemoveTenantMemberReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb3\x01\n" +
+	"\x18ListTenantMembersRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12D\n" +
+	"\x04role\x18\x02 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04role\x12+\n" +
+	"\x11include_inherited\x18\x03 \x01(\bR\x10includeInherited\"\\\n" +
+	"\x16ListTenantMembersReply\x12B\n" +
+	"\amembers\x18\x01 \x03(\v2(.platform.tenant_service.v1.TenantMemberR\amembers\"\x9b\x02\n" +
+	"\x1dCreateTenantInvitationRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x18\x80\x01`\x01R\x05email\x12F\n" +
+	"\x04role\x18\x03 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04role\x12\x18\n" +
+	"\ainherit\x18\x04 \x01(\bR\ainherit\x12&\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\tinvitedBy\x12(\n" +
+	"\vttl_seconds\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"ttlSeconds\"\x81\x01\n" +
+	"\x1bCreateTenantInvitationReply\x12L\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2,.platform.tenant_service.v1.TenantInvitationR\n" +
+	"invitation\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x8e\x01\n" +
+	"\x1dAcceptTenantInvitationRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12\"\n" +
+	"\auser_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x06userId\x12*\n" +
+	"\fdisplay_name\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\vdisplayName\"_\n" +
+	"\x1bAcceptTenantInvitationReply\x12@\n" +
+	"\x06member\x18\x01 \x01(\v2(.platform.tenant_service.v1.TenantMemberR\x06member\"M\n" +
+	"\x1dRevokeTenantInvitationRequest\x12,\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\finvitationId\"k\n" +
+	"\x1bRevokeTenantInvitationReply\x12L\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2,.platform.tenant_service.v1.TenantInvitationR\n" +
+	"invitation\"\x94\x01\n" +
+	"\x1cListTenantInvitationsRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12N\n" +
+	"\x06status\x18\x02 \x01(\x0e2,.platform.tenant_service.v1.InvitationStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"l\n" +
+	"\x1aListTenantInvitationsReply\x12N\n" +
+	"\vinvitations\x18\x01 \x03(\v2,.platform.tenant_service.v1.TenantInvitationR\vinvitations\"\x89\x01\n" +
+	"\x16CheckPermissionRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12'\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"permission\"\xee\x01\n" +
+	"\x14CheckPermissionReply\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12:\n" +
+	"\x04role\x18\x02 \x01(\x0e2&.platform.tenant_service.v1.MemberRoleR\x04role\x12(\n" +
+	"\x10source_tenant_id\x18\x03 \x01(\tR\x0esourceTenantId\x12\x1c\n" +
+	"\tinherited\x18\x04 \x01(\bR\tinherited\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12 \n" +
+	"\vpermissions\x18\x06 \x03(\tR\vpermissions\"y\n" +
+	"\x0fPlanEntitlement\x12\x1b\n" +
+	"\x03key\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18?R\x03key\x12*\n" +
+	"\fproduct_code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18 R\vproductCode\x12\x1d\n" +
+	"\x05value\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05value\"\xc2\x02\n" +
+	"\vEntitlement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\fproduct_code\x18\x03 \x01(\tR\vproductCode\x12E\n" +
+	"\x06source\x18\x04 \x01(\x0e2-.platform.tenant_service.v1.EntitlementSourceR\x06source\x12(\n" +
+	"\x10source_tenant_id\x18\x05 \x01(\tR\x0esourceTenantId\x12\x1c\n" +
+	"\tinherited\x18\x06 \x01(\bR\tinherited\x12\x1b\n" +
+	"\tplan_code\x18\a \x01(\tR\bplanCode\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"~\n" +
+	"\x16GetEntitlementsRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12*\n" +
+	"\fproduct_code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18 R\vproductCode\x12\x12\n" +
+	"\x04keys\x18\x03 \x03(\tR\x04keys\"c\n" +
+	"\x14GetEntitlementsReply\x12K\n" +
+	"\fentitlements\x18\x01 \x03(\v2'.platform.tenant_service.v1.EntitlementR\fentitlements\"\xd7\x01\n" +
+	"\x15SetEntitlementRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12\x1b\n" +
+	"\x03key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18?R\x03key\x12*\n" +
+	"\fproduct_code\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18 R\vproductCode\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x14\n" +
+	"\x05unset\x18\x05 \x01(\bR\x05unset\x12#\n" +
+	"\boperator\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\boperator\"`\n" +
+	"\x13SetEntitlementReply\x12I\n" +
+	"\ventitlement\x18\x01 \x01(\v2'.platform.tenant_service.v1.EntitlementR\ventitlement\"Q\n" +
+	"\vAuditChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\x94\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06before\x18\a \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\b \x01(\tR\x05after\x12A\n" +
+	"\achanges\x18\t \x03(\v2'.platform.tenant_service.v1.AuditChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\n" +
+	" \x01(\tR\trequestId\x12\x1b\n" +
+	"\tsource_ip\x18\v \x01(\tR\bsourceIp\x12\x16\n" +
+	"\x06remark\x18\f \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"\xa3\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\x12$\n" +
+	"\x0eafter_event_id\x18\b \x01(\x03R\fafterEventId\x12 \n" +
+	"\x05limit\x18\t \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"V\n" +
+	"\x14ListAuditEventsReply\x12>\n" +
+	"\x06events\x18\x01 \x03(\v2&.platform.tenant_service.v1.AuditEventR\x06events\"\xa9\x02\n" +
+	"\x18ExportAuditEventsRequest\x12J\n" +
+	"\x06format\x18\x01 \x01(\x0e2&.platform.tenant_service.v1.DataFormatB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06format\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x05 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x06 \x01(\tR\btargetId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\a \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\b \x01(\tR\aendTime\".\n" +
+	"\x16ExportAuditEventsReply\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xa0\x01\n" +
+	"\vLedgerIssue\x12?\n" +
+	"\x04kind\x18\x01 \x01(\x0e2+.platform.tenant_service.v1.LedgerIssueKindR\x04kind\x12\x1b\n" +
+	"\tchain_seq\x18\x02 \x01(\x03R\bchainSeq\x12\x1b\n" +
+	"\trecord_id\x18\x03 \x01(\x03R\brecordId\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\xe4\x01\n" +
+	"\x12LedgerVerification\x12\x19\n" +
+	"\bquota_id\x18\x01 \x01(\x03R\aquotaId\x12\x18\n" +
+	"\arecords\x18\x02 \x01(\x05R\arecords\x12\x19\n" +
+	"\bhead_seq\x18\x03 \x01(\x03R\aheadSeq\x12\x1b\n" +
+	"\thead_hash\x18\x04 \x01(\tR\bheadHash\x12 \n" +
+	"\vcheckpoints\x18\x05 \x01(\x05R\vcheckpoints\x12?\n" +
+	"\x06issues\x18\x06 \x03(\v2'.platform.tenant_service.v1.LedgerIssueR\x06issues\"_\n" +
+	"\x13VerifyLedgerRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12\"\n" +
+	"\bquota_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aquotaId\"m\n" +
+	"\x11VerifyLedgerReply\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12H\n" +
+	"\aresults\x18\x02 \x03(\v2..platform.tenant_service.v1.LedgerVerificationR\aresults\"\x81\x02\n" +
+	"\x10LedgerCheckpoint\x12#\n" +
+	"\rcheckpoint_id\x18\x01 \x01(\x03R\fcheckpointId\x12\x19\n" +
+	"\bquota_id\x18\x02 \x01(\x03R\aquotaId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tchain_seq\x18\x04 \x01(\x03R\bchainSeq\x12\x1f\n" +
+	"\vrecord_hash\x18\x05 \x01(\tR\n" +
+	"recordHash\x12\x15\n" +
+	"\x06key_id\x18\x06 \x01(\tR\x05keyId\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xb1\x01\n" +
+	"\x1cListLedgerCheckpointsRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\btenantId\x12\x19\n" +
+	"\bquota_id\x18\x02 \x01(\x03R\aquotaId\x12.\n" +
+	"\x13after_checkpoint_id\x18\x03 \x01(\x03R\x11afterCheckpointId\x12 \n" +
+	"\x05limit\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"\xa2\x01\n" +
+	"\x1aListLedgerCheckpointsReply\x12N\n" +
+	"\vcheckpoints\x18\x01 \x03(\v2,.platform.tenant_service.v1.LedgerCheckpointR\vcheckpoints\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x15\n" +
+	"\x06key_id\x18\x03 \x01(\tR\x05keyId*x\n" +
 	"\n" +
 	"TenantType\x12\x1b\n" +
 	"\x17TENANT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
@@ -1752,8 +11532,77 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\x1aOPERATION_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16OPERATION_TYPE_CONSUME\x10\x01\x12\x1a\n" +
 	"\x16OPERATION_TYPE_RELEASE\x10\x02\x12\x19\n" +
-	"\x15OPERATION_TYPE_ADJUST\x10\x032\xac\n" +
+	"\x15OPERATION_TYPE_ADJUST\x10\x03*\x8c\x01\n" +
+	"\x0fEnforcementMode\x12 \n" +
+	"\x1cENFORCEMENT_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ENFORCEMENT_MODE_HARD\x10\x01\x12\x1e\n" +
+	"\x1aENFORCEMENT_MODE_SOFT_ONLY\x10\x02\x12\x1c\n" +
+	"\x18ENFORCEMENT_MODE_OVERAGE\x10\x03*\x9f\x02\n" +
+	"\x0fQuotaMatchLevel\x12!\n" +
+	"\x1dQUOTA_MATCH_LEVEL_UNSPECIFIED\x10\x00\x12$\n" +
+	" QUOTA_MATCH_LEVEL_TENANT_PRODUCT\x10\x01\x12%\n" +
+	"!QUOTA_MATCH_LEVEL_TENANT_WILDCARD\x10\x02\x12&\n" +
+	"\"QUOTA_MATCH_LEVEL_ANCESTOR_PRODUCT\x10\x03\x12'\n" +
+	"#QUOTA_MATCH_LEVEL_ANCESTOR_WILDCARD\x10\x04\x12$\n" +
+	" QUOTA_MATCH_LEVEL_GLOBAL_PRODUCT\x10\x05\x12%\n" +
+	"!QUOTA_MATCH_LEVEL_GLOBAL_WILDCARD\x10\x06*\x8c\x01\n" +
+	"\x0fProrationPolicy\x12 \n" +
+	"\x1cPRORATION_POLICY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bPRORATION_POLICY_CARRY_OVER\x10\x01\x12\x1a\n" +
+	"\x16PRORATION_POLICY_SCALE\x10\x02\x12\x1a\n" +
+	"\x16PRORATION_POLICY_RESET\x10\x03*\xbc\x01\n" +
+	"\x11QuotaChangeStatus\x12#\n" +
+	"\x1fQUOTA_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bQUOTA_CHANGE_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bQUOTA_CHANGE_STATUS_APPLIED\x10\x02\x12 \n" +
+	"\x1cQUOTA_CHANGE_STATUS_CANCELED\x10\x03\x12\x1e\n" +
+	"\x1aQUOTA_CHANGE_STATUS_FAILED\x10\x04*U\n" +
+	"\n" +
+	"DataFormat\x12\x1b\n" +
+	"\x17DATA_FORMAT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fDATA_FORMAT_CSV\x10\x01\x12\x15\n" +
+	"\x11DATA_FORMAT_JSONL\x10\x02*\xaa\x01\n" +
+	"\x15WalletTransactionType\x12'\n" +
+	"#WALLET_TRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWALLET_TRANSACTION_TYPE_TOPUP\x10\x01\x12!\n" +
+	"\x1dWALLET_TRANSACTION_TYPE_DEBIT\x10\x02\x12\"\n" +
+	"\x1eWALLET_TRANSACTION_TYPE_REFUND\x10\x03*l\n" +
+	"\x0fLedgerDirection\x12 \n" +
+	"\x1cLEDGER_DIRECTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16LEDGER_DIRECTION_DEBIT\x10\x01\x12\x1b\n" +
+	"\x17LEDGER_DIRECTION_CREDIT\x10\x02*\x98\x01\n" +
+	"\x10QuotaLeaseStatus\x12\"\n" +
+	"\x1eQUOTA_LEASE_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19QUOTA_LEASE_STATUS_ACTIVE\x10\x01\x12\x1f\n" +
+	"\x1bQUOTA_LEASE_STATUS_RETURNED\x10\x02\x12 \n" +
+	"\x1cQUOTA_LEASE_STATUS_RECLAIMED\x10\x03*\x89\x01\n" +
 	"\n" +
+	"MemberRole\x12\x1b\n" +
+	"\x17MEMBER_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11MEMBER_ROLE_OWNER\x10\x01\x12\x15\n" +
+	"\x11MEMBER_ROLE_ADMIN\x10\x02\x12\x18\n" +
+	"\x14MEMBER_ROLE_OPERATOR\x10\x03\x12\x16\n" +
+	"\x12MEMBER_ROLE_VIEWER\x10\x04*\xb2\x01\n" +
+	"\x10InvitationStatus\x12!\n" +
+	"\x1dINVITATION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19INVITATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aINVITATION_STATUS_ACCEPTED\x10\x02\x12\x1d\n" +
+	"\x19INVITATION_STATUS_REVOKED\x10\x03\x12\x1d\n" +
+	"\x19INVITATION_STATUS_EXPIRED\x10\x04*\x93\x01\n" +
+	"\x11EntitlementSource\x12\"\n" +
+	"\x1eENTITLEMENT_SOURCE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aENTITLEMENT_SOURCE_DEFAULT\x10\x01\x12\x1b\n" +
+	"\x17ENTITLEMENT_SOURCE_PLAN\x10\x02\x12\x1d\n" +
+	"\x19ENTITLEMENT_SOURCE_TENANT\x10\x03*\xb8\x02\n" +
+	"\x0fLedgerIssueKind\x12!\n" +
+	"\x1dLEDGER_ISSUE_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15LEDGER_ISSUE_KIND_GAP\x10\x01\x12#\n" +
+	"\x1fLEDGER_ISSUE_KIND_HASH_MISMATCH\x10\x02\x12#\n" +
+	"\x1fLEDGER_ISSUE_KIND_LINK_MISMATCH\x10\x03\x12#\n" +
+	"\x1fLEDGER_ISSUE_KIND_HEAD_MISMATCH\x10\x04\x12)\n" +
+	"%LEDGER_ISSUE_KIND_CHECKPOINT_MISMATCH\x10\x05\x12*\n" +
+	"&LEDGER_ISSUE_KIND_CHECKPOINT_SIGNATURE\x10\x06\x12!\n" +
+	"\x1dLEDGER_ISSUE_KIND_UNSEQUENCED\x10\a2\x8fA\n" +
 	"\x06Tenant\x12\x86\x01\n" +
 	"\fCreateTenant\x12/.platform.tenant_service.v1.CreateTenantRequest\x1a-.platform.tenant_service.v1.CreateTenantReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tenants\x12\x86\x01\n" +
 	"\tGetTenant\x12,.platform.tenant_service.v1.GetTenantRequest\x1a*.platform.tenant_service.v1.GetTenantReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/tenants/{tenant_id}\x12\x80\x01\n" +
@@ -1761,10 +11610,56 @@ const file_platform_tenant_service_v1_tenant_proto_rawDesc = "" +
 	"\fUpdateTenant\x12/.platform.tenant_service.v1.UpdateTenantRequest\x1a-.platform.tenant_service.v1.UpdateTenantReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/tenants/{tenant_id}\x12\x8f\x01\n" +
 	"\fDeleteTenant\x12/.platform.tenant_service.v1.DeleteTenantRequest\x1a-.platform.tenant_service.v1.DeleteTenantReply\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/tenants/{tenant_id}\x12\x98\x01\n" +
 	"\n" +
-	"CheckQuota\x12-.platform.tenant_service.v1.CheckQuotaRequest\x1a+.platform.tenant_service.v1.CheckQuotaReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/tenants/{tenant_id}/quota/check\x12\xa0\x01\n" +
+	"CheckQuota\x12-.platform.tenant_service.v1.CheckQuotaRequest\x1a+.platform.tenant_service.v1.CheckQuotaReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/tenants/{tenant_id}/quota/check\x12\x9d\x01\n" +
+	"\fExplainQuota\x12/.platform.tenant_service.v1.ExplainQuotaRequest\x1a-.platform.tenant_service.v1.ExplainQuotaReply\"-\x82\xd3\xe4\x93\x02'\x12%/v1/tenants/{tenant_id}/quota/explain\x12\xa0\x01\n" +
 	"\fConsumeQuota\x12/.platform.tenant_service.v1.ConsumeQuotaRequest\x1a-.platform.tenant_service.v1.ConsumeQuotaReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/quota/consume\x12\xa0\x01\n" +
-	"\fReleaseQuota\x12/.platform.tenant_service.v1.ReleaseQuotaRequest\x1a-.platform.tenant_service.v1.ReleaseQuotaReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/quota/release\x12\x84\x01\n" +
-	"\fListProducts\x12/.platform.tenant_service.v1.ListProductsRequest\x1a-.platform.tenant_service.v1.ListProductsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/productsBSZQtenant-service/api/tenant_service/v1;v1b\x06proto3"
+	"\fReleaseQuota\x12/.platform.tenant_service.v1.ReleaseQuotaRequest\x1a-.platform.tenant_service.v1.ReleaseQuotaReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/quota/release\x12\x90\x01\n" +
+	"\n" +
+	"ListQuotas\x12-.platform.tenant_service.v1.ListQuotasRequest\x1a+.platform.tenant_service.v1.ListQuotasReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/tenants/{tenant_id}/quotas\x12\x9c\x01\n" +
+	"\vAdjustQuota\x12..platform.tenant_service.v1.AdjustQuotaRequest\x1a,.platform.tenant_service.v1.AdjustQuotaReply\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/quota/adjust\x12\x98\x01\n" +
+	"\n" +
+	"ResetQuota\x12-.platform.tenant_service.v1.ResetQuotaRequest\x1a+.platform.tenant_service.v1.ResetQuotaReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/tenants/{tenant_id}/quota/reset\x12\xa7\x01\n" +
+	"\x10ListUsageRecords\x123.platform.tenant_service.v1.ListUsageRecordsRequest\x1a1.platform.tenant_service.v1.ListUsageRecordsReply\"+\x82\xd3\xe4\x93\x02%\x12#/v1/tenants/{tenant_id}/quota/usage\x12\xb5\x01\n" +
+	"\x13ScheduleQuotaChange\x126.platform.tenant_service.v1.ScheduleQuotaChangeRequest\x1a4.platform.tenant_service.v1.ScheduleQuotaChangeReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/quota/changes\x12\xa9\x01\n" +
+	"\x10ListQuotaChanges\x123.platform.tenant_service.v1.ListQuotaChangesRequest\x1a1.platform.tenant_service.v1.ListQuotaChangesReply\"-\x82\xd3\xe4\x93\x02'\x12%/v1/tenants/{tenant_id}/quota/changes\x12\xc2\x01\n" +
+	"\x11CancelQuotaChange\x124.platform.tenant_service.v1.CancelQuotaChangeRequest\x1a2.platform.tenant_service.v1.CancelQuotaChangeReply\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/tenants/{tenant_id}/quota/changes/{change_id}/cancel\x12\x84\x01\n" +
+	"\fListOverages\x12/.platform.tenant_service.v1.ListOveragesRequest\x1a-.platform.tenant_service.v1.ListOveragesReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/overages\x12\x8e\x01\n" +
+	"\x0eGetUsageReport\x121.platform.tenant_service.v1.GetUsageReportRequest\x1a/.platform.tenant_service.v1.GetUsageReportReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/usage/report\x12\xb2\x01\n" +
+	"\x12GetUsageTimeSeries\x125.platform.tenant_service.v1.GetUsageTimeSeriesRequest\x1a3.platform.tenant_service.v1.GetUsageTimeSeriesReply\"0\x82\xd3\xe4\x93\x02*\x12(/v1/tenants/{tenant_id}/usage/timeseries\x12\x84\x01\n" +
+	"\bSavePlan\x12+.platform.tenant_service.v1.SavePlanRequest\x1a).platform.tenant_service.v1.SavePlanReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/plans/{plan_code}\x12~\n" +
+	"\aGetPlan\x12*.platform.tenant_service.v1.GetPlanRequest\x1a(.platform.tenant_service.v1.GetPlanReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/plans/{plan_code}\x12x\n" +
+	"\tListPlans\x12,.platform.tenant_service.v1.ListPlansRequest\x1a*.platform.tenant_service.v1.ListPlansReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/plans\x12\x91\x01\n" +
+	"\n" +
+	"AssignPlan\x12-.platform.tenant_service.v1.AssignPlanRequest\x1a+.platform.tenant_service.v1.AssignPlanReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/tenants/{tenant_id}/plan\x12\x84\x01\n" +
+	"\fListProducts\x12/.platform.tenant_service.v1.ListProductsRequest\x1a-.platform.tenant_service.v1.ListProductsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/products\x12\x98\x01\n" +
+	"\vBindProduct\x12..platform.tenant_service.v1.BindProductRequest\x1a,.platform.tenant_service.v1.BindProductReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/tenants/{tenant_id}/products\x12\x8d\x01\n" +
+	"\tGetWallet\x12,.platform.tenant_service.v1.GetWalletRequest\x1a*.platform.tenant_service.v1.GetWalletReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/tenants/{tenant_id}/wallet\x12\xb5\x01\n" +
+	"\x12SetWalletThreshold\x125.platform.tenant_service.v1.SetWalletThresholdRequest\x1a3.platform.tenant_service.v1.SetWalletThresholdReply\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/v1/tenants/{tenant_id}/wallet/threshold\x12\x9c\x01\n" +
+	"\vTopUpWallet\x12..platform.tenant_service.v1.TopUpWalletRequest\x1a,.platform.tenant_service.v1.TopUpWalletReply\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/wallet/topup\x12\x9c\x01\n" +
+	"\vDebitWallet\x12..platform.tenant_service.v1.DebitWalletRequest\x1a,.platform.tenant_service.v1.DebitWalletReply\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/wallet/debit\x12\xa0\x01\n" +
+	"\fRefundWallet\x12/.platform.tenant_service.v1.RefundWalletRequest\x1a-.platform.tenant_service.v1.RefundWalletReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/wallet/refund\x12\xc1\x01\n" +
+	"\x16ListWalletTransactions\x129.platform.tenant_service.v1.ListWalletTransactionsRequest\x1a7.platform.tenant_service.v1.ListWalletTransactionsReply\"3\x82\xd3\xe4\x93\x02-\x12+/v1/tenants/{tenant_id}/wallet/transactions\x12\xa8\x01\n" +
+	"\x0fLeaseQuotaBlock\x122.platform.tenant_service.v1.LeaseQuotaBlockRequest\x1a0.platform.tenant_service.v1.LeaseQuotaBlockReply\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/quota/leases\x12\xa5\x01\n" +
+	"\x0fRenewQuotaLease\x122.platform.tenant_service.v1.RenewQuotaLeaseRequest\x1a0.platform.tenant_service.v1.RenewQuotaLeaseReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/quota/leases/{lease_id}/renew\x12\xa9\x01\n" +
+	"\x10ReturnQuotaLease\x123.platform.tenant_service.v1.ReturnQuotaLeaseRequest\x1a1.platform.tenant_service.v1.ReturnQuotaLeaseReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/quota/leases/{lease_id}/return\x12\xa5\x01\n" +
+	"\x0fListQuotaLeases\x122.platform.tenant_service.v1.ListQuotaLeasesRequest\x1a0.platform.tenant_service.v1.ListQuotaLeasesReply\",\x82\xd3\xe4\x93\x02&\x12$/v1/tenants/{tenant_id}/quota/leases\x12\xa3\x01\n" +
+	"\x0fAddTenantMember\x122.platform.tenant_service.v1.AddTenantMemberRequest\x1a0.platform.tenant_service.v1.AddTenantMemberReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tenants/{tenant_id}/members\x12\xb6\x01\n" +
+	"\x12UpdateTenantMember\x125.platform.tenant_service.v1.UpdateTenantMemberRequest\x1a3.platform.tenant_service.v1.UpdateTenantMemberReply\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/tenants/{tenant_id}/members/{user_id}\x12\xb3\x01\n" +
+	"\x12RemoveTenantMember\x125.platform.tenant_service.v1.RemoveTenantMemberRequest\x1a3.platform.tenant_service.v1.RemoveTenantMemberReply\"1\x82\xd3\xe4\x93\x02+*)/v1/tenants/{tenant_id}/members/{user_id}\x12\xa6\x01\n" +
+	"\x11ListTenantMembers\x124.platform.tenant_service.v1.ListTenantMembersRequest\x1a2.platform.tenant_service.v1.ListTenantMembersReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/tenants/{tenant_id}/members\x12\xbc\x01\n" +
+	"\x16CreateTenantInvitation\x129.platform.tenant_service.v1.CreateTenantInvitationRequest\x1a7.platform.tenant_service.v1.CreateTenantInvitationReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/tenants/{tenant_id}/invitations\x12\xaf\x01\n" +
+	"\x16AcceptTenantInvitation\x129.platform.tenant_service.v1.AcceptTenantInvitationRequest\x1a7.platform.tenant_service.v1.AcceptTenantInvitationReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/invitations/accept\x12\xbf\x01\n" +
+	"\x16RevokeTenantInvitation\x129.platform.tenant_service.v1.RevokeTenantInvitationRequest\x1a7.platform.tenant_service.v1.RevokeTenantInvitationReply\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/invitations/{invitation_id}/revoke\x12\xb6\x01\n" +
+	"\x15ListTenantInvitations\x128.platform.tenant_service.v1.ListTenantInvitationsRequest\x1a6.platform.tenant_service.v1.ListTenantInvitationsReply\"+\x82\xd3\xe4\x93\x02%\x12#/v1/tenants/{tenant_id}/invitations\x12\xad\x01\n" +
+	"\x0fCheckPermission\x122.platform.tenant_service.v1.CheckPermissionRequest\x1a0.platform.tenant_service.v1.CheckPermissionReply\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/tenants/{tenant_id}/permissions/check\x12\xa5\x01\n" +
+	"\x0fGetEntitlements\x122.platform.tenant_service.v1.GetEntitlementsRequest\x1a0.platform.tenant_service.v1.GetEntitlementsReply\",\x82\xd3\xe4\x93\x02&\x12$/v1/tenants/{tenant_id}/entitlements\x12\xab\x01\n" +
+	"\x0eSetEntitlement\x121.platform.tenant_service.v1.SetEntitlementRequest\x1a/.platform.tenant_service.v1.SetEntitlementReply\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/v1/tenants/{tenant_id}/entitlements/{key}\x12\x91\x01\n" +
+	"\x0fListAuditEvents\x122.platform.tenant_service.v1.ListAuditEventsRequest\x1a0.platform.tenant_service.v1.ListAuditEventsReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit-events\x12\x7f\n" +
+	"\x11ExportAuditEvents\x124.platform.tenant_service.v1.ExportAuditEventsRequest\x1a2.platform.tenant_service.v1.ExportAuditEventsReply0\x01\x12\xa0\x01\n" +
+	"\fVerifyLedger\x12/.platform.tenant_service.v1.VerifyLedgerRequest\x1a-.platform.tenant_service.v1.VerifyLedgerReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/ledger/verify\x12\xbd\x01\n" +
+	"\x15ListLedgerCheckpoints\x128.platform.tenant_service.v1.ListLedgerCheckpointsRequest\x1a6.platform.tenant_service.v1.ListLedgerCheckpointsReply\"2\x82\xd3\xe4\x93\x02,\x12*/v1/tenants/{tenant_id}/ledger/checkpoints\x12s\n" +
+	"\rImportTenants\x120.platform.tenant_service.v1.ImportTenantsRequest\x1a..platform.tenant_service.v1.ImportTenantsReply(\x01\x12s\n" +
+	"\rExportTenants\x120.platform.tenant_service.v1.ExportTenantsRequest\x1a..platform.tenant_service.v1.ExportTenantsReply0\x01B)Z'tenant-service/api/tenant_service/v1;v1b\x06proto3"
 
 var (
 	file_platform_tenant_service_v1_tenant_proto_rawDescOnce sync.Once
@@ -1778,82 +11673,435 @@ func file_platform_tenant_service_v1_tenant_proto_rawDescGZIP() []byte {
 	return file_platform_tenant_service_v1_tenant_proto_rawDescData
 }
 
-var file_platform_tenant_service_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_platform_tenant_service_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_platform_tenant_service_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_platform_tenant_service_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
 var file_platform_tenant_service_v1_tenant_proto_goTypes = []any{
-	(TenantType)(0),             // 0: platform.tenant_service.v1.TenantType
-	(QuotaType)(0),              // 1: platform.tenant_service.v1.QuotaType
-	(LimitType)(0),              // 2: platform.tenant_service.v1.LimitType
-	(OperationType)(0),          // 3: platform.tenant_service.v1.OperationType
-	(*TenantInfo)(nil),          // 4: platform.tenant_service.v1.TenantInfo
-	(*QuotaInfo)(nil),           // 5: platform.tenant_service.v1.QuotaInfo
-	(*Product)(nil),             // 6: platform.tenant_service.v1.Product
-	(*CreateTenantRequest)(nil), // 7: platform.tenant_service.v1.CreateTenantRequest
-	(*CreateTenantReply)(nil),   // 8: platform.tenant_service.v1.CreateTenantReply
-	(*GetTenantRequest)(nil),    // 9: platform.tenant_service.v1.GetTenantRequest
-	(*GetTenantReply)(nil),      // 10: platform.tenant_service.v1.GetTenantReply
-	(*ListTenantsRequest)(nil),  // 11: platform.tenant_service.v1.ListTenantsRequest
-	(*ListTenantsReply)(nil),    // 12: platform.tenant_service.v1.ListTenantsReply
-	(*UpdateTenantRequest)(nil), // 13: platform.tenant_service.v1.UpdateTenantRequest
-	(*UpdateTenantReply)(nil),   // 14: platform.tenant_service.v1.UpdateTenantReply
-	(*DeleteTenantRequest)(nil), // 15: platform.tenant_service.v1.DeleteTenantRequest
-	(*DeleteTenantReply)(nil),   // 16: platform.tenant_service.v1.DeleteTenantReply
-	(*CheckQuotaRequest)(nil),   // 17: platform.tenant_service.v1.CheckQuotaRequest
-	(*CheckQuotaReply)(nil),     // 18: platform.tenant_service.v1.CheckQuotaReply
-	(*ConsumeQuotaRequest)(nil), // 19: platform.tenant_service.v1.ConsumeQuotaRequest
-	(*ConsumeQuotaReply)(nil),   // 20: platform.tenant_service.v1.ConsumeQuotaReply
-	(*ReleaseQuotaRequest)(nil), // 21: platform.tenant_service.v1.ReleaseQuotaRequest
-	(*ReleaseQuotaReply)(nil),   // 22: platform.tenant_service.v1.ReleaseQuotaReply
-	(*ListProductsRequest)(nil), // 23: platform.tenant_service.v1.ListProductsRequest
-	(*ListProductsReply)(nil),   // 24: platform.tenant_service.v1.ListProductsReply
-	nil,                         // 25: platform.tenant_service.v1.TenantInfo.QuotaConfigEntry
-	nil,                         // 26: platform.tenant_service.v1.CreateTenantRequest.QuotaConfigEntry
-	nil,                         // 27: platform.tenant_service.v1.UpdateTenantRequest.QuotaConfigEntry
+	(TenantType)(0),                       // 0: platform.tenant_service.v1.TenantType
+	(QuotaType)(0),                        // 1: platform.tenant_service.v1.QuotaType
+	(LimitType)(0),                        // 2: platform.tenant_service.v1.LimitType
+	(OperationType)(0),                    // 3: platform.tenant_service.v1.OperationType
+	(EnforcementMode)(0),                  // 4: platform.tenant_service.v1.EnforcementMode
+	(QuotaMatchLevel)(0),                  // 5: platform.tenant_service.v1.QuotaMatchLevel
+	(ProrationPolicy)(0),                  // 6: platform.tenant_service.v1.ProrationPolicy
+	(QuotaChangeStatus)(0),                // 7: platform.tenant_service.v1.QuotaChangeStatus
+	(DataFormat)(0),                       // 8: platform.tenant_service.v1.DataFormat
+	(WalletTransactionType)(0),            // 9: platform.tenant_service.v1.WalletTransactionType
+	(LedgerDirection)(0),                  // 10: platform.tenant_service.v1.LedgerDirection
+	(QuotaLeaseStatus)(0),                 // 11: platform.tenant_service.v1.QuotaLeaseStatus
+	(MemberRole)(0),                       // 12: platform.tenant_service.v1.MemberRole
+	(InvitationStatus)(0),                 // 13: platform.tenant_service.v1.InvitationStatus
+	(EntitlementSource)(0),                // 14: platform.tenant_service.v1.EntitlementSource
+	(LedgerIssueKind)(0),                  // 15: platform.tenant_service.v1.LedgerIssueKind
+	(*TenantInfo)(nil),                    // 16: platform.tenant_service.v1.TenantInfo
+	(*QuotaInfo)(nil),                     // 17: platform.tenant_service.v1.QuotaInfo
+	(*QuotaAllocation)(nil),               // 18: platform.tenant_service.v1.QuotaAllocation
+	(*Product)(nil),                       // 19: platform.tenant_service.v1.Product
+	(*CreateTenantRequest)(nil),           // 20: platform.tenant_service.v1.CreateTenantRequest
+	(*CreateTenantReply)(nil),             // 21: platform.tenant_service.v1.CreateTenantReply
+	(*GetTenantRequest)(nil),              // 22: platform.tenant_service.v1.GetTenantRequest
+	(*GetTenantReply)(nil),                // 23: platform.tenant_service.v1.GetTenantReply
+	(*ListTenantsRequest)(nil),            // 24: platform.tenant_service.v1.ListTenantsRequest
+	(*ListTenantsReply)(nil),              // 25: platform.tenant_service.v1.ListTenantsReply
+	(*UpdateTenantRequest)(nil),           // 26: platform.tenant_service.v1.UpdateTenantRequest
+	(*UpdateTenantReply)(nil),             // 27: platform.tenant_service.v1.UpdateTenantReply
+	(*DeleteTenantRequest)(nil),           // 28: platform.tenant_service.v1.DeleteTenantRequest
+	(*DeleteTenantReply)(nil),             // 29: platform.tenant_service.v1.DeleteTenantReply
+	(*CheckQuotaRequest)(nil),             // 30: platform.tenant_service.v1.CheckQuotaRequest
+	(*CheckQuotaReply)(nil),               // 31: platform.tenant_service.v1.CheckQuotaReply
+	(*QuotaCandidate)(nil),                // 32: platform.tenant_service.v1.QuotaCandidate
+	(*ExplainQuotaRequest)(nil),           // 33: platform.tenant_service.v1.ExplainQuotaRequest
+	(*ExplainQuotaReply)(nil),             // 34: platform.tenant_service.v1.ExplainQuotaReply
+	(*ConsumeQuotaRequest)(nil),           // 35: platform.tenant_service.v1.ConsumeQuotaRequest
+	(*ConsumeQuotaReply)(nil),             // 36: platform.tenant_service.v1.ConsumeQuotaReply
+	(*ReleaseQuotaRequest)(nil),           // 37: platform.tenant_service.v1.ReleaseQuotaRequest
+	(*ReleaseQuotaReply)(nil),             // 38: platform.tenant_service.v1.ReleaseQuotaReply
+	(*QuotaUsageRecord)(nil),              // 39: platform.tenant_service.v1.QuotaUsageRecord
+	(*ListQuotasRequest)(nil),             // 40: platform.tenant_service.v1.ListQuotasRequest
+	(*ListQuotasReply)(nil),               // 41: platform.tenant_service.v1.ListQuotasReply
+	(*AdjustQuotaRequest)(nil),            // 42: platform.tenant_service.v1.AdjustQuotaRequest
+	(*AdjustQuotaReply)(nil),              // 43: platform.tenant_service.v1.AdjustQuotaReply
+	(*ResetQuotaRequest)(nil),             // 44: platform.tenant_service.v1.ResetQuotaRequest
+	(*ResetQuotaReply)(nil),               // 45: platform.tenant_service.v1.ResetQuotaReply
+	(*ListUsageRecordsRequest)(nil),       // 46: platform.tenant_service.v1.ListUsageRecordsRequest
+	(*ListUsageRecordsReply)(nil),         // 47: platform.tenant_service.v1.ListUsageRecordsReply
+	(*ListOveragesRequest)(nil),           // 48: platform.tenant_service.v1.ListOveragesRequest
+	(*QuotaOverage)(nil),                  // 49: platform.tenant_service.v1.QuotaOverage
+	(*ListOveragesReply)(nil),             // 50: platform.tenant_service.v1.ListOveragesReply
+	(*GetUsageReportRequest)(nil),         // 51: platform.tenant_service.v1.GetUsageReportRequest
+	(*TopConsumer)(nil),                   // 52: platform.tenant_service.v1.TopConsumer
+	(*QuotaTypeTopConsumers)(nil),         // 53: platform.tenant_service.v1.QuotaTypeTopConsumers
+	(*UtilizationBucket)(nil),             // 54: platform.tenant_service.v1.UtilizationBucket
+	(*ExhaustionForecast)(nil),            // 55: platform.tenant_service.v1.ExhaustionForecast
+	(*GetUsageReportReply)(nil),           // 56: platform.tenant_service.v1.GetUsageReportReply
+	(*GetUsageTimeSeriesRequest)(nil),     // 57: platform.tenant_service.v1.GetUsageTimeSeriesRequest
+	(*UsagePoint)(nil),                    // 58: platform.tenant_service.v1.UsagePoint
+	(*UsageSeries)(nil),                   // 59: platform.tenant_service.v1.UsageSeries
+	(*GetUsageTimeSeriesReply)(nil),       // 60: platform.tenant_service.v1.GetUsageTimeSeriesReply
+	(*PlanQuota)(nil),                     // 61: platform.tenant_service.v1.PlanQuota
+	(*QuotaPlan)(nil),                     // 62: platform.tenant_service.v1.QuotaPlan
+	(*TenantPlan)(nil),                    // 63: platform.tenant_service.v1.TenantPlan
+	(*SavePlanRequest)(nil),               // 64: platform.tenant_service.v1.SavePlanRequest
+	(*PlanPropagationFailure)(nil),        // 65: platform.tenant_service.v1.PlanPropagationFailure
+	(*SavePlanReply)(nil),                 // 66: platform.tenant_service.v1.SavePlanReply
+	(*GetPlanRequest)(nil),                // 67: platform.tenant_service.v1.GetPlanRequest
+	(*GetPlanReply)(nil),                  // 68: platform.tenant_service.v1.GetPlanReply
+	(*ListPlansRequest)(nil),              // 69: platform.tenant_service.v1.ListPlansRequest
+	(*ListPlansReply)(nil),                // 70: platform.tenant_service.v1.ListPlansReply
+	(*AssignPlanRequest)(nil),             // 71: platform.tenant_service.v1.AssignPlanRequest
+	(*AssignPlanReply)(nil),               // 72: platform.tenant_service.v1.AssignPlanReply
+	(*BindProductRequest)(nil),            // 73: platform.tenant_service.v1.BindProductRequest
+	(*BindProductReply)(nil),              // 74: platform.tenant_service.v1.BindProductReply
+	(*ListProductsRequest)(nil),           // 75: platform.tenant_service.v1.ListProductsRequest
+	(*ListProductsReply)(nil),             // 76: platform.tenant_service.v1.ListProductsReply
+	(*QuotaChange)(nil),                   // 77: platform.tenant_service.v1.QuotaChange
+	(*ScheduleQuotaChangeRequest)(nil),    // 78: platform.tenant_service.v1.ScheduleQuotaChangeRequest
+	(*ScheduleQuotaChangeReply)(nil),      // 79: platform.tenant_service.v1.ScheduleQuotaChangeReply
+	(*ListQuotaChangesRequest)(nil),       // 80: platform.tenant_service.v1.ListQuotaChangesRequest
+	(*ListQuotaChangesReply)(nil),         // 81: platform.tenant_service.v1.ListQuotaChangesReply
+	(*CancelQuotaChangeRequest)(nil),      // 82: platform.tenant_service.v1.CancelQuotaChangeRequest
+	(*CancelQuotaChangeReply)(nil),        // 83: platform.tenant_service.v1.CancelQuotaChangeReply
+	(*ImportOptions)(nil),                 // 84: platform.tenant_service.v1.ImportOptions
+	(*ImportTenantsRequest)(nil),          // 85: platform.tenant_service.v1.ImportTenantsRequest
+	(*ImportRowResult)(nil),               // 86: platform.tenant_service.v1.ImportRowResult
+	(*ImportTenantsReply)(nil),            // 87: platform.tenant_service.v1.ImportTenantsReply
+	(*ExportTenantsRequest)(nil),          // 88: platform.tenant_service.v1.ExportTenantsRequest
+	(*ExportTenantsReply)(nil),            // 89: platform.tenant_service.v1.ExportTenantsReply
+	(*Wallet)(nil),                        // 90: platform.tenant_service.v1.Wallet
+	(*LedgerEntry)(nil),                   // 91: platform.tenant_service.v1.LedgerEntry
+	(*WalletTransaction)(nil),             // 92: platform.tenant_service.v1.WalletTransaction
+	(*GetWalletRequest)(nil),              // 93: platform.tenant_service.v1.GetWalletRequest
+	(*GetWalletReply)(nil),                // 94: platform.tenant_service.v1.GetWalletReply
+	(*SetWalletThresholdRequest)(nil),     // 95: platform.tenant_service.v1.SetWalletThresholdRequest
+	(*SetWalletThresholdReply)(nil),       // 96: platform.tenant_service.v1.SetWalletThresholdReply
+	(*TopUpWalletRequest)(nil),            // 97: platform.tenant_service.v1.TopUpWalletRequest
+	(*TopUpWalletReply)(nil),              // 98: platform.tenant_service.v1.TopUpWalletReply
+	(*DebitWalletRequest)(nil),            // 99: platform.tenant_service.v1.DebitWalletRequest
+	(*DebitWalletReply)(nil),              // 100: platform.tenant_service.v1.DebitWalletReply
+	(*RefundWalletRequest)(nil),           // 101: platform.tenant_service.v1.RefundWalletRequest
+	(*RefundWalletReply)(nil),             // 102: platform.tenant_service.v1.RefundWalletReply
+	(*ListWalletTransactionsRequest)(nil), // 103: platform.tenant_service.v1.ListWalletTransactionsRequest
+	(*ListWalletTransactionsReply)(nil),   // 104: platform.tenant_service.v1.ListWalletTransactionsReply
+	(*QuotaLease)(nil),                    // 105: platform.tenant_service.v1.QuotaLease
+	(*LeaseQuotaBlockRequest)(nil),        // 106: platform.tenant_service.v1.LeaseQuotaBlockRequest
+	(*LeaseQuotaBlockReply)(nil),          // 107: platform.tenant_service.v1.LeaseQuotaBlockReply
+	(*RenewQuotaLeaseRequest)(nil),        // 108: platform.tenant_service.v1.RenewQuotaLeaseRequest
+	(*RenewQuotaLeaseReply)(nil),          // 109: platform.tenant_service.v1.RenewQuotaLeaseReply
+	(*ReturnQuotaLeaseRequest)(nil),       // 110: platform.tenant_service.v1.ReturnQuotaLeaseRequest
+	(*ReturnQuotaLeaseReply)(nil),         // 111: platform.tenant_service.v1.ReturnQuotaLeaseReply
+	(*ListQuotaLeasesRequest)(nil),        // 112: platform.tenant_service.v1.ListQuotaLeasesRequest
+	(*ListQuotaLeasesReply)(nil),          // 113: platform.tenant_service.v1.ListQuotaLeasesReply
+	(*TenantMember)(nil),                  // 114: platform.tenant_service.v1.TenantMember
+	(*TenantInvitation)(nil),              // 115: platform.tenant_service.v1.TenantInvitation
+	(*AddTenantMemberRequest)(nil),        // 116: platform.tenant_service.v1.AddTenantMemberRequest
+	(*AddTenantMemberReply)(nil),          // 117: platform.tenant_service.v1.AddTenantMemberReply
+	(*UpdateTenantMemberRequest)(nil),     // 118: platform.tenant_service.v1.UpdateTenantMemberRequest
+	(*UpdateTenantMemberReply)(nil),       // 119: platform.tenant_service.v1.UpdateTenantMemberReply
+	(*RemoveTenantMemberRequest)(nil),     // 120: platform.tenant_service.v1.RemoveTenantMemberRequest
+	(*RemoveTenantMemberReply)(nil),       // 121: platform.tenant_service.v1.RemoveTenantMemberReply
+	(*ListTenantMembersRequest)(nil),      // 122: platform.tenant_service.v1.ListTenantMembersRequest
+	(*ListTenantMembersReply)(nil),        // 123: platform.tenant_service.v1.ListTenantMembersReply
+	(*CreateTenantInvitationRequest)(nil), // 124: platform.tenant_service.v1.CreateTenantInvitationRequest
+	(*CreateTenantInvitationReply)(nil),   // 125: platform.tenant_service.v1.CreateTenantInvitationReply
+	(*AcceptTenantInvitationRequest)(nil), // 126: platform.tenant_service.v1.AcceptTenantInvitationRequest
+	(*AcceptTenantInvitationReply)(nil),   // 127: platform.tenant_service.v1.AcceptTenantInvitationReply
+	(*RevokeTenantInvitationRequest)(nil), // 128: platform.tenant_service.v1.RevokeTenantInvitationRequest
+	(*RevokeTenantInvitationReply)(nil),   // 129: platform.tenant_service.v1.RevokeTenantInvitationReply
+	(*ListTenantInvitationsRequest)(nil),  // 130: platform.tenant_service.v1.ListTenantInvitationsRequest
+	(*ListTenantInvitationsReply)(nil),    // 131: platform.tenant_service.v1.ListTenantInvitationsReply
+	(*CheckPermissionRequest)(nil),        // 132: platform.tenant_service.v1.CheckPermissionRequest
+	(*CheckPermissionReply)(nil),          // 133: platform.tenant_service.v1.CheckPermissionReply
+	(*PlanEntitlement)(nil),               // 134: platform.tenant_service.v1.PlanEntitlement
+	(*Entitlement)(nil),                   // 135: platform.tenant_service.v1.Entitlement
+	(*GetEntitlementsRequest)(nil),        // 136: platform.tenant_service.v1.GetEntitlementsRequest
+	(*GetEntitlementsReply)(nil),          // 137: platform.tenant_service.v1.GetEntitlementsReply
+	(*SetEntitlementRequest)(nil),         // 138: platform.tenant_service.v1.SetEntitlementRequest
+	(*SetEntitlementReply)(nil),           // 139: platform.tenant_service.v1.SetEntitlementReply
+	(*AuditChange)(nil),                   // 140: platform.tenant_service.v1.AuditChange
+	(*AuditEvent)(nil),                    // 141: platform.tenant_service.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),        // 142: platform.tenant_service.v1.ListAuditEventsRequest
+	(*ListAuditEventsReply)(nil),          // 143: platform.tenant_service.v1.ListAuditEventsReply
+	(*ExportAuditEventsRequest)(nil),      // 144: platform.tenant_service.v1.ExportAuditEventsRequest
+	(*ExportAuditEventsReply)(nil),        // 145: platform.tenant_service.v1.ExportAuditEventsReply
+	(*LedgerIssue)(nil),                   // 146: platform.tenant_service.v1.LedgerIssue
+	(*LedgerVerification)(nil),            // 147: platform.tenant_service.v1.LedgerVerification
+	(*VerifyLedgerRequest)(nil),           // 148: platform.tenant_service.v1.VerifyLedgerRequest
+	(*VerifyLedgerReply)(nil),             // 149: platform.tenant_service.v1.VerifyLedgerReply
+	(*LedgerCheckpoint)(nil),              // 150: platform.tenant_service.v1.LedgerCheckpoint
+	(*ListLedgerCheckpointsRequest)(nil),  // 151: platform.tenant_service.v1.ListLedgerCheckpointsRequest
+	(*ListLedgerCheckpointsReply)(nil),    // 152: platform.tenant_service.v1.ListLedgerCheckpointsReply
+	nil,                                   // 153: platform.tenant_service.v1.TenantInfo.QuotaConfigEntry
+	nil,                                   // 154: platform.tenant_service.v1.TenantInfo.LabelsEntry
+	nil,                                   // 155: platform.tenant_service.v1.TenantInfo.AttributesEntry
+	nil,                                   // 156: platform.tenant_service.v1.CreateTenantRequest.QuotaConfigEntry
+	nil,                                   // 157: platform.tenant_service.v1.CreateTenantRequest.LabelsEntry
+	nil,                                   // 158: platform.tenant_service.v1.CreateTenantRequest.AttributesEntry
+	nil,                                   // 159: platform.tenant_service.v1.ListTenantsRequest.AttributesEntry
+	nil,                                   // 160: platform.tenant_service.v1.UpdateTenantRequest.QuotaConfigEntry
+	nil,                                   // 161: platform.tenant_service.v1.UpdateTenantRequest.LabelsEntry
+	nil,                                   // 162: platform.tenant_service.v1.UpdateTenantRequest.AttributesEntry
+	(*base.PageRequest)(nil),              // 163: base.PageRequest
+	(*base.PageResponse)(nil),             // 164: base.PageResponse
 }
 var file_platform_tenant_service_v1_tenant_proto_depIdxs = []int32{
-	0,  // 0: platform.tenant_service.v1.TenantInfo.tenant_type:type_name -> platform.tenant_service.v1.TenantType
-	25, // 1: platform.tenant_service.v1.TenantInfo.quota_config:type_name -> platform.tenant_service.v1.TenantInfo.QuotaConfigEntry
-	1,  // 2: platform.tenant_service.v1.QuotaInfo.quota_type:type_name -> platform.tenant_service.v1.QuotaType
-	2,  // 3: platform.tenant_service.v1.QuotaInfo.limit_type:type_name -> platform.tenant_service.v1.LimitType
-	0,  // 4: platform.tenant_service.v1.CreateTenantRequest.tenant_type:type_name -> platform.tenant_service.v1.TenantType
-	26, // 5: platform.tenant_service.v1.CreateTenantRequest.quota_config:type_name -> platform.tenant_service.v1.CreateTenantRequest.QuotaConfigEntry
-	4,  // 6: platform.tenant_service.v1.CreateTenantReply.tenant:type_name -> platform.tenant_service.v1.TenantInfo
-	4,  // 7: platform.tenant_service.v1.GetTenantReply.tenant:type_name -> platform.tenant_service.v1.TenantInfo
-	0,  // 8: platform.tenant_service.v1.ListTenantsRequest.tenant_type:type_name -> platform.tenant_service.v1.TenantType
-	4,  // 9: platform.tenant_service.v1.ListTenantsReply.tenants:type_name -> platform.tenant_service.v1.TenantInfo
-	27, // 10: platform.tenant_service.v1.UpdateTenantRequest.quota_config:type_name -> platform.tenant_service.v1.UpdateTenantRequest.QuotaConfigEntry
-	4,  // 11: platform.tenant_service.v1.UpdateTenantReply.tenant:type_name -> platform.tenant_service.v1.TenantInfo
-	1,  // 12: platform.tenant_service.v1.CheckQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
-	2,  // 13: platform.tenant_service.v1.CheckQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
-	5,  // 14: platform.tenant_service.v1.CheckQuotaReply.quota:type_name -> platform.tenant_service.v1.QuotaInfo
-	1,  // 15: platform.tenant_service.v1.ConsumeQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
-	2,  // 16: platform.tenant_service.v1.ConsumeQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
-	1,  // 17: platform.tenant_service.v1.ReleaseQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
-	2,  // 18: platform.tenant_service.v1.ReleaseQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
-	6,  // 19: platform.tenant_service.v1.ListProductsReply.products:type_name -> platform.tenant_service.v1.Product
-	7,  // 20: platform.tenant_service.v1.Tenant.CreateTenant:input_type -> platform.tenant_service.v1.CreateTenantRequest
-	9,  // 21: platform.tenant_service.v1.Tenant.GetTenant:input_type -> platform.tenant_service.v1.GetTenantRequest
-	11, // 22: platform.tenant_service.v1.Tenant.ListTenants:input_type -> platform.tenant_service.v1.ListTenantsRequest
-	13, // 23: platform.tenant_service.v1.Tenant.UpdateTenant:input_type -> platform.tenant_service.v1.UpdateTenantRequest
-	15, // 24: platform.tenant_service.v1.Tenant.DeleteTenant:input_type -> platform.tenant_service.v1.DeleteTenantRequest
-	17, // 25: platform.tenant_service.v1.Tenant.CheckQuota:input_type -> platform.tenant_service.v1.CheckQuotaRequest
-	19, // 26: platform.tenant_service.v1.Tenant.ConsumeQuota:input_type -> platform.tenant_service.v1.ConsumeQuotaRequest
-	21, // 27: platform.tenant_service.v1.Tenant.ReleaseQuota:input_type -> platform.tenant_service.v1.ReleaseQuotaRequest
-	23, // 28: platform.tenant_service.v1.Tenant.ListProducts:input_type -> platform.tenant_service.v1.ListProductsRequest
-	8,  // 29: platform.tenant_service.v1.Tenant.CreateTenant:output_type -> platform.tenant_service.v1.CreateTenantReply
-	10, // 30: platform.tenant_service.v1.Tenant.GetTenant:output_type -> platform.tenant_service.v1.GetTenantReply
-	12, // 31: platform.tenant_service.v1.Tenant.ListTenants:output_type -> platform.tenant_service.v1.ListTenantsReply
-	14, // 32: platform.tenant_service.v1.Tenant.UpdateTenant:output_type -> platform.tenant_service.v1.UpdateTenantReply
-	16, // 33: platform.tenant_service.v1.Tenant.DeleteTenant:output_type -> platform.tenant_service.v1.DeleteTenantReply
-	18, // 34: platform.tenant_service.v1.Tenant.CheckQuota:output_type -> platform.tenant_service.v1.CheckQuotaReply
-	20, // 35: platform.tenant_service.v1.Tenant.ConsumeQuota:output_type -> platform.tenant_service.v1.ConsumeQuotaReply
-	22, // 36: platform.tenant_service.v1.Tenant.ReleaseQuota:output_type -> platform.tenant_service.v1.ReleaseQuotaReply
-	24, // 37: platform.tenant_service.v1.Tenant.ListProducts:output_type -> platform.tenant_service.v1.ListProductsReply
-	29, // [29:38] is the sub-list for method output_type
-	20, // [20:29] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,   // 0: platform.tenant_service.v1.TenantInfo.tenant_type:type_name -> platform.tenant_service.v1.TenantType
+	153, // 1: platform.tenant_service.v1.TenantInfo.quota_config:type_name -> platform.tenant_service.v1.TenantInfo.QuotaConfigEntry
+	154, // 2: platform.tenant_service.v1.TenantInfo.labels:type_name -> platform.tenant_service.v1.TenantInfo.LabelsEntry
+	155, // 3: platform.tenant_service.v1.TenantInfo.attributes:type_name -> platform.tenant_service.v1.TenantInfo.AttributesEntry
+	1,   // 4: platform.tenant_service.v1.QuotaInfo.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 5: platform.tenant_service.v1.QuotaInfo.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	4,   // 6: platform.tenant_service.v1.QuotaInfo.enforcement_mode:type_name -> platform.tenant_service.v1.EnforcementMode
+	18,  // 7: platform.tenant_service.v1.QuotaInfo.allocations:type_name -> platform.tenant_service.v1.QuotaAllocation
+	0,   // 8: platform.tenant_service.v1.CreateTenantRequest.tenant_type:type_name -> platform.tenant_service.v1.TenantType
+	156, // 9: platform.tenant_service.v1.CreateTenantRequest.quota_config:type_name -> platform.tenant_service.v1.CreateTenantRequest.QuotaConfigEntry
+	157, // 10: platform.tenant_service.v1.CreateTenantRequest.labels:type_name -> platform.tenant_service.v1.CreateTenantRequest.LabelsEntry
+	158, // 11: platform.tenant_service.v1.CreateTenantRequest.attributes:type_name -> platform.tenant_service.v1.CreateTenantRequest.AttributesEntry
+	16,  // 12: platform.tenant_service.v1.CreateTenantReply.tenant:type_name -> platform.tenant_service.v1.TenantInfo
+	16,  // 13: platform.tenant_service.v1.GetTenantReply.tenant:type_name -> platform.tenant_service.v1.TenantInfo
+	0,   // 14: platform.tenant_service.v1.ListTenantsRequest.tenant_type:type_name -> platform.tenant_service.v1.TenantType
+	0,   // 15: platform.tenant_service.v1.ListTenantsRequest.tenant_types:type_name -> platform.tenant_service.v1.TenantType
+	163, // 16: platform.tenant_service.v1.ListTenantsRequest.page:type_name -> base.PageRequest
+	159, // 17: platform.tenant_service.v1.ListTenantsRequest.attributes:type_name -> platform.tenant_service.v1.ListTenantsRequest.AttributesEntry
+	16,  // 18: platform.tenant_service.v1.ListTenantsReply.tenants:type_name -> platform.tenant_service.v1.TenantInfo
+	164, // 19: platform.tenant_service.v1.ListTenantsReply.page:type_name -> base.PageResponse
+	160, // 20: platform.tenant_service.v1.UpdateTenantRequest.quota_config:type_name -> platform.tenant_service.v1.UpdateTenantRequest.QuotaConfigEntry
+	161, // 21: platform.tenant_service.v1.UpdateTenantRequest.labels:type_name -> platform.tenant_service.v1.UpdateTenantRequest.LabelsEntry
+	162, // 22: platform.tenant_service.v1.UpdateTenantRequest.attributes:type_name -> platform.tenant_service.v1.UpdateTenantRequest.AttributesEntry
+	16,  // 23: platform.tenant_service.v1.UpdateTenantReply.tenant:type_name -> platform.tenant_service.v1.TenantInfo
+	1,   // 24: platform.tenant_service.v1.CheckQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 25: platform.tenant_service.v1.CheckQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	17,  // 26: platform.tenant_service.v1.CheckQuotaReply.quota:type_name -> platform.tenant_service.v1.QuotaInfo
+	18,  // 27: platform.tenant_service.v1.CheckQuotaReply.allocation:type_name -> platform.tenant_service.v1.QuotaAllocation
+	17,  // 28: platform.tenant_service.v1.QuotaCandidate.quota:type_name -> platform.tenant_service.v1.QuotaInfo
+	5,   // 29: platform.tenant_service.v1.QuotaCandidate.level:type_name -> platform.tenant_service.v1.QuotaMatchLevel
+	1,   // 30: platform.tenant_service.v1.ExplainQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 31: platform.tenant_service.v1.ExplainQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	17,  // 32: platform.tenant_service.v1.ExplainQuotaReply.selected:type_name -> platform.tenant_service.v1.QuotaInfo
+	32,  // 33: platform.tenant_service.v1.ExplainQuotaReply.candidates:type_name -> platform.tenant_service.v1.QuotaCandidate
+	1,   // 34: platform.tenant_service.v1.ConsumeQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 35: platform.tenant_service.v1.ConsumeQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	1,   // 36: platform.tenant_service.v1.ReleaseQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 37: platform.tenant_service.v1.ReleaseQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	3,   // 38: platform.tenant_service.v1.QuotaUsageRecord.operation_type:type_name -> platform.tenant_service.v1.OperationType
+	1,   // 39: platform.tenant_service.v1.ListQuotasRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	17,  // 40: platform.tenant_service.v1.ListQuotasReply.quotas:type_name -> platform.tenant_service.v1.QuotaInfo
+	1,   // 41: platform.tenant_service.v1.AdjustQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 42: platform.tenant_service.v1.AdjustQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	4,   // 43: platform.tenant_service.v1.AdjustQuotaRequest.enforcement_mode:type_name -> platform.tenant_service.v1.EnforcementMode
+	17,  // 44: platform.tenant_service.v1.AdjustQuotaReply.quota:type_name -> platform.tenant_service.v1.QuotaInfo
+	1,   // 45: platform.tenant_service.v1.ResetQuotaRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 46: platform.tenant_service.v1.ResetQuotaRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	17,  // 47: platform.tenant_service.v1.ResetQuotaReply.quota:type_name -> platform.tenant_service.v1.QuotaInfo
+	1,   // 48: platform.tenant_service.v1.ListUsageRecordsRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	39,  // 49: platform.tenant_service.v1.ListUsageRecordsReply.records:type_name -> platform.tenant_service.v1.QuotaUsageRecord
+	1,   // 50: platform.tenant_service.v1.ListOveragesRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	1,   // 51: platform.tenant_service.v1.QuotaOverage.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 52: platform.tenant_service.v1.QuotaOverage.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	49,  // 53: platform.tenant_service.v1.ListOveragesReply.overages:type_name -> platform.tenant_service.v1.QuotaOverage
+	1,   // 54: platform.tenant_service.v1.GetUsageReportRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	1,   // 55: platform.tenant_service.v1.QuotaTypeTopConsumers.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	52,  // 56: platform.tenant_service.v1.QuotaTypeTopConsumers.consumers:type_name -> platform.tenant_service.v1.TopConsumer
+	1,   // 57: platform.tenant_service.v1.ExhaustionForecast.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	53,  // 58: platform.tenant_service.v1.GetUsageReportReply.top_consumers:type_name -> platform.tenant_service.v1.QuotaTypeTopConsumers
+	54,  // 59: platform.tenant_service.v1.GetUsageReportReply.soft_limit_utilization:type_name -> platform.tenant_service.v1.UtilizationBucket
+	55,  // 60: platform.tenant_service.v1.GetUsageReportReply.forecasts:type_name -> platform.tenant_service.v1.ExhaustionForecast
+	1,   // 61: platform.tenant_service.v1.GetUsageTimeSeriesRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 62: platform.tenant_service.v1.GetUsageTimeSeriesRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	1,   // 63: platform.tenant_service.v1.UsageSeries.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 64: platform.tenant_service.v1.UsageSeries.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	58,  // 65: platform.tenant_service.v1.UsageSeries.points:type_name -> platform.tenant_service.v1.UsagePoint
+	59,  // 66: platform.tenant_service.v1.GetUsageTimeSeriesReply.series:type_name -> platform.tenant_service.v1.UsageSeries
+	1,   // 67: platform.tenant_service.v1.PlanQuota.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 68: platform.tenant_service.v1.PlanQuota.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	61,  // 69: platform.tenant_service.v1.QuotaPlan.quotas:type_name -> platform.tenant_service.v1.PlanQuota
+	134, // 70: platform.tenant_service.v1.QuotaPlan.entitlements:type_name -> platform.tenant_service.v1.PlanEntitlement
+	61,  // 71: platform.tenant_service.v1.SavePlanRequest.quotas:type_name -> platform.tenant_service.v1.PlanQuota
+	134, // 72: platform.tenant_service.v1.SavePlanRequest.entitlements:type_name -> platform.tenant_service.v1.PlanEntitlement
+	62,  // 73: platform.tenant_service.v1.SavePlanReply.plan:type_name -> platform.tenant_service.v1.QuotaPlan
+	65,  // 74: platform.tenant_service.v1.SavePlanReply.failures:type_name -> platform.tenant_service.v1.PlanPropagationFailure
+	62,  // 75: platform.tenant_service.v1.GetPlanReply.plan:type_name -> platform.tenant_service.v1.QuotaPlan
+	62,  // 76: platform.tenant_service.v1.ListPlansReply.plans:type_name -> platform.tenant_service.v1.QuotaPlan
+	6,   // 77: platform.tenant_service.v1.AssignPlanRequest.proration:type_name -> platform.tenant_service.v1.ProrationPolicy
+	63,  // 78: platform.tenant_service.v1.AssignPlanReply.assignment:type_name -> platform.tenant_service.v1.TenantPlan
+	17,  // 79: platform.tenant_service.v1.AssignPlanReply.quotas:type_name -> platform.tenant_service.v1.QuotaInfo
+	19,  // 80: platform.tenant_service.v1.ListProductsReply.products:type_name -> platform.tenant_service.v1.Product
+	1,   // 81: platform.tenant_service.v1.QuotaChange.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 82: platform.tenant_service.v1.QuotaChange.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	6,   // 83: platform.tenant_service.v1.QuotaChange.proration:type_name -> platform.tenant_service.v1.ProrationPolicy
+	7,   // 84: platform.tenant_service.v1.QuotaChange.status:type_name -> platform.tenant_service.v1.QuotaChangeStatus
+	1,   // 85: platform.tenant_service.v1.ScheduleQuotaChangeRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 86: platform.tenant_service.v1.ScheduleQuotaChangeRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	6,   // 87: platform.tenant_service.v1.ScheduleQuotaChangeRequest.proration:type_name -> platform.tenant_service.v1.ProrationPolicy
+	77,  // 88: platform.tenant_service.v1.ScheduleQuotaChangeReply.change:type_name -> platform.tenant_service.v1.QuotaChange
+	17,  // 89: platform.tenant_service.v1.ScheduleQuotaChangeReply.quotas:type_name -> platform.tenant_service.v1.QuotaInfo
+	7,   // 90: platform.tenant_service.v1.ListQuotaChangesRequest.status:type_name -> platform.tenant_service.v1.QuotaChangeStatus
+	77,  // 91: platform.tenant_service.v1.ListQuotaChangesReply.changes:type_name -> platform.tenant_service.v1.QuotaChange
+	77,  // 92: platform.tenant_service.v1.CancelQuotaChangeReply.change:type_name -> platform.tenant_service.v1.QuotaChange
+	8,   // 93: platform.tenant_service.v1.ImportOptions.format:type_name -> platform.tenant_service.v1.DataFormat
+	84,  // 94: platform.tenant_service.v1.ImportTenantsRequest.options:type_name -> platform.tenant_service.v1.ImportOptions
+	86,  // 95: platform.tenant_service.v1.ImportTenantsReply.results:type_name -> platform.tenant_service.v1.ImportRowResult
+	8,   // 96: platform.tenant_service.v1.ExportTenantsRequest.format:type_name -> platform.tenant_service.v1.DataFormat
+	0,   // 97: platform.tenant_service.v1.ExportTenantsRequest.tenant_types:type_name -> platform.tenant_service.v1.TenantType
+	10,  // 98: platform.tenant_service.v1.LedgerEntry.direction:type_name -> platform.tenant_service.v1.LedgerDirection
+	9,   // 99: platform.tenant_service.v1.WalletTransaction.type:type_name -> platform.tenant_service.v1.WalletTransactionType
+	1,   // 100: platform.tenant_service.v1.WalletTransaction.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	91,  // 101: platform.tenant_service.v1.WalletTransaction.entries:type_name -> platform.tenant_service.v1.LedgerEntry
+	90,  // 102: platform.tenant_service.v1.GetWalletReply.wallet:type_name -> platform.tenant_service.v1.Wallet
+	90,  // 103: platform.tenant_service.v1.SetWalletThresholdReply.wallet:type_name -> platform.tenant_service.v1.Wallet
+	92,  // 104: platform.tenant_service.v1.TopUpWalletReply.transaction:type_name -> platform.tenant_service.v1.WalletTransaction
+	90,  // 105: platform.tenant_service.v1.TopUpWalletReply.wallet:type_name -> platform.tenant_service.v1.Wallet
+	1,   // 106: platform.tenant_service.v1.DebitWalletRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	92,  // 107: platform.tenant_service.v1.DebitWalletReply.transaction:type_name -> platform.tenant_service.v1.WalletTransaction
+	90,  // 108: platform.tenant_service.v1.DebitWalletReply.wallet:type_name -> platform.tenant_service.v1.Wallet
+	92,  // 109: platform.tenant_service.v1.RefundWalletReply.transaction:type_name -> platform.tenant_service.v1.WalletTransaction
+	90,  // 110: platform.tenant_service.v1.RefundWalletReply.wallet:type_name -> platform.tenant_service.v1.Wallet
+	9,   // 111: platform.tenant_service.v1.ListWalletTransactionsRequest.type:type_name -> platform.tenant_service.v1.WalletTransactionType
+	92,  // 112: platform.tenant_service.v1.ListWalletTransactionsReply.transactions:type_name -> platform.tenant_service.v1.WalletTransaction
+	1,   // 113: platform.tenant_service.v1.QuotaLease.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 114: platform.tenant_service.v1.QuotaLease.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	11,  // 115: platform.tenant_service.v1.QuotaLease.status:type_name -> platform.tenant_service.v1.QuotaLeaseStatus
+	1,   // 116: platform.tenant_service.v1.LeaseQuotaBlockRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	2,   // 117: platform.tenant_service.v1.LeaseQuotaBlockRequest.limit_type:type_name -> platform.tenant_service.v1.LimitType
+	105, // 118: platform.tenant_service.v1.LeaseQuotaBlockReply.lease:type_name -> platform.tenant_service.v1.QuotaLease
+	105, // 119: platform.tenant_service.v1.RenewQuotaLeaseReply.lease:type_name -> platform.tenant_service.v1.QuotaLease
+	105, // 120: platform.tenant_service.v1.ReturnQuotaLeaseReply.lease:type_name -> platform.tenant_service.v1.QuotaLease
+	1,   // 121: platform.tenant_service.v1.ListQuotaLeasesRequest.quota_type:type_name -> platform.tenant_service.v1.QuotaType
+	11,  // 122: platform.tenant_service.v1.ListQuotaLeasesRequest.status:type_name -> platform.tenant_service.v1.QuotaLeaseStatus
+	105, // 123: platform.tenant_service.v1.ListQuotaLeasesReply.leases:type_name -> platform.tenant_service.v1.QuotaLease
+	12,  // 124: platform.tenant_service.v1.TenantMember.role:type_name -> platform.tenant_service.v1.MemberRole
+	12,  // 125: platform.tenant_service.v1.TenantInvitation.role:type_name -> platform.tenant_service.v1.MemberRole
+	13,  // 126: platform.tenant_service.v1.TenantInvitation.status:type_name -> platform.tenant_service.v1.InvitationStatus
+	12,  // 127: platform.tenant_service.v1.AddTenantMemberRequest.role:type_name -> platform.tenant_service.v1.MemberRole
+	114, // 128: platform.tenant_service.v1.AddTenantMemberReply.member:type_name -> platform.tenant_service.v1.TenantMember
+	12,  // 129: platform.tenant_service.v1.UpdateTenantMemberRequest.role:type_name -> platform.tenant_service.v1.MemberRole
+	114, // 130: platform.tenant_service.v1.UpdateTenantMemberReply.member:type_name -> platform.tenant_service.v1.TenantMember
+	12,  // 131: platform.tenant_service.v1.ListTenantMembersRequest.role:type_name -> platform.tenant_service.v1.MemberRole
+	114, // 132: platform.tenant_service.v1.ListTenantMembersReply.members:type_name -> platform.tenant_service.v1.TenantMember
+	12,  // 133: platform.tenant_service.v1.CreateTenantInvitationRequest.role:type_name -> platform.tenant_service.v1.MemberRole
+	115, // 134: platform.tenant_service.v1.CreateTenantInvitationReply.invitation:type_name -> platform.tenant_service.v1.TenantInvitation
+	114, // 135: platform.tenant_service.v1.AcceptTenantInvitationReply.member:type_name -> platform.tenant_service.v1.TenantMember
+	115, // 136: platform.tenant_service.v1.RevokeTenantInvitationReply.invitation:type_name -> platform.tenant_service.v1.TenantInvitation
+	13,  // 137: platform.tenant_service.v1.ListTenantInvitationsRequest.status:type_name -> platform.tenant_service.v1.InvitationStatus
+	115, // 138: platform.tenant_service.v1.ListTenantInvitationsReply.invitations:type_name -> platform.tenant_service.v1.TenantInvitation
+	12,  // 139: platform.tenant_service.v1.CheckPermissionReply.role:type_name -> platform.tenant_service.v1.MemberRole
+	14,  // 140: platform.tenant_service.v1.Entitlement.source:type_name -> platform.tenant_service.v1.EntitlementSource
+	135, // 141: platform.tenant_service.v1.GetEntitlementsReply.entitlements:type_name -> platform.tenant_service.v1.Entitlement
+	135, // 142: platform.tenant_service.v1.SetEntitlementReply.entitlement:type_name -> platform.tenant_service.v1.Entitlement
+	140, // 143: platform.tenant_service.v1.AuditEvent.changes:type_name -> platform.tenant_service.v1.AuditChange
+	141, // 144: platform.tenant_service.v1.ListAuditEventsReply.events:type_name -> platform.tenant_service.v1.AuditEvent
+	8,   // 145: platform.tenant_service.v1.ExportAuditEventsRequest.format:type_name -> platform.tenant_service.v1.DataFormat
+	15,  // 146: platform.tenant_service.v1.LedgerIssue.kind:type_name -> platform.tenant_service.v1.LedgerIssueKind
+	146, // 147: platform.tenant_service.v1.LedgerVerification.issues:type_name -> platform.tenant_service.v1.LedgerIssue
+	147, // 148: platform.tenant_service.v1.VerifyLedgerReply.results:type_name -> platform.tenant_service.v1.LedgerVerification
+	150, // 149: platform.tenant_service.v1.ListLedgerCheckpointsReply.checkpoints:type_name -> platform.tenant_service.v1.LedgerCheckpoint
+	20,  // 150: platform.tenant_service.v1.Tenant.CreateTenant:input_type -> platform.tenant_service.v1.CreateTenantRequest
+	22,  // 151: platform.tenant_service.v1.Tenant.GetTenant:input_type -> platform.tenant_service.v1.GetTenantRequest
+	24,  // 152: platform.tenant_service.v1.Tenant.ListTenants:input_type -> platform.tenant_service.v1.ListTenantsRequest
+	26,  // 153: platform.tenant_service.v1.Tenant.UpdateTenant:input_type -> platform.tenant_service.v1.UpdateTenantRequest
+	28,  // 154: platform.tenant_service.v1.Tenant.DeleteTenant:input_type -> platform.tenant_service.v1.DeleteTenantRequest
+	30,  // 155: platform.tenant_service.v1.Tenant.CheckQuota:input_type -> platform.tenant_service.v1.CheckQuotaRequest
+	33,  // 156: platform.tenant_service.v1.Tenant.ExplainQuota:input_type -> platform.tenant_service.v1.ExplainQuotaRequest
+	35,  // 157: platform.tenant_service.v1.Tenant.ConsumeQuota:input_type -> platform.tenant_service.v1.ConsumeQuotaRequest
+	37,  // 158: platform.tenant_service.v1.Tenant.ReleaseQuota:input_type -> platform.tenant_service.v1.ReleaseQuotaRequest
+	40,  // 159: platform.tenant_service.v1.Tenant.ListQuotas:input_type -> platform.tenant_service.v1.ListQuotasRequest
+	42,  // 160: platform.tenant_service.v1.Tenant.AdjustQuota:input_type -> platform.tenant_service.v1.AdjustQuotaRequest
+	44,  // 161: platform.tenant_service.v1.Tenant.ResetQuota:input_type -> platform.tenant_service.v1.ResetQuotaRequest
+	46,  // 162: platform.tenant_service.v1.Tenant.ListUsageRecords:input_type -> platform.tenant_service.v1.ListUsageRecordsRequest
+	78,  // 163: platform.tenant_service.v1.Tenant.ScheduleQuotaChange:input_type -> platform.tenant_service.v1.ScheduleQuotaChangeRequest
+	80,  // 164: platform.tenant_service.v1.Tenant.ListQuotaChanges:input_type -> platform.tenant_service.v1.ListQuotaChangesRequest
+	82,  // 165: platform.tenant_service.v1.Tenant.CancelQuotaChange:input_type -> platform.tenant_service.v1.CancelQuotaChangeRequest
+	48,  // 166: platform.tenant_service.v1.Tenant.ListOverages:input_type -> platform.tenant_service.v1.ListOveragesRequest
+	51,  // 167: platform.tenant_service.v1.Tenant.GetUsageReport:input_type -> platform.tenant_service.v1.GetUsageReportRequest
+	57,  // 168: platform.tenant_service.v1.Tenant.GetUsageTimeSeries:input_type -> platform.tenant_service.v1.GetUsageTimeSeriesRequest
+	64,  // 169: platform.tenant_service.v1.Tenant.SavePlan:input_type -> platform.tenant_service.v1.SavePlanRequest
+	67,  // 170: platform.tenant_service.v1.Tenant.GetPlan:input_type -> platform.tenant_service.v1.GetPlanRequest
+	69,  // 171: platform.tenant_service.v1.Tenant.ListPlans:input_type -> platform.tenant_service.v1.ListPlansRequest
+	71,  // 172: platform.tenant_service.v1.Tenant.AssignPlan:input_type -> platform.tenant_service.v1.AssignPlanRequest
+	75,  // 173: platform.tenant_service.v1.Tenant.ListProducts:input_type -> platform.tenant_service.v1.ListProductsRequest
+	73,  // 174: platform.tenant_service.v1.Tenant.BindProduct:input_type -> platform.tenant_service.v1.BindProductRequest
+	93,  // 175: platform.tenant_service.v1.Tenant.GetWallet:input_type -> platform.tenant_service.v1.GetWalletRequest
+	95,  // 176: platform.tenant_service.v1.Tenant.SetWalletThreshold:input_type -> platform.tenant_service.v1.SetWalletThresholdRequest
+	97,  // 177: platform.tenant_service.v1.Tenant.TopUpWallet:input_type -> platform.tenant_service.v1.TopUpWalletRequest
+	99,  // 178: platform.tenant_service.v1.Tenant.DebitWallet:input_type -> platform.tenant_service.v1.DebitWalletRequest
+	101, // 179: platform.tenant_service.v1.Tenant.RefundWallet:input_type -> platform.tenant_service.v1.RefundWalletRequest
+	103, // 180: platform.tenant_service.v1.Tenant.ListWalletTransactions:input_type -> platform.tenant_service.v1.ListWalletTransactionsRequest
+	106, // 181: platform.tenant_service.v1.Tenant.LeaseQuotaBlock:input_type -> platform.tenant_service.v1.LeaseQuotaBlockRequest
+	108, // 182: platform.tenant_service.v1.Tenant.RenewQuotaLease:input_type -> platform.tenant_service.v1.RenewQuotaLeaseRequest
+	110, // 183: platform.tenant_service.v1.Tenant.ReturnQuotaLease:input_type -> platform.tenant_service.v1.ReturnQuotaLeaseRequest
+	112, // 184: platform.tenant_service.v1.Tenant.ListQuotaLeases:input_type -> platform.tenant_service.v1.ListQuotaLeasesRequest
+	116, // 185: platform.tenant_service.v1.Tenant.AddTenantMember:input_type -> platform.tenant_service.v1.AddTenantMemberRequest
+	118, // 186: platform.tenant_service.v1.Tenant.UpdateTenantMember:input_type -> platform.tenant_service.v1.UpdateTenantMemberRequest
+	120, // 187: platform.tenant_service.v1.Tenant.RemoveTenantMember:input_type -> platform.tenant_service.v1.RemoveTenantMemberRequest
+	122, // 188: platform.tenant_service.v1.Tenant.ListTenantMembers:input_type -> platform.tenant_service.v1.ListTenantMembersRequest
+	124, // 189: platform.tenant_service.v1.Tenant.CreateTenantInvitation:input_type -> platform.tenant_service.v1.CreateTenantInvitationRequest
+	126, // 190: platform.tenant_service.v1.Tenant.AcceptTenantInvitation:input_type -> platform.tenant_service.v1.AcceptTenantInvitationRequest
+	128, // 191: platform.tenant_service.v1.Tenant.RevokeTenantInvitation:input_type -> platform.tenant_service.v1.RevokeTenantInvitationRequest
+	130, // 192: platform.tenant_service.v1.Tenant.ListTenantInvitations:input_type -> platform.tenant_service.v1.ListTenantInvitationsRequest
+	132, // 193: platform.tenant_service.v1.Tenant.CheckPermission:input_type -> platform.tenant_service.v1.CheckPermissionRequest
+	136, // 194: platform.tenant_service.v1.Tenant.GetEntitlements:input_type -> platform.tenant_service.v1.GetEntitlementsRequest
+	138, // 195: platform.tenant_service.v1.Tenant.SetEntitlement:input_type -> platform.tenant_service.v1.SetEntitlementRequest
+	142, // 196: platform.tenant_service.v1.Tenant.ListAuditEvents:input_type -> platform.tenant_service.v1.ListAuditEventsRequest
+	144, // 197: platform.tenant_service.v1.Tenant.ExportAuditEvents:input_type -> platform.tenant_service.v1.ExportAuditEventsRequest
+	148, // 198: platform.tenant_service.v1.Tenant.VerifyLedger:input_type -> platform.tenant_service.v1.VerifyLedgerRequest
+	151, // 199: platform.tenant_service.v1.Tenant.ListLedgerCheckpoints:input_type -> platform.tenant_service.v1.ListLedgerCheckpointsRequest
+	85,  // 200: platform.tenant_service.v1.Tenant.ImportTenants:input_type -> platform.tenant_service.v1.ImportTenantsRequest
+	88,  // 201: platform.tenant_service.v1.Tenant.ExportTenants:input_type -> platform.tenant_service.v1.ExportTenantsRequest
+	21,  // 202: platform.tenant_service.v1.Tenant.CreateTenant:output_type -> platform.tenant_service.v1.CreateTenantReply
+	23,  // 203: platform.tenant_service.v1.Tenant.GetTenant:output_type -> platform.tenant_service.v1.GetTenantReply
+	25,  // 204: platform.tenant_service.v1.Tenant.ListTenants:output_type -> platform.tenant_service.v1.ListTenantsReply
+	27,  // 205: platform.tenant_service.v1.Tenant.UpdateTenant:output_type -> platform.tenant_service.v1.UpdateTenantReply
+	29,  // 206: platform.tenant_service.v1.Tenant.DeleteTenant:output_type -> platform.tenant_service.v1.DeleteTenantReply
+	31,  // 207: platform.tenant_service.v1.Tenant.CheckQuota:output_type -> platform.tenant_service.v1.CheckQuotaReply
+	34,  // 208: platform.tenant_service.v1.Tenant.ExplainQuota:output_type -> platform.tenant_service.v1.ExplainQuotaReply
+	36,  // 209: platform.tenant_service.v1.Tenant.ConsumeQuota:output_type -> platform.tenant_service.v1.ConsumeQuotaReply
+	38,  // 210: platform.tenant_service.v1.Tenant.ReleaseQuota:output_type -> platform.tenant_service.v1.ReleaseQuotaReply
+	41,  // 211: platform.tenant_service.v1.Tenant.ListQuotas:output_type -> platform.tenant_service.v1.ListQuotasReply
+	43,  // 212: platform.tenant_service.v1.Tenant.AdjustQuota:output_type -> platform.tenant_service.v1.AdjustQuotaReply
+	45,  // 213: platform.tenant_service.v1.Tenant.ResetQuota:output_type -> platform.tenant_service.v1.ResetQuotaReply
+	47,  // 214: platform.tenant_service.v1.Tenant.ListUsageRecords:output_type -> platform.tenant_service.v1.ListUsageRecordsReply
+	79,  // 215: platform.tenant_service.v1.Tenant.ScheduleQuotaChange:output_type -> platform.tenant_service.v1.ScheduleQuotaChangeReply
+	81,  // 216: platform.tenant_service.v1.Tenant.ListQuotaChanges:output_type -> platform.tenant_service.v1.ListQuotaChangesReply
+	83,  // 217: platform.tenant_service.v1.Tenant.CancelQuotaChange:output_type -> platform.tenant_service.v1.CancelQuotaChangeReply
+	50,  // 218: platform.tenant_service.v1.Tenant.ListOverages:output_type -> platform.tenant_service.v1.ListOveragesReply
+	56,  // 219: platform.tenant_service.v1.Tenant.GetUsageReport:output_type -> platform.tenant_service.v1.GetUsageReportReply
+	60,  // 220: platform.tenant_service.v1.Tenant.GetUsageTimeSeries:output_type -> platform.tenant_service.v1.GetUsageTimeSeriesReply
+	66,  // 221: platform.tenant_service.v1.Tenant.SavePlan:output_type -> platform.tenant_service.v1.SavePlanReply
+	68,  // 222: platform.tenant_service.v1.Tenant.GetPlan:output_type -> platform.tenant_service.v1.GetPlanReply
+	70,  // 223: platform.tenant_service.v1.Tenant.ListPlans:output_type -> platform.tenant_service.v1.ListPlansReply
+	72,  // 224: platform.tenant_service.v1.Tenant.AssignPlan:output_type -> platform.tenant_service.v1.AssignPlanReply
+	76,  // 225: platform.tenant_service.v1.Tenant.ListProducts:output_type -> platform.tenant_service.v1.ListProductsReply
+	74,  // 226: platform.tenant_service.v1.Tenant.BindProduct:output_type -> platform.tenant_service.v1.BindProductReply
+	94,  // 227: platform.tenant_service.v1.Tenant.GetWallet:output_type -> platform.tenant_service.v1.GetWalletReply
+	96,  // 228: platform.tenant_service.v1.Tenant.SetWalletThreshold:output_type -> platform.tenant_service.v1.SetWalletThresholdReply
+	98,  // 229: platform.tenant_service.v1.Tenant.TopUpWallet:output_type -> platform.tenant_service.v1.TopUpWalletReply
+	100, // 230: platform.tenant_service.v1.Tenant.DebitWallet:output_type -> platform.tenant_service.v1.DebitWalletReply
+	102, // 231: platform.tenant_service.v1.Tenant.RefundWallet:output_type -> platform.tenant_service.v1.RefundWalletReply
+	104, // 232: platform.tenant_service.v1.Tenant.ListWalletTransactions:output_type -> platform.tenant_service.v1.ListWalletTransactionsReply
+	107, // 233: platform.tenant_service.v1.Tenant.LeaseQuotaBlock:output_type -> platform.tenant_service.v1.LeaseQuotaBlockReply
+	109, // 234: platform.tenant_service.v1.Tenant.RenewQuotaLease:output_type -> platform.tenant_service.v1.RenewQuotaLeaseReply
+	111, // 235: platform.tenant_service.v1.Tenant.ReturnQuotaLease:output_type -> platform.tenant_service.v1.ReturnQuotaLeaseReply
+	113, // 236: platform.tenant_service.v1.Tenant.ListQuotaLeases:output_type -> platform.tenant_service.v1.ListQuotaLeasesReply
+	117, // 237: platform.tenant_service.v1.Tenant.AddTenantMember:output_type -> platform.tenant_service.v1.AddTenantMemberReply
+	119, // 238: platform.tenant_service.v1.Tenant.UpdateTenantMember:output_type -> platform.tenant_service.v1.UpdateTenantMemberReply
+	121, // 239: platform.tenant_service.v1.Tenant.RemoveTenantMember:output_type -> platform.tenant_service.v1.RemoveTenantMemberReply
+	123, // 240: platform.tenant_service.v1.Tenant.ListTenantMembers:output_type -> platform.tenant_service.v1.ListTenantMembersReply
+	125, // 241: platform.tenant_service.v1.Tenant.CreateTenantInvitation:output_type -> platform.tenant_service.v1.CreateTenantInvitationReply
+	127, // 242: platform.tenant_service.v1.Tenant.AcceptTenantInvitation:output_type -> platform.tenant_service.v1.AcceptTenantInvitationReply
+	129, // 243: platform.tenant_service.v1.Tenant.RevokeTenantInvitation:output_type -> platform.tenant_service.v1.RevokeTenantInvitationReply
+	131, // 244: platform.tenant_service.v1.Tenant.ListTenantInvitations:output_type -> platform.tenant_service.v1.ListTenantInvitationsReply
+	133, // 245: platform.tenant_service.v1.Tenant.CheckPermission:output_type -> platform.tenant_service.v1.CheckPermissionReply
+	137, // 246: platform.tenant_service.v1.Tenant.GetEntitlements:output_type -> platform.tenant_service.v1.GetEntitlementsReply
+	139, // 247: platform.tenant_service.v1.Tenant.SetEntitlement:output_type -> platform.tenant_service.v1.SetEntitlementReply
+	143, // 248: platform.tenant_service.v1.Tenant.ListAuditEvents:output_type -> platform.tenant_service.v1.ListAuditEventsReply
+	145, // 249: platform.tenant_service.v1.Tenant.ExportAuditEvents:output_type -> platform.tenant_service.v1.ExportAuditEventsReply
+	149, // 250: platform.tenant_service.v1.Tenant.VerifyLedger:output_type -> platform.tenant_service.v1.VerifyLedgerReply
+	152, // 251: platform.tenant_service.v1.Tenant.ListLedgerCheckpoints:output_type -> platform.tenant_service.v1.ListLedgerCheckpointsReply
+	87,  // 252: platform.tenant_service.v1.Tenant.ImportTenants:output_type -> platform.tenant_service.v1.ImportTenantsReply
+	89,  // 253: platform.tenant_service.v1.Tenant.ExportTenants:output_type -> platform.tenant_service.v1.ExportTenantsReply
+	202, // [202:254] is the sub-list for method output_type
+	150, // [150:202] is the sub-list for method input_type
+	150, // [150:150] is the sub-list for extension type_name
+	150, // [150:150] is the sub-list for extension extendee
+	0,   // [0:150] is the sub-list for field type_name
 }
 
 func init() { file_platform_tenant_service_v1_tenant_proto_init() }
@@ -1861,13 +12109,23 @@ func file_platform_tenant_service_v1_tenant_proto_init() {
 	if File_platform_tenant_service_v1_tenant_proto != nil {
 		return
 	}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[8].OneofWrappers = []any{}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[26].OneofWrappers = []any{}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[61].OneofWrappers = []any{}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[62].OneofWrappers = []any{}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[69].OneofWrappers = []any{
+		(*ImportTenantsRequest_Options)(nil),
+		(*ImportTenantsRequest_Chunk)(nil),
+	}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[72].OneofWrappers = []any{}
+	file_platform_tenant_service_v1_tenant_proto_msgTypes[102].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_platform_tenant_service_v1_tenant_proto_rawDesc), len(file_platform_tenant_service_v1_tenant_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   24,
+			NumEnums:      16,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
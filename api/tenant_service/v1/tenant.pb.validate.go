@@ -73,6 +73,10 @@ func (m *TenantInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for Labels
+
+	// no validation rules for Attributes
+
 	if len(errors) > 0 {
 		return TenantInfoMultiError(errors)
 	}
@@ -196,6 +200,60 @@ func (m *QuotaInfo) validate(all bool) error {
 
 	// no validation rules for IsGlobal
 
+	// no validation rules for PlanCode
+
+	// no validation rules for RolloverGranted
+
+	// no validation rules for RolloverUsed
+
+	// no validation rules for RolloverExpireTime
+
+	// no validation rules for EnforcementMode
+
+	// no validation rules for MaxOverage
+
+	// no validation rules for Overage
+
+	for idx, item := range m.GetAllocations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuotaInfoValidationError{
+						field:  fmt.Sprintf("Allocations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuotaInfoValidationError{
+						field:  fmt.Sprintf("Allocations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuotaInfoValidationError{
+					field:  fmt.Sprintf("Allocations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SharedLimit
+
+	// no validation rules for SharedUsed
+
+	// no validation rules for Shards
+
 	if len(errors) > 0 {
 		return QuotaInfoMultiError(errors)
 	}
@@ -273,6 +331,114 @@ var _ interface {
 	ErrorName() string
 } = QuotaInfoValidationError{}
 
+// Validate checks the field values on QuotaAllocation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QuotaAllocation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaAllocation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuotaAllocationMultiError, or nil if none found.
+func (m *QuotaAllocation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaAllocation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Limit
+
+	// no validation rules for UsedCount
+
+	// no validation rules for Remaining
+
+	if len(errors) > 0 {
+		return QuotaAllocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaAllocationMultiError is an error wrapping multiple validation errors
+// returned by QuotaAllocation.ValidateAll() if the designated constraints
+// aren't met.
+type QuotaAllocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaAllocationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaAllocationMultiError) AllErrors() []error { return m }
+
+// QuotaAllocationValidationError is the validation error returned by
+// QuotaAllocation.Validate if the designated constraints aren't met.
+type QuotaAllocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaAllocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaAllocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaAllocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaAllocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaAllocationValidationError) ErrorName() string { return "QuotaAllocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaAllocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaAllocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaAllocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaAllocationValidationError{}
+
 // Validate checks the field values on Product with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -425,6 +591,32 @@ func (m *CreateTenantRequest) validate(all bool) error {
 
 	// no validation rules for QuotaConfig
 
+	if utf8.RuneCountInString(m.GetTenantId()) > 32 {
+		err := CreateTenantRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateTenantRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := CreateTenantRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Labels
+
+	// no validation rules for Attributes
+
 	if len(errors) > 0 {
 		return CreateTenantRequestMultiError(errors)
 	}
@@ -505,6 +697,8 @@ var _ interface {
 	ErrorName() string
 } = CreateTenantRequestValidationError{}
 
+var _CreateTenantRequest_TenantId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]*$")
+
 // Validate checks the field values on CreateTenantReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -902,12 +1096,80 @@ func (m *ListTenantsRequest) validate(all bool) error {
 
 	// no validation rules for ParentTenantId
 
-	// no validation rules for Status
-
-	// no validation rules for PageSize
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListTenantsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for PageNum
 
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := ListTenantsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CreatedAfter
+
+	// no validation rules for CreatedBefore
+
+	if all {
+		switch v := interface{}(m.GetPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTenantsRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTenantsRequestValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTenantsRequestValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLabelSelector()) > 1024 {
+		err := ListTenantsRequestValidationError{
+			field:  "LabelSelector",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Attributes
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
 	if len(errors) > 0 {
 		return ListTenantsRequestMultiError(errors)
 	}
@@ -1046,6 +1308,35 @@ func (m *ListTenantsReply) validate(all bool) error {
 
 	// no validation rules for Total
 
+	if all {
+		switch v := interface{}(m.GetPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTenantsReplyValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTenantsReplyValidationError{
+					field:  "Page",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTenantsReplyValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ListTenantsReplyMultiError(errors)
 	}
@@ -1172,6 +1463,10 @@ func (m *UpdateTenantRequest) validate(all bool) error {
 
 	// no validation rules for QuotaConfig
 
+	// no validation rules for Labels
+
+	// no validation rules for Attributes
+
 	if len(errors) > 0 {
 		return UpdateTenantRequestMultiError(errors)
 	}
@@ -1792,6 +2087,37 @@ func (m *CheckQuotaReply) validate(all bool) error {
 
 	// no validation rules for AvailableQuota
 
+	if all {
+		switch v := interface{}(m.GetAllocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckQuotaReplyValidationError{
+					field:  "Allocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckQuotaReplyValidationError{
+					field:  "Allocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAllocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckQuotaReplyValidationError{
+				field:  "Allocation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SharedAvailable
+
 	if len(errors) > 0 {
 		return CheckQuotaReplyMultiError(errors)
 	}
@@ -1870,32 +2196,180 @@ var _ interface {
 	ErrorName() string
 } = CheckQuotaReplyValidationError{}
 
-// Validate checks the field values on ConsumeQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConsumeQuotaRequest) Validate() error {
+// Validate checks the field values on QuotaCandidate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaCandidate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConsumeQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ConsumeQuotaRequestMultiError, or nil if none found.
-func (m *ConsumeQuotaRequest) ValidateAll() error {
+// ValidateAll checks the field values on QuotaCandidate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaCandidateMultiError,
+// or nil if none found.
+func (m *QuotaCandidate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConsumeQuotaRequest) validate(all bool) error {
+func (m *QuotaCandidate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
-		err := ConsumeQuotaRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuotaCandidateValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuotaCandidateValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuotaCandidateValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Level
+
+	// no validation rules for Depth
+
+	// no validation rules for Selected
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return QuotaCandidateMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaCandidateMultiError is an error wrapping multiple validation errors
+// returned by QuotaCandidate.ValidateAll() if the designated constraints
+// aren't met.
+type QuotaCandidateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaCandidateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaCandidateMultiError) AllErrors() []error { return m }
+
+// QuotaCandidateValidationError is the validation error returned by
+// QuotaCandidate.Validate if the designated constraints aren't met.
+type QuotaCandidateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaCandidateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaCandidateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaCandidateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaCandidateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaCandidateValidationError) ErrorName() string { return "QuotaCandidateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaCandidateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaCandidate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaCandidateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaCandidateValidationError{}
+
+// Validate checks the field values on ExplainQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainQuotaRequestMultiError, or nil if none found.
+func (m *ExplainQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ExplainQuotaRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExplainQuotaRequest_QuotaType_NotInLookup[m.GetQuotaType()]; ok {
+		err := ExplainQuotaRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must not be in list [QUOTA_TYPE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -1904,7 +2378,7 @@ func (m *ConsumeQuotaRequest) validate(all bool) error {
 	}
 
 	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
-		err := ConsumeQuotaRequestValidationError{
+		err := ExplainQuotaRequestValidationError{
 			field:  "QuotaType",
 			reason: "value must be one of the defined enum values",
 		}
@@ -1914,10 +2388,10 @@ func (m *ConsumeQuotaRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
-		err := ConsumeQuotaRequestValidationError{
+	if _, ok := _ExplainQuotaRequest_LimitType_NotInLookup[m.GetLimitType()]; ok {
+		err := ExplainQuotaRequestValidationError{
 			field:  "LimitType",
-			reason: "value must be one of the defined enum values",
+			reason: "value must not be in list [LIMIT_TYPE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -1925,10 +2399,10 @@ func (m *ConsumeQuotaRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetAmount() <= 0 {
-		err := ConsumeQuotaRequestValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := ExplainQuotaRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -1938,24 +2412,20 @@ func (m *ConsumeQuotaRequest) validate(all bool) error {
 
 	// no validation rules for ProductCode
 
-	// no validation rules for BizId
-
-	// no validation rules for BizType
-
 	if len(errors) > 0 {
-		return ConsumeQuotaRequestMultiError(errors)
+		return ExplainQuotaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConsumeQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by ConsumeQuotaRequest.ValidateAll() if the designated
+// ExplainQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by ExplainQuotaRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ConsumeQuotaRequestMultiError []error
+type ExplainQuotaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConsumeQuotaRequestMultiError) Error() string {
+func (m ExplainQuotaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1964,11 +2434,11 @@ func (m ConsumeQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConsumeQuotaRequestMultiError) AllErrors() []error { return m }
+func (m ExplainQuotaRequestMultiError) AllErrors() []error { return m }
 
-// ConsumeQuotaRequestValidationError is the validation error returned by
-// ConsumeQuotaRequest.Validate if the designated constraints aren't met.
-type ConsumeQuotaRequestValidationError struct {
+// ExplainQuotaRequestValidationError is the validation error returned by
+// ExplainQuotaRequest.Validate if the designated constraints aren't met.
+type ExplainQuotaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1976,24 +2446,24 @@ type ConsumeQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConsumeQuotaRequestValidationError) Field() string { return e.field }
+func (e ExplainQuotaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConsumeQuotaRequestValidationError) Reason() string { return e.reason }
+func (e ExplainQuotaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConsumeQuotaRequestValidationError) Cause() error { return e.cause }
+func (e ExplainQuotaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConsumeQuotaRequestValidationError) Key() bool { return e.key }
+func (e ExplainQuotaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConsumeQuotaRequestValidationError) ErrorName() string {
-	return "ConsumeQuotaRequestValidationError"
+func (e ExplainQuotaRequestValidationError) ErrorName() string {
+	return "ExplainQuotaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConsumeQuotaRequestValidationError) Error() string {
+func (e ExplainQuotaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2005,14 +2475,14 @@ func (e ConsumeQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConsumeQuotaRequest.%s: %s%s",
+		"invalid %sExplainQuotaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConsumeQuotaRequestValidationError{}
+var _ error = ExplainQuotaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2020,50 +2490,15606 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConsumeQuotaRequestValidationError{}
+} = ExplainQuotaRequestValidationError{}
 
-// Validate checks the field values on ConsumeQuotaReply with the rules defined
+var _ExplainQuotaRequest_QuotaType_NotInLookup = map[QuotaType]struct{}{
+	0: {},
+}
+
+var _ExplainQuotaRequest_LimitType_NotInLookup = map[LimitType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExplainQuotaReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ConsumeQuotaReply) Validate() error {
+func (m *ExplainQuotaReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConsumeQuotaReply with the rules
+// ValidateAll checks the field values on ExplainQuotaReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConsumeQuotaReplyMultiError, or nil if none found.
-func (m *ConsumeQuotaReply) ValidateAll() error {
+// ExplainQuotaReplyMultiError, or nil if none found.
+func (m *ExplainQuotaReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConsumeQuotaReply) validate(all bool) error {
+func (m *ExplainQuotaReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Success
-
-	// no validation rules for RemainingQuota
-
-	// no validation rules for Message
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSelected()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainQuotaReplyValidationError{
+					field:  "Selected",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainQuotaReplyValidationError{
+					field:  "Selected",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelected()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainQuotaReplyValidationError{
+				field:  "Selected",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCandidates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplainQuotaReplyValidationError{
+						field:  fmt.Sprintf("Candidates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplainQuotaReplyValidationError{
+						field:  fmt.Sprintf("Candidates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplainQuotaReplyValidationError{
+					field:  fmt.Sprintf("Candidates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExplainQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by ExplainQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExplainQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainQuotaReplyMultiError) AllErrors() []error { return m }
+
+// ExplainQuotaReplyValidationError is the validation error returned by
+// ExplainQuotaReply.Validate if the designated constraints aren't met.
+type ExplainQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainQuotaReplyValidationError) ErrorName() string {
+	return "ExplainQuotaReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainQuotaReplyValidationError{}
+
+// Validate checks the field values on ConsumeQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsumeQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumeQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsumeQuotaRequestMultiError, or nil if none found.
+func (m *ConsumeQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumeQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ConsumeQuotaRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := ConsumeQuotaRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := ConsumeQuotaRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := ConsumeQuotaRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ProductCode
+
+	// no validation rules for BizId
+
+	// no validation rules for BizType
+
+	if len(errors) > 0 {
+		return ConsumeQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumeQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by ConsumeQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConsumeQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumeQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumeQuotaRequestMultiError) AllErrors() []error { return m }
+
+// ConsumeQuotaRequestValidationError is the validation error returned by
+// ConsumeQuotaRequest.Validate if the designated constraints aren't met.
+type ConsumeQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumeQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumeQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumeQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumeQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumeQuotaRequestValidationError) ErrorName() string {
+	return "ConsumeQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumeQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumeQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumeQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumeQuotaRequestValidationError{}
+
+// Validate checks the field values on ConsumeQuotaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsumeQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumeQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsumeQuotaReplyMultiError, or nil if none found.
+func (m *ConsumeQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumeQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for RemainingQuota
+
+	// no validation rules for Message
+
+	// no validation rules for Overage
+
+	if len(errors) > 0 {
+		return ConsumeQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumeQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by ConsumeQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type ConsumeQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumeQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumeQuotaReplyMultiError) AllErrors() []error { return m }
+
+// ConsumeQuotaReplyValidationError is the validation error returned by
+// ConsumeQuotaReply.Validate if the designated constraints aren't met.
+type ConsumeQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumeQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumeQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumeQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumeQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumeQuotaReplyValidationError) ErrorName() string {
+	return "ConsumeQuotaReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumeQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumeQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumeQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumeQuotaReplyValidationError{}
+
+// Validate checks the field values on ReleaseQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseQuotaRequestMultiError, or nil if none found.
+func (m *ReleaseQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ReleaseQuotaRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := ReleaseQuotaRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := ReleaseQuotaRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := ReleaseQuotaRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ProductCode
+
+	// no validation rules for BizId
+
+	if len(errors) > 0 {
+		return ReleaseQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseQuotaRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseQuotaRequestValidationError is the validation error returned by
+// ReleaseQuotaRequest.Validate if the designated constraints aren't met.
+type ReleaseQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseQuotaRequestValidationError) ErrorName() string {
+	return "ReleaseQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseQuotaRequestValidationError{}
+
+// Validate checks the field values on ReleaseQuotaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseQuotaReplyMultiError, or nil if none found.
+func (m *ReleaseQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for RemainingQuota
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ReleaseQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by ReleaseQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseQuotaReplyMultiError) AllErrors() []error { return m }
+
+// ReleaseQuotaReplyValidationError is the validation error returned by
+// ReleaseQuotaReply.Validate if the designated constraints aren't met.
+type ReleaseQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseQuotaReplyValidationError) ErrorName() string {
+	return "ReleaseQuotaReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseQuotaReplyValidationError{}
+
+// Validate checks the field values on QuotaUsageRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QuotaUsageRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaUsageRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuotaUsageRecordMultiError, or nil if none found.
+func (m *QuotaUsageRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaUsageRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecordId
+
+	// no validation rules for QuotaId
+
+	// no validation rules for TenantId
+
+	// no validation rules for OperationType
+
+	// no validation rules for DeltaValue
+
+	// no validation rules for CurrentUsed
+
+	// no validation rules for BizId
+
+	// no validation rules for BizType
+
+	// no validation rules for Operator
+
+	// no validation rules for OperationTime
+
+	// no validation rules for Remark
+
+	// no validation rules for ChainSeq
+
+	// no validation rules for PrevHash
+
+	// no validation rules for RecordHash
+
+	if len(errors) > 0 {
+		return QuotaUsageRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaUsageRecordMultiError is an error wrapping multiple validation errors
+// returned by QuotaUsageRecord.ValidateAll() if the designated constraints
+// aren't met.
+type QuotaUsageRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaUsageRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaUsageRecordMultiError) AllErrors() []error { return m }
+
+// QuotaUsageRecordValidationError is the validation error returned by
+// QuotaUsageRecord.Validate if the designated constraints aren't met.
+type QuotaUsageRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaUsageRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaUsageRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaUsageRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaUsageRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaUsageRecordValidationError) ErrorName() string { return "QuotaUsageRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaUsageRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaUsageRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaUsageRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaUsageRecordValidationError{}
+
+// Validate checks the field values on ListQuotasRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotasRequestMultiError, or nil if none found.
+func (m *ListQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListQuotasRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for QuotaType
+
+	if len(errors) > 0 {
+		return ListQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotasRequestMultiError is an error wrapping multiple validation errors
+// returned by ListQuotasRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotasRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotasRequestMultiError) AllErrors() []error { return m }
+
+// ListQuotasRequestValidationError is the validation error returned by
+// ListQuotasRequest.Validate if the designated constraints aren't met.
+type ListQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotasRequestValidationError) ErrorName() string {
+	return "ListQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotasRequestValidationError{}
+
+// Validate checks the field values on ListQuotasReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotasReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotasReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotasReplyMultiError, or nil if none found.
+func (m *ListQuotasReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotasReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQuotasReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQuotasReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQuotasReplyValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQuotasReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotasReplyMultiError is an error wrapping multiple validation errors
+// returned by ListQuotasReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListQuotasReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotasReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotasReplyMultiError) AllErrors() []error { return m }
+
+// ListQuotasReplyValidationError is the validation error returned by
+// ListQuotasReply.Validate if the designated constraints aren't met.
+type ListQuotasReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotasReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotasReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotasReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotasReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotasReplyValidationError) ErrorName() string { return "ListQuotasReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListQuotasReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotasReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotasReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotasReplyValidationError{}
+
+// Validate checks the field values on AdjustQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustQuotaRequestMultiError, or nil if none found.
+func (m *AdjustQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := AdjustQuotaRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := AdjustQuotaRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := AdjustQuotaRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := AdjustQuotaRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.HardLimit != nil {
+
+		if m.GetHardLimit() < 0 {
+			err := AdjustQuotaRequestValidationError{
+				field:  "HardLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SoftLimit != nil {
+
+		if m.GetSoftLimit() < 0 {
+			err := AdjustQuotaRequestValidationError{
+				field:  "SoftLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.UsedCount != nil {
+
+		if m.GetUsedCount() < 0 {
+			err := AdjustQuotaRequestValidationError{
+				field:  "UsedCount",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ExtraConfig != nil {
+		// no validation rules for ExtraConfig
+	}
+
+	if m.EnforcementMode != nil {
+
+		if _, ok := EnforcementMode_name[int32(m.GetEnforcementMode())]; !ok {
+			err := AdjustQuotaRequestValidationError{
+				field:  "EnforcementMode",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MaxOverage != nil {
+
+		if m.GetMaxOverage() < 0 {
+			err := AdjustQuotaRequestValidationError{
+				field:  "MaxOverage",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdjustQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustQuotaRequestMultiError) AllErrors() []error { return m }
+
+// AdjustQuotaRequestValidationError is the validation error returned by
+// AdjustQuotaRequest.Validate if the designated constraints aren't met.
+type AdjustQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustQuotaRequestValidationError) ErrorName() string {
+	return "AdjustQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustQuotaRequestValidationError{}
+
+// Validate checks the field values on AdjustQuotaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdjustQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustQuotaReplyMultiError, or nil if none found.
+func (m *AdjustQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustQuotaReplyValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustQuotaReplyValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustQuotaReplyValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdjustQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by AdjustQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustQuotaReplyMultiError) AllErrors() []error { return m }
+
+// AdjustQuotaReplyValidationError is the validation error returned by
+// AdjustQuotaReply.Validate if the designated constraints aren't met.
+type AdjustQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustQuotaReplyValidationError) ErrorName() string { return "AdjustQuotaReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdjustQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustQuotaReplyValidationError{}
+
+// Validate checks the field values on ResetQuotaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResetQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetQuotaRequestMultiError, or nil if none found.
+func (m *ResetQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ResetQuotaRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := ResetQuotaRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := ResetQuotaRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := ResetQuotaRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by ResetQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResetQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetQuotaRequestMultiError) AllErrors() []error { return m }
+
+// ResetQuotaRequestValidationError is the validation error returned by
+// ResetQuotaRequest.Validate if the designated constraints aren't met.
+type ResetQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetQuotaRequestValidationError) ErrorName() string {
+	return "ResetQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetQuotaRequestValidationError{}
+
+// Validate checks the field values on ResetQuotaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResetQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetQuotaReplyMultiError, or nil if none found.
+func (m *ResetQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResetQuotaReplyValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResetQuotaReplyValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResetQuotaReplyValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResetQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by ResetQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type ResetQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetQuotaReplyMultiError) AllErrors() []error { return m }
+
+// ResetQuotaReplyValidationError is the validation error returned by
+// ResetQuotaReply.Validate if the designated constraints aren't met.
+type ResetQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetQuotaReplyValidationError) ErrorName() string { return "ResetQuotaReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResetQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetQuotaReplyValidationError{}
+
+// Validate checks the field values on ListUsageRecordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsageRecordsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsageRecordsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsageRecordsRequestMultiError, or nil if none found.
+func (m *ListUsageRecordsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsageRecordsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListUsageRecordsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for QuotaType
+
+	// no validation rules for AfterRecordId
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ListUsageRecordsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUsageRecordsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsageRecordsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUsageRecordsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsageRecordsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsageRecordsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsageRecordsRequestMultiError) AllErrors() []error { return m }
+
+// ListUsageRecordsRequestValidationError is the validation error returned by
+// ListUsageRecordsRequest.Validate if the designated constraints aren't met.
+type ListUsageRecordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsageRecordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsageRecordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsageRecordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsageRecordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsageRecordsRequestValidationError) ErrorName() string {
+	return "ListUsageRecordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsageRecordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsageRecordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsageRecordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsageRecordsRequestValidationError{}
+
+// Validate checks the field values on ListUsageRecordsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsageRecordsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsageRecordsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsageRecordsReplyMultiError, or nil if none found.
+func (m *ListUsageRecordsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsageRecordsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsageRecordsReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsageRecordsReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsageRecordsReplyValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUsageRecordsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsageRecordsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListUsageRecordsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsageRecordsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsageRecordsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsageRecordsReplyMultiError) AllErrors() []error { return m }
+
+// ListUsageRecordsReplyValidationError is the validation error returned by
+// ListUsageRecordsReply.Validate if the designated constraints aren't met.
+type ListUsageRecordsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsageRecordsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsageRecordsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsageRecordsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsageRecordsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsageRecordsReplyValidationError) ErrorName() string {
+	return "ListUsageRecordsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsageRecordsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsageRecordsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsageRecordsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsageRecordsReplyValidationError{}
+
+// Validate checks the field values on ListOveragesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOveragesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOveragesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOveragesRequestMultiError, or nil if none found.
+func (m *ListOveragesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOveragesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if len(errors) > 0 {
+		return ListOveragesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOveragesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListOveragesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOveragesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOveragesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOveragesRequestMultiError) AllErrors() []error { return m }
+
+// ListOveragesRequestValidationError is the validation error returned by
+// ListOveragesRequest.Validate if the designated constraints aren't met.
+type ListOveragesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOveragesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOveragesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOveragesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOveragesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOveragesRequestValidationError) ErrorName() string {
+	return "ListOveragesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOveragesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOveragesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOveragesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOveragesRequestValidationError{}
+
+// Validate checks the field values on QuotaOverage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaOverage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaOverage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaOverageMultiError, or
+// nil if none found.
+func (m *QuotaOverage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaOverage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuotaId
+
+	// no validation rules for TenantId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for LimitType
+
+	// no validation rules for PeriodStart
+
+	// no validation rules for PeriodEnd
+
+	// no validation rules for Overage
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return QuotaOverageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaOverageMultiError is an error wrapping multiple validation errors
+// returned by QuotaOverage.ValidateAll() if the designated constraints aren't met.
+type QuotaOverageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaOverageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaOverageMultiError) AllErrors() []error { return m }
+
+// QuotaOverageValidationError is the validation error returned by
+// QuotaOverage.Validate if the designated constraints aren't met.
+type QuotaOverageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaOverageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaOverageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaOverageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaOverageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaOverageValidationError) ErrorName() string { return "QuotaOverageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaOverageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaOverage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaOverageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaOverageValidationError{}
+
+// Validate checks the field values on ListOveragesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOveragesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOveragesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOveragesReplyMultiError, or nil if none found.
+func (m *ListOveragesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOveragesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOverages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOveragesReplyValidationError{
+						field:  fmt.Sprintf("Overages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOveragesReplyValidationError{
+						field:  fmt.Sprintf("Overages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOveragesReplyValidationError{
+					field:  fmt.Sprintf("Overages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOveragesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOveragesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListOveragesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListOveragesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOveragesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOveragesReplyMultiError) AllErrors() []error { return m }
+
+// ListOveragesReplyValidationError is the validation error returned by
+// ListOveragesReply.Validate if the designated constraints aren't met.
+type ListOveragesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOveragesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOveragesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOveragesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOveragesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOveragesReplyValidationError) ErrorName() string {
+	return "ListOveragesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOveragesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOveragesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOveragesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOveragesReplyValidationError{}
+
+// Validate checks the field values on GetUsageReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageReportRequestMultiError, or nil if none found.
+func (m *GetUsageReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if val := m.GetTopN(); val < 0 || val > 100 {
+		err := GetUsageReportRequestValidationError{
+			field:  "TopN",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUsageReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUsageReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsageReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageReportRequestMultiError) AllErrors() []error { return m }
+
+// GetUsageReportRequestValidationError is the validation error returned by
+// GetUsageReportRequest.Validate if the designated constraints aren't met.
+type GetUsageReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageReportRequestValidationError) ErrorName() string {
+	return "GetUsageReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsageReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageReportRequestValidationError{}
+
+// Validate checks the field values on TopConsumer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TopConsumer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopConsumer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TopConsumerMultiError, or
+// nil if none found.
+func (m *TopConsumer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopConsumer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Consumed
+
+	// no validation rules for Released
+
+	// no validation rules for ConsumeCount
+
+	if len(errors) > 0 {
+		return TopConsumerMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopConsumerMultiError is an error wrapping multiple validation errors
+// returned by TopConsumer.ValidateAll() if the designated constraints aren't met.
+type TopConsumerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopConsumerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopConsumerMultiError) AllErrors() []error { return m }
+
+// TopConsumerValidationError is the validation error returned by
+// TopConsumer.Validate if the designated constraints aren't met.
+type TopConsumerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopConsumerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopConsumerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopConsumerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopConsumerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopConsumerValidationError) ErrorName() string { return "TopConsumerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TopConsumerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopConsumer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopConsumerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopConsumerValidationError{}
+
+// Validate checks the field values on QuotaTypeTopConsumers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QuotaTypeTopConsumers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaTypeTopConsumers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuotaTypeTopConsumersMultiError, or nil if none found.
+func (m *QuotaTypeTopConsumers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaTypeTopConsumers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuotaType
+
+	for idx, item := range m.GetConsumers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuotaTypeTopConsumersValidationError{
+						field:  fmt.Sprintf("Consumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuotaTypeTopConsumersValidationError{
+						field:  fmt.Sprintf("Consumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuotaTypeTopConsumersValidationError{
+					field:  fmt.Sprintf("Consumers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QuotaTypeTopConsumersMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaTypeTopConsumersMultiError is an error wrapping multiple validation
+// errors returned by QuotaTypeTopConsumers.ValidateAll() if the designated
+// constraints aren't met.
+type QuotaTypeTopConsumersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaTypeTopConsumersMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaTypeTopConsumersMultiError) AllErrors() []error { return m }
+
+// QuotaTypeTopConsumersValidationError is the validation error returned by
+// QuotaTypeTopConsumers.Validate if the designated constraints aren't met.
+type QuotaTypeTopConsumersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaTypeTopConsumersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaTypeTopConsumersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaTypeTopConsumersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaTypeTopConsumersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaTypeTopConsumersValidationError) ErrorName() string {
+	return "QuotaTypeTopConsumersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QuotaTypeTopConsumersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaTypeTopConsumers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaTypeTopConsumersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaTypeTopConsumersValidationError{}
+
+// Validate checks the field values on UtilizationBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UtilizationBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UtilizationBucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UtilizationBucketMultiError, or nil if none found.
+func (m *UtilizationBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UtilizationBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LowerPercent
+
+	// no validation rules for UpperPercent
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return UtilizationBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// UtilizationBucketMultiError is an error wrapping multiple validation errors
+// returned by UtilizationBucket.ValidateAll() if the designated constraints
+// aren't met.
+type UtilizationBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UtilizationBucketMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UtilizationBucketMultiError) AllErrors() []error { return m }
+
+// UtilizationBucketValidationError is the validation error returned by
+// UtilizationBucket.Validate if the designated constraints aren't met.
+type UtilizationBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UtilizationBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UtilizationBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UtilizationBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UtilizationBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UtilizationBucketValidationError) ErrorName() string {
+	return "UtilizationBucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UtilizationBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUtilizationBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UtilizationBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UtilizationBucketValidationError{}
+
+// Validate checks the field values on ExhaustionForecast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExhaustionForecast) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExhaustionForecast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExhaustionForecastMultiError, or nil if none found.
+func (m *ExhaustionForecast) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExhaustionForecast) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for HardLimit
+
+	// no validation rules for UsedCount
+
+	// no validation rules for DailyRate
+
+	// no validation rules for WillExhaust
+
+	// no validation rules for ExhaustTime
+
+	// no validation rules for NextResetTime
+
+	// no validation rules for ExhaustBeforeReset
+
+	if len(errors) > 0 {
+		return ExhaustionForecastMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExhaustionForecastMultiError is an error wrapping multiple validation errors
+// returned by ExhaustionForecast.ValidateAll() if the designated constraints
+// aren't met.
+type ExhaustionForecastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExhaustionForecastMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExhaustionForecastMultiError) AllErrors() []error { return m }
+
+// ExhaustionForecastValidationError is the validation error returned by
+// ExhaustionForecast.Validate if the designated constraints aren't met.
+type ExhaustionForecastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExhaustionForecastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExhaustionForecastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExhaustionForecastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExhaustionForecastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExhaustionForecastValidationError) ErrorName() string {
+	return "ExhaustionForecastValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExhaustionForecastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExhaustionForecast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExhaustionForecastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExhaustionForecastValidationError{}
+
+// Validate checks the field values on GetUsageReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageReportReplyMultiError, or nil if none found.
+func (m *GetUsageReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	for idx, item := range m.GetTopConsumers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsageReportReplyValidationError{
+						field:  fmt.Sprintf("TopConsumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsageReportReplyValidationError{
+						field:  fmt.Sprintf("TopConsumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsageReportReplyValidationError{
+					field:  fmt.Sprintf("TopConsumers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSoftLimitUtilization() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsageReportReplyValidationError{
+						field:  fmt.Sprintf("SoftLimitUtilization[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsageReportReplyValidationError{
+						field:  fmt.Sprintf("SoftLimitUtilization[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsageReportReplyValidationError{
+					field:  fmt.Sprintf("SoftLimitUtilization[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetForecasts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsageReportReplyValidationError{
+						field:  fmt.Sprintf("Forecasts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsageReportReplyValidationError{
+						field:  fmt.Sprintf("Forecasts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsageReportReplyValidationError{
+					field:  fmt.Sprintf("Forecasts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RollupRecordId
+
+	// no validation rules for RollupTime
+
+	if len(errors) > 0 {
+		return GetUsageReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUsageReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsageReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageReportReplyMultiError) AllErrors() []error { return m }
+
+// GetUsageReportReplyValidationError is the validation error returned by
+// GetUsageReportReply.Validate if the designated constraints aren't met.
+type GetUsageReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageReportReplyValidationError) ErrorName() string {
+	return "GetUsageReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsageReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageReportReplyValidationError{}
+
+// Validate checks the field values on GetUsageTimeSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageTimeSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageTimeSeriesRequestMultiError, or nil if none found.
+func (m *GetUsageTimeSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageTimeSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := GetUsageTimeSeriesRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for QuotaType
+
+	// no validation rules for LimitType
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if len(errors) > 0 {
+		return GetUsageTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageTimeSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUsageTimeSeriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetUsageTimeSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageTimeSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageTimeSeriesRequestMultiError) AllErrors() []error { return m }
+
+// GetUsageTimeSeriesRequestValidationError is the validation error returned by
+// GetUsageTimeSeriesRequest.Validate if the designated constraints aren't met.
+type GetUsageTimeSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageTimeSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageTimeSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageTimeSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageTimeSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageTimeSeriesRequestValidationError) ErrorName() string {
+	return "GetUsageTimeSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsageTimeSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageTimeSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageTimeSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageTimeSeriesRequestValidationError{}
+
+// Validate checks the field values on UsagePoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UsagePoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsagePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsagePointMultiError, or
+// nil if none found.
+func (m *UsagePoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsagePoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Consumed
+
+	// no validation rules for Released
+
+	// no validation rules for ConsumeCount
+
+	// no validation rules for PeakUsed
+
+	// no validation rules for EndUsed
+
+	// no validation rules for HardLimit
+
+	// no validation rules for SoftLimit
+
+	if len(errors) > 0 {
+		return UsagePointMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsagePointMultiError is an error wrapping multiple validation errors
+// returned by UsagePoint.ValidateAll() if the designated constraints aren't met.
+type UsagePointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsagePointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsagePointMultiError) AllErrors() []error { return m }
+
+// UsagePointValidationError is the validation error returned by
+// UsagePoint.Validate if the designated constraints aren't met.
+type UsagePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsagePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsagePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsagePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsagePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsagePointValidationError) ErrorName() string { return "UsagePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsagePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsagePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsagePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsagePointValidationError{}
+
+// Validate checks the field values on UsageSeries with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UsageSeries) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsageSeries with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsageSeriesMultiError, or
+// nil if none found.
+func (m *UsageSeries) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsageSeries) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuotaId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for LimitType
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UsageSeriesValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UsageSeriesValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UsageSeriesValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UsageSeriesMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsageSeriesMultiError is an error wrapping multiple validation errors
+// returned by UsageSeries.ValidateAll() if the designated constraints aren't met.
+type UsageSeriesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsageSeriesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsageSeriesMultiError) AllErrors() []error { return m }
+
+// UsageSeriesValidationError is the validation error returned by
+// UsageSeries.Validate if the designated constraints aren't met.
+type UsageSeriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageSeriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageSeriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageSeriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageSeriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageSeriesValidationError) ErrorName() string { return "UsageSeriesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsageSeriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageSeries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageSeriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageSeriesValidationError{}
+
+// Validate checks the field values on GetUsageTimeSeriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageTimeSeriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageTimeSeriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageTimeSeriesReplyMultiError, or nil if none found.
+func (m *GetUsageTimeSeriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageTimeSeriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	for idx, item := range m.GetSeries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsageTimeSeriesReplyValidationError{
+						field:  fmt.Sprintf("Series[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsageTimeSeriesReplyValidationError{
+						field:  fmt.Sprintf("Series[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsageTimeSeriesReplyValidationError{
+					field:  fmt.Sprintf("Series[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RollupRecordId
+
+	// no validation rules for RollupTime
+
+	if len(errors) > 0 {
+		return GetUsageTimeSeriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageTimeSeriesReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUsageTimeSeriesReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsageTimeSeriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageTimeSeriesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageTimeSeriesReplyMultiError) AllErrors() []error { return m }
+
+// GetUsageTimeSeriesReplyValidationError is the validation error returned by
+// GetUsageTimeSeriesReply.Validate if the designated constraints aren't met.
+type GetUsageTimeSeriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageTimeSeriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageTimeSeriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageTimeSeriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageTimeSeriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageTimeSeriesReplyValidationError) ErrorName() string {
+	return "GetUsageTimeSeriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsageTimeSeriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageTimeSeriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageTimeSeriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageTimeSeriesReplyValidationError{}
+
+// Validate checks the field values on PlanQuota with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlanQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlanQuotaMultiError, or nil
+// if none found.
+func (m *PlanQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _PlanQuota_QuotaType_NotInLookup[m.GetQuotaType()]; ok {
+		err := PlanQuotaValidationError{
+			field:  "QuotaType",
+			reason: "value must not be in list [QUOTA_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := PlanQuotaValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PlanQuota_LimitType_NotInLookup[m.GetLimitType()]; ok {
+		err := PlanQuotaValidationError{
+			field:  "LimitType",
+			reason: "value must not be in list [LIMIT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := PlanQuotaValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHardLimit() < 0 {
+		err := PlanQuotaValidationError{
+			field:  "HardLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSoftLimit() < 0 {
+		err := PlanQuotaValidationError{
+			field:  "SoftLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlanQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanQuotaMultiError is an error wrapping multiple validation errors returned
+// by PlanQuota.ValidateAll() if the designated constraints aren't met.
+type PlanQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanQuotaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanQuotaMultiError) AllErrors() []error { return m }
+
+// PlanQuotaValidationError is the validation error returned by
+// PlanQuota.Validate if the designated constraints aren't met.
+type PlanQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanQuotaValidationError) ErrorName() string { return "PlanQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanQuotaValidationError{}
+
+var _PlanQuota_QuotaType_NotInLookup = map[QuotaType]struct{}{
+	0: {},
+}
+
+var _PlanQuota_LimitType_NotInLookup = map[LimitType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on QuotaPlan with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaPlan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaPlan with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaPlanMultiError, or nil
+// if none found.
+func (m *QuotaPlan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaPlan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlanCode
+
+	// no validation rules for PlanName
+
+	// no validation rules for Description
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuotaPlanValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuotaPlanValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuotaPlanValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuotaPlanValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuotaPlanValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuotaPlanValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QuotaPlanMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaPlanMultiError is an error wrapping multiple validation errors returned
+// by QuotaPlan.ValidateAll() if the designated constraints aren't met.
+type QuotaPlanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaPlanMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaPlanMultiError) AllErrors() []error { return m }
+
+// QuotaPlanValidationError is the validation error returned by
+// QuotaPlan.Validate if the designated constraints aren't met.
+type QuotaPlanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaPlanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaPlanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaPlanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaPlanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaPlanValidationError) ErrorName() string { return "QuotaPlanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaPlanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaPlan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaPlanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaPlanValidationError{}
+
+// Validate checks the field values on TenantPlan with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantPlan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantPlan with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantPlanMultiError, or
+// nil if none found.
+func (m *TenantPlan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantPlan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for PlanCode
+
+	// no validation rules for PlanVersion
+
+	// no validation rules for AssignedBy
+
+	// no validation rules for AssignedAt
+
+	if len(errors) > 0 {
+		return TenantPlanMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantPlanMultiError is an error wrapping multiple validation errors
+// returned by TenantPlan.ValidateAll() if the designated constraints aren't met.
+type TenantPlanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantPlanMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantPlanMultiError) AllErrors() []error { return m }
+
+// TenantPlanValidationError is the validation error returned by
+// TenantPlan.Validate if the designated constraints aren't met.
+type TenantPlanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantPlanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantPlanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantPlanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantPlanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantPlanValidationError) ErrorName() string { return "TenantPlanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantPlanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantPlan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantPlanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantPlanValidationError{}
+
+// Validate checks the field values on SavePlanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SavePlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavePlanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavePlanRequestMultiError, or nil if none found.
+func (m *SavePlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavePlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPlanCode()); l < 1 || l > 32 {
+		err := SavePlanRequestValidationError{
+			field:  "PlanCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SavePlanRequest_PlanCode_Pattern.MatchString(m.GetPlanCode()) {
+		err := SavePlanRequestValidationError{
+			field:  "PlanCode",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPlanName()); l < 1 || l > 64 {
+		err := SavePlanRequestValidationError{
+			field:  "PlanName",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := SavePlanRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetQuotas()) < 1 {
+		err := SavePlanRequestValidationError{
+			field:  "Quotas",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SavePlanRequestValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SavePlanRequestValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SavePlanRequestValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Operator
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SavePlanRequestValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SavePlanRequestValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SavePlanRequestValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SavePlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavePlanRequestMultiError is an error wrapping multiple validation errors
+// returned by SavePlanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SavePlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavePlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavePlanRequestMultiError) AllErrors() []error { return m }
+
+// SavePlanRequestValidationError is the validation error returned by
+// SavePlanRequest.Validate if the designated constraints aren't met.
+type SavePlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavePlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavePlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavePlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavePlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavePlanRequestValidationError) ErrorName() string { return "SavePlanRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SavePlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavePlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavePlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavePlanRequestValidationError{}
+
+var _SavePlanRequest_PlanCode_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on PlanPropagationFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanPropagationFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanPropagationFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanPropagationFailureMultiError, or nil if none found.
+func (m *PlanPropagationFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanPropagationFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return PlanPropagationFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanPropagationFailureMultiError is an error wrapping multiple validation
+// errors returned by PlanPropagationFailure.ValidateAll() if the designated
+// constraints aren't met.
+type PlanPropagationFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanPropagationFailureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanPropagationFailureMultiError) AllErrors() []error { return m }
+
+// PlanPropagationFailureValidationError is the validation error returned by
+// PlanPropagationFailure.Validate if the designated constraints aren't met.
+type PlanPropagationFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanPropagationFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanPropagationFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanPropagationFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanPropagationFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanPropagationFailureValidationError) ErrorName() string {
+	return "PlanPropagationFailureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanPropagationFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanPropagationFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanPropagationFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanPropagationFailureValidationError{}
+
+// Validate checks the field values on SavePlanReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SavePlanReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavePlanReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SavePlanReplyMultiError, or
+// nil if none found.
+func (m *SavePlanReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavePlanReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavePlanReplyValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavePlanReplyValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavePlanReplyValidationError{
+				field:  "Plan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Propagated
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SavePlanReplyValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SavePlanReplyValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SavePlanReplyValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SavePlanReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavePlanReplyMultiError is an error wrapping multiple validation errors
+// returned by SavePlanReply.ValidateAll() if the designated constraints
+// aren't met.
+type SavePlanReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavePlanReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavePlanReplyMultiError) AllErrors() []error { return m }
+
+// SavePlanReplyValidationError is the validation error returned by
+// SavePlanReply.Validate if the designated constraints aren't met.
+type SavePlanReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavePlanReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavePlanReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavePlanReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavePlanReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavePlanReplyValidationError) ErrorName() string { return "SavePlanReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SavePlanReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavePlanReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavePlanReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavePlanReplyValidationError{}
+
+// Validate checks the field values on GetPlanRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetPlanRequestMultiError,
+// or nil if none found.
+func (m *GetPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPlanCode()) < 1 {
+		err := GetPlanRequestValidationError{
+			field:  "PlanCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlanRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPlanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlanRequestMultiError) AllErrors() []error { return m }
+
+// GetPlanRequestValidationError is the validation error returned by
+// GetPlanRequest.Validate if the designated constraints aren't met.
+type GetPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlanRequestValidationError) ErrorName() string { return "GetPlanRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlanRequestValidationError{}
+
+// Validate checks the field values on GetPlanReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetPlanReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlanReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetPlanReplyMultiError, or
+// nil if none found.
+func (m *GetPlanReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlanReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPlanReplyValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPlanReplyValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPlanReplyValidationError{
+				field:  "Plan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPlanReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlanReplyMultiError is an error wrapping multiple validation errors
+// returned by GetPlanReply.ValidateAll() if the designated constraints aren't met.
+type GetPlanReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlanReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlanReplyMultiError) AllErrors() []error { return m }
+
+// GetPlanReplyValidationError is the validation error returned by
+// GetPlanReply.Validate if the designated constraints aren't met.
+type GetPlanReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlanReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlanReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlanReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlanReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlanReplyValidationError) ErrorName() string { return "GetPlanReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPlanReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlanReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlanReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlanReplyValidationError{}
+
+// Validate checks the field values on ListPlansRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPlansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlansRequestMultiError, or nil if none found.
+func (m *ListPlansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPlansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlansRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPlansRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPlansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlansRequestMultiError) AllErrors() []error { return m }
+
+// ListPlansRequestValidationError is the validation error returned by
+// ListPlansRequest.Validate if the designated constraints aren't met.
+type ListPlansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlansRequestValidationError) ErrorName() string { return "ListPlansRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPlansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlansRequestValidationError{}
+
+// Validate checks the field values on ListPlansReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListPlansReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlansReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListPlansReplyMultiError,
+// or nil if none found.
+func (m *ListPlansReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlansReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPlans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPlansReplyValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPlansReplyValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPlansReplyValidationError{
+					field:  fmt.Sprintf("Plans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPlansReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlansReplyMultiError is an error wrapping multiple validation errors
+// returned by ListPlansReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListPlansReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlansReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlansReplyMultiError) AllErrors() []error { return m }
+
+// ListPlansReplyValidationError is the validation error returned by
+// ListPlansReply.Validate if the designated constraints aren't met.
+type ListPlansReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlansReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlansReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlansReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlansReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlansReplyValidationError) ErrorName() string { return "ListPlansReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPlansReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlansReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlansReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlansReplyValidationError{}
+
+// Validate checks the field values on AssignPlanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssignPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignPlanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignPlanRequestMultiError, or nil if none found.
+func (m *AssignPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := AssignPlanRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPlanCode()) < 1 {
+		err := AssignPlanRequestValidationError{
+			field:  "PlanCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	// no validation rules for ClearOverrides
+
+	if _, ok := ProrationPolicy_name[int32(m.GetProration())]; !ok {
+		err := AssignPlanRequestValidationError{
+			field:  "Proration",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AssignPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignPlanRequestMultiError is an error wrapping multiple validation errors
+// returned by AssignPlanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AssignPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignPlanRequestMultiError) AllErrors() []error { return m }
+
+// AssignPlanRequestValidationError is the validation error returned by
+// AssignPlanRequest.Validate if the designated constraints aren't met.
+type AssignPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignPlanRequestValidationError) ErrorName() string {
+	return "AssignPlanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignPlanRequestValidationError{}
+
+// Validate checks the field values on AssignPlanReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssignPlanReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignPlanReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignPlanReplyMultiError, or nil if none found.
+func (m *AssignPlanReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignPlanReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAssignment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssignPlanReplyValidationError{
+					field:  "Assignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssignPlanReplyValidationError{
+					field:  "Assignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAssignment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssignPlanReplyValidationError{
+				field:  "Assignment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssignPlanReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssignPlanReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssignPlanReplyValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssignPlanReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignPlanReplyMultiError is an error wrapping multiple validation errors
+// returned by AssignPlanReply.ValidateAll() if the designated constraints
+// aren't met.
+type AssignPlanReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignPlanReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignPlanReplyMultiError) AllErrors() []error { return m }
+
+// AssignPlanReplyValidationError is the validation error returned by
+// AssignPlanReply.Validate if the designated constraints aren't met.
+type AssignPlanReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignPlanReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignPlanReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignPlanReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignPlanReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignPlanReplyValidationError) ErrorName() string { return "AssignPlanReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssignPlanReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignPlanReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignPlanReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignPlanReplyValidationError{}
+
+// Validate checks the field values on BindProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BindProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BindProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BindProductRequestMultiError, or nil if none found.
+func (m *BindProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BindProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := BindProductRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductCode()) < 1 {
+		err := BindProductRequestValidationError{
+			field:  "ProductCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BindProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BindProductRequestMultiError is an error wrapping multiple validation errors
+// returned by BindProductRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BindProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BindProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BindProductRequestMultiError) AllErrors() []error { return m }
+
+// BindProductRequestValidationError is the validation error returned by
+// BindProductRequest.Validate if the designated constraints aren't met.
+type BindProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BindProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BindProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BindProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BindProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BindProductRequestValidationError) ErrorName() string {
+	return "BindProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BindProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBindProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BindProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BindProductRequestValidationError{}
+
+// Validate checks the field values on BindProductReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BindProductReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BindProductReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BindProductReplyMultiError, or nil if none found.
+func (m *BindProductReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BindProductReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return BindProductReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BindProductReplyMultiError is an error wrapping multiple validation errors
+// returned by BindProductReply.ValidateAll() if the designated constraints
+// aren't met.
+type BindProductReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BindProductReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BindProductReplyMultiError) AllErrors() []error { return m }
+
+// BindProductReplyValidationError is the validation error returned by
+// BindProductReply.Validate if the designated constraints aren't met.
+type BindProductReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BindProductReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BindProductReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BindProductReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BindProductReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BindProductReplyValidationError) ErrorName() string { return "BindProductReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BindProductReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBindProductReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BindProductReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BindProductReplyValidationError{}
+
+// Validate checks the field values on ListProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProductsRequestMultiError, or nil if none found.
+func (m *ListProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if len(errors) > 0 {
+		return ListProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProductsRequestMultiError) AllErrors() []error { return m }
+
+// ListProductsRequestValidationError is the validation error returned by
+// ListProductsRequest.Validate if the designated constraints aren't met.
+type ListProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProductsRequestValidationError) ErrorName() string {
+	return "ListProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProductsRequestValidationError{}
+
+// Validate checks the field values on ListProductsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListProductsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProductsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProductsReplyMultiError, or nil if none found.
+func (m *ListProductsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProductsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProductsReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProductsReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProductsReplyValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProductsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProductsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListProductsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListProductsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProductsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProductsReplyMultiError) AllErrors() []error { return m }
+
+// ListProductsReplyValidationError is the validation error returned by
+// ListProductsReply.Validate if the designated constraints aren't met.
+type ListProductsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProductsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProductsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProductsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProductsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProductsReplyValidationError) ErrorName() string {
+	return "ListProductsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProductsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProductsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProductsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProductsReplyValidationError{}
+
+// Validate checks the field values on QuotaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaChangeMultiError, or
+// nil if none found.
+func (m *QuotaChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChangeId
+
+	// no validation rules for TenantId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for LimitType
+
+	// no validation rules for PlanCode
+
+	// no validation rules for ApplyAt
+
+	// no validation rules for Proration
+
+	// no validation rules for Status
+
+	// no validation rules for Operator
+
+	// no validation rules for Remark
+
+	// no validation rules for Error
+
+	// no validation rules for AppliedAt
+
+	// no validation rules for CreatedAt
+
+	if m.HardLimit != nil {
+		// no validation rules for HardLimit
+	}
+
+	if m.SoftLimit != nil {
+		// no validation rules for SoftLimit
+	}
+
+	if len(errors) > 0 {
+		return QuotaChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaChangeMultiError is an error wrapping multiple validation errors
+// returned by QuotaChange.ValidateAll() if the designated constraints aren't met.
+type QuotaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaChangeMultiError) AllErrors() []error { return m }
+
+// QuotaChangeValidationError is the validation error returned by
+// QuotaChange.Validate if the designated constraints aren't met.
+type QuotaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaChangeValidationError) ErrorName() string { return "QuotaChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaChangeValidationError{}
+
+// Validate checks the field values on ScheduleQuotaChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleQuotaChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleQuotaChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleQuotaChangeRequestMultiError, or nil if none found.
+func (m *ScheduleQuotaChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleQuotaChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ScheduleQuotaChangeRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := ScheduleQuotaChangeRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := ScheduleQuotaChangeRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PlanCode
+
+	// no validation rules for ApplyAt
+
+	// no validation rules for AtNextReset
+
+	if _, ok := ProrationPolicy_name[int32(m.GetProration())]; !ok {
+		err := ScheduleQuotaChangeRequestValidationError{
+			field:  "Proration",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := ScheduleQuotaChangeRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.HardLimit != nil {
+
+		if m.GetHardLimit() < 0 {
+			err := ScheduleQuotaChangeRequestValidationError{
+				field:  "HardLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SoftLimit != nil {
+
+		if m.GetSoftLimit() < 0 {
+			err := ScheduleQuotaChangeRequestValidationError{
+				field:  "SoftLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScheduleQuotaChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleQuotaChangeRequestMultiError is an error wrapping multiple
+// validation errors returned by ScheduleQuotaChangeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ScheduleQuotaChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleQuotaChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleQuotaChangeRequestMultiError) AllErrors() []error { return m }
+
+// ScheduleQuotaChangeRequestValidationError is the validation error returned
+// by ScheduleQuotaChangeRequest.Validate if the designated constraints aren't met.
+type ScheduleQuotaChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleQuotaChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleQuotaChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleQuotaChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleQuotaChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleQuotaChangeRequestValidationError) ErrorName() string {
+	return "ScheduleQuotaChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleQuotaChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleQuotaChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleQuotaChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleQuotaChangeRequestValidationError{}
+
+// Validate checks the field values on ScheduleQuotaChangeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleQuotaChangeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleQuotaChangeReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleQuotaChangeReplyMultiError, or nil if none found.
+func (m *ScheduleQuotaChangeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleQuotaChangeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleQuotaChangeReplyValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleQuotaChangeReplyValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleQuotaChangeReplyValidationError{
+				field:  "Change",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScheduleQuotaChangeReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScheduleQuotaChangeReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScheduleQuotaChangeReplyValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScheduleQuotaChangeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleQuotaChangeReplyMultiError is an error wrapping multiple validation
+// errors returned by ScheduleQuotaChangeReply.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleQuotaChangeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleQuotaChangeReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleQuotaChangeReplyMultiError) AllErrors() []error { return m }
+
+// ScheduleQuotaChangeReplyValidationError is the validation error returned by
+// ScheduleQuotaChangeReply.Validate if the designated constraints aren't met.
+type ScheduleQuotaChangeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleQuotaChangeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleQuotaChangeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleQuotaChangeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleQuotaChangeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleQuotaChangeReplyValidationError) ErrorName() string {
+	return "ScheduleQuotaChangeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleQuotaChangeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleQuotaChangeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleQuotaChangeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleQuotaChangeReplyValidationError{}
+
+// Validate checks the field values on ListQuotaChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotaChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotaChangesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotaChangesRequestMultiError, or nil if none found.
+func (m *ListQuotaChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotaChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListQuotaChangesRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaChangeStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListQuotaChangesRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListQuotaChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotaChangesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQuotaChangesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuotaChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotaChangesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotaChangesRequestMultiError) AllErrors() []error { return m }
+
+// ListQuotaChangesRequestValidationError is the validation error returned by
+// ListQuotaChangesRequest.Validate if the designated constraints aren't met.
+type ListQuotaChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotaChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotaChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotaChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotaChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotaChangesRequestValidationError) ErrorName() string {
+	return "ListQuotaChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotaChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotaChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotaChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotaChangesRequestValidationError{}
+
+// Validate checks the field values on ListQuotaChangesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotaChangesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotaChangesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotaChangesReplyMultiError, or nil if none found.
+func (m *ListQuotaChangesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotaChangesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQuotaChangesReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQuotaChangesReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQuotaChangesReplyValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQuotaChangesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotaChangesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListQuotaChangesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuotaChangesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotaChangesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotaChangesReplyMultiError) AllErrors() []error { return m }
+
+// ListQuotaChangesReplyValidationError is the validation error returned by
+// ListQuotaChangesReply.Validate if the designated constraints aren't met.
+type ListQuotaChangesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotaChangesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotaChangesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotaChangesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotaChangesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotaChangesReplyValidationError) ErrorName() string {
+	return "ListQuotaChangesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotaChangesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotaChangesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotaChangesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotaChangesReplyValidationError{}
+
+// Validate checks the field values on CancelQuotaChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelQuotaChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelQuotaChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelQuotaChangeRequestMultiError, or nil if none found.
+func (m *CancelQuotaChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelQuotaChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := CancelQuotaChangeRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChangeId() <= 0 {
+		err := CancelQuotaChangeRequestValidationError{
+			field:  "ChangeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	if len(errors) > 0 {
+		return CancelQuotaChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelQuotaChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelQuotaChangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelQuotaChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelQuotaChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelQuotaChangeRequestMultiError) AllErrors() []error { return m }
+
+// CancelQuotaChangeRequestValidationError is the validation error returned by
+// CancelQuotaChangeRequest.Validate if the designated constraints aren't met.
+type CancelQuotaChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelQuotaChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelQuotaChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelQuotaChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelQuotaChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelQuotaChangeRequestValidationError) ErrorName() string {
+	return "CancelQuotaChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelQuotaChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelQuotaChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelQuotaChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelQuotaChangeRequestValidationError{}
+
+// Validate checks the field values on CancelQuotaChangeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelQuotaChangeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelQuotaChangeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelQuotaChangeReplyMultiError, or nil if none found.
+func (m *CancelQuotaChangeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelQuotaChangeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelQuotaChangeReplyValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelQuotaChangeReplyValidationError{
+					field:  "Change",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelQuotaChangeReplyValidationError{
+				field:  "Change",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelQuotaChangeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelQuotaChangeReplyMultiError is an error wrapping multiple validation
+// errors returned by CancelQuotaChangeReply.ValidateAll() if the designated
+// constraints aren't met.
+type CancelQuotaChangeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelQuotaChangeReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelQuotaChangeReplyMultiError) AllErrors() []error { return m }
+
+// CancelQuotaChangeReplyValidationError is the validation error returned by
+// CancelQuotaChangeReply.Validate if the designated constraints aren't met.
+type CancelQuotaChangeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelQuotaChangeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelQuotaChangeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelQuotaChangeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelQuotaChangeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelQuotaChangeReplyValidationError) ErrorName() string {
+	return "CancelQuotaChangeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelQuotaChangeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelQuotaChangeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelQuotaChangeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelQuotaChangeReplyValidationError{}
+
+// Validate checks the field values on ImportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportOptionsMultiError, or
+// nil if none found.
+func (m *ImportOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportOptions_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ImportOptionsValidationError{
+			field:  "Format",
+			reason: "value must not be in list [DATA_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DataFormat_name[int32(m.GetFormat())]; !ok {
+		err := ImportOptionsValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Upsert
+
+	if len(errors) > 0 {
+		return ImportOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOptionsMultiError is an error wrapping multiple validation errors
+// returned by ImportOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ImportOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOptionsMultiError) AllErrors() []error { return m }
+
+// ImportOptionsValidationError is the validation error returned by
+// ImportOptions.Validate if the designated constraints aren't met.
+type ImportOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOptionsValidationError) ErrorName() string { return "ImportOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOptionsValidationError{}
+
+var _ImportOptions_Format_NotInLookup = map[DataFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ImportTenantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportTenantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportTenantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportTenantsRequestMultiError, or nil if none found.
+func (m *ImportTenantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportTenantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ImportTenantsRequest_Options:
+		if v == nil {
+			err := ImportTenantsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportTenantsRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportTenantsRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportTenantsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportTenantsRequest_Chunk:
+		if v == nil {
+			err := ImportTenantsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ImportTenantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportTenantsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportTenantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportTenantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportTenantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportTenantsRequestMultiError) AllErrors() []error { return m }
+
+// ImportTenantsRequestValidationError is the validation error returned by
+// ImportTenantsRequest.Validate if the designated constraints aren't met.
+type ImportTenantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportTenantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportTenantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportTenantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportTenantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportTenantsRequestValidationError) ErrorName() string {
+	return "ImportTenantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportTenantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportTenantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportTenantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportTenantsRequestValidationError{}
+
+// Validate checks the field values on ImportRowResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportRowResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRowResultMultiError, or nil if none found.
+func (m *ImportRowResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for TenantId
+
+	// no validation rules for Action
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ImportRowResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowResultMultiError is an error wrapping multiple validation errors
+// returned by ImportRowResult.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowResultMultiError) AllErrors() []error { return m }
+
+// ImportRowResultValidationError is the validation error returned by
+// ImportRowResult.Validate if the designated constraints aren't met.
+type ImportRowResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowResultValidationError) ErrorName() string { return "ImportRowResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowResultValidationError{}
+
+// Validate checks the field values on ImportTenantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportTenantsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportTenantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportTenantsReplyMultiError, or nil if none found.
+func (m *ImportTenantsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportTenantsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	// no validation rules for Total
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportTenantsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportTenantsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportTenantsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportTenantsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportTenantsReplyMultiError is an error wrapping multiple validation errors
+// returned by ImportTenantsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ImportTenantsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportTenantsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportTenantsReplyMultiError) AllErrors() []error { return m }
+
+// ImportTenantsReplyValidationError is the validation error returned by
+// ImportTenantsReply.Validate if the designated constraints aren't met.
+type ImportTenantsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportTenantsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportTenantsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportTenantsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportTenantsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportTenantsReplyValidationError) ErrorName() string {
+	return "ImportTenantsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportTenantsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportTenantsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportTenantsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportTenantsReplyValidationError{}
+
+// Validate checks the field values on ExportTenantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTenantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportTenantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTenantsRequestMultiError, or nil if none found.
+func (m *ExportTenantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportTenantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportTenantsRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ExportTenantsRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [DATA_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DataFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportTenantsRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ParentTenantId
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return ExportTenantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportTenantsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportTenantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportTenantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportTenantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportTenantsRequestMultiError) AllErrors() []error { return m }
+
+// ExportTenantsRequestValidationError is the validation error returned by
+// ExportTenantsRequest.Validate if the designated constraints aren't met.
+type ExportTenantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportTenantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportTenantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportTenantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportTenantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportTenantsRequestValidationError) ErrorName() string {
+	return "ExportTenantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportTenantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportTenantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportTenantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportTenantsRequestValidationError{}
+
+var _ExportTenantsRequest_Format_NotInLookup = map[DataFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExportTenantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTenantsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportTenantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTenantsReplyMultiError, or nil if none found.
+func (m *ExportTenantsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportTenantsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return ExportTenantsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportTenantsReplyMultiError is an error wrapping multiple validation errors
+// returned by ExportTenantsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExportTenantsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportTenantsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportTenantsReplyMultiError) AllErrors() []error { return m }
+
+// ExportTenantsReplyValidationError is the validation error returned by
+// ExportTenantsReply.Validate if the designated constraints aren't met.
+type ExportTenantsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportTenantsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportTenantsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportTenantsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportTenantsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportTenantsReplyValidationError) ErrorName() string {
+	return "ExportTenantsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportTenantsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportTenantsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportTenantsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportTenantsReplyValidationError{}
+
+// Validate checks the field values on Wallet with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Wallet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Wallet with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WalletMultiError, or nil if none found.
+func (m *Wallet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Wallet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Balance
+
+	// no validation rules for LowBalanceThreshold
+
+	// no validation rules for LowBalance
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return WalletMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalletMultiError is an error wrapping multiple validation errors returned by
+// Wallet.ValidateAll() if the designated constraints aren't met.
+type WalletMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalletMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalletMultiError) AllErrors() []error { return m }
+
+// WalletValidationError is the validation error returned by Wallet.Validate if
+// the designated constraints aren't met.
+type WalletValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalletValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalletValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalletValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalletValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalletValidationError) ErrorName() string { return "WalletValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WalletValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWallet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalletValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalletValidationError{}
+
+// Validate checks the field values on LedgerEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LedgerEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LedgerEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LedgerEntryMultiError, or
+// nil if none found.
+func (m *LedgerEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LedgerEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntryId
+
+	// no validation rules for Account
+
+	// no validation rules for Direction
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return LedgerEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// LedgerEntryMultiError is an error wrapping multiple validation errors
+// returned by LedgerEntry.ValidateAll() if the designated constraints aren't met.
+type LedgerEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LedgerEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LedgerEntryMultiError) AllErrors() []error { return m }
+
+// LedgerEntryValidationError is the validation error returned by
+// LedgerEntry.Validate if the designated constraints aren't met.
+type LedgerEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LedgerEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LedgerEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LedgerEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LedgerEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LedgerEntryValidationError) ErrorName() string { return "LedgerEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LedgerEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLedgerEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LedgerEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LedgerEntryValidationError{}
+
+// Validate checks the field values on WalletTransaction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WalletTransaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WalletTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WalletTransactionMultiError, or nil if none found.
+func (m *WalletTransaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WalletTransaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TxId
+
+	// no validation rules for TenantId
+
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	// no validation rules for BalanceAfter
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for QuotaType
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Quantity
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for RefTxId
+
+	// no validation rules for BizId
+
+	// no validation rules for Operator
+
+	// no validation rules for Remark
+
+	// no validation rules for CreatedAt
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WalletTransactionValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WalletTransactionValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WalletTransactionValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WalletTransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalletTransactionMultiError is an error wrapping multiple validation errors
+// returned by WalletTransaction.ValidateAll() if the designated constraints
+// aren't met.
+type WalletTransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalletTransactionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalletTransactionMultiError) AllErrors() []error { return m }
+
+// WalletTransactionValidationError is the validation error returned by
+// WalletTransaction.Validate if the designated constraints aren't met.
+type WalletTransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalletTransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalletTransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalletTransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalletTransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalletTransactionValidationError) ErrorName() string {
+	return "WalletTransactionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WalletTransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWalletTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalletTransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalletTransactionValidationError{}
+
+// Validate checks the field values on GetWalletRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWalletRequestMultiError, or nil if none found.
+func (m *GetWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := GetWalletRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWalletRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWalletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWalletRequestMultiError) AllErrors() []error { return m }
+
+// GetWalletRequestValidationError is the validation error returned by
+// GetWalletRequest.Validate if the designated constraints aren't met.
+type GetWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWalletRequestValidationError) ErrorName() string { return "GetWalletRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWalletRequestValidationError{}
+
+// Validate checks the field values on GetWalletReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetWalletReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWalletReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetWalletReplyMultiError,
+// or nil if none found.
+func (m *GetWalletReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWalletReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWallet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWallet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWalletReplyValidationError{
+				field:  "Wallet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWalletReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWalletReplyMultiError is an error wrapping multiple validation errors
+// returned by GetWalletReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetWalletReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWalletReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWalletReplyMultiError) AllErrors() []error { return m }
+
+// GetWalletReplyValidationError is the validation error returned by
+// GetWalletReply.Validate if the designated constraints aren't met.
+type GetWalletReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWalletReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWalletReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWalletReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWalletReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWalletReplyValidationError) ErrorName() string { return "GetWalletReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetWalletReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWalletReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWalletReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWalletReplyValidationError{}
+
+// Validate checks the field values on SetWalletThresholdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetWalletThresholdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetWalletThresholdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetWalletThresholdRequestMultiError, or nil if none found.
+func (m *SetWalletThresholdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetWalletThresholdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := SetWalletThresholdRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLowBalanceThreshold() < 0 {
+		err := SetWalletThresholdRequestValidationError{
+			field:  "LowBalanceThreshold",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetWalletThresholdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetWalletThresholdRequestMultiError is an error wrapping multiple validation
+// errors returned by SetWalletThresholdRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetWalletThresholdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetWalletThresholdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetWalletThresholdRequestMultiError) AllErrors() []error { return m }
+
+// SetWalletThresholdRequestValidationError is the validation error returned by
+// SetWalletThresholdRequest.Validate if the designated constraints aren't met.
+type SetWalletThresholdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetWalletThresholdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetWalletThresholdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetWalletThresholdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetWalletThresholdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetWalletThresholdRequestValidationError) ErrorName() string {
+	return "SetWalletThresholdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetWalletThresholdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetWalletThresholdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetWalletThresholdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetWalletThresholdRequestValidationError{}
+
+// Validate checks the field values on SetWalletThresholdReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetWalletThresholdReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetWalletThresholdReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetWalletThresholdReplyMultiError, or nil if none found.
+func (m *SetWalletThresholdReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetWalletThresholdReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWallet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetWalletThresholdReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetWalletThresholdReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWallet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetWalletThresholdReplyValidationError{
+				field:  "Wallet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetWalletThresholdReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetWalletThresholdReplyMultiError is an error wrapping multiple validation
+// errors returned by SetWalletThresholdReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetWalletThresholdReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetWalletThresholdReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetWalletThresholdReplyMultiError) AllErrors() []error { return m }
+
+// SetWalletThresholdReplyValidationError is the validation error returned by
+// SetWalletThresholdReply.Validate if the designated constraints aren't met.
+type SetWalletThresholdReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetWalletThresholdReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetWalletThresholdReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetWalletThresholdReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetWalletThresholdReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetWalletThresholdReplyValidationError) ErrorName() string {
+	return "SetWalletThresholdReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetWalletThresholdReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetWalletThresholdReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetWalletThresholdReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetWalletThresholdReplyValidationError{}
+
+// Validate checks the field values on TopUpWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopUpWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopUpWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopUpWalletRequestMultiError, or nil if none found.
+func (m *TopUpWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopUpWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := TopUpWalletRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := TopUpWalletRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 64 {
+		err := TopUpWalletRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := TopUpWalletRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TopUpWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopUpWalletRequestMultiError is an error wrapping multiple validation errors
+// returned by TopUpWalletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TopUpWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopUpWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopUpWalletRequestMultiError) AllErrors() []error { return m }
+
+// TopUpWalletRequestValidationError is the validation error returned by
+// TopUpWalletRequest.Validate if the designated constraints aren't met.
+type TopUpWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopUpWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopUpWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopUpWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopUpWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopUpWalletRequestValidationError) ErrorName() string {
+	return "TopUpWalletRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopUpWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopUpWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopUpWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopUpWalletRequestValidationError{}
+
+// Validate checks the field values on TopUpWalletReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TopUpWalletReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopUpWalletReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopUpWalletReplyMultiError, or nil if none found.
+func (m *TopUpWalletReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopUpWalletReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TopUpWalletReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TopUpWalletReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TopUpWalletReplyValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWallet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TopUpWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TopUpWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWallet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TopUpWalletReplyValidationError{
+				field:  "Wallet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Replayed
+
+	if len(errors) > 0 {
+		return TopUpWalletReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopUpWalletReplyMultiError is an error wrapping multiple validation errors
+// returned by TopUpWalletReply.ValidateAll() if the designated constraints
+// aren't met.
+type TopUpWalletReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopUpWalletReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopUpWalletReplyMultiError) AllErrors() []error { return m }
+
+// TopUpWalletReplyValidationError is the validation error returned by
+// TopUpWalletReply.Validate if the designated constraints aren't met.
+type TopUpWalletReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopUpWalletReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopUpWalletReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopUpWalletReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopUpWalletReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopUpWalletReplyValidationError) ErrorName() string { return "TopUpWalletReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TopUpWalletReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopUpWalletReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopUpWalletReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopUpWalletReplyValidationError{}
+
+// Validate checks the field values on DebitWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DebitWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DebitWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DebitWalletRequestMultiError, or nil if none found.
+func (m *DebitWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DebitWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := DebitWalletRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DebitWalletRequest_QuotaType_NotInLookup[m.GetQuotaType()]; ok {
+		err := DebitWalletRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must not be in list [QUOTA_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := DebitWalletRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ProductCode
+
+	if m.GetQuantity() <= 0 {
+		err := DebitWalletRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 64 {
+		err := DebitWalletRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BizId
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := DebitWalletRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DebitWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DebitWalletRequestMultiError is an error wrapping multiple validation errors
+// returned by DebitWalletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DebitWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DebitWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DebitWalletRequestMultiError) AllErrors() []error { return m }
+
+// DebitWalletRequestValidationError is the validation error returned by
+// DebitWalletRequest.Validate if the designated constraints aren't met.
+type DebitWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DebitWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DebitWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DebitWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DebitWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DebitWalletRequestValidationError) ErrorName() string {
+	return "DebitWalletRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DebitWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDebitWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DebitWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DebitWalletRequestValidationError{}
+
+var _DebitWalletRequest_QuotaType_NotInLookup = map[QuotaType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on DebitWalletReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DebitWalletReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DebitWalletReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DebitWalletReplyMultiError, or nil if none found.
+func (m *DebitWalletReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DebitWalletReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitWalletReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitWalletReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitWalletReplyValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWallet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DebitWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DebitWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWallet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DebitWalletReplyValidationError{
+				field:  "Wallet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Replayed
+
+	// no validation rules for LowBalance
+
+	if len(errors) > 0 {
+		return DebitWalletReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DebitWalletReplyMultiError is an error wrapping multiple validation errors
+// returned by DebitWalletReply.ValidateAll() if the designated constraints
+// aren't met.
+type DebitWalletReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DebitWalletReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DebitWalletReplyMultiError) AllErrors() []error { return m }
+
+// DebitWalletReplyValidationError is the validation error returned by
+// DebitWalletReply.Validate if the designated constraints aren't met.
+type DebitWalletReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DebitWalletReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DebitWalletReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DebitWalletReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DebitWalletReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DebitWalletReplyValidationError) ErrorName() string { return "DebitWalletReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DebitWalletReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDebitWalletReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DebitWalletReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DebitWalletReplyValidationError{}
+
+// Validate checks the field values on RefundWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundWalletRequestMultiError, or nil if none found.
+func (m *RefundWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := RefundWalletRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDebitTxId() <= 0 {
+		err := RefundWalletRequestValidationError{
+			field:  "DebitTxId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() < 0 {
+		err := RefundWalletRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 64 {
+		err := RefundWalletRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operator
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := RefundWalletRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefundWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundWalletRequestMultiError is an error wrapping multiple validation
+// errors returned by RefundWalletRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefundWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundWalletRequestMultiError) AllErrors() []error { return m }
+
+// RefundWalletRequestValidationError is the validation error returned by
+// RefundWalletRequest.Validate if the designated constraints aren't met.
+type RefundWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundWalletRequestValidationError) ErrorName() string {
+	return "RefundWalletRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundWalletRequestValidationError{}
+
+// Validate checks the field values on RefundWalletReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RefundWalletReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundWalletReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundWalletReplyMultiError, or nil if none found.
+func (m *RefundWalletReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundWalletReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundWalletReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundWalletReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundWalletReplyValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWallet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundWalletReplyValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWallet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundWalletReplyValidationError{
+				field:  "Wallet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Replayed
+
+	if len(errors) > 0 {
+		return RefundWalletReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundWalletReplyMultiError is an error wrapping multiple validation errors
+// returned by RefundWalletReply.ValidateAll() if the designated constraints
+// aren't met.
+type RefundWalletReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundWalletReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundWalletReplyMultiError) AllErrors() []error { return m }
+
+// RefundWalletReplyValidationError is the validation error returned by
+// RefundWalletReply.Validate if the designated constraints aren't met.
+type RefundWalletReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundWalletReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundWalletReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundWalletReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundWalletReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundWalletReplyValidationError) ErrorName() string {
+	return "RefundWalletReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundWalletReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundWalletReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundWalletReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundWalletReplyValidationError{}
+
+// Validate checks the field values on ListWalletTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWalletTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWalletTransactionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWalletTransactionsRequestMultiError, or nil if none found.
+func (m *ListWalletTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWalletTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListWalletTransactionsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := WalletTransactionType_name[int32(m.GetType())]; !ok {
+		err := ListWalletTransactionsRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AfterTxId
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ListWalletTransactionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWalletTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWalletTransactionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWalletTransactionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWalletTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWalletTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWalletTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ListWalletTransactionsRequestValidationError is the validation error
+// returned by ListWalletTransactionsRequest.Validate if the designated
+// constraints aren't met.
+type ListWalletTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWalletTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWalletTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWalletTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWalletTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWalletTransactionsRequestValidationError) ErrorName() string {
+	return "ListWalletTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWalletTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWalletTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWalletTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWalletTransactionsRequestValidationError{}
+
+// Validate checks the field values on ListWalletTransactionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWalletTransactionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWalletTransactionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWalletTransactionsReplyMultiError, or nil if none found.
+func (m *ListWalletTransactionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWalletTransactionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWalletTransactionsReplyValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWalletTransactionsReplyValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWalletTransactionsReplyValidationError{
+					field:  fmt.Sprintf("Transactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWalletTransactionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWalletTransactionsReplyMultiError is an error wrapping multiple
+// validation errors returned by ListWalletTransactionsReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListWalletTransactionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWalletTransactionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWalletTransactionsReplyMultiError) AllErrors() []error { return m }
+
+// ListWalletTransactionsReplyValidationError is the validation error returned
+// by ListWalletTransactionsReply.Validate if the designated constraints
+// aren't met.
+type ListWalletTransactionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWalletTransactionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWalletTransactionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWalletTransactionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWalletTransactionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWalletTransactionsReplyValidationError) ErrorName() string {
+	return "ListWalletTransactionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWalletTransactionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWalletTransactionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWalletTransactionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWalletTransactionsReplyValidationError{}
+
+// Validate checks the field values on QuotaLease with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaLease) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaLease with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaLeaseMultiError, or
+// nil if none found.
+func (m *QuotaLease) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaLease) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LeaseId
+
+	// no validation rules for TenantId
+
+	// no validation rules for QuotaId
+
+	// no validation rules for QuotaType
+
+	// no validation rules for LimitType
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Holder
+
+	// no validation rules for Amount
+
+	// no validation rules for UsedCount
+
+	// no validation rules for Returned
+
+	// no validation rules for Status
+
+	// no validation rules for ExpireTime
+
+	// no validation rules for ClosedAt
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return QuotaLeaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaLeaseMultiError is an error wrapping multiple validation errors
+// returned by QuotaLease.ValidateAll() if the designated constraints aren't met.
+type QuotaLeaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaLeaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaLeaseMultiError) AllErrors() []error { return m }
+
+// QuotaLeaseValidationError is the validation error returned by
+// QuotaLease.Validate if the designated constraints aren't met.
+type QuotaLeaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaLeaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaLeaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaLeaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaLeaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaLeaseValidationError) ErrorName() string { return "QuotaLeaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaLeaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaLease.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaLeaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaLeaseValidationError{}
+
+// Validate checks the field values on LeaseQuotaBlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaseQuotaBlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaseQuotaBlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaseQuotaBlockRequestMultiError, or nil if none found.
+func (m *LeaseQuotaBlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaseQuotaBlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _LeaseQuotaBlockRequest_QuotaType_NotInLookup[m.GetQuotaType()]; ok {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must not be in list [QUOTA_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _LeaseQuotaBlockRequest_LimitType_NotInLookup[m.GetLimitType()]; ok {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "LimitType",
+			reason: "value must not be in list [LIMIT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "LimitType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ProductCode
+
+	if utf8.RuneCountInString(m.GetHolder()) > 128 {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "Holder",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTtlSeconds() < 0 {
+		err := LeaseQuotaBlockRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaseQuotaBlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaseQuotaBlockRequestMultiError is an error wrapping multiple validation
+// errors returned by LeaseQuotaBlockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LeaseQuotaBlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaseQuotaBlockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaseQuotaBlockRequestMultiError) AllErrors() []error { return m }
+
+// LeaseQuotaBlockRequestValidationError is the validation error returned by
+// LeaseQuotaBlockRequest.Validate if the designated constraints aren't met.
+type LeaseQuotaBlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaseQuotaBlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaseQuotaBlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaseQuotaBlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaseQuotaBlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaseQuotaBlockRequestValidationError) ErrorName() string {
+	return "LeaseQuotaBlockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaseQuotaBlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaseQuotaBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaseQuotaBlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaseQuotaBlockRequestValidationError{}
+
+var _LeaseQuotaBlockRequest_QuotaType_NotInLookup = map[QuotaType]struct{}{
+	0: {},
+}
+
+var _LeaseQuotaBlockRequest_LimitType_NotInLookup = map[LimitType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on LeaseQuotaBlockReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaseQuotaBlockReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaseQuotaBlockReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaseQuotaBlockReplyMultiError, or nil if none found.
+func (m *LeaseQuotaBlockReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaseQuotaBlockReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLease()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaseQuotaBlockReplyValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaseQuotaBlockReplyValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLease()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaseQuotaBlockReplyValidationError{
+				field:  "Lease",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LeaseQuotaBlockReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaseQuotaBlockReplyMultiError is an error wrapping multiple validation
+// errors returned by LeaseQuotaBlockReply.ValidateAll() if the designated
+// constraints aren't met.
+type LeaseQuotaBlockReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaseQuotaBlockReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaseQuotaBlockReplyMultiError) AllErrors() []error { return m }
+
+// LeaseQuotaBlockReplyValidationError is the validation error returned by
+// LeaseQuotaBlockReply.Validate if the designated constraints aren't met.
+type LeaseQuotaBlockReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaseQuotaBlockReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaseQuotaBlockReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaseQuotaBlockReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaseQuotaBlockReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaseQuotaBlockReplyValidationError) ErrorName() string {
+	return "LeaseQuotaBlockReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaseQuotaBlockReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaseQuotaBlockReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaseQuotaBlockReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaseQuotaBlockReplyValidationError{}
+
+// Validate checks the field values on RenewQuotaLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenewQuotaLeaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewQuotaLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewQuotaLeaseRequestMultiError, or nil if none found.
+func (m *RenewQuotaLeaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewQuotaLeaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLeaseId()) < 1 {
+		err := RenewQuotaLeaseRequestValidationError{
+			field:  "LeaseId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUsedCount() < 0 {
+		err := RenewQuotaLeaseRequestValidationError{
+			field:  "UsedCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTtlSeconds() < 0 {
+		err := RenewQuotaLeaseRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenewQuotaLeaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewQuotaLeaseRequestMultiError is an error wrapping multiple validation
+// errors returned by RenewQuotaLeaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenewQuotaLeaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewQuotaLeaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewQuotaLeaseRequestMultiError) AllErrors() []error { return m }
+
+// RenewQuotaLeaseRequestValidationError is the validation error returned by
+// RenewQuotaLeaseRequest.Validate if the designated constraints aren't met.
+type RenewQuotaLeaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewQuotaLeaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewQuotaLeaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewQuotaLeaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewQuotaLeaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewQuotaLeaseRequestValidationError) ErrorName() string {
+	return "RenewQuotaLeaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewQuotaLeaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewQuotaLeaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewQuotaLeaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewQuotaLeaseRequestValidationError{}
+
+// Validate checks the field values on RenewQuotaLeaseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenewQuotaLeaseReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewQuotaLeaseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewQuotaLeaseReplyMultiError, or nil if none found.
+func (m *RenewQuotaLeaseReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewQuotaLeaseReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLease()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenewQuotaLeaseReplyValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenewQuotaLeaseReplyValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLease()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenewQuotaLeaseReplyValidationError{
+				field:  "Lease",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenewQuotaLeaseReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewQuotaLeaseReplyMultiError is an error wrapping multiple validation
+// errors returned by RenewQuotaLeaseReply.ValidateAll() if the designated
+// constraints aren't met.
+type RenewQuotaLeaseReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewQuotaLeaseReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewQuotaLeaseReplyMultiError) AllErrors() []error { return m }
+
+// RenewQuotaLeaseReplyValidationError is the validation error returned by
+// RenewQuotaLeaseReply.Validate if the designated constraints aren't met.
+type RenewQuotaLeaseReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewQuotaLeaseReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewQuotaLeaseReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewQuotaLeaseReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewQuotaLeaseReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewQuotaLeaseReplyValidationError) ErrorName() string {
+	return "RenewQuotaLeaseReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewQuotaLeaseReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewQuotaLeaseReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewQuotaLeaseReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewQuotaLeaseReplyValidationError{}
+
+// Validate checks the field values on ReturnQuotaLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnQuotaLeaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnQuotaLeaseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnQuotaLeaseRequestMultiError, or nil if none found.
+func (m *ReturnQuotaLeaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnQuotaLeaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLeaseId()) < 1 {
+		err := ReturnQuotaLeaseRequestValidationError{
+			field:  "LeaseId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUsedCount() < 0 {
+		err := ReturnQuotaLeaseRequestValidationError{
+			field:  "UsedCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReturnQuotaLeaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnQuotaLeaseRequestMultiError is an error wrapping multiple validation
+// errors returned by ReturnQuotaLeaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnQuotaLeaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnQuotaLeaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnQuotaLeaseRequestMultiError) AllErrors() []error { return m }
+
+// ReturnQuotaLeaseRequestValidationError is the validation error returned by
+// ReturnQuotaLeaseRequest.Validate if the designated constraints aren't met.
+type ReturnQuotaLeaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnQuotaLeaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnQuotaLeaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnQuotaLeaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnQuotaLeaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnQuotaLeaseRequestValidationError) ErrorName() string {
+	return "ReturnQuotaLeaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnQuotaLeaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnQuotaLeaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnQuotaLeaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnQuotaLeaseRequestValidationError{}
+
+// Validate checks the field values on ReturnQuotaLeaseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnQuotaLeaseReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnQuotaLeaseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnQuotaLeaseReplyMultiError, or nil if none found.
+func (m *ReturnQuotaLeaseReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnQuotaLeaseReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLease()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnQuotaLeaseReplyValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnQuotaLeaseReplyValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLease()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnQuotaLeaseReplyValidationError{
+				field:  "Lease",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReturnQuotaLeaseReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnQuotaLeaseReplyMultiError is an error wrapping multiple validation
+// errors returned by ReturnQuotaLeaseReply.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnQuotaLeaseReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnQuotaLeaseReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnQuotaLeaseReplyMultiError) AllErrors() []error { return m }
+
+// ReturnQuotaLeaseReplyValidationError is the validation error returned by
+// ReturnQuotaLeaseReply.Validate if the designated constraints aren't met.
+type ReturnQuotaLeaseReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnQuotaLeaseReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnQuotaLeaseReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnQuotaLeaseReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnQuotaLeaseReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnQuotaLeaseReplyValidationError) ErrorName() string {
+	return "ReturnQuotaLeaseReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnQuotaLeaseReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnQuotaLeaseReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnQuotaLeaseReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnQuotaLeaseReplyValidationError{}
+
+// Validate checks the field values on ListQuotaLeasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotaLeasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotaLeasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotaLeasesRequestMultiError, or nil if none found.
+func (m *ListQuotaLeasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotaLeasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListQuotaLeasesRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
+		err := ListQuotaLeasesRequestValidationError{
+			field:  "QuotaType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := QuotaLeaseStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListQuotaLeasesRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListQuotaLeasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotaLeasesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQuotaLeasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuotaLeasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotaLeasesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotaLeasesRequestMultiError) AllErrors() []error { return m }
+
+// ListQuotaLeasesRequestValidationError is the validation error returned by
+// ListQuotaLeasesRequest.Validate if the designated constraints aren't met.
+type ListQuotaLeasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotaLeasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotaLeasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotaLeasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotaLeasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotaLeasesRequestValidationError) ErrorName() string {
+	return "ListQuotaLeasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotaLeasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotaLeasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotaLeasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotaLeasesRequestValidationError{}
+
+// Validate checks the field values on ListQuotaLeasesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotaLeasesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotaLeasesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotaLeasesReplyMultiError, or nil if none found.
+func (m *ListQuotaLeasesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotaLeasesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLeases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQuotaLeasesReplyValidationError{
+						field:  fmt.Sprintf("Leases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQuotaLeasesReplyValidationError{
+						field:  fmt.Sprintf("Leases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQuotaLeasesReplyValidationError{
+					field:  fmt.Sprintf("Leases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQuotaLeasesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotaLeasesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListQuotaLeasesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuotaLeasesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotaLeasesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotaLeasesReplyMultiError) AllErrors() []error { return m }
+
+// ListQuotaLeasesReplyValidationError is the validation error returned by
+// ListQuotaLeasesReply.Validate if the designated constraints aren't met.
+type ListQuotaLeasesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotaLeasesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotaLeasesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotaLeasesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotaLeasesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotaLeasesReplyValidationError) ErrorName() string {
+	return "ListQuotaLeasesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotaLeasesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotaLeasesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotaLeasesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotaLeasesReplyValidationError{}
+
+// Validate checks the field values on TenantMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantMemberMultiError, or
+// nil if none found.
+func (m *TenantMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Email
+
+	// no validation rules for Inherit
+
+	// no validation rules for InvitedBy
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return TenantMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantMemberMultiError is an error wrapping multiple validation errors
+// returned by TenantMember.ValidateAll() if the designated constraints aren't met.
+type TenantMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantMemberMultiError) AllErrors() []error { return m }
+
+// TenantMemberValidationError is the validation error returned by
+// TenantMember.Validate if the designated constraints aren't met.
+type TenantMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantMemberValidationError) ErrorName() string { return "TenantMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantMemberValidationError{}
+
+// Validate checks the field values on TenantInvitation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantInvitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantInvitation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantInvitationMultiError, or nil if none found.
+func (m *TenantInvitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantInvitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InvitationId
+
+	// no validation rules for TenantId
+
+	// no validation rules for Email
+
+	// no validation rules for Role
+
+	// no validation rules for Inherit
+
+	// no validation rules for Status
+
+	// no validation rules for InvitedBy
+
+	// no validation rules for ExpireTime
+
+	// no validation rules for AcceptedBy
+
+	// no validation rules for AcceptedAt
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return TenantInvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantInvitationMultiError is an error wrapping multiple validation errors
+// returned by TenantInvitation.ValidateAll() if the designated constraints
+// aren't met.
+type TenantInvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantInvitationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantInvitationMultiError) AllErrors() []error { return m }
+
+// TenantInvitationValidationError is the validation error returned by
+// TenantInvitation.Validate if the designated constraints aren't met.
+type TenantInvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantInvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantInvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantInvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantInvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantInvitationValidationError) ErrorName() string { return "TenantInvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantInvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantInvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantInvitationValidationError{}
+
+// Validate checks the field values on AddTenantMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTenantMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTenantMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTenantMemberRequestMultiError, or nil if none found.
+func (m *AddTenantMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTenantMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := AddTenantMemberRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 64 {
+		err := AddTenantMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AddTenantMemberRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := AddTenantMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [MEMBER_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MemberRole_name[int32(m.GetRole())]; !ok {
+		err := AddTenantMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 64 {
+		err := AddTenantMemberRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmail()) > 128 {
+		err := AddTenantMemberRequestValidationError{
+			field:  "Email",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Inherit
+
+	if utf8.RuneCountInString(m.GetInvitedBy()) > 64 {
+		err := AddTenantMemberRequestValidationError{
+			field:  "InvitedBy",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddTenantMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTenantMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddTenantMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddTenantMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTenantMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTenantMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddTenantMemberRequestValidationError is the validation error returned by
+// AddTenantMemberRequest.Validate if the designated constraints aren't met.
+type AddTenantMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTenantMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTenantMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTenantMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTenantMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTenantMemberRequestValidationError) ErrorName() string {
+	return "AddTenantMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTenantMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTenantMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTenantMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTenantMemberRequestValidationError{}
+
+var _AddTenantMemberRequest_Role_NotInLookup = map[MemberRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AddTenantMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTenantMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTenantMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTenantMemberReplyMultiError, or nil if none found.
+func (m *AddTenantMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTenantMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTenantMemberReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTenantMemberReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTenantMemberReplyValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddTenantMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTenantMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by AddTenantMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type AddTenantMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTenantMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTenantMemberReplyMultiError) AllErrors() []error { return m }
+
+// AddTenantMemberReplyValidationError is the validation error returned by
+// AddTenantMemberReply.Validate if the designated constraints aren't met.
+type AddTenantMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTenantMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTenantMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTenantMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTenantMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTenantMemberReplyValidationError) ErrorName() string {
+	return "AddTenantMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTenantMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTenantMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTenantMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTenantMemberReplyValidationError{}
+
+// Validate checks the field values on UpdateTenantMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTenantMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTenantMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTenantMemberRequestMultiError, or nil if none found.
+func (m *UpdateTenantMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTenantMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := UpdateTenantMemberRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := UpdateTenantMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MemberRole_name[int32(m.GetRole())]; !ok {
+		err := UpdateTenantMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Inherit != nil {
+		// no validation rules for Inherit
+	}
+
+	if len(errors) > 0 {
+		return UpdateTenantMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTenantMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTenantMemberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateTenantMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTenantMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTenantMemberRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTenantMemberRequestValidationError is the validation error returned by
+// UpdateTenantMemberRequest.Validate if the designated constraints aren't met.
+type UpdateTenantMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTenantMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTenantMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTenantMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTenantMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTenantMemberRequestValidationError) ErrorName() string {
+	return "UpdateTenantMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTenantMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTenantMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTenantMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTenantMemberRequestValidationError{}
+
+// Validate checks the field values on UpdateTenantMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTenantMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTenantMemberReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTenantMemberReplyMultiError, or nil if none found.
+func (m *UpdateTenantMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTenantMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTenantMemberReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTenantMemberReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTenantMemberReplyValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTenantMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTenantMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateTenantMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTenantMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTenantMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTenantMemberReplyMultiError) AllErrors() []error { return m }
+
+// UpdateTenantMemberReplyValidationError is the validation error returned by
+// UpdateTenantMemberReply.Validate if the designated constraints aren't met.
+type UpdateTenantMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTenantMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTenantMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTenantMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTenantMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTenantMemberReplyValidationError) ErrorName() string {
+	return "UpdateTenantMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTenantMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTenantMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTenantMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTenantMemberReplyValidationError{}
+
+// Validate checks the field values on RemoveTenantMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveTenantMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTenantMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveTenantMemberRequestMultiError, or nil if none found.
+func (m *RemoveTenantMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTenantMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := RemoveTenantMemberRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := RemoveTenantMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveTenantMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTenantMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveTenantMemberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveTenantMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTenantMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTenantMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveTenantMemberRequestValidationError is the validation error returned by
+// RemoveTenantMemberRequest.Validate if the designated constraints aren't met.
+type RemoveTenantMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTenantMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTenantMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTenantMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTenantMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTenantMemberRequestValidationError) ErrorName() string {
+	return "RemoveTenantMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveTenantMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTenantMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTenantMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTenantMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveTenantMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveTenantMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTenantMemberReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveTenantMemberReplyMultiError, or nil if none found.
+func (m *RemoveTenantMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTenantMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RemoveTenantMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTenantMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by RemoveTenantMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveTenantMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTenantMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTenantMemberReplyMultiError) AllErrors() []error { return m }
+
+// RemoveTenantMemberReplyValidationError is the validation error returned by
+// RemoveTenantMemberReply.Validate if the designated constraints aren't met.
+type RemoveTenantMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTenantMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTenantMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTenantMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTenantMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTenantMemberReplyValidationError) ErrorName() string {
+	return "RemoveTenantMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveTenantMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTenantMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTenantMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTenantMemberReplyValidationError{}
+
+// Validate checks the field values on ListTenantMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantMembersRequestMultiError, or nil if none found.
+func (m *ListTenantMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListTenantMembersRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MemberRole_name[int32(m.GetRole())]; !ok {
+		err := ListTenantMembersRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeInherited
+
+	if len(errors) > 0 {
+		return ListTenantMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTenantMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListTenantMembersRequestValidationError is the validation error returned by
+// ListTenantMembersRequest.Validate if the designated constraints aren't met.
+type ListTenantMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantMembersRequestValidationError) ErrorName() string {
+	return "ListTenantMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantMembersRequestValidationError{}
+
+// Validate checks the field values on ListTenantMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantMembersReplyMultiError, or nil if none found.
+func (m *ListTenantMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantMembersReplyValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantMembersReplyValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantMembersReplyValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTenantMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTenantMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantMembersReplyMultiError) AllErrors() []error { return m }
+
+// ListTenantMembersReplyValidationError is the validation error returned by
+// ListTenantMembersReply.Validate if the designated constraints aren't met.
+type ListTenantMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantMembersReplyValidationError) ErrorName() string {
+	return "ListTenantMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantMembersReplyValidationError{}
+
+// Validate checks the field values on CreateTenantInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTenantInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTenantInvitationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateTenantInvitationRequestMultiError, or nil if none found.
+func (m *CreateTenantInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTenantInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := CreateTenantInvitationRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmail()) > 128 {
+		err := CreateTenantInvitationRequestValidationError{
+			field:  "Email",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = CreateTenantInvitationRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateTenantInvitationRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := CreateTenantInvitationRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [MEMBER_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MemberRole_name[int32(m.GetRole())]; !ok {
+		err := CreateTenantInvitationRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Inherit
+
+	if utf8.RuneCountInString(m.GetInvitedBy()) > 64 {
+		err := CreateTenantInvitationRequestValidationError{
+			field:  "InvitedBy",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTtlSeconds() < 0 {
+		err := CreateTenantInvitationRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTenantInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateTenantInvitationRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateTenantInvitationRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreateTenantInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateTenantInvitationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateTenantInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTenantInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTenantInvitationRequestMultiError) AllErrors() []error { return m }
+
+// CreateTenantInvitationRequestValidationError is the validation error
+// returned by CreateTenantInvitationRequest.Validate if the designated
+// constraints aren't met.
+type CreateTenantInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTenantInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTenantInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTenantInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTenantInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTenantInvitationRequestValidationError) ErrorName() string {
+	return "CreateTenantInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTenantInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTenantInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTenantInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTenantInvitationRequestValidationError{}
+
+var _CreateTenantInvitationRequest_Role_NotInLookup = map[MemberRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateTenantInvitationReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTenantInvitationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTenantInvitationReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTenantInvitationReplyMultiError, or nil if none found.
+func (m *CreateTenantInvitationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTenantInvitationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTenantInvitationReplyValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTenantInvitationReplyValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTenantInvitationReplyValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return CreateTenantInvitationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTenantInvitationReplyMultiError is an error wrapping multiple
+// validation errors returned by CreateTenantInvitationReply.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTenantInvitationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTenantInvitationReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTenantInvitationReplyMultiError) AllErrors() []error { return m }
+
+// CreateTenantInvitationReplyValidationError is the validation error returned
+// by CreateTenantInvitationReply.Validate if the designated constraints
+// aren't met.
+type CreateTenantInvitationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTenantInvitationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTenantInvitationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTenantInvitationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTenantInvitationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTenantInvitationReplyValidationError) ErrorName() string {
+	return "CreateTenantInvitationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTenantInvitationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTenantInvitationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTenantInvitationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTenantInvitationReplyValidationError{}
+
+// Validate checks the field values on AcceptTenantInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptTenantInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptTenantInvitationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AcceptTenantInvitationRequestMultiError, or nil if none found.
+func (m *AcceptTenantInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptTenantInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := AcceptTenantInvitationRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 64 {
+		err := AcceptTenantInvitationRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 64 {
+		err := AcceptTenantInvitationRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AcceptTenantInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptTenantInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by AcceptTenantInvitationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AcceptTenantInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptTenantInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptTenantInvitationRequestMultiError) AllErrors() []error { return m }
+
+// AcceptTenantInvitationRequestValidationError is the validation error
+// returned by AcceptTenantInvitationRequest.Validate if the designated
+// constraints aren't met.
+type AcceptTenantInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptTenantInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptTenantInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptTenantInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptTenantInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptTenantInvitationRequestValidationError) ErrorName() string {
+	return "AcceptTenantInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptTenantInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptTenantInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptTenantInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptTenantInvitationRequestValidationError{}
+
+// Validate checks the field values on AcceptTenantInvitationReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptTenantInvitationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptTenantInvitationReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptTenantInvitationReplyMultiError, or nil if none found.
+func (m *AcceptTenantInvitationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptTenantInvitationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptTenantInvitationReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptTenantInvitationReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptTenantInvitationReplyValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptTenantInvitationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptTenantInvitationReplyMultiError is an error wrapping multiple
+// validation errors returned by AcceptTenantInvitationReply.ValidateAll() if
+// the designated constraints aren't met.
+type AcceptTenantInvitationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptTenantInvitationReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptTenantInvitationReplyMultiError) AllErrors() []error { return m }
+
+// AcceptTenantInvitationReplyValidationError is the validation error returned
+// by AcceptTenantInvitationReply.Validate if the designated constraints
+// aren't met.
+type AcceptTenantInvitationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptTenantInvitationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptTenantInvitationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptTenantInvitationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptTenantInvitationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptTenantInvitationReplyValidationError) ErrorName() string {
+	return "AcceptTenantInvitationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptTenantInvitationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptTenantInvitationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptTenantInvitationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptTenantInvitationReplyValidationError{}
+
+// Validate checks the field values on RevokeTenantInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeTenantInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTenantInvitationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeTenantInvitationRequestMultiError, or nil if none found.
+func (m *RevokeTenantInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTenantInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetInvitationId()) < 1 {
+		err := RevokeTenantInvitationRequestValidationError{
+			field:  "InvitationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeTenantInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTenantInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeTenantInvitationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeTenantInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTenantInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTenantInvitationRequestMultiError) AllErrors() []error { return m }
+
+// RevokeTenantInvitationRequestValidationError is the validation error
+// returned by RevokeTenantInvitationRequest.Validate if the designated
+// constraints aren't met.
+type RevokeTenantInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTenantInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTenantInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTenantInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTenantInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTenantInvitationRequestValidationError) ErrorName() string {
+	return "RevokeTenantInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeTenantInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTenantInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTenantInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTenantInvitationRequestValidationError{}
+
+// Validate checks the field values on RevokeTenantInvitationReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeTenantInvitationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTenantInvitationReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeTenantInvitationReplyMultiError, or nil if none found.
+func (m *RevokeTenantInvitationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTenantInvitationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeTenantInvitationReplyValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeTenantInvitationReplyValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeTenantInvitationReplyValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeTenantInvitationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTenantInvitationReplyMultiError is an error wrapping multiple
+// validation errors returned by RevokeTenantInvitationReply.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeTenantInvitationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTenantInvitationReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTenantInvitationReplyMultiError) AllErrors() []error { return m }
+
+// RevokeTenantInvitationReplyValidationError is the validation error returned
+// by RevokeTenantInvitationReply.Validate if the designated constraints
+// aren't met.
+type RevokeTenantInvitationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTenantInvitationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTenantInvitationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTenantInvitationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTenantInvitationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTenantInvitationReplyValidationError) ErrorName() string {
+	return "RevokeTenantInvitationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeTenantInvitationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTenantInvitationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTenantInvitationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTenantInvitationReplyValidationError{}
+
+// Validate checks the field values on ListTenantInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantInvitationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantInvitationsRequestMultiError, or nil if none found.
+func (m *ListTenantInvitationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantInvitationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListTenantInvitationsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := InvitationStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListTenantInvitationsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTenantInvitationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantInvitationsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTenantInvitationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListTenantInvitationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantInvitationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantInvitationsRequestMultiError) AllErrors() []error { return m }
+
+// ListTenantInvitationsRequestValidationError is the validation error returned
+// by ListTenantInvitationsRequest.Validate if the designated constraints
+// aren't met.
+type ListTenantInvitationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantInvitationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantInvitationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantInvitationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantInvitationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantInvitationsRequestValidationError) ErrorName() string {
+	return "ListTenantInvitationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantInvitationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantInvitationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantInvitationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantInvitationsRequestValidationError{}
+
+// Validate checks the field values on ListTenantInvitationsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantInvitationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantInvitationsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantInvitationsReplyMultiError, or nil if none found.
+func (m *ListTenantInvitationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantInvitationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantInvitationsReplyValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantInvitationsReplyValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantInvitationsReplyValidationError{
+					field:  fmt.Sprintf("Invitations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTenantInvitationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantInvitationsReplyMultiError is an error wrapping multiple
+// validation errors returned by ListTenantInvitationsReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListTenantInvitationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantInvitationsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantInvitationsReplyMultiError) AllErrors() []error { return m }
+
+// ListTenantInvitationsReplyValidationError is the validation error returned
+// by ListTenantInvitationsReply.Validate if the designated constraints aren't met.
+type ListTenantInvitationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantInvitationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantInvitationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantInvitationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantInvitationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantInvitationsReplyValidationError) ErrorName() string {
+	return "ListTenantInvitationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantInvitationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantInvitationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantInvitationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantInvitationsReplyValidationError{}
+
+// Validate checks the field values on CheckPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckPermissionRequestMultiError, or nil if none found.
+func (m *CheckPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := CheckPermissionRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := CheckPermissionRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPermission()) < 1 {
+		err := CheckPermissionRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckPermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckPermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckPermissionRequestMultiError) AllErrors() []error { return m }
+
+// CheckPermissionRequestValidationError is the validation error returned by
+// CheckPermissionRequest.Validate if the designated constraints aren't met.
+type CheckPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckPermissionRequestValidationError) ErrorName() string {
+	return "CheckPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckPermissionRequestValidationError{}
+
+// Validate checks the field values on CheckPermissionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckPermissionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckPermissionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckPermissionReplyMultiError, or nil if none found.
+func (m *CheckPermissionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckPermissionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for Role
+
+	// no validation rules for SourceTenantId
+
+	// no validation rules for Inherited
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return CheckPermissionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckPermissionReplyMultiError is an error wrapping multiple validation
+// errors returned by CheckPermissionReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckPermissionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckPermissionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckPermissionReplyMultiError) AllErrors() []error { return m }
+
+// CheckPermissionReplyValidationError is the validation error returned by
+// CheckPermissionReply.Validate if the designated constraints aren't met.
+type CheckPermissionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckPermissionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckPermissionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckPermissionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckPermissionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckPermissionReplyValidationError) ErrorName() string {
+	return "CheckPermissionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckPermissionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckPermissionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckPermissionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckPermissionReplyValidationError{}
+
+// Validate checks the field values on PlanEntitlement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlanEntitlement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanEntitlement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanEntitlementMultiError, or nil if none found.
+func (m *PlanEntitlement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanEntitlement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 63 {
+		err := PlanEntitlementValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 63 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductCode()) > 32 {
+		err := PlanEntitlementValidationError{
+			field:  "ProductCode",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetValue()) < 1 {
+		err := PlanEntitlementValidationError{
+			field:  "Value",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlanEntitlementMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanEntitlementMultiError is an error wrapping multiple validation errors
+// returned by PlanEntitlement.ValidateAll() if the designated constraints
+// aren't met.
+type PlanEntitlementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanEntitlementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanEntitlementMultiError) AllErrors() []error { return m }
+
+// PlanEntitlementValidationError is the validation error returned by
+// PlanEntitlement.Validate if the designated constraints aren't met.
+type PlanEntitlementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanEntitlementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanEntitlementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanEntitlementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanEntitlementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanEntitlementValidationError) ErrorName() string { return "PlanEntitlementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanEntitlementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanEntitlement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanEntitlementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanEntitlementValidationError{}
+
+// Validate checks the field values on Entitlement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Entitlement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Entitlement with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EntitlementMultiError, or
+// nil if none found.
+func (m *Entitlement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Entitlement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Source
+
+	// no validation rules for SourceTenantId
+
+	// no validation rules for Inherited
+
+	// no validation rules for PlanCode
+
+	// no validation rules for UpdatedBy
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return EntitlementMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntitlementMultiError is an error wrapping multiple validation errors
+// returned by Entitlement.ValidateAll() if the designated constraints aren't met.
+type EntitlementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntitlementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntitlementMultiError) AllErrors() []error { return m }
+
+// EntitlementValidationError is the validation error returned by
+// Entitlement.Validate if the designated constraints aren't met.
+type EntitlementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntitlementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntitlementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntitlementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntitlementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntitlementValidationError) ErrorName() string { return "EntitlementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EntitlementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntitlement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntitlementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntitlementValidationError{}
+
+// Validate checks the field values on GetEntitlementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEntitlementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEntitlementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEntitlementsRequestMultiError, or nil if none found.
+func (m *GetEntitlementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEntitlementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := GetEntitlementsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductCode()) > 32 {
+		err := GetEntitlementsRequestValidationError{
+			field:  "ProductCode",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetEntitlementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEntitlementsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetEntitlementsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetEntitlementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEntitlementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEntitlementsRequestMultiError) AllErrors() []error { return m }
+
+// GetEntitlementsRequestValidationError is the validation error returned by
+// GetEntitlementsRequest.Validate if the designated constraints aren't met.
+type GetEntitlementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEntitlementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEntitlementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEntitlementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEntitlementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEntitlementsRequestValidationError) ErrorName() string {
+	return "GetEntitlementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEntitlementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEntitlementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEntitlementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEntitlementsRequestValidationError{}
+
+// Validate checks the field values on GetEntitlementsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEntitlementsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEntitlementsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEntitlementsReplyMultiError, or nil if none found.
+func (m *GetEntitlementsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEntitlementsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetEntitlementsReplyValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetEntitlementsReplyValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetEntitlementsReplyValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetEntitlementsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEntitlementsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetEntitlementsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetEntitlementsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEntitlementsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEntitlementsReplyMultiError) AllErrors() []error { return m }
+
+// GetEntitlementsReplyValidationError is the validation error returned by
+// GetEntitlementsReply.Validate if the designated constraints aren't met.
+type GetEntitlementsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEntitlementsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEntitlementsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEntitlementsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEntitlementsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEntitlementsReplyValidationError) ErrorName() string {
+	return "GetEntitlementsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEntitlementsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEntitlementsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEntitlementsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEntitlementsReplyValidationError{}
+
+// Validate checks the field values on SetEntitlementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEntitlementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEntitlementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEntitlementRequestMultiError, or nil if none found.
+func (m *SetEntitlementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEntitlementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := SetEntitlementRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 63 {
+		err := SetEntitlementRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 63 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductCode()) > 32 {
+		err := SetEntitlementRequestValidationError{
+			field:  "ProductCode",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Value
+
+	// no validation rules for Unset
+
+	if utf8.RuneCountInString(m.GetOperator()) > 64 {
+		err := SetEntitlementRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetEntitlementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEntitlementRequestMultiError is an error wrapping multiple validation
+// errors returned by SetEntitlementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetEntitlementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEntitlementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEntitlementRequestMultiError) AllErrors() []error { return m }
+
+// SetEntitlementRequestValidationError is the validation error returned by
+// SetEntitlementRequest.Validate if the designated constraints aren't met.
+type SetEntitlementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEntitlementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEntitlementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEntitlementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEntitlementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEntitlementRequestValidationError) ErrorName() string {
+	return "SetEntitlementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEntitlementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEntitlementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEntitlementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEntitlementRequestValidationError{}
+
+// Validate checks the field values on SetEntitlementReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEntitlementReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEntitlementReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEntitlementReplyMultiError, or nil if none found.
+func (m *SetEntitlementReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEntitlementReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetEntitlementReplyValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetEntitlementReplyValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetEntitlementReplyValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetEntitlementReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEntitlementReplyMultiError is an error wrapping multiple validation
+// errors returned by SetEntitlementReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetEntitlementReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEntitlementReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEntitlementReplyMultiError) AllErrors() []error { return m }
+
+// SetEntitlementReplyValidationError is the validation error returned by
+// SetEntitlementReply.Validate if the designated constraints aren't met.
+type SetEntitlementReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEntitlementReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEntitlementReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEntitlementReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEntitlementReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEntitlementReplyValidationError) ErrorName() string {
+	return "SetEntitlementReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEntitlementReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEntitlementReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEntitlementReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEntitlementReplyValidationError{}
+
+// Validate checks the field values on AuditChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditChangeMultiError, or
+// nil if none found.
+func (m *AuditChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	if len(errors) > 0 {
+		return AuditChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditChangeMultiError is an error wrapping multiple validation errors
+// returned by AuditChange.ValidateAll() if the designated constraints aren't met.
+type AuditChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditChangeMultiError) AllErrors() []error { return m }
+
+// AuditChangeValidationError is the validation error returned by
+// AuditChange.Validate if the designated constraints aren't met.
+type AuditChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditChangeValidationError) ErrorName() string { return "AuditChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditChangeValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for Actor
+
+	// no validation rules for Action
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for TenantId
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditEventValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditEventValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditEventValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RequestId
+
+	// no validation rules for SourceIp
+
+	// no validation rules for Remark
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Actor
+
+	// no validation rules for Action
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for AfterEventId
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsReplyMultiError, or nil if none found.
+func (m *ListAuditEventsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsReplyMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsReplyValidationError is the validation error returned by
+// ListAuditEventsReply.Validate if the designated constraints aren't met.
+type ListAuditEventsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsReplyValidationError) ErrorName() string {
+	return "ListAuditEventsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsReplyValidationError{}
+
+// Validate checks the field values on ExportAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportAuditEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportAuditEventsRequestMultiError, or nil if none found.
+func (m *ExportAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportAuditEventsRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ExportAuditEventsRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [DATA_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DataFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportAuditEventsRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TenantId
+
+	// no validation rules for Actor
+
+	// no validation rules for Action
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if len(errors) > 0 {
+		return ExportAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportAuditEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ExportAuditEventsRequestValidationError is the validation error returned by
+// ExportAuditEventsRequest.Validate if the designated constraints aren't met.
+type ExportAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportAuditEventsRequestValidationError) ErrorName() string {
+	return "ExportAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportAuditEventsRequestValidationError{}
+
+var _ExportAuditEventsRequest_Format_NotInLookup = map[DataFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExportAuditEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportAuditEventsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportAuditEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportAuditEventsReplyMultiError, or nil if none found.
+func (m *ExportAuditEventsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportAuditEventsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return ExportAuditEventsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportAuditEventsReplyMultiError is an error wrapping multiple validation
+// errors returned by ExportAuditEventsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ExportAuditEventsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportAuditEventsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportAuditEventsReplyMultiError) AllErrors() []error { return m }
+
+// ExportAuditEventsReplyValidationError is the validation error returned by
+// ExportAuditEventsReply.Validate if the designated constraints aren't met.
+type ExportAuditEventsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportAuditEventsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportAuditEventsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportAuditEventsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportAuditEventsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportAuditEventsReplyValidationError) ErrorName() string {
+	return "ExportAuditEventsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportAuditEventsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportAuditEventsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportAuditEventsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportAuditEventsReplyValidationError{}
+
+// Validate checks the field values on LedgerIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LedgerIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LedgerIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LedgerIssueMultiError, or
+// nil if none found.
+func (m *LedgerIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LedgerIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for ChainSeq
+
+	// no validation rules for RecordId
+
+	// no validation rules for Detail
+
+	if len(errors) > 0 {
+		return LedgerIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// LedgerIssueMultiError is an error wrapping multiple validation errors
+// returned by LedgerIssue.ValidateAll() if the designated constraints aren't met.
+type LedgerIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LedgerIssueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LedgerIssueMultiError) AllErrors() []error { return m }
+
+// LedgerIssueValidationError is the validation error returned by
+// LedgerIssue.Validate if the designated constraints aren't met.
+type LedgerIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LedgerIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LedgerIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LedgerIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LedgerIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LedgerIssueValidationError) ErrorName() string { return "LedgerIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LedgerIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLedgerIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LedgerIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LedgerIssueValidationError{}
+
+// Validate checks the field values on LedgerVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LedgerVerification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LedgerVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LedgerVerificationMultiError, or nil if none found.
+func (m *LedgerVerification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LedgerVerification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuotaId
+
+	// no validation rules for Records
+
+	// no validation rules for HeadSeq
+
+	// no validation rules for HeadHash
+
+	// no validation rules for Checkpoints
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LedgerVerificationValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LedgerVerificationValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LedgerVerificationValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LedgerVerificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// LedgerVerificationMultiError is an error wrapping multiple validation errors
+// returned by LedgerVerification.ValidateAll() if the designated constraints
+// aren't met.
+type LedgerVerificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LedgerVerificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LedgerVerificationMultiError) AllErrors() []error { return m }
+
+// LedgerVerificationValidationError is the validation error returned by
+// LedgerVerification.Validate if the designated constraints aren't met.
+type LedgerVerificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LedgerVerificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LedgerVerificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LedgerVerificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LedgerVerificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LedgerVerificationValidationError) ErrorName() string {
+	return "LedgerVerificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LedgerVerificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLedgerVerification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LedgerVerificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LedgerVerificationValidationError{}
+
+// Validate checks the field values on VerifyLedgerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyLedgerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyLedgerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyLedgerRequestMultiError, or nil if none found.
+func (m *VerifyLedgerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyLedgerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := VerifyLedgerRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuotaId() < 0 {
+		err := VerifyLedgerRequestValidationError{
+			field:  "QuotaId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ConsumeQuotaReplyMultiError(errors)
+		return VerifyLedgerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConsumeQuotaReplyMultiError is an error wrapping multiple validation errors
-// returned by ConsumeQuotaReply.ValidateAll() if the designated constraints
-// aren't met.
-type ConsumeQuotaReplyMultiError []error
+// VerifyLedgerRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyLedgerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyLedgerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConsumeQuotaReplyMultiError) Error() string {
+func (m VerifyLedgerRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2072,11 +18098,11 @@ func (m ConsumeQuotaReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConsumeQuotaReplyMultiError) AllErrors() []error { return m }
+func (m VerifyLedgerRequestMultiError) AllErrors() []error { return m }
 
-// ConsumeQuotaReplyValidationError is the validation error returned by
-// ConsumeQuotaReply.Validate if the designated constraints aren't met.
-type ConsumeQuotaReplyValidationError struct {
+// VerifyLedgerRequestValidationError is the validation error returned by
+// VerifyLedgerRequest.Validate if the designated constraints aren't met.
+type VerifyLedgerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2084,24 +18110,24 @@ type ConsumeQuotaReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConsumeQuotaReplyValidationError) Field() string { return e.field }
+func (e VerifyLedgerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConsumeQuotaReplyValidationError) Reason() string { return e.reason }
+func (e VerifyLedgerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConsumeQuotaReplyValidationError) Cause() error { return e.cause }
+func (e VerifyLedgerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConsumeQuotaReplyValidationError) Key() bool { return e.key }
+func (e VerifyLedgerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConsumeQuotaReplyValidationError) ErrorName() string {
-	return "ConsumeQuotaReplyValidationError"
+func (e VerifyLedgerRequestValidationError) ErrorName() string {
+	return "VerifyLedgerRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConsumeQuotaReplyValidationError) Error() string {
+func (e VerifyLedgerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2113,14 +18139,14 @@ func (e ConsumeQuotaReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConsumeQuotaReply.%s: %s%s",
+		"invalid %sVerifyLedgerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConsumeQuotaReplyValidationError{}
+var _ error = VerifyLedgerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2128,92 +18154,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConsumeQuotaReplyValidationError{}
+} = VerifyLedgerRequestValidationError{}
 
-// Validate checks the field values on ReleaseQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReleaseQuotaRequest) Validate() error {
+// Validate checks the field values on VerifyLedgerReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyLedgerReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReleaseQuotaRequest with the rules
+// ValidateAll checks the field values on VerifyLedgerReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReleaseQuotaRequestMultiError, or nil if none found.
-func (m *ReleaseQuotaRequest) ValidateAll() error {
+// VerifyLedgerReplyMultiError, or nil if none found.
+func (m *VerifyLedgerReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReleaseQuotaRequest) validate(all bool) error {
+func (m *VerifyLedgerReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
-		err := ReleaseQuotaRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Ok
 
-	if _, ok := QuotaType_name[int32(m.GetQuotaType())]; !ok {
-		err := ReleaseQuotaRequestValidationError{
-			field:  "QuotaType",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-	if _, ok := LimitType_name[int32(m.GetLimitType())]; !ok {
-		err := ReleaseQuotaRequestValidationError{
-			field:  "LimitType",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyLedgerReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyLedgerReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyLedgerReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if m.GetAmount() <= 0 {
-		err := ReleaseQuotaRequestValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for ProductCode
-
-	// no validation rules for BizId
-
 	if len(errors) > 0 {
-		return ReleaseQuotaRequestMultiError(errors)
+		return VerifyLedgerReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReleaseQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by ReleaseQuotaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ReleaseQuotaRequestMultiError []error
+// VerifyLedgerReplyMultiError is an error wrapping multiple validation errors
+// returned by VerifyLedgerReply.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyLedgerReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReleaseQuotaRequestMultiError) Error() string {
+func (m VerifyLedgerReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2222,11 +18236,11 @@ func (m ReleaseQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReleaseQuotaRequestMultiError) AllErrors() []error { return m }
+func (m VerifyLedgerReplyMultiError) AllErrors() []error { return m }
 
-// ReleaseQuotaRequestValidationError is the validation error returned by
-// ReleaseQuotaRequest.Validate if the designated constraints aren't met.
-type ReleaseQuotaRequestValidationError struct {
+// VerifyLedgerReplyValidationError is the validation error returned by
+// VerifyLedgerReply.Validate if the designated constraints aren't met.
+type VerifyLedgerReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2234,24 +18248,24 @@ type ReleaseQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReleaseQuotaRequestValidationError) Field() string { return e.field }
+func (e VerifyLedgerReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReleaseQuotaRequestValidationError) Reason() string { return e.reason }
+func (e VerifyLedgerReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReleaseQuotaRequestValidationError) Cause() error { return e.cause }
+func (e VerifyLedgerReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReleaseQuotaRequestValidationError) Key() bool { return e.key }
+func (e VerifyLedgerReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReleaseQuotaRequestValidationError) ErrorName() string {
-	return "ReleaseQuotaRequestValidationError"
+func (e VerifyLedgerReplyValidationError) ErrorName() string {
+	return "VerifyLedgerReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReleaseQuotaRequestValidationError) Error() string {
+func (e VerifyLedgerReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2263,14 +18277,14 @@ func (e ReleaseQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReleaseQuotaRequest.%s: %s%s",
+		"invalid %sVerifyLedgerReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReleaseQuotaRequestValidationError{}
+var _ error = VerifyLedgerReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2278,50 +18292,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReleaseQuotaRequestValidationError{}
+} = VerifyLedgerReplyValidationError{}
 
-// Validate checks the field values on ReleaseQuotaReply with the rules defined
+// Validate checks the field values on LedgerCheckpoint with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ReleaseQuotaReply) Validate() error {
+func (m *LedgerCheckpoint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReleaseQuotaReply with the rules
+// ValidateAll checks the field values on LedgerCheckpoint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReleaseQuotaReplyMultiError, or nil if none found.
-func (m *ReleaseQuotaReply) ValidateAll() error {
+// LedgerCheckpointMultiError, or nil if none found.
+func (m *LedgerCheckpoint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReleaseQuotaReply) validate(all bool) error {
+func (m *LedgerCheckpoint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for CheckpointId
 
-	// no validation rules for RemainingQuota
+	// no validation rules for QuotaId
 
-	// no validation rules for Message
+	// no validation rules for TenantId
+
+	// no validation rules for ChainSeq
+
+	// no validation rules for RecordHash
+
+	// no validation rules for KeyId
+
+	// no validation rules for Signature
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return ReleaseQuotaReplyMultiError(errors)
+		return LedgerCheckpointMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReleaseQuotaReplyMultiError is an error wrapping multiple validation errors
-// returned by ReleaseQuotaReply.ValidateAll() if the designated constraints
+// LedgerCheckpointMultiError is an error wrapping multiple validation errors
+// returned by LedgerCheckpoint.ValidateAll() if the designated constraints
 // aren't met.
-type ReleaseQuotaReplyMultiError []error
+type LedgerCheckpointMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReleaseQuotaReplyMultiError) Error() string {
+func (m LedgerCheckpointMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2330,11 +18354,11 @@ func (m ReleaseQuotaReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReleaseQuotaReplyMultiError) AllErrors() []error { return m }
+func (m LedgerCheckpointMultiError) AllErrors() []error { return m }
 
-// ReleaseQuotaReplyValidationError is the validation error returned by
-// ReleaseQuotaReply.Validate if the designated constraints aren't met.
-type ReleaseQuotaReplyValidationError struct {
+// LedgerCheckpointValidationError is the validation error returned by
+// LedgerCheckpoint.Validate if the designated constraints aren't met.
+type LedgerCheckpointValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2342,24 +18366,22 @@ type ReleaseQuotaReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReleaseQuotaReplyValidationError) Field() string { return e.field }
+func (e LedgerCheckpointValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReleaseQuotaReplyValidationError) Reason() string { return e.reason }
+func (e LedgerCheckpointValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReleaseQuotaReplyValidationError) Cause() error { return e.cause }
+func (e LedgerCheckpointValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReleaseQuotaReplyValidationError) Key() bool { return e.key }
+func (e LedgerCheckpointValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReleaseQuotaReplyValidationError) ErrorName() string {
-	return "ReleaseQuotaReplyValidationError"
-}
+func (e LedgerCheckpointValidationError) ErrorName() string { return "LedgerCheckpointValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReleaseQuotaReplyValidationError) Error() string {
+func (e LedgerCheckpointValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2371,14 +18393,14 @@ func (e ReleaseQuotaReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReleaseQuotaReply.%s: %s%s",
+		"invalid %sLedgerCheckpoint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReleaseQuotaReplyValidationError{}
+var _ error = LedgerCheckpointValidationError{}
 
 var _ interface {
 	Field() string
@@ -2386,46 +18408,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReleaseQuotaReplyValidationError{}
+} = LedgerCheckpointValidationError{}
 
-// Validate checks the field values on ListProductsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListLedgerCheckpointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProductsRequest) Validate() error {
+func (m *ListLedgerCheckpointsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProductsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListLedgerCheckpointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProductsRequestMultiError, or nil if none found.
-func (m *ListProductsRequest) ValidateAll() error {
+// ListLedgerCheckpointsRequestMultiError, or nil if none found.
+func (m *ListLedgerCheckpointsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProductsRequest) validate(all bool) error {
+func (m *ListLedgerCheckpointsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantId
+	if utf8.RuneCountInString(m.GetTenantId()) < 1 {
+		err := ListLedgerCheckpointsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for QuotaId
+
+	// no validation rules for AfterCheckpointId
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ListLedgerCheckpointsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListProductsRequestMultiError(errors)
+		return ListLedgerCheckpointsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProductsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProductsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListProductsRequestMultiError []error
+// ListLedgerCheckpointsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListLedgerCheckpointsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListLedgerCheckpointsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProductsRequestMultiError) Error() string {
+func (m ListLedgerCheckpointsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2434,11 +18480,12 @@ func (m ListProductsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProductsRequestMultiError) AllErrors() []error { return m }
+func (m ListLedgerCheckpointsRequestMultiError) AllErrors() []error { return m }
 
-// ListProductsRequestValidationError is the validation error returned by
-// ListProductsRequest.Validate if the designated constraints aren't met.
-type ListProductsRequestValidationError struct {
+// ListLedgerCheckpointsRequestValidationError is the validation error returned
+// by ListLedgerCheckpointsRequest.Validate if the designated constraints
+// aren't met.
+type ListLedgerCheckpointsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2446,24 +18493,24 @@ type ListProductsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProductsRequestValidationError) Field() string { return e.field }
+func (e ListLedgerCheckpointsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProductsRequestValidationError) Reason() string { return e.reason }
+func (e ListLedgerCheckpointsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProductsRequestValidationError) Cause() error { return e.cause }
+func (e ListLedgerCheckpointsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProductsRequestValidationError) Key() bool { return e.key }
+func (e ListLedgerCheckpointsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProductsRequestValidationError) ErrorName() string {
-	return "ListProductsRequestValidationError"
+func (e ListLedgerCheckpointsRequestValidationError) ErrorName() string {
+	return "ListLedgerCheckpointsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProductsRequestValidationError) Error() string {
+func (e ListLedgerCheckpointsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2475,14 +18522,14 @@ func (e ListProductsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProductsRequest.%s: %s%s",
+		"invalid %sListLedgerCheckpointsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProductsRequestValidationError{}
+var _ error = ListLedgerCheckpointsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2490,47 +18537,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProductsRequestValidationError{}
+} = ListLedgerCheckpointsRequestValidationError{}
 
-// Validate checks the field values on ListProductsReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListProductsReply) Validate() error {
+// Validate checks the field values on ListLedgerCheckpointsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLedgerCheckpointsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProductsReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListLedgerCheckpointsReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProductsReplyMultiError, or nil if none found.
-func (m *ListProductsReply) ValidateAll() error {
+// ListLedgerCheckpointsReplyMultiError, or nil if none found.
+func (m *ListLedgerCheckpointsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProductsReply) validate(all bool) error {
+func (m *ListLedgerCheckpointsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProducts() {
+	for idx, item := range m.GetCheckpoints() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProductsReplyValidationError{
-						field:  fmt.Sprintf("Products[%v]", idx),
+					errors = append(errors, ListLedgerCheckpointsReplyValidationError{
+						field:  fmt.Sprintf("Checkpoints[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProductsReplyValidationError{
-						field:  fmt.Sprintf("Products[%v]", idx),
+					errors = append(errors, ListLedgerCheckpointsReplyValidationError{
+						field:  fmt.Sprintf("Checkpoints[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2538,8 +18585,8 @@ func (m *ListProductsReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListProductsReplyValidationError{
-					field:  fmt.Sprintf("Products[%v]", idx),
+				return ListLedgerCheckpointsReplyValidationError{
+					field:  fmt.Sprintf("Checkpoints[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2548,20 +18595,24 @@ func (m *ListProductsReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for PublicKey
+
+	// no validation rules for KeyId
+
 	if len(errors) > 0 {
-		return ListProductsReplyMultiError(errors)
+		return ListLedgerCheckpointsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProductsReplyMultiError is an error wrapping multiple validation errors
-// returned by ListProductsReply.ValidateAll() if the designated constraints
-// aren't met.
-type ListProductsReplyMultiError []error
+// ListLedgerCheckpointsReplyMultiError is an error wrapping multiple
+// validation errors returned by ListLedgerCheckpointsReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListLedgerCheckpointsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProductsReplyMultiError) Error() string {
+func (m ListLedgerCheckpointsReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2570,11 +18621,11 @@ func (m ListProductsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProductsReplyMultiError) AllErrors() []error { return m }
+func (m ListLedgerCheckpointsReplyMultiError) AllErrors() []error { return m }
 
-// ListProductsReplyValidationError is the validation error returned by
-// ListProductsReply.Validate if the designated constraints aren't met.
-type ListProductsReplyValidationError struct {
+// ListLedgerCheckpointsReplyValidationError is the validation error returned
+// by ListLedgerCheckpointsReply.Validate if the designated constraints aren't met.
+type ListLedgerCheckpointsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2582,24 +18633,24 @@ type ListProductsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProductsReplyValidationError) Field() string { return e.field }
+func (e ListLedgerCheckpointsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProductsReplyValidationError) Reason() string { return e.reason }
+func (e ListLedgerCheckpointsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProductsReplyValidationError) Cause() error { return e.cause }
+func (e ListLedgerCheckpointsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProductsReplyValidationError) Key() bool { return e.key }
+func (e ListLedgerCheckpointsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProductsReplyValidationError) ErrorName() string {
-	return "ListProductsReplyValidationError"
+func (e ListLedgerCheckpointsReplyValidationError) ErrorName() string {
+	return "ListLedgerCheckpointsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProductsReplyValidationError) Error() string {
+func (e ListLedgerCheckpointsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2611,14 +18662,14 @@ func (e ListProductsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProductsReply.%s: %s%s",
+		"invalid %sListLedgerCheckpointsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProductsReplyValidationError{}
+var _ error = ListLedgerCheckpointsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2626,4 +18677,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProductsReplyValidationError{}
+} = ListLedgerCheckpointsReplyValidationError{}
@@ -19,15 +19,58 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Tenant_CreateTenant_FullMethodName = "/platform.tenant_service.v1.Tenant/CreateTenant"
-	Tenant_GetTenant_FullMethodName    = "/platform.tenant_service.v1.Tenant/GetTenant"
-	Tenant_ListTenants_FullMethodName  = "/platform.tenant_service.v1.Tenant/ListTenants"
-	Tenant_UpdateTenant_FullMethodName = "/platform.tenant_service.v1.Tenant/UpdateTenant"
-	Tenant_DeleteTenant_FullMethodName = "/platform.tenant_service.v1.Tenant/DeleteTenant"
-	Tenant_CheckQuota_FullMethodName   = "/platform.tenant_service.v1.Tenant/CheckQuota"
-	Tenant_ConsumeQuota_FullMethodName = "/platform.tenant_service.v1.Tenant/ConsumeQuota"
-	Tenant_ReleaseQuota_FullMethodName = "/platform.tenant_service.v1.Tenant/ReleaseQuota"
-	Tenant_ListProducts_FullMethodName = "/platform.tenant_service.v1.Tenant/ListProducts"
+	Tenant_CreateTenant_FullMethodName           = "/platform.tenant_service.v1.Tenant/CreateTenant"
+	Tenant_GetTenant_FullMethodName              = "/platform.tenant_service.v1.Tenant/GetTenant"
+	Tenant_ListTenants_FullMethodName            = "/platform.tenant_service.v1.Tenant/ListTenants"
+	Tenant_UpdateTenant_FullMethodName           = "/platform.tenant_service.v1.Tenant/UpdateTenant"
+	Tenant_DeleteTenant_FullMethodName           = "/platform.tenant_service.v1.Tenant/DeleteTenant"
+	Tenant_CheckQuota_FullMethodName             = "/platform.tenant_service.v1.Tenant/CheckQuota"
+	Tenant_ExplainQuota_FullMethodName           = "/platform.tenant_service.v1.Tenant/ExplainQuota"
+	Tenant_ConsumeQuota_FullMethodName           = "/platform.tenant_service.v1.Tenant/ConsumeQuota"
+	Tenant_ReleaseQuota_FullMethodName           = "/platform.tenant_service.v1.Tenant/ReleaseQuota"
+	Tenant_ListQuotas_FullMethodName             = "/platform.tenant_service.v1.Tenant/ListQuotas"
+	Tenant_AdjustQuota_FullMethodName            = "/platform.tenant_service.v1.Tenant/AdjustQuota"
+	Tenant_ResetQuota_FullMethodName             = "/platform.tenant_service.v1.Tenant/ResetQuota"
+	Tenant_ListUsageRecords_FullMethodName       = "/platform.tenant_service.v1.Tenant/ListUsageRecords"
+	Tenant_ScheduleQuotaChange_FullMethodName    = "/platform.tenant_service.v1.Tenant/ScheduleQuotaChange"
+	Tenant_ListQuotaChanges_FullMethodName       = "/platform.tenant_service.v1.Tenant/ListQuotaChanges"
+	Tenant_CancelQuotaChange_FullMethodName      = "/platform.tenant_service.v1.Tenant/CancelQuotaChange"
+	Tenant_ListOverages_FullMethodName           = "/platform.tenant_service.v1.Tenant/ListOverages"
+	Tenant_GetUsageReport_FullMethodName         = "/platform.tenant_service.v1.Tenant/GetUsageReport"
+	Tenant_GetUsageTimeSeries_FullMethodName     = "/platform.tenant_service.v1.Tenant/GetUsageTimeSeries"
+	Tenant_SavePlan_FullMethodName               = "/platform.tenant_service.v1.Tenant/SavePlan"
+	Tenant_GetPlan_FullMethodName                = "/platform.tenant_service.v1.Tenant/GetPlan"
+	Tenant_ListPlans_FullMethodName              = "/platform.tenant_service.v1.Tenant/ListPlans"
+	Tenant_AssignPlan_FullMethodName             = "/platform.tenant_service.v1.Tenant/AssignPlan"
+	Tenant_ListProducts_FullMethodName           = "/platform.tenant_service.v1.Tenant/ListProducts"
+	Tenant_BindProduct_FullMethodName            = "/platform.tenant_service.v1.Tenant/BindProduct"
+	Tenant_GetWallet_FullMethodName              = "/platform.tenant_service.v1.Tenant/GetWallet"
+	Tenant_SetWalletThreshold_FullMethodName     = "/platform.tenant_service.v1.Tenant/SetWalletThreshold"
+	Tenant_TopUpWallet_FullMethodName            = "/platform.tenant_service.v1.Tenant/TopUpWallet"
+	Tenant_DebitWallet_FullMethodName            = "/platform.tenant_service.v1.Tenant/DebitWallet"
+	Tenant_RefundWallet_FullMethodName           = "/platform.tenant_service.v1.Tenant/RefundWallet"
+	Tenant_ListWalletTransactions_FullMethodName = "/platform.tenant_service.v1.Tenant/ListWalletTransactions"
+	Tenant_LeaseQuotaBlock_FullMethodName        = "/platform.tenant_service.v1.Tenant/LeaseQuotaBlock"
+	Tenant_RenewQuotaLease_FullMethodName        = "/platform.tenant_service.v1.Tenant/RenewQuotaLease"
+	Tenant_ReturnQuotaLease_FullMethodName       = "/platform.tenant_service.v1.Tenant/ReturnQuotaLease"
+	Tenant_ListQuotaLeases_FullMethodName        = "/platform.tenant_service.v1.Tenant/ListQuotaLeases"
+	Tenant_AddTenantMember_FullMethodName        = "/platform.tenant_service.v1.Tenant/AddTenantMember"
+	Tenant_UpdateTenantMember_FullMethodName     = "/platform.tenant_service.v1.Tenant/UpdateTenantMember"
+	Tenant_RemoveTenantMember_FullMethodName     = "/platform.tenant_service.v1.Tenant/RemoveTenantMember"
+	Tenant_ListTenantMembers_FullMethodName      = "/platform.tenant_service.v1.Tenant/ListTenantMembers"
+	Tenant_CreateTenantInvitation_FullMethodName = "/platform.tenant_service.v1.Tenant/CreateTenantInvitation"
+	Tenant_AcceptTenantInvitation_FullMethodName = "/platform.tenant_service.v1.Tenant/AcceptTenantInvitation"
+	Tenant_RevokeTenantInvitation_FullMethodName = "/platform.tenant_service.v1.Tenant/RevokeTenantInvitation"
+	Tenant_ListTenantInvitations_FullMethodName  = "/platform.tenant_service.v1.Tenant/ListTenantInvitations"
+	Tenant_CheckPermission_FullMethodName        = "/platform.tenant_service.v1.Tenant/CheckPermission"
+	Tenant_GetEntitlements_FullMethodName        = "/platform.tenant_service.v1.Tenant/GetEntitlements"
+	Tenant_SetEntitlement_FullMethodName         = "/platform.tenant_service.v1.Tenant/SetEntitlement"
+	Tenant_ListAuditEvents_FullMethodName        = "/platform.tenant_service.v1.Tenant/ListAuditEvents"
+	Tenant_ExportAuditEvents_FullMethodName      = "/platform.tenant_service.v1.Tenant/ExportAuditEvents"
+	Tenant_VerifyLedger_FullMethodName           = "/platform.tenant_service.v1.Tenant/VerifyLedger"
+	Tenant_ListLedgerCheckpoints_FullMethodName  = "/platform.tenant_service.v1.Tenant/ListLedgerCheckpoints"
+	Tenant_ImportTenants_FullMethodName          = "/platform.tenant_service.v1.Tenant/ImportTenants"
+	Tenant_ExportTenants_FullMethodName          = "/platform.tenant_service.v1.Tenant/ExportTenants"
 )
 
 // TenantClient is the client API for Tenant service.
@@ -48,12 +91,98 @@ type TenantClient interface {
 	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantReply, error)
 	// CheckQuota 检查配额
 	CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error)
+	// ExplainQuota 解释租户在产品线下使用哪条配额，列出所有候选配额及选中/未选中原因
+	ExplainQuota(ctx context.Context, in *ExplainQuotaRequest, opts ...grpc.CallOption) (*ExplainQuotaReply, error)
 	// ConsumeQuota 消费配额
 	ConsumeQuota(ctx context.Context, in *ConsumeQuotaRequest, opts ...grpc.CallOption) (*ConsumeQuotaReply, error)
 	// ReleaseQuota 释放配额
 	ReleaseQuota(ctx context.Context, in *ReleaseQuotaRequest, opts ...grpc.CallOption) (*ReleaseQuotaReply, error)
+	// ListQuotas 列出租户配额
+	ListQuotas(ctx context.Context, in *ListQuotasRequest, opts ...grpc.CallOption) (*ListQuotasReply, error)
+	// AdjustQuota 调整配额
+	AdjustQuota(ctx context.Context, in *AdjustQuotaRequest, opts ...grpc.CallOption) (*AdjustQuotaReply, error)
+	// ResetQuota 重置配额已用量
+	ResetQuota(ctx context.Context, in *ResetQuotaRequest, opts ...grpc.CallOption) (*ResetQuotaReply, error)
+	// ListUsageRecords 列出配额使用记录
+	ListUsageRecords(ctx context.Context, in *ListUsageRecordsRequest, opts ...grpc.CallOption) (*ListUsageRecordsReply, error)
+	// ScheduleQuotaChange 计划配额变更，立即或在指定时间/下次重置时生效
+	ScheduleQuotaChange(ctx context.Context, in *ScheduleQuotaChangeRequest, opts ...grpc.CallOption) (*ScheduleQuotaChangeReply, error)
+	// ListQuotaChanges 列出租户的计划配额变更
+	ListQuotaChanges(ctx context.Context, in *ListQuotaChangesRequest, opts ...grpc.CallOption) (*ListQuotaChangesReply, error)
+	// CancelQuotaChange 取消待生效的配额变更
+	CancelQuotaChange(ctx context.Context, in *CancelQuotaChangeRequest, opts ...grpc.CallOption) (*CancelQuotaChangeReply, error)
+	// ListOverages 列出OVERAGE模式配额各周期的计费超额
+	ListOverages(ctx context.Context, in *ListOveragesRequest, opts ...grpc.CallOption) (*ListOveragesReply, error)
+	// GetUsageReport 获取配额用量报表（基于日汇总表）
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportReply, error)
+	// GetUsageTimeSeries 获取租户配额按日用量序列（基于日汇总表）
+	GetUsageTimeSeries(ctx context.Context, in *GetUsageTimeSeriesRequest, opts ...grpc.CallOption) (*GetUsageTimeSeriesReply, error)
+	// SavePlan 创建或更新配额套餐，更新后同步到已订阅的租户
+	SavePlan(ctx context.Context, in *SavePlanRequest, opts ...grpc.CallOption) (*SavePlanReply, error)
+	// GetPlan 获取配额套餐
+	GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanReply, error)
+	// ListPlans 列出配额套餐
+	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansReply, error)
+	// AssignPlan 为租户分配套餐，按套餐创建或替换租户配额
+	AssignPlan(ctx context.Context, in *AssignPlanRequest, opts ...grpc.CallOption) (*AssignPlanReply, error)
 	// ListProducts 列出产品线
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
+	// BindProduct 关联产品线到租户
+	BindProduct(ctx context.Context, in *BindProductRequest, opts ...grpc.CallOption) (*BindProductReply, error)
+	// GetWallet 获取租户预付费钱包
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletReply, error)
+	// SetWalletThreshold 设置钱包低余额阈值
+	SetWalletThreshold(ctx context.Context, in *SetWalletThresholdRequest, opts ...grpc.CallOption) (*SetWalletThresholdReply, error)
+	// TopUpWallet 钱包充值，按幂等键去重
+	TopUpWallet(ctx context.Context, in *TopUpWalletRequest, opts ...grpc.CallOption) (*TopUpWalletReply, error)
+	// DebitWallet 按计费项单价从钱包扣费，按幂等键去重
+	DebitWallet(ctx context.Context, in *DebitWalletRequest, opts ...grpc.CallOption) (*DebitWalletReply, error)
+	// RefundWallet 退回扣费交易的全部或部分金额，按幂等键去重
+	RefundWallet(ctx context.Context, in *RefundWalletRequest, opts ...grpc.CallOption) (*RefundWalletReply, error)
+	// ListWalletTransactions 列出钱包交易及记账分录
+	ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsReply, error)
+	// LeaseQuotaBlock 租用一块配额在客户端本地使用，额度在租用时即计入已用量
+	LeaseQuotaBlock(ctx context.Context, in *LeaseQuotaBlockRequest, opts ...grpc.CallOption) (*LeaseQuotaBlockReply, error)
+	// RenewQuotaLease 上报租约已用量并延长有效期
+	RenewQuotaLease(ctx context.Context, in *RenewQuotaLeaseRequest, opts ...grpc.CallOption) (*RenewQuotaLeaseReply, error)
+	// ReturnQuotaLease 归还租约，退回未使用的额度
+	ReturnQuotaLease(ctx context.Context, in *ReturnQuotaLeaseRequest, opts ...grpc.CallOption) (*ReturnQuotaLeaseReply, error)
+	// ListQuotaLeases 列出租户的配额租约
+	ListQuotaLeases(ctx context.Context, in *ListQuotaLeasesRequest, opts ...grpc.CallOption) (*ListQuotaLeasesReply, error)
+	// AddTenantMember 添加租户成员
+	AddTenantMember(ctx context.Context, in *AddTenantMemberRequest, opts ...grpc.CallOption) (*AddTenantMemberReply, error)
+	// UpdateTenantMember 更新租户成员角色和继承设置
+	UpdateTenantMember(ctx context.Context, in *UpdateTenantMemberRequest, opts ...grpc.CallOption) (*UpdateTenantMemberReply, error)
+	// RemoveTenantMember 移除租户成员
+	RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberReply, error)
+	// ListTenantMembers 列出租户成员
+	ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...grpc.CallOption) (*ListTenantMembersReply, error)
+	// CreateTenantInvitation 邀请用户加入租户
+	CreateTenantInvitation(ctx context.Context, in *CreateTenantInvitationRequest, opts ...grpc.CallOption) (*CreateTenantInvitationReply, error)
+	// AcceptTenantInvitation 以邀请令牌加入租户
+	AcceptTenantInvitation(ctx context.Context, in *AcceptTenantInvitationRequest, opts ...grpc.CallOption) (*AcceptTenantInvitationReply, error)
+	// RevokeTenantInvitation 撤销待接受的邀请
+	RevokeTenantInvitation(ctx context.Context, in *RevokeTenantInvitationRequest, opts ...grpc.CallOption) (*RevokeTenantInvitationReply, error)
+	// ListTenantInvitations 列出租户的邀请
+	ListTenantInvitations(ctx context.Context, in *ListTenantInvitationsRequest, opts ...grpc.CallOption) (*ListTenantInvitationsReply, error)
+	// CheckPermission 检查用户在租户上是否拥有权限
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionReply, error)
+	// GetEntitlements 获取租户生效的授权项
+	GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...grpc.CallOption) (*GetEntitlementsReply, error)
+	// SetEntitlement 为租户单独授予或重置授权项
+	SetEntitlement(ctx context.Context, in *SetEntitlementRequest, opts ...grpc.CallOption) (*SetEntitlementReply, error)
+	// ListAuditEvents 查询审计事件
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsReply, error)
+	// ExportAuditEvents 导出审计事件（服务端流式下载）
+	ExportAuditEvents(ctx context.Context, in *ExportAuditEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditEventsReply], error)
+	// VerifyLedger 校验租户配额使用记录的哈希链
+	VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...grpc.CallOption) (*VerifyLedgerReply, error)
+	// ListLedgerCheckpoints 查询租户配额使用记录的签名检查点
+	ListLedgerCheckpoints(ctx context.Context, in *ListLedgerCheckpointsRequest, opts ...grpc.CallOption) (*ListLedgerCheckpointsReply, error)
+	// ImportTenants 批量导入租户（客户端流式上传，首个消息为导入选项）
+	ImportTenants(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportTenantsRequest, ImportTenantsReply], error)
+	// ExportTenants 批量导出租户（服务端流式下载）
+	ExportTenants(ctx context.Context, in *ExportTenantsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTenantsReply], error)
 }
 
 type tenantClient struct {
@@ -124,6 +253,16 @@ func (c *tenantClient) CheckQuota(ctx context.Context, in *CheckQuotaRequest, op
 	return out, nil
 }
 
+func (c *tenantClient) ExplainQuota(ctx context.Context, in *ExplainQuotaRequest, opts ...grpc.CallOption) (*ExplainQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainQuotaReply)
+	err := c.cc.Invoke(ctx, Tenant_ExplainQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantClient) ConsumeQuota(ctx context.Context, in *ConsumeQuotaRequest, opts ...grpc.CallOption) (*ConsumeQuotaReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConsumeQuotaReply)
@@ -144,6 +283,146 @@ func (c *tenantClient) ReleaseQuota(ctx context.Context, in *ReleaseQuotaRequest
 	return out, nil
 }
 
+func (c *tenantClient) ListQuotas(ctx context.Context, in *ListQuotasRequest, opts ...grpc.CallOption) (*ListQuotasReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuotasReply)
+	err := c.cc.Invoke(ctx, Tenant_ListQuotas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) AdjustQuota(ctx context.Context, in *AdjustQuotaRequest, opts ...grpc.CallOption) (*AdjustQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustQuotaReply)
+	err := c.cc.Invoke(ctx, Tenant_AdjustQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ResetQuota(ctx context.Context, in *ResetQuotaRequest, opts ...grpc.CallOption) (*ResetQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetQuotaReply)
+	err := c.cc.Invoke(ctx, Tenant_ResetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListUsageRecords(ctx context.Context, in *ListUsageRecordsRequest, opts ...grpc.CallOption) (*ListUsageRecordsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsageRecordsReply)
+	err := c.cc.Invoke(ctx, Tenant_ListUsageRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ScheduleQuotaChange(ctx context.Context, in *ScheduleQuotaChangeRequest, opts ...grpc.CallOption) (*ScheduleQuotaChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleQuotaChangeReply)
+	err := c.cc.Invoke(ctx, Tenant_ScheduleQuotaChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListQuotaChanges(ctx context.Context, in *ListQuotaChangesRequest, opts ...grpc.CallOption) (*ListQuotaChangesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuotaChangesReply)
+	err := c.cc.Invoke(ctx, Tenant_ListQuotaChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) CancelQuotaChange(ctx context.Context, in *CancelQuotaChangeRequest, opts ...grpc.CallOption) (*CancelQuotaChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelQuotaChangeReply)
+	err := c.cc.Invoke(ctx, Tenant_CancelQuotaChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListOverages(ctx context.Context, in *ListOveragesRequest, opts ...grpc.CallOption) (*ListOveragesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOveragesReply)
+	err := c.cc.Invoke(ctx, Tenant_ListOverages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageReportReply)
+	err := c.cc.Invoke(ctx, Tenant_GetUsageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) GetUsageTimeSeries(ctx context.Context, in *GetUsageTimeSeriesRequest, opts ...grpc.CallOption) (*GetUsageTimeSeriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageTimeSeriesReply)
+	err := c.cc.Invoke(ctx, Tenant_GetUsageTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) SavePlan(ctx context.Context, in *SavePlanRequest, opts ...grpc.CallOption) (*SavePlanReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavePlanReply)
+	err := c.cc.Invoke(ctx, Tenant_SavePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlanReply)
+	err := c.cc.Invoke(ctx, Tenant_GetPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlansReply)
+	err := c.cc.Invoke(ctx, Tenant_ListPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) AssignPlan(ctx context.Context, in *AssignPlanRequest, opts ...grpc.CallOption) (*AssignPlanReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignPlanReply)
+	err := c.cc.Invoke(ctx, Tenant_AssignPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductsReply)
@@ -154,250 +433,1515 @@ func (c *tenantClient) ListProducts(ctx context.Context, in *ListProductsRequest
 	return out, nil
 }
 
-// TenantServer is the server API for Tenant service.
-// All implementations must embed UnimplementedTenantServer
-// for forward compatibility.
-//
-// TenantService 租户服务API
-type TenantServer interface {
-	// CreateTenant 创建租户
-	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error)
-	// GetTenant 获取租户信息
-	GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error)
-	// ListTenants 列出租户
-	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error)
-	// UpdateTenant 更新租户
-	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantReply, error)
-	// DeleteTenant 删除租户
-	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantReply, error)
-	// CheckQuota 检查配额
-	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
-	// ConsumeQuota 消费配额
-	ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaReply, error)
-	// ReleaseQuota 释放配额
-	ReleaseQuota(context.Context, *ReleaseQuotaRequest) (*ReleaseQuotaReply, error)
-	// ListProducts 列出产品线
-	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
-	mustEmbedUnimplementedTenantServer()
+func (c *tenantClient) BindProduct(ctx context.Context, in *BindProductRequest, opts ...grpc.CallOption) (*BindProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BindProductReply)
+	err := c.cc.Invoke(ctx, Tenant_BindProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedTenantServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTenantServer struct{}
+func (c *tenantClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletReply)
+	err := c.cc.Invoke(ctx, Tenant_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedTenantServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+func (c *tenantClient) SetWalletThreshold(ctx context.Context, in *SetWalletThresholdRequest, opts ...grpc.CallOption) (*SetWalletThresholdReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWalletThresholdReply)
+	err := c.cc.Invoke(ctx, Tenant_SetWalletThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTenantServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+
+func (c *tenantClient) TopUpWallet(ctx context.Context, in *TopUpWalletRequest, opts ...grpc.CallOption) (*TopUpWalletReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpWalletReply)
+	err := c.cc.Invoke(ctx, Tenant_TopUpWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTenantServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+
+func (c *tenantClient) DebitWallet(ctx context.Context, in *DebitWalletRequest, opts ...grpc.CallOption) (*DebitWalletReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebitWalletReply)
+	err := c.cc.Invoke(ctx, Tenant_DebitWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTenantServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+
+func (c *tenantClient) RefundWallet(ctx context.Context, in *RefundWalletRequest, opts ...grpc.CallOption) (*RefundWalletReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundWalletReply)
+	err := c.cc.Invoke(ctx, Tenant_RefundWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletTransactionsReply)
+	err := c.cc.Invoke(ctx, Tenant_ListWalletTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) LeaseQuotaBlock(ctx context.Context, in *LeaseQuotaBlockRequest, opts ...grpc.CallOption) (*LeaseQuotaBlockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaseQuotaBlockReply)
+	err := c.cc.Invoke(ctx, Tenant_LeaseQuotaBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) RenewQuotaLease(ctx context.Context, in *RenewQuotaLeaseRequest, opts ...grpc.CallOption) (*RenewQuotaLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewQuotaLeaseReply)
+	err := c.cc.Invoke(ctx, Tenant_RenewQuotaLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ReturnQuotaLease(ctx context.Context, in *ReturnQuotaLeaseRequest, opts ...grpc.CallOption) (*ReturnQuotaLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnQuotaLeaseReply)
+	err := c.cc.Invoke(ctx, Tenant_ReturnQuotaLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListQuotaLeases(ctx context.Context, in *ListQuotaLeasesRequest, opts ...grpc.CallOption) (*ListQuotaLeasesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuotaLeasesReply)
+	err := c.cc.Invoke(ctx, Tenant_ListQuotaLeases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) AddTenantMember(ctx context.Context, in *AddTenantMemberRequest, opts ...grpc.CallOption) (*AddTenantMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTenantMemberReply)
+	err := c.cc.Invoke(ctx, Tenant_AddTenantMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) UpdateTenantMember(ctx context.Context, in *UpdateTenantMemberRequest, opts ...grpc.CallOption) (*UpdateTenantMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantMemberReply)
+	err := c.cc.Invoke(ctx, Tenant_UpdateTenantMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTenantMemberReply)
+	err := c.cc.Invoke(ctx, Tenant_RemoveTenantMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...grpc.CallOption) (*ListTenantMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantMembersReply)
+	err := c.cc.Invoke(ctx, Tenant_ListTenantMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) CreateTenantInvitation(ctx context.Context, in *CreateTenantInvitationRequest, opts ...grpc.CallOption) (*CreateTenantInvitationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTenantInvitationReply)
+	err := c.cc.Invoke(ctx, Tenant_CreateTenantInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) AcceptTenantInvitation(ctx context.Context, in *AcceptTenantInvitationRequest, opts ...grpc.CallOption) (*AcceptTenantInvitationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptTenantInvitationReply)
+	err := c.cc.Invoke(ctx, Tenant_AcceptTenantInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) RevokeTenantInvitation(ctx context.Context, in *RevokeTenantInvitationRequest, opts ...grpc.CallOption) (*RevokeTenantInvitationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTenantInvitationReply)
+	err := c.cc.Invoke(ctx, Tenant_RevokeTenantInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListTenantInvitations(ctx context.Context, in *ListTenantInvitationsRequest, opts ...grpc.CallOption) (*ListTenantInvitationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantInvitationsReply)
+	err := c.cc.Invoke(ctx, Tenant_ListTenantInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionReply)
+	err := c.cc.Invoke(ctx, Tenant_CheckPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...grpc.CallOption) (*GetEntitlementsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntitlementsReply)
+	err := c.cc.Invoke(ctx, Tenant_GetEntitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) SetEntitlement(ctx context.Context, in *SetEntitlementRequest, opts ...grpc.CallOption) (*SetEntitlementReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEntitlementReply)
+	err := c.cc.Invoke(ctx, Tenant_SetEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsReply)
+	err := c.cc.Invoke(ctx, Tenant_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ExportAuditEvents(ctx context.Context, in *ExportAuditEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditEventsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Tenant_ServiceDesc.Streams[0], Tenant_ExportAuditEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAuditEventsRequest, ExportAuditEventsReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tenant_ExportAuditEventsClient = grpc.ServerStreamingClient[ExportAuditEventsReply]
+
+func (c *tenantClient) VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...grpc.CallOption) (*VerifyLedgerReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyLedgerReply)
+	err := c.cc.Invoke(ctx, Tenant_VerifyLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListLedgerCheckpoints(ctx context.Context, in *ListLedgerCheckpointsRequest, opts ...grpc.CallOption) (*ListLedgerCheckpointsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerCheckpointsReply)
+	err := c.cc.Invoke(ctx, Tenant_ListLedgerCheckpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ImportTenants(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportTenantsRequest, ImportTenantsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Tenant_ServiceDesc.Streams[1], Tenant_ImportTenants_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportTenantsRequest, ImportTenantsReply]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tenant_ImportTenantsClient = grpc.ClientStreamingClient[ImportTenantsRequest, ImportTenantsReply]
+
+func (c *tenantClient) ExportTenants(ctx context.Context, in *ExportTenantsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTenantsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Tenant_ServiceDesc.Streams[2], Tenant_ExportTenants_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTenantsRequest, ExportTenantsReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tenant_ExportTenantsClient = grpc.ServerStreamingClient[ExportTenantsReply]
+
+// TenantServer is the server API for Tenant service.
+// All implementations must embed UnimplementedTenantServer
+// for forward compatibility.
+//
+// TenantService 租户服务API
+type TenantServer interface {
+	// CreateTenant 创建租户
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error)
+	// GetTenant 获取租户信息
+	GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error)
+	// ListTenants 列出租户
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error)
+	// UpdateTenant 更新租户
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantReply, error)
+	// DeleteTenant 删除租户
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantReply, error)
+	// CheckQuota 检查配额
+	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
+	// ExplainQuota 解释租户在产品线下使用哪条配额，列出所有候选配额及选中/未选中原因
+	ExplainQuota(context.Context, *ExplainQuotaRequest) (*ExplainQuotaReply, error)
+	// ConsumeQuota 消费配额
+	ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaReply, error)
+	// ReleaseQuota 释放配额
+	ReleaseQuota(context.Context, *ReleaseQuotaRequest) (*ReleaseQuotaReply, error)
+	// ListQuotas 列出租户配额
+	ListQuotas(context.Context, *ListQuotasRequest) (*ListQuotasReply, error)
+	// AdjustQuota 调整配额
+	AdjustQuota(context.Context, *AdjustQuotaRequest) (*AdjustQuotaReply, error)
+	// ResetQuota 重置配额已用量
+	ResetQuota(context.Context, *ResetQuotaRequest) (*ResetQuotaReply, error)
+	// ListUsageRecords 列出配额使用记录
+	ListUsageRecords(context.Context, *ListUsageRecordsRequest) (*ListUsageRecordsReply, error)
+	// ScheduleQuotaChange 计划配额变更，立即或在指定时间/下次重置时生效
+	ScheduleQuotaChange(context.Context, *ScheduleQuotaChangeRequest) (*ScheduleQuotaChangeReply, error)
+	// ListQuotaChanges 列出租户的计划配额变更
+	ListQuotaChanges(context.Context, *ListQuotaChangesRequest) (*ListQuotaChangesReply, error)
+	// CancelQuotaChange 取消待生效的配额变更
+	CancelQuotaChange(context.Context, *CancelQuotaChangeRequest) (*CancelQuotaChangeReply, error)
+	// ListOverages 列出OVERAGE模式配额各周期的计费超额
+	ListOverages(context.Context, *ListOveragesRequest) (*ListOveragesReply, error)
+	// GetUsageReport 获取配额用量报表（基于日汇总表）
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportReply, error)
+	// GetUsageTimeSeries 获取租户配额按日用量序列（基于日汇总表）
+	GetUsageTimeSeries(context.Context, *GetUsageTimeSeriesRequest) (*GetUsageTimeSeriesReply, error)
+	// SavePlan 创建或更新配额套餐，更新后同步到已订阅的租户
+	SavePlan(context.Context, *SavePlanRequest) (*SavePlanReply, error)
+	// GetPlan 获取配额套餐
+	GetPlan(context.Context, *GetPlanRequest) (*GetPlanReply, error)
+	// ListPlans 列出配额套餐
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansReply, error)
+	// AssignPlan 为租户分配套餐，按套餐创建或替换租户配额
+	AssignPlan(context.Context, *AssignPlanRequest) (*AssignPlanReply, error)
+	// ListProducts 列出产品线
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	// BindProduct 关联产品线到租户
+	BindProduct(context.Context, *BindProductRequest) (*BindProductReply, error)
+	// GetWallet 获取租户预付费钱包
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletReply, error)
+	// SetWalletThreshold 设置钱包低余额阈值
+	SetWalletThreshold(context.Context, *SetWalletThresholdRequest) (*SetWalletThresholdReply, error)
+	// TopUpWallet 钱包充值，按幂等键去重
+	TopUpWallet(context.Context, *TopUpWalletRequest) (*TopUpWalletReply, error)
+	// DebitWallet 按计费项单价从钱包扣费，按幂等键去重
+	DebitWallet(context.Context, *DebitWalletRequest) (*DebitWalletReply, error)
+	// RefundWallet 退回扣费交易的全部或部分金额，按幂等键去重
+	RefundWallet(context.Context, *RefundWalletRequest) (*RefundWalletReply, error)
+	// ListWalletTransactions 列出钱包交易及记账分录
+	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsReply, error)
+	// LeaseQuotaBlock 租用一块配额在客户端本地使用，额度在租用时即计入已用量
+	LeaseQuotaBlock(context.Context, *LeaseQuotaBlockRequest) (*LeaseQuotaBlockReply, error)
+	// RenewQuotaLease 上报租约已用量并延长有效期
+	RenewQuotaLease(context.Context, *RenewQuotaLeaseRequest) (*RenewQuotaLeaseReply, error)
+	// ReturnQuotaLease 归还租约，退回未使用的额度
+	ReturnQuotaLease(context.Context, *ReturnQuotaLeaseRequest) (*ReturnQuotaLeaseReply, error)
+	// ListQuotaLeases 列出租户的配额租约
+	ListQuotaLeases(context.Context, *ListQuotaLeasesRequest) (*ListQuotaLeasesReply, error)
+	// AddTenantMember 添加租户成员
+	AddTenantMember(context.Context, *AddTenantMemberRequest) (*AddTenantMemberReply, error)
+	// UpdateTenantMember 更新租户成员角色和继承设置
+	UpdateTenantMember(context.Context, *UpdateTenantMemberRequest) (*UpdateTenantMemberReply, error)
+	// RemoveTenantMember 移除租户成员
+	RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberReply, error)
+	// ListTenantMembers 列出租户成员
+	ListTenantMembers(context.Context, *ListTenantMembersRequest) (*ListTenantMembersReply, error)
+	// CreateTenantInvitation 邀请用户加入租户
+	CreateTenantInvitation(context.Context, *CreateTenantInvitationRequest) (*CreateTenantInvitationReply, error)
+	// AcceptTenantInvitation 以邀请令牌加入租户
+	AcceptTenantInvitation(context.Context, *AcceptTenantInvitationRequest) (*AcceptTenantInvitationReply, error)
+	// RevokeTenantInvitation 撤销待接受的邀请
+	RevokeTenantInvitation(context.Context, *RevokeTenantInvitationRequest) (*RevokeTenantInvitationReply, error)
+	// ListTenantInvitations 列出租户的邀请
+	ListTenantInvitations(context.Context, *ListTenantInvitationsRequest) (*ListTenantInvitationsReply, error)
+	// CheckPermission 检查用户在租户上是否拥有权限
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionReply, error)
+	// GetEntitlements 获取租户生效的授权项
+	GetEntitlements(context.Context, *GetEntitlementsRequest) (*GetEntitlementsReply, error)
+	// SetEntitlement 为租户单独授予或重置授权项
+	SetEntitlement(context.Context, *SetEntitlementRequest) (*SetEntitlementReply, error)
+	// ListAuditEvents 查询审计事件
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error)
+	// ExportAuditEvents 导出审计事件（服务端流式下载）
+	ExportAuditEvents(*ExportAuditEventsRequest, grpc.ServerStreamingServer[ExportAuditEventsReply]) error
+	// VerifyLedger 校验租户配额使用记录的哈希链
+	VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerReply, error)
+	// ListLedgerCheckpoints 查询租户配额使用记录的签名检查点
+	ListLedgerCheckpoints(context.Context, *ListLedgerCheckpointsRequest) (*ListLedgerCheckpointsReply, error)
+	// ImportTenants 批量导入租户（客户端流式上传，首个消息为导入选项）
+	ImportTenants(grpc.ClientStreamingServer[ImportTenantsRequest, ImportTenantsReply]) error
+	// ExportTenants 批量导出租户（服务端流式下载）
+	ExportTenants(*ExportTenantsRequest, grpc.ServerStreamingServer[ExportTenantsReply]) error
+	mustEmbedUnimplementedTenantServer()
+}
+
+// UnimplementedTenantServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantServer struct{}
+
+func (UnimplementedTenantServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedTenantServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedTenantServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedTenantServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedTenantServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedTenantServer) CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQuota not implemented")
+}
+func (UnimplementedTenantServer) ExplainQuota(context.Context, *ExplainQuotaRequest) (*ExplainQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainQuota not implemented")
+}
+func (UnimplementedTenantServer) ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeQuota not implemented")
+}
+func (UnimplementedTenantServer) ReleaseQuota(context.Context, *ReleaseQuotaRequest) (*ReleaseQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQuota not implemented")
+}
+func (UnimplementedTenantServer) ListQuotas(context.Context, *ListQuotasRequest) (*ListQuotasReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotas not implemented")
+}
+func (UnimplementedTenantServer) AdjustQuota(context.Context, *AdjustQuotaRequest) (*AdjustQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustQuota not implemented")
+}
+func (UnimplementedTenantServer) ResetQuota(context.Context, *ResetQuotaRequest) (*ResetQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetQuota not implemented")
+}
+func (UnimplementedTenantServer) ListUsageRecords(context.Context, *ListUsageRecordsRequest) (*ListUsageRecordsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsageRecords not implemented")
+}
+func (UnimplementedTenantServer) ScheduleQuotaChange(context.Context, *ScheduleQuotaChangeRequest) (*ScheduleQuotaChangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleQuotaChange not implemented")
+}
+func (UnimplementedTenantServer) ListQuotaChanges(context.Context, *ListQuotaChangesRequest) (*ListQuotaChangesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotaChanges not implemented")
+}
+func (UnimplementedTenantServer) CancelQuotaChange(context.Context, *CancelQuotaChangeRequest) (*CancelQuotaChangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQuotaChange not implemented")
+}
+func (UnimplementedTenantServer) ListOverages(context.Context, *ListOveragesRequest) (*ListOveragesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverages not implemented")
+}
+func (UnimplementedTenantServer) GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (UnimplementedTenantServer) GetUsageTimeSeries(context.Context, *GetUsageTimeSeriesRequest) (*GetUsageTimeSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageTimeSeries not implemented")
+}
+func (UnimplementedTenantServer) SavePlan(context.Context, *SavePlanRequest) (*SavePlanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePlan not implemented")
+}
+func (UnimplementedTenantServer) GetPlan(context.Context, *GetPlanRequest) (*GetPlanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlan not implemented")
+}
+func (UnimplementedTenantServer) ListPlans(context.Context, *ListPlansRequest) (*ListPlansReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlans not implemented")
+}
+func (UnimplementedTenantServer) AssignPlan(context.Context, *AssignPlanRequest) (*AssignPlanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPlan not implemented")
+}
+func (UnimplementedTenantServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedTenantServer) BindProduct(context.Context, *BindProductRequest) (*BindProductReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindProduct not implemented")
+}
+func (UnimplementedTenantServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedTenantServer) SetWalletThreshold(context.Context, *SetWalletThresholdRequest) (*SetWalletThresholdReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWalletThreshold not implemented")
+}
+func (UnimplementedTenantServer) TopUpWallet(context.Context, *TopUpWalletRequest) (*TopUpWalletReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpWallet not implemented")
+}
+func (UnimplementedTenantServer) DebitWallet(context.Context, *DebitWalletRequest) (*DebitWalletReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebitWallet not implemented")
+}
+func (UnimplementedTenantServer) RefundWallet(context.Context, *RefundWalletRequest) (*RefundWalletReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundWallet not implemented")
+}
+func (UnimplementedTenantServer) ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletTransactions not implemented")
+}
+func (UnimplementedTenantServer) LeaseQuotaBlock(context.Context, *LeaseQuotaBlockRequest) (*LeaseQuotaBlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseQuotaBlock not implemented")
+}
+func (UnimplementedTenantServer) RenewQuotaLease(context.Context, *RenewQuotaLeaseRequest) (*RenewQuotaLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewQuotaLease not implemented")
+}
+func (UnimplementedTenantServer) ReturnQuotaLease(context.Context, *ReturnQuotaLeaseRequest) (*ReturnQuotaLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnQuotaLease not implemented")
+}
+func (UnimplementedTenantServer) ListQuotaLeases(context.Context, *ListQuotaLeasesRequest) (*ListQuotaLeasesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotaLeases not implemented")
+}
+func (UnimplementedTenantServer) AddTenantMember(context.Context, *AddTenantMemberRequest) (*AddTenantMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTenantMember not implemented")
+}
+func (UnimplementedTenantServer) UpdateTenantMember(context.Context, *UpdateTenantMemberRequest) (*UpdateTenantMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantMember not implemented")
+}
+func (UnimplementedTenantServer) RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTenantMember not implemented")
+}
+func (UnimplementedTenantServer) ListTenantMembers(context.Context, *ListTenantMembersRequest) (*ListTenantMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenantMembers not implemented")
+}
+func (UnimplementedTenantServer) CreateTenantInvitation(context.Context, *CreateTenantInvitationRequest) (*CreateTenantInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenantInvitation not implemented")
+}
+func (UnimplementedTenantServer) AcceptTenantInvitation(context.Context, *AcceptTenantInvitationRequest) (*AcceptTenantInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTenantInvitation not implemented")
+}
+func (UnimplementedTenantServer) RevokeTenantInvitation(context.Context, *RevokeTenantInvitationRequest) (*RevokeTenantInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTenantInvitation not implemented")
+}
+func (UnimplementedTenantServer) ListTenantInvitations(context.Context, *ListTenantInvitationsRequest) (*ListTenantInvitationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenantInvitations not implemented")
+}
+func (UnimplementedTenantServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedTenantServer) GetEntitlements(context.Context, *GetEntitlementsRequest) (*GetEntitlementsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntitlements not implemented")
+}
+func (UnimplementedTenantServer) SetEntitlement(context.Context, *SetEntitlementRequest) (*SetEntitlementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEntitlement not implemented")
+}
+func (UnimplementedTenantServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedTenantServer) ExportAuditEvents(*ExportAuditEventsRequest, grpc.ServerStreamingServer[ExportAuditEventsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAuditEvents not implemented")
+}
+func (UnimplementedTenantServer) VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLedger not implemented")
+}
+func (UnimplementedTenantServer) ListLedgerCheckpoints(context.Context, *ListLedgerCheckpointsRequest) (*ListLedgerCheckpointsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerCheckpoints not implemented")
+}
+func (UnimplementedTenantServer) ImportTenants(grpc.ClientStreamingServer[ImportTenantsRequest, ImportTenantsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTenants not implemented")
+}
+func (UnimplementedTenantServer) ExportTenants(*ExportTenantsRequest, grpc.ServerStreamingServer[ExportTenantsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTenants not implemented")
+}
+func (UnimplementedTenantServer) mustEmbedUnimplementedTenantServer() {}
+func (UnimplementedTenantServer) testEmbeddedByValue()                {}
+
+// UnsafeTenantServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantServer will
+// result in compilation errors.
+type UnsafeTenantServer interface {
+	mustEmbedUnimplementedTenantServer()
+}
+
+func RegisterTenantServer(s grpc.ServiceRegistrar, srv TenantServer) {
+	// If the following call pancis, it indicates UnimplementedTenantServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Tenant_ServiceDesc, srv)
+}
+
+func _Tenant_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).CheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_CheckQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ExplainQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ExplainQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ExplainQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ExplainQuota(ctx, req.(*ExplainQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ConsumeQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ConsumeQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ConsumeQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ConsumeQuota(ctx, req.(*ConsumeQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ReleaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ReleaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ReleaseQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ReleaseQuota(ctx, req.(*ReleaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListQuotas(ctx, req.(*ListQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_AdjustQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).AdjustQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_AdjustQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).AdjustQuota(ctx, req.(*AdjustQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ResetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ResetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ResetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ResetQuota(ctx, req.(*ResetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListUsageRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListUsageRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListUsageRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListUsageRecords(ctx, req.(*ListUsageRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ScheduleQuotaChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleQuotaChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ScheduleQuotaChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ScheduleQuotaChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ScheduleQuotaChange(ctx, req.(*ScheduleQuotaChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListQuotaChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotaChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListQuotaChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListQuotaChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListQuotaChanges(ctx, req.(*ListQuotaChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_CancelQuotaChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQuotaChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).CancelQuotaChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_CancelQuotaChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).CancelQuotaChange(ctx, req.(*CancelQuotaChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListOverages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOveragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListOverages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListOverages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListOverages(ctx, req.(*ListOveragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_GetUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_GetUsageTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).GetUsageTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_GetUsageTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).GetUsageTimeSeries(ctx, req.(*GetUsageTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_SavePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).SavePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_SavePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).SavePlan(ctx, req.(*SavePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_GetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).GetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_GetPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).GetPlan(ctx, req.(*GetPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListPlans(ctx, req.(*ListPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_AssignPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).AssignPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_AssignPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).AssignPlan(ctx, req.(*AssignPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_BindProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).BindProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_BindProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).BindProduct(ctx, req.(*BindProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_SetWalletThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWalletThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).SetWalletThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_SetWalletThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).SetWalletThreshold(ctx, req.(*SetWalletThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_TopUpWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).TopUpWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_TopUpWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).TopUpWallet(ctx, req.(*TopUpWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_DebitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebitWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).DebitWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_DebitWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).DebitWallet(ctx, req.(*DebitWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_RefundWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).RefundWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_RefundWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).RefundWallet(ctx, req.(*RefundWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListWalletTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListWalletTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListWalletTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListWalletTransactions(ctx, req.(*ListWalletTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_LeaseQuotaBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseQuotaBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).LeaseQuotaBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_LeaseQuotaBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).LeaseQuotaBlock(ctx, req.(*LeaseQuotaBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_RenewQuotaLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewQuotaLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).RenewQuotaLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_RenewQuotaLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).RenewQuotaLease(ctx, req.(*RenewQuotaLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTenantServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+
+func _Tenant_ReturnQuotaLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnQuotaLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ReturnQuotaLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ReturnQuotaLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ReturnQuotaLease(ctx, req.(*ReturnQuotaLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTenantServer) CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckQuota not implemented")
+
+func _Tenant_ListQuotaLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotaLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListQuotaLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListQuotaLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListQuotaLeases(ctx, req.(*ListQuotaLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTenantServer) ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsumeQuota not implemented")
+
+func _Tenant_AddTenantMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTenantMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).AddTenantMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_AddTenantMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).AddTenantMember(ctx, req.(*AddTenantMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTenantServer) ReleaseQuota(context.Context, *ReleaseQuotaRequest) (*ReleaseQuotaReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQuota not implemented")
+
+func _Tenant_UpdateTenantMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).UpdateTenantMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_UpdateTenantMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).UpdateTenantMember(ctx, req.(*UpdateTenantMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTenantServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+
+func _Tenant_RemoveTenantMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTenantMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).RemoveTenantMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_RemoveTenantMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).RemoveTenantMember(ctx, req.(*RemoveTenantMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTenantServer) mustEmbedUnimplementedTenantServer() {}
-func (UnimplementedTenantServer) testEmbeddedByValue()                {}
 
-// UnsafeTenantServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TenantServer will
-// result in compilation errors.
-type UnsafeTenantServer interface {
-	mustEmbedUnimplementedTenantServer()
+func _Tenant_ListTenantMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListTenantMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListTenantMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListTenantMembers(ctx, req.(*ListTenantMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTenantServer(s grpc.ServiceRegistrar, srv TenantServer) {
-	// If the following call pancis, it indicates UnimplementedTenantServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Tenant_CreateTenantInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Tenant_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TenantServer).CreateTenantInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_CreateTenantInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).CreateTenantInvitation(ctx, req.(*CreateTenantInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTenantRequest)
+func _Tenant_AcceptTenantInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTenantInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).CreateTenant(ctx, in)
+		return srv.(TenantServer).AcceptTenantInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_CreateTenant_FullMethodName,
+		FullMethod: Tenant_AcceptTenantInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+		return srv.(TenantServer).AcceptTenantInvitation(ctx, req.(*AcceptTenantInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantRequest)
+func _Tenant_RevokeTenantInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTenantInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).GetTenant(ctx, in)
+		return srv.(TenantServer).RevokeTenantInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_GetTenant_FullMethodName,
+		FullMethod: Tenant_RevokeTenantInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).GetTenant(ctx, req.(*GetTenantRequest))
+		return srv.(TenantServer).RevokeTenantInvitation(ctx, req.(*RevokeTenantInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTenantsRequest)
+func _Tenant_ListTenantInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantInvitationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).ListTenants(ctx, in)
+		return srv.(TenantServer).ListTenantInvitations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_ListTenants_FullMethodName,
+		FullMethod: Tenant_ListTenantInvitations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).ListTenants(ctx, req.(*ListTenantsRequest))
+		return srv.(TenantServer).ListTenantInvitations(ctx, req.(*ListTenantInvitationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTenantRequest)
+func _Tenant_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).UpdateTenant(ctx, in)
+		return srv.(TenantServer).CheckPermission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_UpdateTenant_FullMethodName,
+		FullMethod: Tenant_CheckPermission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+		return srv.(TenantServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTenantRequest)
+func _Tenant_GetEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntitlementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).DeleteTenant(ctx, in)
+		return srv.(TenantServer).GetEntitlements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_DeleteTenant_FullMethodName,
+		FullMethod: Tenant_GetEntitlements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+		return srv.(TenantServer).GetEntitlements(ctx, req.(*GetEntitlementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckQuotaRequest)
+func _Tenant_SetEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEntitlementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).CheckQuota(ctx, in)
+		return srv.(TenantServer).SetEntitlement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_CheckQuota_FullMethodName,
+		FullMethod: Tenant_SetEntitlement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+		return srv.(TenantServer).SetEntitlement(ctx, req.(*SetEntitlementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_ConsumeQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumeQuotaRequest)
+func _Tenant_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).ConsumeQuota(ctx, in)
+		return srv.(TenantServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_ConsumeQuota_FullMethodName,
+		FullMethod: Tenant_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).ConsumeQuota(ctx, req.(*ConsumeQuotaRequest))
+		return srv.(TenantServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_ReleaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseQuotaRequest)
+func _Tenant_ExportAuditEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuditEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TenantServer).ExportAuditEvents(m, &grpc.GenericServerStream[ExportAuditEventsRequest, ExportAuditEventsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tenant_ExportAuditEventsServer = grpc.ServerStreamingServer[ExportAuditEventsReply]
+
+func _Tenant_VerifyLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLedgerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).ReleaseQuota(ctx, in)
+		return srv.(TenantServer).VerifyLedger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_ReleaseQuota_FullMethodName,
+		FullMethod: Tenant_VerifyLedger_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).ReleaseQuota(ctx, req.(*ReleaseQuotaRequest))
+		return srv.(TenantServer).VerifyLedger(ctx, req.(*VerifyLedgerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tenant_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _Tenant_ListLedgerCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerCheckpointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServer).ListProducts(ctx, in)
+		return srv.(TenantServer).ListLedgerCheckpoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Tenant_ListProducts_FullMethodName,
+		FullMethod: Tenant_ListLedgerCheckpoints_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(TenantServer).ListLedgerCheckpoints(ctx, req.(*ListLedgerCheckpointsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tenant_ImportTenants_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TenantServer).ImportTenants(&grpc.GenericServerStream[ImportTenantsRequest, ImportTenantsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tenant_ImportTenantsServer = grpc.ClientStreamingServer[ImportTenantsRequest, ImportTenantsReply]
+
+func _Tenant_ExportTenants_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTenantsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TenantServer).ExportTenants(m, &grpc.GenericServerStream[ExportTenantsRequest, ExportTenantsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tenant_ExportTenantsServer = grpc.ServerStreamingServer[ExportTenantsReply]
+
 // Tenant_ServiceDesc is the grpc.ServiceDesc for Tenant service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -429,6 +1973,10 @@ var Tenant_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckQuota",
 			Handler:    _Tenant_CheckQuota_Handler,
 		},
+		{
+			MethodName: "ExplainQuota",
+			Handler:    _Tenant_ExplainQuota_Handler,
+		},
 		{
 			MethodName: "ConsumeQuota",
 			Handler:    _Tenant_ConsumeQuota_Handler,
@@ -437,11 +1985,183 @@ var Tenant_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseQuota",
 			Handler:    _Tenant_ReleaseQuota_Handler,
 		},
+		{
+			MethodName: "ListQuotas",
+			Handler:    _Tenant_ListQuotas_Handler,
+		},
+		{
+			MethodName: "AdjustQuota",
+			Handler:    _Tenant_AdjustQuota_Handler,
+		},
+		{
+			MethodName: "ResetQuota",
+			Handler:    _Tenant_ResetQuota_Handler,
+		},
+		{
+			MethodName: "ListUsageRecords",
+			Handler:    _Tenant_ListUsageRecords_Handler,
+		},
+		{
+			MethodName: "ScheduleQuotaChange",
+			Handler:    _Tenant_ScheduleQuotaChange_Handler,
+		},
+		{
+			MethodName: "ListQuotaChanges",
+			Handler:    _Tenant_ListQuotaChanges_Handler,
+		},
+		{
+			MethodName: "CancelQuotaChange",
+			Handler:    _Tenant_CancelQuotaChange_Handler,
+		},
+		{
+			MethodName: "ListOverages",
+			Handler:    _Tenant_ListOverages_Handler,
+		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _Tenant_GetUsageReport_Handler,
+		},
+		{
+			MethodName: "GetUsageTimeSeries",
+			Handler:    _Tenant_GetUsageTimeSeries_Handler,
+		},
+		{
+			MethodName: "SavePlan",
+			Handler:    _Tenant_SavePlan_Handler,
+		},
+		{
+			MethodName: "GetPlan",
+			Handler:    _Tenant_GetPlan_Handler,
+		},
+		{
+			MethodName: "ListPlans",
+			Handler:    _Tenant_ListPlans_Handler,
+		},
+		{
+			MethodName: "AssignPlan",
+			Handler:    _Tenant_AssignPlan_Handler,
+		},
 		{
 			MethodName: "ListProducts",
 			Handler:    _Tenant_ListProducts_Handler,
 		},
+		{
+			MethodName: "BindProduct",
+			Handler:    _Tenant_BindProduct_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _Tenant_GetWallet_Handler,
+		},
+		{
+			MethodName: "SetWalletThreshold",
+			Handler:    _Tenant_SetWalletThreshold_Handler,
+		},
+		{
+			MethodName: "TopUpWallet",
+			Handler:    _Tenant_TopUpWallet_Handler,
+		},
+		{
+			MethodName: "DebitWallet",
+			Handler:    _Tenant_DebitWallet_Handler,
+		},
+		{
+			MethodName: "RefundWallet",
+			Handler:    _Tenant_RefundWallet_Handler,
+		},
+		{
+			MethodName: "ListWalletTransactions",
+			Handler:    _Tenant_ListWalletTransactions_Handler,
+		},
+		{
+			MethodName: "LeaseQuotaBlock",
+			Handler:    _Tenant_LeaseQuotaBlock_Handler,
+		},
+		{
+			MethodName: "RenewQuotaLease",
+			Handler:    _Tenant_RenewQuotaLease_Handler,
+		},
+		{
+			MethodName: "ReturnQuotaLease",
+			Handler:    _Tenant_ReturnQuotaLease_Handler,
+		},
+		{
+			MethodName: "ListQuotaLeases",
+			Handler:    _Tenant_ListQuotaLeases_Handler,
+		},
+		{
+			MethodName: "AddTenantMember",
+			Handler:    _Tenant_AddTenantMember_Handler,
+		},
+		{
+			MethodName: "UpdateTenantMember",
+			Handler:    _Tenant_UpdateTenantMember_Handler,
+		},
+		{
+			MethodName: "RemoveTenantMember",
+			Handler:    _Tenant_RemoveTenantMember_Handler,
+		},
+		{
+			MethodName: "ListTenantMembers",
+			Handler:    _Tenant_ListTenantMembers_Handler,
+		},
+		{
+			MethodName: "CreateTenantInvitation",
+			Handler:    _Tenant_CreateTenantInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptTenantInvitation",
+			Handler:    _Tenant_AcceptTenantInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeTenantInvitation",
+			Handler:    _Tenant_RevokeTenantInvitation_Handler,
+		},
+		{
+			MethodName: "ListTenantInvitations",
+			Handler:    _Tenant_ListTenantInvitations_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _Tenant_CheckPermission_Handler,
+		},
+		{
+			MethodName: "GetEntitlements",
+			Handler:    _Tenant_GetEntitlements_Handler,
+		},
+		{
+			MethodName: "SetEntitlement",
+			Handler:    _Tenant_SetEntitlement_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Tenant_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "VerifyLedger",
+			Handler:    _Tenant_VerifyLedger_Handler,
+		},
+		{
+			MethodName: "ListLedgerCheckpoints",
+			Handler:    _Tenant_ListLedgerCheckpoints_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAuditEvents",
+			Handler:       _Tenant_ExportAuditEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTenants",
+			Handler:       _Tenant_ImportTenants_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportTenants",
+			Handler:       _Tenant_ExportTenants_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "platform/tenant_service/v1/tenant.proto",
 }
@@ -19,35 +19,155 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationTenantAcceptTenantInvitation = "/platform.tenant_service.v1.Tenant/AcceptTenantInvitation"
+const OperationTenantAddTenantMember = "/platform.tenant_service.v1.Tenant/AddTenantMember"
+const OperationTenantAdjustQuota = "/platform.tenant_service.v1.Tenant/AdjustQuota"
+const OperationTenantAssignPlan = "/platform.tenant_service.v1.Tenant/AssignPlan"
+const OperationTenantBindProduct = "/platform.tenant_service.v1.Tenant/BindProduct"
+const OperationTenantCancelQuotaChange = "/platform.tenant_service.v1.Tenant/CancelQuotaChange"
+const OperationTenantCheckPermission = "/platform.tenant_service.v1.Tenant/CheckPermission"
 const OperationTenantCheckQuota = "/platform.tenant_service.v1.Tenant/CheckQuota"
 const OperationTenantConsumeQuota = "/platform.tenant_service.v1.Tenant/ConsumeQuota"
 const OperationTenantCreateTenant = "/platform.tenant_service.v1.Tenant/CreateTenant"
+const OperationTenantCreateTenantInvitation = "/platform.tenant_service.v1.Tenant/CreateTenantInvitation"
+const OperationTenantDebitWallet = "/platform.tenant_service.v1.Tenant/DebitWallet"
 const OperationTenantDeleteTenant = "/platform.tenant_service.v1.Tenant/DeleteTenant"
+const OperationTenantExplainQuota = "/platform.tenant_service.v1.Tenant/ExplainQuota"
+const OperationTenantGetEntitlements = "/platform.tenant_service.v1.Tenant/GetEntitlements"
+const OperationTenantGetPlan = "/platform.tenant_service.v1.Tenant/GetPlan"
 const OperationTenantGetTenant = "/platform.tenant_service.v1.Tenant/GetTenant"
+const OperationTenantGetUsageReport = "/platform.tenant_service.v1.Tenant/GetUsageReport"
+const OperationTenantGetUsageTimeSeries = "/platform.tenant_service.v1.Tenant/GetUsageTimeSeries"
+const OperationTenantGetWallet = "/platform.tenant_service.v1.Tenant/GetWallet"
+const OperationTenantLeaseQuotaBlock = "/platform.tenant_service.v1.Tenant/LeaseQuotaBlock"
+const OperationTenantListAuditEvents = "/platform.tenant_service.v1.Tenant/ListAuditEvents"
+const OperationTenantListLedgerCheckpoints = "/platform.tenant_service.v1.Tenant/ListLedgerCheckpoints"
+const OperationTenantListOverages = "/platform.tenant_service.v1.Tenant/ListOverages"
+const OperationTenantListPlans = "/platform.tenant_service.v1.Tenant/ListPlans"
 const OperationTenantListProducts = "/platform.tenant_service.v1.Tenant/ListProducts"
+const OperationTenantListQuotaChanges = "/platform.tenant_service.v1.Tenant/ListQuotaChanges"
+const OperationTenantListQuotaLeases = "/platform.tenant_service.v1.Tenant/ListQuotaLeases"
+const OperationTenantListQuotas = "/platform.tenant_service.v1.Tenant/ListQuotas"
+const OperationTenantListTenantInvitations = "/platform.tenant_service.v1.Tenant/ListTenantInvitations"
+const OperationTenantListTenantMembers = "/platform.tenant_service.v1.Tenant/ListTenantMembers"
 const OperationTenantListTenants = "/platform.tenant_service.v1.Tenant/ListTenants"
+const OperationTenantListUsageRecords = "/platform.tenant_service.v1.Tenant/ListUsageRecords"
+const OperationTenantListWalletTransactions = "/platform.tenant_service.v1.Tenant/ListWalletTransactions"
+const OperationTenantRefundWallet = "/platform.tenant_service.v1.Tenant/RefundWallet"
 const OperationTenantReleaseQuota = "/platform.tenant_service.v1.Tenant/ReleaseQuota"
+const OperationTenantRemoveTenantMember = "/platform.tenant_service.v1.Tenant/RemoveTenantMember"
+const OperationTenantRenewQuotaLease = "/platform.tenant_service.v1.Tenant/RenewQuotaLease"
+const OperationTenantResetQuota = "/platform.tenant_service.v1.Tenant/ResetQuota"
+const OperationTenantReturnQuotaLease = "/platform.tenant_service.v1.Tenant/ReturnQuotaLease"
+const OperationTenantRevokeTenantInvitation = "/platform.tenant_service.v1.Tenant/RevokeTenantInvitation"
+const OperationTenantSavePlan = "/platform.tenant_service.v1.Tenant/SavePlan"
+const OperationTenantScheduleQuotaChange = "/platform.tenant_service.v1.Tenant/ScheduleQuotaChange"
+const OperationTenantSetEntitlement = "/platform.tenant_service.v1.Tenant/SetEntitlement"
+const OperationTenantSetWalletThreshold = "/platform.tenant_service.v1.Tenant/SetWalletThreshold"
+const OperationTenantTopUpWallet = "/platform.tenant_service.v1.Tenant/TopUpWallet"
 const OperationTenantUpdateTenant = "/platform.tenant_service.v1.Tenant/UpdateTenant"
+const OperationTenantUpdateTenantMember = "/platform.tenant_service.v1.Tenant/UpdateTenantMember"
+const OperationTenantVerifyLedger = "/platform.tenant_service.v1.Tenant/VerifyLedger"
 
 type TenantHTTPServer interface {
+	// AcceptTenantInvitation AcceptTenantInvitation 以邀请令牌加入租户
+	AcceptTenantInvitation(context.Context, *AcceptTenantInvitationRequest) (*AcceptTenantInvitationReply, error)
+	// AddTenantMember AddTenantMember 添加租户成员
+	AddTenantMember(context.Context, *AddTenantMemberRequest) (*AddTenantMemberReply, error)
+	// AdjustQuota AdjustQuota 调整配额
+	AdjustQuota(context.Context, *AdjustQuotaRequest) (*AdjustQuotaReply, error)
+	// AssignPlan AssignPlan 为租户分配套餐，按套餐创建或替换租户配额
+	AssignPlan(context.Context, *AssignPlanRequest) (*AssignPlanReply, error)
+	// BindProduct BindProduct 关联产品线到租户
+	BindProduct(context.Context, *BindProductRequest) (*BindProductReply, error)
+	// CancelQuotaChange CancelQuotaChange 取消待生效的配额变更
+	CancelQuotaChange(context.Context, *CancelQuotaChangeRequest) (*CancelQuotaChangeReply, error)
+	// CheckPermission CheckPermission 检查用户在租户上是否拥有权限
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionReply, error)
 	// CheckQuota CheckQuota 检查配额
 	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
 	// ConsumeQuota ConsumeQuota 消费配额
 	ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaReply, error)
 	// CreateTenant CreateTenant 创建租户
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error)
+	// CreateTenantInvitation CreateTenantInvitation 邀请用户加入租户
+	CreateTenantInvitation(context.Context, *CreateTenantInvitationRequest) (*CreateTenantInvitationReply, error)
+	// DebitWallet DebitWallet 按计费项单价从钱包扣费，按幂等键去重
+	DebitWallet(context.Context, *DebitWalletRequest) (*DebitWalletReply, error)
 	// DeleteTenant DeleteTenant 删除租户
 	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantReply, error)
+	// ExplainQuota ExplainQuota 解释租户在产品线下使用哪条配额，列出所有候选配额及选中/未选中原因
+	ExplainQuota(context.Context, *ExplainQuotaRequest) (*ExplainQuotaReply, error)
+	// GetEntitlements GetEntitlements 获取租户生效的授权项
+	GetEntitlements(context.Context, *GetEntitlementsRequest) (*GetEntitlementsReply, error)
+	// GetPlan GetPlan 获取配额套餐
+	GetPlan(context.Context, *GetPlanRequest) (*GetPlanReply, error)
 	// GetTenant GetTenant 获取租户信息
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error)
+	// GetUsageReport GetUsageReport 获取配额用量报表（基于日汇总表）
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportReply, error)
+	// GetUsageTimeSeries GetUsageTimeSeries 获取租户配额按日用量序列（基于日汇总表）
+	GetUsageTimeSeries(context.Context, *GetUsageTimeSeriesRequest) (*GetUsageTimeSeriesReply, error)
+	// GetWallet GetWallet 获取租户预付费钱包
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletReply, error)
+	// LeaseQuotaBlock LeaseQuotaBlock 租用一块配额在客户端本地使用，额度在租用时即计入已用量
+	LeaseQuotaBlock(context.Context, *LeaseQuotaBlockRequest) (*LeaseQuotaBlockReply, error)
+	// ListAuditEvents ListAuditEvents 查询审计事件
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error)
+	// ListLedgerCheckpoints ListLedgerCheckpoints 查询租户配额使用记录的签名检查点
+	ListLedgerCheckpoints(context.Context, *ListLedgerCheckpointsRequest) (*ListLedgerCheckpointsReply, error)
+	// ListOverages ListOverages 列出OVERAGE模式配额各周期的计费超额
+	ListOverages(context.Context, *ListOveragesRequest) (*ListOveragesReply, error)
+	// ListPlans ListPlans 列出配额套餐
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansReply, error)
 	// ListProducts ListProducts 列出产品线
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	// ListQuotaChanges ListQuotaChanges 列出租户的计划配额变更
+	ListQuotaChanges(context.Context, *ListQuotaChangesRequest) (*ListQuotaChangesReply, error)
+	// ListQuotaLeases ListQuotaLeases 列出租户的配额租约
+	ListQuotaLeases(context.Context, *ListQuotaLeasesRequest) (*ListQuotaLeasesReply, error)
+	// ListQuotas ListQuotas 列出租户配额
+	ListQuotas(context.Context, *ListQuotasRequest) (*ListQuotasReply, error)
+	// ListTenantInvitations ListTenantInvitations 列出租户的邀请
+	ListTenantInvitations(context.Context, *ListTenantInvitationsRequest) (*ListTenantInvitationsReply, error)
+	// ListTenantMembers ListTenantMembers 列出租户成员
+	ListTenantMembers(context.Context, *ListTenantMembersRequest) (*ListTenantMembersReply, error)
 	// ListTenants ListTenants 列出租户
 	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsReply, error)
+	// ListUsageRecords ListUsageRecords 列出配额使用记录
+	ListUsageRecords(context.Context, *ListUsageRecordsRequest) (*ListUsageRecordsReply, error)
+	// ListWalletTransactions ListWalletTransactions 列出钱包交易及记账分录
+	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsReply, error)
+	// RefundWallet RefundWallet 退回扣费交易的全部或部分金额，按幂等键去重
+	RefundWallet(context.Context, *RefundWalletRequest) (*RefundWalletReply, error)
 	// ReleaseQuota ReleaseQuota 释放配额
 	ReleaseQuota(context.Context, *ReleaseQuotaRequest) (*ReleaseQuotaReply, error)
+	// RemoveTenantMember RemoveTenantMember 移除租户成员
+	RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberReply, error)
+	// RenewQuotaLease RenewQuotaLease 上报租约已用量并延长有效期
+	RenewQuotaLease(context.Context, *RenewQuotaLeaseRequest) (*RenewQuotaLeaseReply, error)
+	// ResetQuota ResetQuota 重置配额已用量
+	ResetQuota(context.Context, *ResetQuotaRequest) (*ResetQuotaReply, error)
+	// ReturnQuotaLease ReturnQuotaLease 归还租约，退回未使用的额度
+	ReturnQuotaLease(context.Context, *ReturnQuotaLeaseRequest) (*ReturnQuotaLeaseReply, error)
+	// RevokeTenantInvitation RevokeTenantInvitation 撤销待接受的邀请
+	RevokeTenantInvitation(context.Context, *RevokeTenantInvitationRequest) (*RevokeTenantInvitationReply, error)
+	// SavePlan SavePlan 创建或更新配额套餐，更新后同步到已订阅的租户
+	SavePlan(context.Context, *SavePlanRequest) (*SavePlanReply, error)
+	// ScheduleQuotaChange ScheduleQuotaChange 计划配额变更，立即或在指定时间/下次重置时生效
+	ScheduleQuotaChange(context.Context, *ScheduleQuotaChangeRequest) (*ScheduleQuotaChangeReply, error)
+	// SetEntitlement SetEntitlement 为租户单独授予或重置授权项
+	SetEntitlement(context.Context, *SetEntitlementRequest) (*SetEntitlementReply, error)
+	// SetWalletThreshold SetWalletThreshold 设置钱包低余额阈值
+	SetWalletThreshold(context.Context, *SetWalletThresholdRequest) (*SetWalletThresholdReply, error)
+	// TopUpWallet TopUpWallet 钱包充值，按幂等键去重
+	TopUpWallet(context.Context, *TopUpWalletRequest) (*TopUpWalletReply, error)
 	// UpdateTenant UpdateTenant 更新租户
 	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantReply, error)
+	// UpdateTenantMember UpdateTenantMember 更新租户成员角色和继承设置
+	UpdateTenantMember(context.Context, *UpdateTenantMemberRequest) (*UpdateTenantMemberReply, error)
+	// VerifyLedger VerifyLedger 校验租户配额使用记录的哈希链
+	VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerReply, error)
 }
 
 func RegisterTenantHTTPServer(s *http.Server, srv TenantHTTPServer) {
@@ -58,9 +178,49 @@ func RegisterTenantHTTPServer(s *http.Server, srv TenantHTTPServer) {
 	r.PUT("/v1/tenants/{tenant_id}", _Tenant_UpdateTenant0_HTTP_Handler(srv))
 	r.DELETE("/v1/tenants/{tenant_id}", _Tenant_DeleteTenant0_HTTP_Handler(srv))
 	r.POST("/v1/tenants/{tenant_id}/quota/check", _Tenant_CheckQuota0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/quota/explain", _Tenant_ExplainQuota0_HTTP_Handler(srv))
 	r.POST("/v1/tenants/{tenant_id}/quota/consume", _Tenant_ConsumeQuota0_HTTP_Handler(srv))
 	r.POST("/v1/tenants/{tenant_id}/quota/release", _Tenant_ReleaseQuota0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/quotas", _Tenant_ListQuotas0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/quota/adjust", _Tenant_AdjustQuota0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/quota/reset", _Tenant_ResetQuota0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/quota/usage", _Tenant_ListUsageRecords0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/quota/changes", _Tenant_ScheduleQuotaChange0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/quota/changes", _Tenant_ListQuotaChanges0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/quota/changes/{change_id}/cancel", _Tenant_CancelQuotaChange0_HTTP_Handler(srv))
+	r.GET("/v1/overages", _Tenant_ListOverages0_HTTP_Handler(srv))
+	r.GET("/v1/usage/report", _Tenant_GetUsageReport0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/usage/timeseries", _Tenant_GetUsageTimeSeries0_HTTP_Handler(srv))
+	r.PUT("/v1/plans/{plan_code}", _Tenant_SavePlan0_HTTP_Handler(srv))
+	r.GET("/v1/plans/{plan_code}", _Tenant_GetPlan0_HTTP_Handler(srv))
+	r.GET("/v1/plans", _Tenant_ListPlans0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/plan", _Tenant_AssignPlan0_HTTP_Handler(srv))
 	r.GET("/v1/products", _Tenant_ListProducts0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/products", _Tenant_BindProduct0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/wallet", _Tenant_GetWallet0_HTTP_Handler(srv))
+	r.PUT("/v1/tenants/{tenant_id}/wallet/threshold", _Tenant_SetWalletThreshold0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/wallet/topup", _Tenant_TopUpWallet0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/wallet/debit", _Tenant_DebitWallet0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/wallet/refund", _Tenant_RefundWallet0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/wallet/transactions", _Tenant_ListWalletTransactions0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/quota/leases", _Tenant_LeaseQuotaBlock0_HTTP_Handler(srv))
+	r.POST("/v1/quota/leases/{lease_id}/renew", _Tenant_RenewQuotaLease0_HTTP_Handler(srv))
+	r.POST("/v1/quota/leases/{lease_id}/return", _Tenant_ReturnQuotaLease0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/quota/leases", _Tenant_ListQuotaLeases0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/members", _Tenant_AddTenantMember0_HTTP_Handler(srv))
+	r.PUT("/v1/tenants/{tenant_id}/members/{user_id}", _Tenant_UpdateTenantMember0_HTTP_Handler(srv))
+	r.DELETE("/v1/tenants/{tenant_id}/members/{user_id}", _Tenant_RemoveTenantMember0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/members", _Tenant_ListTenantMembers0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/invitations", _Tenant_CreateTenantInvitation0_HTTP_Handler(srv))
+	r.POST("/v1/invitations/accept", _Tenant_AcceptTenantInvitation0_HTTP_Handler(srv))
+	r.POST("/v1/invitations/{invitation_id}/revoke", _Tenant_RevokeTenantInvitation0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/invitations", _Tenant_ListTenantInvitations0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/permissions/check", _Tenant_CheckPermission0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/entitlements", _Tenant_GetEntitlements0_HTTP_Handler(srv))
+	r.PUT("/v1/tenants/{tenant_id}/entitlements/{key}", _Tenant_SetEntitlement0_HTTP_Handler(srv))
+	r.GET("/v1/audit-events", _Tenant_ListAuditEvents0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{tenant_id}/ledger/verify", _Tenant_VerifyLedger0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/ledger/checkpoints", _Tenant_ListLedgerCheckpoints0_HTTP_Handler(srv))
 }
 
 func _Tenant_CreateTenant0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
@@ -198,6 +358,28 @@ func _Tenant_CheckQuota0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _Tenant_ExplainQuota0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExplainQuotaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantExplainQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExplainQuota(ctx, req.(*ExplainQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExplainQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Tenant_ConsumeQuota0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ConsumeQuotaRequest
@@ -248,50 +430,1259 @@ func _Tenant_ReleaseQuota0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Cont
 	}
 }
 
-func _Tenant_ListProducts0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+func _Tenant_ListQuotas0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListProductsRequest
+		var in ListQuotasRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantListProducts)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListQuotas)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListProducts(ctx, req.(*ListProductsRequest))
+			return srv.ListQuotas(ctx, req.(*ListQuotasRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListProductsReply)
+		reply := out.(*ListQuotasReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_AdjustQuota0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdjustQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantAdjustQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdjustQuota(ctx, req.(*AdjustQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdjustQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ResetQuota0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantResetQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetQuota(ctx, req.(*ResetQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListUsageRecords0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUsageRecordsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListUsageRecords)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUsageRecords(ctx, req.(*ListUsageRecordsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUsageRecordsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ScheduleQuotaChange0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ScheduleQuotaChangeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantScheduleQuotaChange)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ScheduleQuotaChange(ctx, req.(*ScheduleQuotaChangeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ScheduleQuotaChangeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListQuotaChanges0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListQuotaChangesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListQuotaChanges)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListQuotaChanges(ctx, req.(*ListQuotaChangesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListQuotaChangesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_CancelQuotaChange0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelQuotaChangeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantCancelQuotaChange)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelQuotaChange(ctx, req.(*CancelQuotaChangeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelQuotaChangeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListOverages0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOveragesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListOverages)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOverages(ctx, req.(*ListOveragesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOveragesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_GetUsageReport0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsageReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantGetUsageReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsageReport(ctx, req.(*GetUsageReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsageReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_GetUsageTimeSeries0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsageTimeSeriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantGetUsageTimeSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsageTimeSeries(ctx, req.(*GetUsageTimeSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsageTimeSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_SavePlan0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SavePlanRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantSavePlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SavePlan(ctx, req.(*SavePlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SavePlanReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_GetPlan0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPlanRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantGetPlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPlan(ctx, req.(*GetPlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPlanReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListPlans0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPlansRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListPlans)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPlans(ctx, req.(*ListPlansRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPlansReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_AssignPlan0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignPlanRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantAssignPlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignPlan(ctx, req.(*AssignPlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AssignPlanReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type TenantHTTPClient interface {
-	CheckQuota(ctx context.Context, req *CheckQuotaRequest, opts ...http.CallOption) (rsp *CheckQuotaReply, err error)
-	ConsumeQuota(ctx context.Context, req *ConsumeQuotaRequest, opts ...http.CallOption) (rsp *ConsumeQuotaReply, err error)
-	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *CreateTenantReply, err error)
-	DeleteTenant(ctx context.Context, req *DeleteTenantRequest, opts ...http.CallOption) (rsp *DeleteTenantReply, err error)
-	GetTenant(ctx context.Context, req *GetTenantRequest, opts ...http.CallOption) (rsp *GetTenantReply, err error)
-	ListProducts(ctx context.Context, req *ListProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
-	ListTenants(ctx context.Context, req *ListTenantsRequest, opts ...http.CallOption) (rsp *ListTenantsReply, err error)
-	ReleaseQuota(ctx context.Context, req *ReleaseQuotaRequest, opts ...http.CallOption) (rsp *ReleaseQuotaReply, err error)
-	UpdateTenant(ctx context.Context, req *UpdateTenantRequest, opts ...http.CallOption) (rsp *UpdateTenantReply, err error)
+func _Tenant_ListProducts0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListProductsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListProducts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListProducts(ctx, req.(*ListProductsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListProductsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_BindProduct0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BindProductRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantBindProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BindProduct(ctx, req.(*BindProductRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BindProductReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_GetWallet0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWalletRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantGetWallet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWallet(ctx, req.(*GetWalletRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetWalletReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_SetWalletThreshold0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetWalletThresholdRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantSetWalletThreshold)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetWalletThreshold(ctx, req.(*SetWalletThresholdRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetWalletThresholdReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_TopUpWallet0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TopUpWalletRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantTopUpWallet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TopUpWallet(ctx, req.(*TopUpWalletRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TopUpWalletReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_DebitWallet0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DebitWalletRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantDebitWallet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DebitWallet(ctx, req.(*DebitWalletRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DebitWalletReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_RefundWallet0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefundWalletRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantRefundWallet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefundWallet(ctx, req.(*RefundWalletRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefundWalletReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListWalletTransactions0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWalletTransactionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListWalletTransactions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWalletTransactions(ctx, req.(*ListWalletTransactionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWalletTransactionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_LeaseQuotaBlock0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LeaseQuotaBlockRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantLeaseQuotaBlock)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LeaseQuotaBlock(ctx, req.(*LeaseQuotaBlockRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaseQuotaBlockReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_RenewQuotaLease0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenewQuotaLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantRenewQuotaLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenewQuotaLease(ctx, req.(*RenewQuotaLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenewQuotaLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ReturnQuotaLease0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReturnQuotaLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantReturnQuotaLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReturnQuotaLease(ctx, req.(*ReturnQuotaLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReturnQuotaLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListQuotaLeases0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListQuotaLeasesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListQuotaLeases)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListQuotaLeases(ctx, req.(*ListQuotaLeasesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListQuotaLeasesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_AddTenantMember0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddTenantMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantAddTenantMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddTenantMember(ctx, req.(*AddTenantMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddTenantMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_UpdateTenantMember0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTenantMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantUpdateTenantMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTenantMember(ctx, req.(*UpdateTenantMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTenantMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_RemoveTenantMember0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveTenantMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantRemoveTenantMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveTenantMember(ctx, req.(*RemoveTenantMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveTenantMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListTenantMembers0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantMembersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListTenantMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantMembers(ctx, req.(*ListTenantMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantMembersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_CreateTenantInvitation0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTenantInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantCreateTenantInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTenantInvitation(ctx, req.(*CreateTenantInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTenantInvitationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_AcceptTenantInvitation0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptTenantInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantAcceptTenantInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptTenantInvitation(ctx, req.(*AcceptTenantInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcceptTenantInvitationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_RevokeTenantInvitation0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeTenantInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantRevokeTenantInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeTenantInvitation(ctx, req.(*RevokeTenantInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeTenantInvitationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListTenantInvitations0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantInvitationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListTenantInvitations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantInvitations(ctx, req.(*ListTenantInvitationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantInvitationsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_CheckPermission0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckPermissionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantCheckPermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckPermission(ctx, req.(*CheckPermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckPermissionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_GetEntitlements0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEntitlementsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantGetEntitlements)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEntitlements(ctx, req.(*GetEntitlementsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetEntitlementsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_SetEntitlement0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetEntitlementRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantSetEntitlement)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetEntitlement(ctx, req.(*SetEntitlementRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetEntitlementReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListAuditEvents0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditEventsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_VerifyLedger0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyLedgerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantVerifyLedger)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyLedger(ctx, req.(*VerifyLedgerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyLedgerReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Tenant_ListLedgerCheckpoints0_HTTP_Handler(srv TenantHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLedgerCheckpointsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantListLedgerCheckpoints)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLedgerCheckpoints(ctx, req.(*ListLedgerCheckpointsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLedgerCheckpointsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type TenantHTTPClient interface {
+	AcceptTenantInvitation(ctx context.Context, req *AcceptTenantInvitationRequest, opts ...http.CallOption) (rsp *AcceptTenantInvitationReply, err error)
+	AddTenantMember(ctx context.Context, req *AddTenantMemberRequest, opts ...http.CallOption) (rsp *AddTenantMemberReply, err error)
+	AdjustQuota(ctx context.Context, req *AdjustQuotaRequest, opts ...http.CallOption) (rsp *AdjustQuotaReply, err error)
+	AssignPlan(ctx context.Context, req *AssignPlanRequest, opts ...http.CallOption) (rsp *AssignPlanReply, err error)
+	BindProduct(ctx context.Context, req *BindProductRequest, opts ...http.CallOption) (rsp *BindProductReply, err error)
+	CancelQuotaChange(ctx context.Context, req *CancelQuotaChangeRequest, opts ...http.CallOption) (rsp *CancelQuotaChangeReply, err error)
+	CheckPermission(ctx context.Context, req *CheckPermissionRequest, opts ...http.CallOption) (rsp *CheckPermissionReply, err error)
+	CheckQuota(ctx context.Context, req *CheckQuotaRequest, opts ...http.CallOption) (rsp *CheckQuotaReply, err error)
+	ConsumeQuota(ctx context.Context, req *ConsumeQuotaRequest, opts ...http.CallOption) (rsp *ConsumeQuotaReply, err error)
+	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *CreateTenantReply, err error)
+	CreateTenantInvitation(ctx context.Context, req *CreateTenantInvitationRequest, opts ...http.CallOption) (rsp *CreateTenantInvitationReply, err error)
+	DebitWallet(ctx context.Context, req *DebitWalletRequest, opts ...http.CallOption) (rsp *DebitWalletReply, err error)
+	DeleteTenant(ctx context.Context, req *DeleteTenantRequest, opts ...http.CallOption) (rsp *DeleteTenantReply, err error)
+	ExplainQuota(ctx context.Context, req *ExplainQuotaRequest, opts ...http.CallOption) (rsp *ExplainQuotaReply, err error)
+	GetEntitlements(ctx context.Context, req *GetEntitlementsRequest, opts ...http.CallOption) (rsp *GetEntitlementsReply, err error)
+	GetPlan(ctx context.Context, req *GetPlanRequest, opts ...http.CallOption) (rsp *GetPlanReply, err error)
+	GetTenant(ctx context.Context, req *GetTenantRequest, opts ...http.CallOption) (rsp *GetTenantReply, err error)
+	GetUsageReport(ctx context.Context, req *GetUsageReportRequest, opts ...http.CallOption) (rsp *GetUsageReportReply, err error)
+	GetUsageTimeSeries(ctx context.Context, req *GetUsageTimeSeriesRequest, opts ...http.CallOption) (rsp *GetUsageTimeSeriesReply, err error)
+	GetWallet(ctx context.Context, req *GetWalletRequest, opts ...http.CallOption) (rsp *GetWalletReply, err error)
+	LeaseQuotaBlock(ctx context.Context, req *LeaseQuotaBlockRequest, opts ...http.CallOption) (rsp *LeaseQuotaBlockReply, err error)
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest, opts ...http.CallOption) (rsp *ListAuditEventsReply, err error)
+	ListLedgerCheckpoints(ctx context.Context, req *ListLedgerCheckpointsRequest, opts ...http.CallOption) (rsp *ListLedgerCheckpointsReply, err error)
+	ListOverages(ctx context.Context, req *ListOveragesRequest, opts ...http.CallOption) (rsp *ListOveragesReply, err error)
+	ListPlans(ctx context.Context, req *ListPlansRequest, opts ...http.CallOption) (rsp *ListPlansReply, err error)
+	ListProducts(ctx context.Context, req *ListProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
+	ListQuotaChanges(ctx context.Context, req *ListQuotaChangesRequest, opts ...http.CallOption) (rsp *ListQuotaChangesReply, err error)
+	ListQuotaLeases(ctx context.Context, req *ListQuotaLeasesRequest, opts ...http.CallOption) (rsp *ListQuotaLeasesReply, err error)
+	ListQuotas(ctx context.Context, req *ListQuotasRequest, opts ...http.CallOption) (rsp *ListQuotasReply, err error)
+	ListTenantInvitations(ctx context.Context, req *ListTenantInvitationsRequest, opts ...http.CallOption) (rsp *ListTenantInvitationsReply, err error)
+	ListTenantMembers(ctx context.Context, req *ListTenantMembersRequest, opts ...http.CallOption) (rsp *ListTenantMembersReply, err error)
+	ListTenants(ctx context.Context, req *ListTenantsRequest, opts ...http.CallOption) (rsp *ListTenantsReply, err error)
+	ListUsageRecords(ctx context.Context, req *ListUsageRecordsRequest, opts ...http.CallOption) (rsp *ListUsageRecordsReply, err error)
+	ListWalletTransactions(ctx context.Context, req *ListWalletTransactionsRequest, opts ...http.CallOption) (rsp *ListWalletTransactionsReply, err error)
+	RefundWallet(ctx context.Context, req *RefundWalletRequest, opts ...http.CallOption) (rsp *RefundWalletReply, err error)
+	ReleaseQuota(ctx context.Context, req *ReleaseQuotaRequest, opts ...http.CallOption) (rsp *ReleaseQuotaReply, err error)
+	RemoveTenantMember(ctx context.Context, req *RemoveTenantMemberRequest, opts ...http.CallOption) (rsp *RemoveTenantMemberReply, err error)
+	RenewQuotaLease(ctx context.Context, req *RenewQuotaLeaseRequest, opts ...http.CallOption) (rsp *RenewQuotaLeaseReply, err error)
+	ResetQuota(ctx context.Context, req *ResetQuotaRequest, opts ...http.CallOption) (rsp *ResetQuotaReply, err error)
+	ReturnQuotaLease(ctx context.Context, req *ReturnQuotaLeaseRequest, opts ...http.CallOption) (rsp *ReturnQuotaLeaseReply, err error)
+	RevokeTenantInvitation(ctx context.Context, req *RevokeTenantInvitationRequest, opts ...http.CallOption) (rsp *RevokeTenantInvitationReply, err error)
+	SavePlan(ctx context.Context, req *SavePlanRequest, opts ...http.CallOption) (rsp *SavePlanReply, err error)
+	ScheduleQuotaChange(ctx context.Context, req *ScheduleQuotaChangeRequest, opts ...http.CallOption) (rsp *ScheduleQuotaChangeReply, err error)
+	SetEntitlement(ctx context.Context, req *SetEntitlementRequest, opts ...http.CallOption) (rsp *SetEntitlementReply, err error)
+	SetWalletThreshold(ctx context.Context, req *SetWalletThresholdRequest, opts ...http.CallOption) (rsp *SetWalletThresholdReply, err error)
+	TopUpWallet(ctx context.Context, req *TopUpWalletRequest, opts ...http.CallOption) (rsp *TopUpWalletReply, err error)
+	UpdateTenant(ctx context.Context, req *UpdateTenantRequest, opts ...http.CallOption) (rsp *UpdateTenantReply, err error)
+	UpdateTenantMember(ctx context.Context, req *UpdateTenantMemberRequest, opts ...http.CallOption) (rsp *UpdateTenantMemberReply, err error)
+	VerifyLedger(ctx context.Context, req *VerifyLedgerRequest, opts ...http.CallOption) (rsp *VerifyLedgerReply, err error)
+}
+
+type TenantHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewTenantHTTPClient(client *http.Client) TenantHTTPClient {
+	return &TenantHTTPClientImpl{client}
+}
+
+func (c *TenantHTTPClientImpl) AcceptTenantInvitation(ctx context.Context, in *AcceptTenantInvitationRequest, opts ...http.CallOption) (*AcceptTenantInvitationReply, error) {
+	var out AcceptTenantInvitationReply
+	pattern := "/v1/invitations/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantAcceptTenantInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) AddTenantMember(ctx context.Context, in *AddTenantMemberRequest, opts ...http.CallOption) (*AddTenantMemberReply, error) {
+	var out AddTenantMemberReply
+	pattern := "/v1/tenants/{tenant_id}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantAddTenantMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) AdjustQuota(ctx context.Context, in *AdjustQuotaRequest, opts ...http.CallOption) (*AdjustQuotaReply, error) {
+	var out AdjustQuotaReply
+	pattern := "/v1/tenants/{tenant_id}/quota/adjust"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantAdjustQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) AssignPlan(ctx context.Context, in *AssignPlanRequest, opts ...http.CallOption) (*AssignPlanReply, error) {
+	var out AssignPlanReply
+	pattern := "/v1/tenants/{tenant_id}/plan"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantAssignPlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) BindProduct(ctx context.Context, in *BindProductRequest, opts ...http.CallOption) (*BindProductReply, error) {
+	var out BindProductReply
+	pattern := "/v1/tenants/{tenant_id}/products"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantBindProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) CancelQuotaChange(ctx context.Context, in *CancelQuotaChangeRequest, opts ...http.CallOption) (*CancelQuotaChangeReply, error) {
+	var out CancelQuotaChangeReply
+	pattern := "/v1/tenants/{tenant_id}/quota/changes/{change_id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantCancelQuotaChange))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...http.CallOption) (*CheckPermissionReply, error) {
+	var out CheckPermissionReply
+	pattern := "/v1/tenants/{tenant_id}/permissions/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantCheckPermission))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...http.CallOption) (*CheckQuotaReply, error) {
+	var out CheckQuotaReply
+	pattern := "/v1/tenants/{tenant_id}/quota/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantCheckQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ConsumeQuota(ctx context.Context, in *ConsumeQuotaRequest, opts ...http.CallOption) (*ConsumeQuotaReply, error) {
+	var out ConsumeQuotaReply
+	pattern := "/v1/tenants/{tenant_id}/quota/consume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantConsumeQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...http.CallOption) (*CreateTenantReply, error) {
+	var out CreateTenantReply
+	pattern := "/v1/tenants"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantCreateTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) CreateTenantInvitation(ctx context.Context, in *CreateTenantInvitationRequest, opts ...http.CallOption) (*CreateTenantInvitationReply, error) {
+	var out CreateTenantInvitationReply
+	pattern := "/v1/tenants/{tenant_id}/invitations"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantCreateTenantInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) DebitWallet(ctx context.Context, in *DebitWalletRequest, opts ...http.CallOption) (*DebitWalletReply, error) {
+	var out DebitWalletReply
+	pattern := "/v1/tenants/{tenant_id}/wallet/debit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantDebitWallet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...http.CallOption) (*DeleteTenantReply, error) {
+	var out DeleteTenantReply
+	pattern := "/v1/tenants/{tenant_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantDeleteTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ExplainQuota(ctx context.Context, in *ExplainQuotaRequest, opts ...http.CallOption) (*ExplainQuotaReply, error) {
+	var out ExplainQuotaReply
+	pattern := "/v1/tenants/{tenant_id}/quota/explain"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantExplainQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...http.CallOption) (*GetEntitlementsReply, error) {
+	var out GetEntitlementsReply
+	pattern := "/v1/tenants/{tenant_id}/entitlements"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantGetEntitlements))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) GetPlan(ctx context.Context, in *GetPlanRequest, opts ...http.CallOption) (*GetPlanReply, error) {
+	var out GetPlanReply
+	pattern := "/v1/plans/{plan_code}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantGetPlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...http.CallOption) (*GetTenantReply, error) {
+	var out GetTenantReply
+	pattern := "/v1/tenants/{tenant_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantGetTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...http.CallOption) (*GetUsageReportReply, error) {
+	var out GetUsageReportReply
+	pattern := "/v1/usage/report"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantGetUsageReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-type TenantHTTPClientImpl struct {
-	cc *http.Client
+func (c *TenantHTTPClientImpl) GetUsageTimeSeries(ctx context.Context, in *GetUsageTimeSeriesRequest, opts ...http.CallOption) (*GetUsageTimeSeriesReply, error) {
+	var out GetUsageTimeSeriesReply
+	pattern := "/v1/tenants/{tenant_id}/usage/timeseries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantGetUsageTimeSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func NewTenantHTTPClient(client *http.Client) TenantHTTPClient {
-	return &TenantHTTPClientImpl{client}
+func (c *TenantHTTPClientImpl) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...http.CallOption) (*GetWalletReply, error) {
+	var out GetWalletReply
+	pattern := "/v1/tenants/{tenant_id}/wallet"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantGetWallet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func (c *TenantHTTPClientImpl) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...http.CallOption) (*CheckQuotaReply, error) {
-	var out CheckQuotaReply
-	pattern := "/v1/tenants/{tenant_id}/quota/check"
+func (c *TenantHTTPClientImpl) LeaseQuotaBlock(ctx context.Context, in *LeaseQuotaBlockRequest, opts ...http.CallOption) (*LeaseQuotaBlockReply, error) {
+	var out LeaseQuotaBlockReply
+	pattern := "/v1/tenants/{tenant_id}/quota/leases"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationTenantCheckQuota))
+	opts = append(opts, http.Operation(OperationTenantLeaseQuotaBlock))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -300,50 +1691,50 @@ func (c *TenantHTTPClientImpl) CheckQuota(ctx context.Context, in *CheckQuotaReq
 	return &out, nil
 }
 
-func (c *TenantHTTPClientImpl) ConsumeQuota(ctx context.Context, in *ConsumeQuotaRequest, opts ...http.CallOption) (*ConsumeQuotaReply, error) {
-	var out ConsumeQuotaReply
-	pattern := "/v1/tenants/{tenant_id}/quota/consume"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationTenantConsumeQuota))
+func (c *TenantHTTPClientImpl) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...http.CallOption) (*ListAuditEventsReply, error) {
+	var out ListAuditEventsReply
+	pattern := "/v1/audit-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListAuditEvents))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *TenantHTTPClientImpl) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...http.CallOption) (*CreateTenantReply, error) {
-	var out CreateTenantReply
-	pattern := "/v1/tenants"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationTenantCreateTenant))
+func (c *TenantHTTPClientImpl) ListLedgerCheckpoints(ctx context.Context, in *ListLedgerCheckpointsRequest, opts ...http.CallOption) (*ListLedgerCheckpointsReply, error) {
+	var out ListLedgerCheckpointsReply
+	pattern := "/v1/tenants/{tenant_id}/ledger/checkpoints"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListLedgerCheckpoints))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *TenantHTTPClientImpl) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...http.CallOption) (*DeleteTenantReply, error) {
-	var out DeleteTenantReply
-	pattern := "/v1/tenants/{tenant_id}"
+func (c *TenantHTTPClientImpl) ListOverages(ctx context.Context, in *ListOveragesRequest, opts ...http.CallOption) (*ListOveragesReply, error) {
+	var out ListOveragesReply
+	pattern := "/v1/overages"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationTenantDeleteTenant))
+	opts = append(opts, http.Operation(OperationTenantListOverages))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *TenantHTTPClientImpl) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...http.CallOption) (*GetTenantReply, error) {
-	var out GetTenantReply
-	pattern := "/v1/tenants/{tenant_id}"
+func (c *TenantHTTPClientImpl) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...http.CallOption) (*ListPlansReply, error) {
+	var out ListPlansReply
+	pattern := "/v1/plans"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationTenantGetTenant))
+	opts = append(opts, http.Operation(OperationTenantListPlans))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -365,6 +1756,71 @@ func (c *TenantHTTPClientImpl) ListProducts(ctx context.Context, in *ListProduct
 	return &out, nil
 }
 
+func (c *TenantHTTPClientImpl) ListQuotaChanges(ctx context.Context, in *ListQuotaChangesRequest, opts ...http.CallOption) (*ListQuotaChangesReply, error) {
+	var out ListQuotaChangesReply
+	pattern := "/v1/tenants/{tenant_id}/quota/changes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListQuotaChanges))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ListQuotaLeases(ctx context.Context, in *ListQuotaLeasesRequest, opts ...http.CallOption) (*ListQuotaLeasesReply, error) {
+	var out ListQuotaLeasesReply
+	pattern := "/v1/tenants/{tenant_id}/quota/leases"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListQuotaLeases))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ListQuotas(ctx context.Context, in *ListQuotasRequest, opts ...http.CallOption) (*ListQuotasReply, error) {
+	var out ListQuotasReply
+	pattern := "/v1/tenants/{tenant_id}/quotas"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListQuotas))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ListTenantInvitations(ctx context.Context, in *ListTenantInvitationsRequest, opts ...http.CallOption) (*ListTenantInvitationsReply, error) {
+	var out ListTenantInvitationsReply
+	pattern := "/v1/tenants/{tenant_id}/invitations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListTenantInvitations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...http.CallOption) (*ListTenantMembersReply, error) {
+	var out ListTenantMembersReply
+	pattern := "/v1/tenants/{tenant_id}/members"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListTenantMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *TenantHTTPClientImpl) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...http.CallOption) (*ListTenantsReply, error) {
 	var out ListTenantsReply
 	pattern := "/v1/tenants"
@@ -378,6 +1834,45 @@ func (c *TenantHTTPClientImpl) ListTenants(ctx context.Context, in *ListTenantsR
 	return &out, nil
 }
 
+func (c *TenantHTTPClientImpl) ListUsageRecords(ctx context.Context, in *ListUsageRecordsRequest, opts ...http.CallOption) (*ListUsageRecordsReply, error) {
+	var out ListUsageRecordsReply
+	pattern := "/v1/tenants/{tenant_id}/quota/usage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListUsageRecords))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...http.CallOption) (*ListWalletTransactionsReply, error) {
+	var out ListWalletTransactionsReply
+	pattern := "/v1/tenants/{tenant_id}/wallet/transactions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantListWalletTransactions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) RefundWallet(ctx context.Context, in *RefundWalletRequest, opts ...http.CallOption) (*RefundWalletReply, error) {
+	var out RefundWalletReply
+	pattern := "/v1/tenants/{tenant_id}/wallet/refund"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantRefundWallet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *TenantHTTPClientImpl) ReleaseQuota(ctx context.Context, in *ReleaseQuotaRequest, opts ...http.CallOption) (*ReleaseQuotaReply, error) {
 	var out ReleaseQuotaReply
 	pattern := "/v1/tenants/{tenant_id}/quota/release"
@@ -391,6 +1886,136 @@ func (c *TenantHTTPClientImpl) ReleaseQuota(ctx context.Context, in *ReleaseQuot
 	return &out, nil
 }
 
+func (c *TenantHTTPClientImpl) RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...http.CallOption) (*RemoveTenantMemberReply, error) {
+	var out RemoveTenantMemberReply
+	pattern := "/v1/tenants/{tenant_id}/members/{user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantRemoveTenantMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) RenewQuotaLease(ctx context.Context, in *RenewQuotaLeaseRequest, opts ...http.CallOption) (*RenewQuotaLeaseReply, error) {
+	var out RenewQuotaLeaseReply
+	pattern := "/v1/quota/leases/{lease_id}/renew"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantRenewQuotaLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ResetQuota(ctx context.Context, in *ResetQuotaRequest, opts ...http.CallOption) (*ResetQuotaReply, error) {
+	var out ResetQuotaReply
+	pattern := "/v1/tenants/{tenant_id}/quota/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantResetQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ReturnQuotaLease(ctx context.Context, in *ReturnQuotaLeaseRequest, opts ...http.CallOption) (*ReturnQuotaLeaseReply, error) {
+	var out ReturnQuotaLeaseReply
+	pattern := "/v1/quota/leases/{lease_id}/return"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantReturnQuotaLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) RevokeTenantInvitation(ctx context.Context, in *RevokeTenantInvitationRequest, opts ...http.CallOption) (*RevokeTenantInvitationReply, error) {
+	var out RevokeTenantInvitationReply
+	pattern := "/v1/invitations/{invitation_id}/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantRevokeTenantInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) SavePlan(ctx context.Context, in *SavePlanRequest, opts ...http.CallOption) (*SavePlanReply, error) {
+	var out SavePlanReply
+	pattern := "/v1/plans/{plan_code}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantSavePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) ScheduleQuotaChange(ctx context.Context, in *ScheduleQuotaChangeRequest, opts ...http.CallOption) (*ScheduleQuotaChangeReply, error) {
+	var out ScheduleQuotaChangeReply
+	pattern := "/v1/tenants/{tenant_id}/quota/changes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantScheduleQuotaChange))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) SetEntitlement(ctx context.Context, in *SetEntitlementRequest, opts ...http.CallOption) (*SetEntitlementReply, error) {
+	var out SetEntitlementReply
+	pattern := "/v1/tenants/{tenant_id}/entitlements/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantSetEntitlement))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) SetWalletThreshold(ctx context.Context, in *SetWalletThresholdRequest, opts ...http.CallOption) (*SetWalletThresholdReply, error) {
+	var out SetWalletThresholdReply
+	pattern := "/v1/tenants/{tenant_id}/wallet/threshold"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantSetWalletThreshold))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) TopUpWallet(ctx context.Context, in *TopUpWalletRequest, opts ...http.CallOption) (*TopUpWalletReply, error) {
+	var out TopUpWalletReply
+	pattern := "/v1/tenants/{tenant_id}/wallet/topup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantTopUpWallet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *TenantHTTPClientImpl) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...http.CallOption) (*UpdateTenantReply, error) {
 	var out UpdateTenantReply
 	pattern := "/v1/tenants/{tenant_id}"
@@ -403,3 +2028,29 @@ func (c *TenantHTTPClientImpl) UpdateTenant(ctx context.Context, in *UpdateTenan
 	}
 	return &out, nil
 }
+
+func (c *TenantHTTPClientImpl) UpdateTenantMember(ctx context.Context, in *UpdateTenantMemberRequest, opts ...http.CallOption) (*UpdateTenantMemberReply, error) {
+	var out UpdateTenantMemberReply
+	pattern := "/v1/tenants/{tenant_id}/members/{user_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantUpdateTenantMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TenantHTTPClientImpl) VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...http.CallOption) (*VerifyLedgerReply, error) {
+	var out VerifyLedgerReply
+	pattern := "/v1/tenants/{tenant_id}/ledger/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantVerifyLedger))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -5,12 +5,14 @@ import (
 	"os"
 
 	"tenant-service/internal/conf"
+	"tenant-service/internal/server"
 
 	"github.com/gaoyong06/go-pkg/logger"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	_ "go.uber.org/automaxprocs"
@@ -32,7 +34,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs/config.yaml", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, probe *server.HealthProbe, rs *server.QuotaResetScheduler, uj *server.UsageRollupJob, lj *server.QuotaLeaseReclaimJob, sj *server.LedgerSequencerJob, cj *server.LedgerCheckpointJob) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -42,6 +44,12 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			probe,
+			rs,
+			uj,
+			lj,
+			sj,
+			cj,
 		),
 	)
 }
@@ -77,9 +85,11 @@ func main() {
 		"service.id", id,
 		"service.name", Name,
 		"service.version", Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
 	)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, appLogger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Tenant, bc.Metrics, bc.Trace, appLogger)
 	if err != nil {
 		panic(err)
 	}
@@ -10,11 +10,14 @@ import (
 	"tenant-service/internal/biz"
 	"tenant-service/internal/conf"
 	"tenant-service/internal/data"
+	"tenant-service/internal/health"
+	"tenant-service/internal/metrics"
 	"tenant-service/internal/server"
 	"tenant-service/internal/service"
+	"tenant-service/internal/tracing"
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+func wireApp(*conf.Server, *conf.Data, *conf.Tenant, *conf.Metrics, *conf.Trace, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, metrics.ProviderSet, tracing.ProviderSet, health.ProviderSet, newApp))
 }
@@ -12,31 +12,153 @@ import (
 	"tenant-service/internal/biz"
 	"tenant-service/internal/conf"
 	"tenant-service/internal/data"
+	"tenant-service/internal/health"
+	"tenant-service/internal/metrics"
 	"tenant-service/internal/server"
 	"tenant-service/internal/service"
+	"tenant-service/internal/tracing"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	db := data.NewDB(confData, logger)
-	client := data.NewRedis(confData, logger)
-	dataData, cleanup, err := data.NewData(db, client, logger)
+func wireApp(confServer *conf.Server, confData *conf.Data, tenant *conf.Tenant, confMetrics *conf.Metrics, trace *conf.Trace, logger log.Logger) (*kratos.App, func(), error) {
+	meter, cleanup, err := metrics.NewMeter()
 	if err != nil {
 		return nil, nil, err
 	}
+	tracerProvider, cleanup2, err := tracing.NewTracerProvider(trace, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	checker := health.NewChecker()
+	healthProbe := server.NewHealthProbe(checker, logger)
+	db := data.NewDB(confData, tracerProvider, logger)
+	client := data.NewRedis(confData, tracerProvider, logger)
+	dataData, cleanup3, err := data.NewData(db, client, checker, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	tenantRepo := data.NewTenantRepo(dataData, logger)
-	tenantUsecase := biz.NewTenantUsecase(tenantRepo, logger)
-	quotaRepo := data.NewQuotaRepo(dataData, logger)
-	quotaUsecase := biz.NewQuotaUsecase(quotaRepo, logger)
+	tenantIDGenerator, err := data.NewTenantIDGenerator(tenant, dataData, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auditRepo := data.NewAuditRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	auditUsecase := biz.NewAuditUsecase(auditRepo, transaction, logger)
+	tenantUsecase, err := biz.NewTenantUsecase(tenant, tenantRepo, tenantIDGenerator, auditUsecase, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	quotaMetrics, err := metrics.NewQuotaMetrics(confMetrics, meter)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	quotaRepo := data.NewQuotaRepo(dataData, quotaMetrics, logger)
+	quotaUsecase := biz.NewQuotaUsecase(quotaRepo, quotaMetrics, auditUsecase, logger)
 	productRepo := data.NewProductRepo(dataData, logger)
-	productUsecase := biz.NewProductUsecase(productRepo, logger)
-	tenantService := service.NewTenantService(tenantUsecase, quotaUsecase, productUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, tenantService, logger)
-	httpServer := server.NewHTTPServer(confServer, tenantService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	productUsecase := biz.NewProductUsecase(productRepo, auditUsecase, logger)
+	tenantTransferUsecase := biz.NewTenantTransferUsecase(tenantRepo, productRepo, quotaRepo, quotaUsecase, tenantIDGenerator, auditUsecase, logger)
+	usageReportRepo := data.NewUsageReportRepo(dataData, logger)
+	usageReportUsecase := biz.NewUsageReportUsecase(usageReportRepo, quotaRepo, logger)
+	planRepo := data.NewPlanRepo(dataData, logger)
+	entitlementCatalog, err := biz.NewEntitlementCatalog(tenant)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	planUsecase := biz.NewPlanUsecase(planRepo, tenantRepo, entitlementCatalog, auditUsecase, logger)
+	quotaChangeRepo := data.NewQuotaChangeRepo(dataData, logger)
+	quotaChangeUsecase, err := biz.NewQuotaChangeUsecase(tenant, quotaChangeRepo, quotaRepo, planRepo, quotaMetrics, auditUsecase, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	walletMetrics, err := metrics.NewWalletMetrics(confMetrics, meter)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	walletRepo := data.NewWalletRepo(dataData, walletMetrics, logger)
+	walletUsecase, err := biz.NewWalletUsecase(tenant, walletRepo, tenantRepo, walletMetrics, auditUsecase, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	quotaLeaseRepo := data.NewQuotaLeaseRepo(dataData, logger)
+	quotaLeaseUsecase := biz.NewQuotaLeaseUsecase(tenant, quotaLeaseRepo, quotaRepo, transaction, quotaMetrics, logger)
+	memberRepo := data.NewMemberRepo(dataData, logger)
+	memberUsecase := biz.NewMemberUsecase(tenant, memberRepo, tenantRepo, auditUsecase, logger)
+	entitlementRepo := data.NewEntitlementRepo(dataData, logger)
+	entitlementUsecase := biz.NewEntitlementUsecase(entitlementRepo, tenantRepo, entitlementCatalog, auditUsecase, logger)
+	ledgerRepo := data.NewLedgerRepo(dataData, logger)
+	ledgerMetrics, err := metrics.NewLedgerMetrics(meter)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	ledgerUsecase, err := biz.NewLedgerUsecase(tenant, ledgerRepo, ledgerMetrics, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	trustedProxies, err := service.NewTrustedProxies(confServer)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tenantService := service.NewTenantService(tenantUsecase, quotaUsecase, productUsecase, tenantTransferUsecase, usageReportUsecase, planUsecase, quotaChangeUsecase, walletUsecase, quotaLeaseUsecase, memberUsecase, entitlementUsecase, auditUsecase, ledgerUsecase, trustedProxies, logger)
+	resolver := service.NewTenantResolver(tenantUsecase)
+	grpcServer, err := server.NewGRPCServer(confServer, meter, tracerProvider, healthProbe, tenantService, resolver, trustedProxies, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer, err := server.NewHTTPServer(confServer, confMetrics, meter, tracerProvider, healthProbe, tenantService, resolver, trustedProxies, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	quotaResetScheduler := server.NewQuotaResetScheduler(tenant, quotaUsecase, quotaChangeUsecase, checker, logger)
+	usageRollupJob := server.NewUsageRollupJob(tenant, usageReportUsecase, checker, logger)
+	quotaLeaseReclaimJob := server.NewQuotaLeaseReclaimJob(tenant, quotaLeaseUsecase, checker, logger)
+	ledgerSequencerJob := server.NewLedgerSequencerJob(tenant, ledgerUsecase, checker, logger)
+	ledgerCheckpointJob := server.NewLedgerCheckpointJob(tenant, ledgerUsecase, checker, logger)
+	app := newApp(logger, grpcServer, httpServer, healthProbe, quotaResetScheduler, usageRollupJob, quotaLeaseReclaimJob, ledgerSequencerJob, ledgerCheckpointJob)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newAuditCommand 审计事件命令
+func newAuditCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect and export audit events",
+	}
+	cmd.AddCommand(newAuditListCommand(c), newAuditExportCommand(c))
+	return cmd
+}
+
+// auditFilter 审计事件过滤参数
+type auditFilter struct {
+	tenantID   string
+	actor      string
+	action     string
+	targetType string
+	targetID   string
+	startTime  string
+	endTime    string
+}
+
+// register 注册过滤参数
+func (f *auditFilter) register(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVarP(&f.tenantID, "tenant", "t", "", "tenant ID")
+	flags.StringVar(&f.actor, "actor", "", "actor")
+	flags.StringVar(&f.action, "action", "", "action, e.g. tenant.update")
+	flags.StringVar(&f.targetType, "target-type", "", "target type, e.g. quota")
+	flags.StringVar(&f.targetID, "target-id", "", "target ID")
+	flags.StringVar(&f.startTime, "start", "", "recorded at or after (RFC3339)")
+	flags.StringVar(&f.endTime, "end", "", "recorded before (RFC3339)")
+}
+
+// auditTable 审计事件表格
+func auditTable(events []*pb.AuditEvent) *table {
+	t := newTable("EVENT_ID", "TIME", "ACTOR", "ACTION", "TARGET", "TENANT_ID", "CHANGED", "REQUEST_ID")
+	for _, e := range events {
+		fields := make([]string, 0, len(e.GetChanges()))
+		for _, change := range e.GetChanges() {
+			fields = append(fields, change.GetField())
+		}
+		t.add(e.GetEventId(), e.GetCreatedAt(), e.GetActor(), e.GetAction(), e.GetTargetType()+"/"+e.GetTargetId(),
+			e.GetTenantId(), strings.Join(fields, ","), e.GetRequestId())
+	}
+	return t
+}
+
+// newAuditListCommand audit list
+func newAuditListCommand(c *cli) *cobra.Command {
+	var filter auditFilter
+	var after int64
+	var limit int32
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit events in event ID order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), c.cfg.Timeout)
+			defer cancel()
+			reply, err := c.client.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{
+				TenantId:     filter.tenantID,
+				Actor:        filter.actor,
+				Action:       filter.action,
+				TargetType:   filter.targetType,
+				TargetId:     filter.targetID,
+				StartTime:    filter.startTime,
+				EndTime:      filter.endTime,
+				AfterEventId: after,
+				Limit:        limit,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return auditTable(reply.GetEvents()) })
+		},
+	}
+
+	filter.register(cmd)
+	flags := cmd.Flags()
+	flags.Int64Var(&after, "after", 0, "only show events with ID greater than this")
+	flags.Int32Var(&limit, "limit", 100, "max events to return")
+	return cmd
+}
+
+// newAuditExportCommand audit export
+func newAuditExportCommand(c *cli) *cobra.Command {
+	var filter auditFilter
+	var format, output string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export audit events to a CSV or JSONL file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := parseDataFormat(format, output)
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+			if output != "" && output != "-" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+
+			stream, err := c.client.ExportAuditEvents(cmd.Context(), &pb.ExportAuditEventsRequest{
+				Format:     f,
+				TenantId:   filter.tenantID,
+				Actor:      filter.actor,
+				Action:     filter.action,
+				TargetType: filter.targetType,
+				TargetId:   filter.targetID,
+				StartTime:  filter.startTime,
+				EndTime:    filter.endTime,
+			})
+			if err != nil {
+				return err
+			}
+			for {
+				reply, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if _, err := w.Write(reply.GetChunk()); err != nil {
+					return err
+				}
+			}
+		},
+	}
+
+	filter.register(cmd)
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "data format: csv|jsonl (inferred from --file by default)")
+	flags.StringVar(&output, "file", "", "output file, stdout by default")
+	return cmd
+}
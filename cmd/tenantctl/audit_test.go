@@ -0,0 +1,46 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuditListCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("tenant", "label", id, "tier=gold")
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"audit", "list"}, want: []string{"EVENT_ID", "ops-test", "tenant.create", "tenant.update"}, wantOrder: []string{"tenant.create", "tenant.update"}},
+		{name: "by action", args: []string{"audit", "list", "--action", "tenant.update"}, want: []string{"tenant/" + id}, notWant: []string{"tenant.create"}},
+		{name: "by tenant", args: []string{"audit", "list", "-t", "EN_missing"}, want: []string{"EVENT_ID"}, notWant: []string{"ops-test"}},
+		{name: "limit", args: []string{"audit", "list", "--limit", "1"}, want: []string{"tenant.create"}, notWant: []string{"tenant.update"}},
+		{name: "yaml", args: []string{"audit", "list", "--action", "tenant.update", "-o", "yaml"}, want: []string{"actor: ops-test", "field: Labels"}},
+		{name: "unexpected argument", args: []string{"audit", "list", id}, wantCode: 1, want: []string{`unknown command "` + id + `"`}},
+	})
+}
+
+func TestAuditExportCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("tenant", "label", id, "tier=gold")
+	file := filepath.Join(t.TempDir(), "audit.csv")
+
+	e.runCases([]cmdCase{
+		{name: "jsonl", args: []string{"audit", "export", "--format", "jsonl"}, want: []string{`"tenant.create"`, `"tenant.update"`, `"ops-test"`}},
+		{name: "filtered", args: []string{"audit", "export", "--format", "jsonl", "--action", "tenant.update"}, want: []string{`"tenant.update"`}, notWant: []string{`"tenant.create"`}},
+		{name: "csv file", args: []string{"audit", "export", "--file", file}},
+		{name: "unknown format", args: []string{"audit", "export", "--file", "audit.xml"}, wantCode: 1, want: []string{`cannot infer format from "audit.xml", use --format`}},
+		{name: "invalid format", args: []string{"audit", "export", "--format", "xml"}, wantCode: 1, want: []string{"invalid format: xml"}},
+	})
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(content)), "\n"); len(lines) != 3 || !strings.Contains(lines[2], "tenant.update") {
+		t.Fatalf("csv export = %q, want header and 2 events", content)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// tokenCredentials 以Bearer Token形式附加到每个请求的元数据
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// operatorCredentials 以x-operator请求头附加操作人，服务端记录到审计事件
+type operatorCredentials struct {
+	operator string
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (c *operatorCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"x-operator": c.operator}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials
+func (c *operatorCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// newTLSConfig 根据凭证构造TLS配置
+func newTLSConfig(c *Credentials) (*tls.Config, error) {
+	tlsConf := &tls.Config{
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("invalid ca file: %s", c.CAFile)
+		}
+		tlsConf.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConf, nil
+}
+
+// dial 连接租户服务
+func dial(ctx context.Context, cfg *Config, dialOpts ...ggrpc.DialOption) (pb.TenantClient, func(), error) {
+	// WithOptions会覆盖之前设置的选项，gRPC选项汇总后一次传入
+	if cfg.Credentials.Token != "" {
+		dialOpts = append(dialOpts, ggrpc.WithPerRPCCredentials(&tokenCredentials{
+			token:  cfg.Credentials.Token,
+			secure: cfg.Credentials.TLS,
+		}))
+	}
+	if cfg.Operator != "" {
+		dialOpts = append(dialOpts, ggrpc.WithPerRPCCredentials(&operatorCredentials{operator: cfg.Operator}))
+	}
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(cfg.Server),
+		grpc.WithTimeout(cfg.Timeout),
+		grpc.WithOptions(dialOpts...),
+	}
+
+	var conn *ggrpc.ClientConn
+	var err error
+	if cfg.Credentials.TLS {
+		tlsConf, tlsErr := newTLSConfig(&cfg.Credentials)
+		if tlsErr != nil {
+			return nil, nil, tlsErr
+		}
+		conn, err = grpc.Dial(ctx, append(opts, grpc.WithTLSConfig(tlsConf))...)
+	} else {
+		conn, err = grpc.DialInsecure(ctx, opts...)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial %s: %w", cfg.Server, err)
+	}
+
+	return pb.NewTenantClient(conn), func() { _ = conn.Close() }, nil
+}
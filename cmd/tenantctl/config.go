@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config tenantctl 配置
+type Config struct {
+	Server      string        `yaml:"server"`      // gRPC服务地址
+	Timeout     time.Duration `yaml:"timeout"`     // 请求超时
+	Operator    string        `yaml:"operator"`    // 操作人，记录在配额调整流水和审计事件中
+	Output      string        `yaml:"output"`      // 默认输出格式：table/json/yaml
+	Credentials Credentials   `yaml:"credentials"` // 访问凭证
+}
+
+// Credentials 访问凭证
+type Credentials struct {
+	Token              string `yaml:"token"`                // Bearer Token
+	TLS                bool   `yaml:"tls"`                  // 是否启用TLS
+	CAFile             string `yaml:"ca_file"`              // CA证书
+	CertFile           string `yaml:"cert_file"`            // 客户端证书
+	KeyFile            string `yaml:"key_file"`             // 客户端私钥
+	ServerName         string `yaml:"server_name"`          // TLS校验使用的服务名
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"` // 跳过证书校验
+}
+
+// defaultConfigPath 默认配置文件路径
+func defaultConfigPath() string {
+	if path := os.Getenv("TENANTCTL_CONFIG"); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".tenantctl.yaml"
+	}
+	return filepath.Join(home, ".tenantctl.yaml")
+}
+
+// loadConfig 加载配置文件，文件不存在时使用默认配置
+func loadConfig(path string, explicit bool) (*Config, error) {
+	cfg := &Config{
+		Server:  "127.0.0.1:9000",
+		Timeout: 5 * time.Second,
+		Output:  outputTable,
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+
+	if cfg.Operator == "" {
+		cfg.Operator = os.Getenv("USER")
+	}
+	return cfg, nil
+}
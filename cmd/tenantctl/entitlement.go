@@ -0,0 +1,113 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newEntitlementCommand 租户授权项命令
+func newEntitlementCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entitlement",
+		Short: "Inspect and grant tenant entitlements",
+	}
+	cmd.AddCommand(
+		newEntitlementGetCommand(c),
+		newEntitlementSetCommand(c),
+		newEntitlementUnsetCommand(c),
+	)
+	return cmd
+}
+
+// entitlementTable 授权项表格
+func entitlementTable(entitlements ...*pb.Entitlement) *table {
+	t := newTable("KEY", "VALUE", "SOURCE", "FROM_TENANT", "PLAN", "PRODUCT", "UPDATED_AT")
+	for _, e := range entitlements {
+		t.add(e.GetKey(), e.GetValue(), enumName(e.GetSource().String(), "ENTITLEMENT_SOURCE_"),
+			e.GetSourceTenantId(), e.GetPlanCode(), e.GetProductCode(), e.GetUpdatedAt())
+	}
+	return t
+}
+
+// newEntitlementGetCommand entitlement get
+func newEntitlementGetCommand(c *cli) *cobra.Command {
+	var product string
+
+	cmd := &cobra.Command{
+		Use:   "get TENANT_ID [KEY...]",
+		Short: "Show effective entitlements of a tenant",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.GetEntitlements(ctx, &pb.GetEntitlementsRequest{
+				TenantId:    args[0],
+				ProductCode: product,
+				Keys:        args[1:],
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return entitlementTable(reply.GetEntitlements()...) })
+		},
+	}
+	cmd.Flags().StringVarP(&product, "product", "p", "", "product code")
+	return cmd
+}
+
+// newEntitlementSetCommand entitlement set
+func newEntitlementSetCommand(c *cli) *cobra.Command {
+	var product string
+
+	cmd := &cobra.Command{
+		Use:   "set TENANT_ID KEY VALUE",
+		Short: "Grant an entitlement to a tenant and its children",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setEntitlement(c, cmd, &pb.SetEntitlementRequest{
+				TenantId:    args[0],
+				Key:         args[1],
+				ProductCode: product,
+				Value:       args[2],
+				Operator:    c.cfg.Operator,
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&product, "product", "p", "", "product code, empty for all products")
+	return cmd
+}
+
+// newEntitlementUnsetCommand entitlement unset
+func newEntitlementUnsetCommand(c *cli) *cobra.Command {
+	var product string
+
+	cmd := &cobra.Command{
+		Use:   "unset TENANT_ID KEY",
+		Short: "Remove a tenant grant, falling back to parent, plan or default",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setEntitlement(c, cmd, &pb.SetEntitlementRequest{
+				TenantId:    args[0],
+				Key:         args[1],
+				ProductCode: product,
+				Unset:       true,
+				Operator:    c.cfg.Operator,
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&product, "product", "p", "", "product code, empty for all products")
+	return cmd
+}
+
+// setEntitlement 设置授权项并输出生效的取值
+func setEntitlement(c *cli, cmd *cobra.Command, req *pb.SetEntitlementRequest) error {
+	ctx, cancel := c.context(cmd)
+	defer cancel()
+
+	reply, err := c.client.SetEntitlement(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printer(cmd).print(reply, func() *table { return entitlementTable(reply.GetEntitlement()) })
+}
@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestEntitlementGetCommand(t *testing.T) {
+	e := newTestEnv(t)
+	e.saveBasicPlan()
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("plan", "assign", id, "basic")
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"entitlement", "get", id}, want: []string{"can_create_lucky_draw", "true", "PLAN", "basic", "sms_template_review", "manual", "DEFAULT"}},
+		{name: "by key", args: []string{"entitlement", "get", id, "sms_template_review"}, want: []string{"manual"}, notWant: []string{"can_create_lucky_draw"}},
+		{name: "yaml", args: []string{"entitlement", "get", id, "can_create_lucky_draw", "-o", "yaml"}, want: []string{"key: can_create_lucky_draw", `value: "true"`}},
+		{name: "missing argument", args: []string{"entitlement", "get"}, wantCode: 1, want: []string{"requires at least 1 arg(s)"}},
+	})
+}
+
+func TestEntitlementSetCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "set", args: []string{"entitlement", "set", id, "sms_template_review", "auto"}, want: []string{"sms_template_review", "auto", "TENANT"}},
+		{name: "resolved", args: []string{"entitlement", "get", id, "sms_template_review"}, want: []string{"auto", "TENANT"}},
+		{name: "invalid value", args: []string{"entitlement", "set", id, "sms_template_review", "bogus"}, wantCode: 1, want: []string{"InvalidArgument", "entitlement value is invalid"}},
+		{name: "unknown key", args: []string{"entitlement", "set", id, "can_fly", "true"}, wantCode: 1, want: []string{"entitlement is not defined"}},
+		{name: "missing value", args: []string{"entitlement", "set", id, "sms_template_review"}, wantCode: 1, want: []string{"accepts 3 arg(s), received 2"}},
+	})
+}
+
+func TestEntitlementUnsetCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("entitlement", "set", id, "sms_template_review", "auto")
+
+	e.runCases([]cmdCase{
+		{name: "unset", args: []string{"entitlement", "unset", id, "sms_template_review"}, want: []string{"manual", "DEFAULT"}, notWant: []string{"auto"}},
+		{name: "resolved", args: []string{"entitlement", "get", id, "sms_template_review"}, want: []string{"manual", "DEFAULT"}},
+		{name: "unknown key", args: []string{"entitlement", "unset", id, "can_fly"}, wantCode: 1, want: []string{"entitlement is not defined"}},
+		{name: "missing key", args: []string{"entitlement", "unset", id}, wantCode: 1, want: []string{"accepts 2 arg(s), received 1"}},
+	})
+}
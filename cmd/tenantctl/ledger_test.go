@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"tenant-service/internal/data"
+)
+
+// sealLedger 将待入链的使用记录入链并生成签名检查点
+func (e *testEnv) sealLedger() {
+	e.t.Helper()
+	if _, err := e.ledger.SequenceRecords(context.Background(), 10); err != nil {
+		e.t.Fatalf("sequence records: %v", err)
+	}
+	if _, err := e.ledger.CreateCheckpoints(context.Background(), 10); err != nil {
+		e.t.Fatalf("create checkpoints: %v", err)
+	}
+}
+
+func TestLedgerVerifyCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	other := e.importTenant(quotaTenantJSONL)
+	e.consumeSMS(id, 30, "order-1")
+	e.consumeSMS(id, 5, "order-2")
+	e.consumeSMS(other, 1, "order-3")
+	e.sealLedger()
+
+	e.runCases([]cmdCase{
+		{name: "intact", args: []string{"ledger", "verify", id}, want: []string{"QUOTA_ID", "OK"}, notWant: []string{"HASH_MISMATCH"}},
+		{name: "yaml", args: []string{"ledger", "verify", id, "-o", "yaml"}, want: []string{"ok: true"}},
+	})
+
+	if err := e.db.Model(&data.QuotaUsageModel{}).Where("biz_id = ?", "order-1").Update("remark", "tampered").Error; err != nil {
+		t.Fatalf("tamper record: %v", err)
+	}
+	e.runCases([]cmdCase{
+		{name: "tampered", args: []string{"ledger", "verify", id}, wantCode: 1, want: []string{"HASH_MISMATCH", "ledger verification of tenant " + id + " found issues"}},
+		{name: "other tenant", args: []string{"ledger", "verify", other}, want: []string{"OK"}, notWant: []string{"HASH_MISMATCH"}},
+		{name: "missing argument", args: []string{"ledger", "verify"}, wantCode: 1, want: []string{"accepts 1 arg(s), received 0"}},
+	})
+}
+
+func TestLedgerCheckpointsCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	e.consumeSMS(id, 30, "order-1")
+	e.sealLedger()
+	otherKey := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("\x09", 32)))
+
+	e.runCases([]cmdCase{
+		{name: "server key", args: []string{"ledger", "checkpoints", id}, want: []string{"CHECKPOINT_ID", "test-key", "VALID"}, notWant: []string{"INVALID"}},
+		{name: "other key", args: []string{"ledger", "checkpoints", id, "--public-key", otherKey}, want: []string{"INVALID"}},
+		{name: "yaml", args: []string{"ledger", "checkpoints", id, "-o", "yaml"}, want: []string{"key_id: test-key", "public_key:"}},
+		{name: "after", args: []string{"ledger", "checkpoints", id, "--after", "1000"}, want: []string{"CHECKPOINT_ID"}, notWant: []string{"test-key"}},
+		{name: "invalid key", args: []string{"ledger", "checkpoints", id, "--public-key", "bm90IGEga2V5"}, wantCode: 1, want: []string{"invalid public key: bm90IGEga2V5"}},
+	})
+}
@@ -0,0 +1,215 @@
+// Command tenantctl is the operator CLI for the tenant service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	ggrpc "google.golang.org/grpc"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// cli 命令行共享状态
+type cli struct {
+	configPath string
+	server     string
+	output     string
+	timeout    time.Duration
+	dialOpts   []ggrpc.DialOption
+
+	cfg     *Config
+	client  pb.TenantClient
+	cleanup func()
+}
+
+// newRootCommand 创建根命令，dialOpts附加到连接服务的选项
+func newRootCommand(dialOpts ...ggrpc.DialOption) *cobra.Command {
+	c := &cli{dialOpts: dialOpts}
+	root := &cobra.Command{
+		Use:           "tenantctl",
+		Short:         "Operator CLI for the tenant service",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return c.init(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if c.cleanup != nil {
+				c.cleanup()
+			}
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&c.configPath, "config", defaultConfigPath(), "config file (env TENANTCTL_CONFIG)")
+	flags.StringVar(&c.server, "server", "", "tenant service gRPC address, overrides config")
+	flags.StringVarP(&c.output, "output", "o", "", "output format: table|json|yaml")
+	flags.DurationVar(&c.timeout, "timeout", 0, "request timeout, overrides config")
+
+	root.AddCommand(
+		newTenantCommand(c),
+		newQuotaCommand(c),
+		newUsageCommand(c),
+		newProductCommand(c),
+		newPlanCommand(c),
+		newWalletCommand(c),
+		newMemberCommand(c),
+		newEntitlementCommand(c),
+		newAuditCommand(c),
+		newLedgerCommand(c),
+		newImportCommand(c),
+		newExportCommand(c),
+	)
+	return root
+}
+
+// init 加载配置并连接服务
+func (c *cli) init(cmd *cobra.Command) error {
+	cfg, err := loadConfig(c.configPath, cmd.Flags().Changed("config"))
+	if err != nil {
+		return err
+	}
+	if c.server != "" {
+		cfg.Server = c.server
+	}
+	if c.output != "" {
+		cfg.Output = c.output
+	}
+	if c.timeout > 0 {
+		cfg.Timeout = c.timeout
+	}
+	switch cfg.Output {
+	case outputTable, outputJSON, outputYAML:
+	default:
+		return fmt.Errorf("unsupported output format: %s", cfg.Output)
+	}
+	c.cfg = cfg
+
+	client, cleanup, err := dial(cmd.Context(), cfg, c.dialOpts...)
+	if err != nil {
+		return err
+	}
+	c.client = client
+	c.cleanup = cleanup
+	return nil
+}
+
+// printer 返回当前输出格式的打印器
+func (c *cli) printer(cmd *cobra.Command) *printer {
+	return &printer{out: cmd.OutOrStdout(), format: c.cfg.Output}
+}
+
+// context 返回带超时的请求上下文
+func (c *cli) context(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(cmd.Context(), c.cfg.Timeout)
+}
+
+// parseTenantType 解析租户类型参数，如 channel
+func parseTenantType(v string) (pb.TenantType, error) {
+	if v == "" {
+		return pb.TenantType_TENANT_TYPE_UNSPECIFIED, nil
+	}
+	t, ok := pb.TenantType_value["TENANT_TYPE_"+strings.ToUpper(v)]
+	if !ok {
+		return 0, fmt.Errorf("invalid tenant type: %s", v)
+	}
+	return pb.TenantType(t), nil
+}
+
+// parseQuotaType 解析配额类型参数，如 redeem_code
+func parseQuotaType(v string) (pb.QuotaType, error) {
+	if v == "" {
+		return pb.QuotaType_QUOTA_TYPE_UNSPECIFIED, nil
+	}
+	t, ok := pb.QuotaType_value["QUOTA_TYPE_"+strings.ToUpper(v)]
+	if !ok {
+		return 0, fmt.Errorf("invalid quota type: %s", v)
+	}
+	return pb.QuotaType(t), nil
+}
+
+// parseLimitType 解析限制类型参数，如 monthly
+func parseLimitType(v string) (pb.LimitType, error) {
+	t, ok := pb.LimitType_value["LIMIT_TYPE_"+strings.ToUpper(v)]
+	if !ok || v == "" {
+		return 0, fmt.Errorf("invalid limit type: %s", v)
+	}
+	return pb.LimitType(t), nil
+}
+
+// parseEnforcementMode 解析配额执行模式参数，如 overage
+func parseEnforcementMode(v string) (pb.EnforcementMode, error) {
+	m, ok := pb.EnforcementMode_value["ENFORCEMENT_MODE_"+strings.ToUpper(v)]
+	if !ok || v == "" {
+		return 0, fmt.Errorf("invalid enforcement mode: %s", v)
+	}
+	return pb.EnforcementMode(m), nil
+}
+
+// parseProrationPolicy 解析已用量折算策略参数，如 carry_over，为空时使用服务端默认策略
+func parseProrationPolicy(v string) (pb.ProrationPolicy, error) {
+	if v == "" {
+		return pb.ProrationPolicy_PRORATION_POLICY_UNSPECIFIED, nil
+	}
+	p, ok := pb.ProrationPolicy_value["PRORATION_POLICY_"+strings.ToUpper(v)]
+	if !ok {
+		return 0, fmt.Errorf("invalid proration policy: %s", v)
+	}
+	return pb.ProrationPolicy(p), nil
+}
+
+// parseQuotaLeaseStatus 解析配额租约状态参数，如 active，为空表示全部
+func parseQuotaLeaseStatus(v string) (pb.QuotaLeaseStatus, error) {
+	if v == "" {
+		return pb.QuotaLeaseStatus_QUOTA_LEASE_STATUS_UNSPECIFIED, nil
+	}
+	st, ok := pb.QuotaLeaseStatus_value["QUOTA_LEASE_STATUS_"+strings.ToUpper(v)]
+	if !ok {
+		return 0, fmt.Errorf("invalid quota lease status: %s", v)
+	}
+	return pb.QuotaLeaseStatus(st), nil
+}
+
+// parseMemberRole 解析成员角色参数，如 admin，为空表示未指定
+func parseMemberRole(v string) (pb.MemberRole, error) {
+	if v == "" {
+		return pb.MemberRole_MEMBER_ROLE_UNSPECIFIED, nil
+	}
+	r, ok := pb.MemberRole_value["MEMBER_ROLE_"+strings.ToUpper(v)]
+	if !ok {
+		return 0, fmt.Errorf("invalid member role: %s", v)
+	}
+	return pb.MemberRole(r), nil
+}
+
+// enumName 去掉枚举前缀，如 TENANT_TYPE_CHANNEL -> CHANNEL
+func enumName(name, prefix string) string {
+	return strings.TrimPrefix(name, prefix)
+}
+
+// run 执行命令并返回进程退出码
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, dialOpts ...ggrpc.DialOption) int {
+	root := newRootCommand(dialOpts...)
+	root.SetArgs(args)
+	root.SetOut(stdout)
+	root.SetErr(stderr)
+	if err := root.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(stderr, "Error:", err)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
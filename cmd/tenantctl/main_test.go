@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/data"
+	"tenant-service/internal/health"
+	"tenant-service/internal/metrics"
+	"tenant-service/internal/service"
+	"tenant-service/pkg/tenantctx"
+)
+
+// testLedgerSeed 测试用的检查点签名密钥种子
+var testLedgerSeed = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, ed25519.SeedSize))
+
+// testEnv 测试环境：bufconn上的租户服务，数据存储为临时SQLite库和miniredis
+type testEnv struct {
+	t      *testing.T
+	lis    *bufconn.Listener
+	db     *gorm.DB
+	config string
+	ledger *biz.LedgerUsecase
+}
+
+// cmdCase 命令测试用例
+type cmdCase struct {
+	name      string
+	args      []string
+	wantCode  int
+	want      []string // 输出（标准输出和错误输出）中应包含的内容
+	notWant   []string // 输出中不应包含的内容
+	wantOrder []string // 输出中应按顺序出现的内容
+}
+
+// newTestEnv 启动测试服务，测试结束时关闭
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	logger := log.NewStdLogger(&bytes.Buffer{})
+
+	dir := t.TempDir()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(dir, "tenant.db")+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"), &gorm.Config{
+		Logger:         gormlogger.Discard,
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(
+		&data.TenantModel{}, &data.ChannelModel{}, &data.TenantLabelModel{}, &data.TenantIDSequenceModel{},
+		&data.ProductModel{}, &data.TenantProductModel{},
+		&data.QuotaModel{}, &data.QuotaUsageModel{}, &data.QuotaShardModel{}, &data.QuotaOverageModel{}, &data.QuotaChangeModel{}, &data.QuotaLeaseModel{},
+		&data.PlanModel{}, &data.PlanQuotaModel{}, &data.PlanEntitlementModel{}, &data.TenantPlanModel{}, &data.QuotaOverrideModel{},
+		&data.WalletModel{}, &data.WalletTransactionModel{}, &data.WalletLedgerEntryModel{},
+		&data.MemberModel{}, &data.InvitationModel{}, &data.EntitlementModel{},
+		&data.AuditEventModel{}, &data.LedgerHeadModel{}, &data.LedgerCheckpointModel{},
+		&data.UsageDailyModel{}, &data.UsageRollupStateModel{}, &data.SchemaMigrationModel{},
+	); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	checker := health.NewChecker()
+	d, cleanup, err := data.NewData(db, rdb, checker, logger)
+	if err != nil {
+		t.Fatalf("new data: %v", err)
+	}
+	t.Cleanup(cleanup)
+
+	tenantConf := &conf.Tenant{
+		Wallet: &conf.Tenant_Wallet{Prices: []*conf.Tenant_Wallet_Price{{QuotaType: "sms", UnitPrice: 5}}},
+		Entitlements: []*conf.Tenant_Entitlement{
+			{Key: "can_create_lucky_draw"},
+			{Key: "sms_template_review", Type: "enum", Values: []string{"manual", "auto"}, DefaultValue: "manual"},
+		},
+		Ledger: &conf.Tenant_Ledger{SigningKey: testLedgerSeed, KeyId: "test-key"},
+	}
+	metricsConf := &conf.Metrics{}
+	meter := metricnoop.NewMeterProvider().Meter("tenantctl-test")
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("wire service: %v", err)
+		}
+	}
+
+	tenantRepo := data.NewTenantRepo(d, logger)
+	idGen, err := data.NewTenantIDGenerator(tenantConf, d, logger)
+	must(err)
+	tx := data.NewTransaction(d)
+	audit := biz.NewAuditUsecase(data.NewAuditRepo(d, logger), tx, logger)
+	tenants, err := biz.NewTenantUsecase(tenantConf, tenantRepo, idGen, audit, logger)
+	must(err)
+	quotaMetrics, err := metrics.NewQuotaMetrics(metricsConf, meter)
+	must(err)
+	quotaRepo := data.NewQuotaRepo(d, quotaMetrics, logger)
+	quotas := biz.NewQuotaUsecase(quotaRepo, quotaMetrics, audit, logger)
+	productRepo := data.NewProductRepo(d, logger)
+	products := biz.NewProductUsecase(productRepo, audit, logger)
+	transfer := biz.NewTenantTransferUsecase(tenantRepo, productRepo, quotaRepo, quotas, idGen, audit, logger)
+	usage := biz.NewUsageReportUsecase(data.NewUsageReportRepo(d, logger), quotaRepo, logger)
+	planRepo := data.NewPlanRepo(d, logger)
+	catalog, err := biz.NewEntitlementCatalog(tenantConf)
+	must(err)
+	plans := biz.NewPlanUsecase(planRepo, tenantRepo, catalog, audit, logger)
+	changes, err := biz.NewQuotaChangeUsecase(tenantConf, data.NewQuotaChangeRepo(d, logger), quotaRepo, planRepo, quotaMetrics, audit, logger)
+	must(err)
+	walletMetrics, err := metrics.NewWalletMetrics(metricsConf, meter)
+	must(err)
+	wallets, err := biz.NewWalletUsecase(tenantConf, data.NewWalletRepo(d, walletMetrics, logger), tenantRepo, walletMetrics, audit, logger)
+	must(err)
+	leases := biz.NewQuotaLeaseUsecase(tenantConf, data.NewQuotaLeaseRepo(d, logger), quotaRepo, tx, quotaMetrics, logger)
+	members := biz.NewMemberUsecase(tenantConf, data.NewMemberRepo(d, logger), tenantRepo, audit, logger)
+	entitlements := biz.NewEntitlementUsecase(data.NewEntitlementRepo(d, logger), tenantRepo, catalog, audit, logger)
+	ledgerMetrics, err := metrics.NewLedgerMetrics(meter)
+	must(err)
+	ledger, err := biz.NewLedgerUsecase(tenantConf, data.NewLedgerRepo(d, logger), ledgerMetrics, logger)
+	must(err)
+
+	proxies, err := service.NewTrustedProxies(&conf.Server{})
+	must(err)
+	svc := service.NewTenantService(tenants, quotas, products, transfer, usage, plans, changes, wallets, leases, members, entitlements, audit, ledger, proxies, logger)
+
+	// 与服务端相同的租户上下文和审计中间件，监听bufconn
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.Listener(lis),
+		grpc.Endpoint(&url.URL{Scheme: "grpc", Host: "bufnet"}),
+		grpc.Middleware(
+			recovery.Recovery(),
+			validate.Validator(),
+			tenantctx.Server(service.NewTenantResolver(tenants), tenantctx.WithOptional()),
+			service.NewAuditMiddleware(proxies),
+		),
+	)
+	pb.RegisterTenantServer(srv, svc)
+	go func() { _ = srv.Start(context.Background()) }()
+	t.Cleanup(func() { _ = srv.Stop(context.Background()) })
+
+	config := filepath.Join(dir, "tenantctl.yaml")
+	if err := os.WriteFile(config, []byte("server: bufnet\ntimeout: 10s\noperator: ops-test\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return &testEnv{t: t, lis: lis, db: db, config: config, ledger: ledger}
+}
+
+// run 执行tenantctl命令，返回退出码和输出
+func (e *testEnv) run(args ...string) (int, string) {
+	e.t.Helper()
+	var out bytes.Buffer
+	dialer := ggrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return e.lis.DialContext(ctx)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	code := run(ctx, append([]string{"--config", e.config}, args...), &out, &out, dialer)
+	return code, out.String()
+}
+
+// mustRun 执行准备数据的命令，失败时终止测试
+func (e *testEnv) mustRun(args ...string) string {
+	e.t.Helper()
+	code, out := e.run(args...)
+	if code != 0 {
+		e.t.Fatalf("tenantctl %s: exit %d\n%s", strings.Join(args, " "), code, out)
+	}
+	return out
+}
+
+// createTenant 以tenant create创建租户，返回租户ID
+func (e *testEnv) createTenant(args ...string) string {
+	e.t.Helper()
+	out := e.mustRun(append([]string{"tenant", "create", "-o", "json"}, args...)...)
+	var reply struct {
+		Tenant struct {
+			TenantID string `json:"tenant_id"`
+		} `json:"tenant"`
+	}
+	if err := json.Unmarshal([]byte(out), &reply); err != nil || reply.Tenant.TenantID == "" {
+		e.t.Fatalf("parse tenant create output: %v\n%s", err, out)
+	}
+	return reply.Tenant.TenantID
+}
+
+// client 直接调用服务的客户端，准备命令行没有提供的数据，如消费配额和钱包扣费
+func (e *testEnv) client() pb.TenantClient {
+	e.t.Helper()
+	conn, err := ggrpc.NewClient("passthrough:///bufnet",
+		ggrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return e.lis.DialContext(ctx)
+		}),
+		ggrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		e.t.Fatalf("dial bufnet: %v", err)
+	}
+	e.t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewTenantClient(conn)
+}
+
+// importTenant 以import导入一行JSONL租户数据，返回租户ID
+func (e *testEnv) importTenant(line string) string {
+	e.t.Helper()
+	file := e.writeFile("tenant.jsonl", line+"\n")
+	out := e.mustRun("import", file, "-o", "json")
+	var reply struct {
+		Results []struct {
+			TenantID string `json:"tenant_id"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(out), &reply); err != nil || len(reply.Results) != 1 {
+		e.t.Fatalf("parse import output: %v\n%s", err, out)
+	}
+	return reply.Results[0].TenantID
+}
+
+// writeFile 在临时目录写入文件，返回路径
+func (e *testEnv) writeFile(name, content string) string {
+	e.t.Helper()
+	path := filepath.Join(e.t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		e.t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+// runCases 逐个执行用例，核对退出码和输出
+func (e *testEnv) runCases(cases []cmdCase) {
+	e.t.Helper()
+	for _, tc := range cases {
+		e.t.Run(tc.name, func(t *testing.T) {
+			code, out := e.run(tc.args...)
+			if code != tc.wantCode {
+				t.Fatalf("exit code = %d, want %d\n%s", code, tc.wantCode, out)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %q\n%s", want, out)
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(out, notWant) {
+					t.Errorf("output contains %q\n%s", notWant, out)
+				}
+			}
+			rest := out
+			for _, want := range tc.wantOrder {
+				i := strings.Index(rest, want)
+				if i < 0 {
+					t.Errorf("output does not contain %q in order %q\n%s", want, tc.wantOrder, out)
+					break
+				}
+				rest = rest[i+len(want):]
+			}
+		})
+	}
+}
+
+// jsonInt64 取JSON输出中列表首个元素的整数字段，int64字段在JSON中为字符串
+func jsonInt64(t *testing.T, out, list, field string) int64 {
+	t.Helper()
+	var reply map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &reply); err != nil {
+		t.Fatalf("parse json output: %v\n%s", err, out)
+	}
+	items, _ := reply[list].([]interface{})
+	if len(items) == 0 {
+		t.Fatalf("json output has no %s\n%s", list, out)
+	}
+	item, _ := items[0].(map[string]interface{})
+	switch v := item[field].(type) {
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			t.Fatalf("parse %s.%s: %v", list, field, err)
+		}
+		return n
+	case float64:
+		return int64(v)
+	default:
+		t.Fatalf("json output has no %s.%s\n%s", list, field, out)
+		return 0
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newMemberCommand 租户成员管理命令
+func newMemberCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "Manage tenant members and invitations",
+	}
+	cmd.AddCommand(
+		newMemberListCommand(c),
+		newMemberAddCommand(c),
+		newMemberRemoveCommand(c),
+		newMemberInviteCommand(c),
+		newMemberCheckCommand(c),
+	)
+	return cmd
+}
+
+// memberTable 成员表格
+func memberTable(members ...*pb.TenantMember) *table {
+	t := newTable("TENANT_ID", "USER_ID", "ROLE", "INHERIT", "NAME", "EMAIL", "CREATED_AT")
+	for _, member := range members {
+		t.add(member.GetTenantId(), member.GetUserId(), enumName(member.GetRole().String(), "MEMBER_ROLE_"),
+			member.GetInherit(), member.GetDisplayName(), member.GetEmail(), member.GetCreatedAt())
+	}
+	return t
+}
+
+// newMemberListCommand member list
+func newMemberListCommand(c *cli) *cobra.Command {
+	var role string
+	var inherited bool
+
+	cmd := &cobra.Command{
+		Use:   "list TENANT_ID",
+		Short: "List members of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r, err := parseMemberRole(role)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListTenantMembers(ctx, &pb.ListTenantMembersRequest{
+				TenantId:         args[0],
+				Role:             r,
+				IncludeInherited: inherited,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return memberTable(reply.GetMembers()...) })
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&role, "role", "", "role filter: owner|admin|operator|viewer")
+	flags.BoolVar(&inherited, "inherited", false, "include members inherited from parent tenants")
+	return cmd
+}
+
+// newMemberAddCommand member add
+func newMemberAddCommand(c *cli) *cobra.Command {
+	var role, name, email string
+	var inherit bool
+
+	cmd := &cobra.Command{
+		Use:   "add TENANT_ID USER_ID",
+		Short: "Add a member to a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r, err := parseMemberRole(role)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.AddTenantMember(ctx, &pb.AddTenantMemberRequest{
+				TenantId:    args[0],
+				UserId:      args[1],
+				Role:        r,
+				DisplayName: name,
+				Email:       email,
+				Inherit:     inherit,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return memberTable(reply.GetMember()) })
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&role, "role", "", "role: owner|admin|operator|viewer")
+	flags.StringVar(&name, "name", "", "display name")
+	flags.StringVar(&email, "email", "", "email")
+	flags.BoolVar(&inherit, "inherit", false, "apply the role to child tenants")
+	_ = cmd.MarkFlagRequired("role")
+	return cmd
+}
+
+// newMemberRemoveCommand member remove
+func newMemberRemoveCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove TENANT_ID USER_ID",
+		Short: "Remove a member from a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.RemoveTenantMember(ctx, &pb.RemoveTenantMemberRequest{TenantId: args[0], UserId: args[1]})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("TENANT_ID", "USER_ID", "SUCCESS")
+				t.add(args[0], args[1], reply.GetSuccess())
+				return t
+			})
+		},
+	}
+}
+
+// newMemberInviteCommand member invite
+func newMemberInviteCommand(c *cli) *cobra.Command {
+	var role, invitedBy string
+	var inherit bool
+
+	cmd := &cobra.Command{
+		Use:   "invite TENANT_ID EMAIL",
+		Short: "Invite a user to a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r, err := parseMemberRole(role)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.CreateTenantInvitation(ctx, &pb.CreateTenantInvitationRequest{
+				TenantId:  args[0],
+				Email:     args[1],
+				Role:      r,
+				Inherit:   inherit,
+				InvitedBy: invitedBy,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				invitation := reply.GetInvitation()
+				t := newTable("INVITATION_ID", "EMAIL", "ROLE", "EXPIRE_TIME", "TOKEN")
+				t.add(invitation.GetInvitationId(), invitation.GetEmail(), enumName(invitation.GetRole().String(), "MEMBER_ROLE_"),
+					invitation.GetExpireTime(), reply.GetToken())
+				return t
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&role, "role", "viewer", "role after accepting: owner|admin|operator|viewer")
+	flags.StringVar(&invitedBy, "invited-by", "", "inviter user ID")
+	flags.BoolVar(&inherit, "inherit", false, "apply the role to child tenants")
+	return cmd
+}
+
+// newMemberCheckCommand member check
+func newMemberCheckCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check TENANT_ID USER_ID PERMISSION",
+		Short: "Check whether a user has a permission on a tenant",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.CheckPermission(ctx, &pb.CheckPermissionRequest{TenantId: args[0], UserId: args[1], Permission: args[2]})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("ALLOWED", "ROLE", "SOURCE_TENANT", "INHERITED", "REASON", "PERMISSIONS")
+				t.add(reply.GetAllowed(), enumName(reply.GetRole().String(), "MEMBER_ROLE_"), reply.GetSourceTenantId(),
+					reply.GetInherited(), reply.GetReason(), strings.Join(reply.GetPermissions(), ","))
+				return t
+			})
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import "testing"
+
+func TestMemberListCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("member", "add", id, "u1", "--role", "admin", "--name", "Alice")
+	e.mustRun("member", "add", id, "u2", "--role", "viewer", "--name", "Bob")
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"member", "list", id}, want: []string{"u1", "ADMIN", "Alice", "u2", "VIEWER", "Bob"}},
+		{name: "by role", args: []string{"member", "list", id, "--role", "viewer"}, want: []string{"u2"}, notWant: []string{"u1"}},
+		{name: "yaml", args: []string{"member", "list", id, "--role", "admin", "-o", "yaml"}, want: []string{"user_id: u1", "role: MEMBER_ROLE_ADMIN"}},
+		{name: "invalid role", args: []string{"member", "list", id, "--role", "boss"}, wantCode: 1, want: []string{"invalid member role: boss"}},
+	})
+}
+
+func TestMemberAddCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "add", args: []string{"member", "add", id, "u1", "--role", "admin", "--name", "Alice", "--email", "a@x.com"}, want: []string{"u1", "ADMIN", "Alice", "a@x.com"}},
+		{name: "listed", args: []string{"member", "list", id}, want: []string{"u1"}},
+		{name: "already member", args: []string{"member", "add", id, "u1", "--role", "viewer"}, wantCode: 1, want: []string{"Aborted", "user is already a member of the tenant"}},
+		{name: "invalid role", args: []string{"member", "add", id, "u2", "--role", "boss"}, wantCode: 1, want: []string{"invalid member role: boss"}},
+		{name: "missing role", args: []string{"member", "add", id, "u2"}, wantCode: 1, want: []string{`required flag(s) "role" not set`}},
+	})
+}
+
+func TestMemberRemoveCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("member", "add", id, "u1", "--role", "admin")
+
+	e.runCases([]cmdCase{
+		{name: "remove", args: []string{"member", "remove", id, "u1"}, want: []string{"true"}},
+		{name: "gone", args: []string{"member", "list", id}, notWant: []string{"u1"}},
+		{name: "not a member", args: []string{"member", "remove", id, "u1"}, wantCode: 1, want: []string{"NotFound", "tenant member not found"}},
+		{name: "missing user", args: []string{"member", "remove", id}, wantCode: 1, want: []string{"accepts 2 arg(s), received 1"}},
+	})
+}
+
+func TestMemberInviteCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "invite", args: []string{"member", "invite", id, "bob@x.com", "--role", "operator"}, want: []string{"inv_", "bob@x.com", "OPERATOR"}},
+		{name: "default role", args: []string{"member", "invite", id, "carol@x.com", "-o", "yaml"}, want: []string{"email: carol@x.com", "role: MEMBER_ROLE_VIEWER", "token:"}},
+		{name: "invalid role", args: []string{"member", "invite", id, "dan@x.com", "--role", "boss"}, wantCode: 1, want: []string{"invalid member role: boss"}},
+	})
+}
+
+func TestMemberCheckCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("member", "add", id, "u1", "--role", "admin")
+	e.mustRun("member", "add", id, "u2", "--role", "viewer")
+
+	e.runCases([]cmdCase{
+		{name: "allowed", args: []string{"member", "check", id, "u1", "quota.adjust"}, want: []string{"ALLOWED", "true", "ADMIN", "quota.adjust"}},
+		{name: "denied by role", args: []string{"member", "check", id, "u2", "quota.adjust", "-o", "yaml"}, want: []string{"allowed: false", "role: MEMBER_ROLE_VIEWER"}},
+		{name: "not a member", args: []string{"member", "check", id, "u9", "quota.adjust"}, want: []string{"false", "user is not a member of the tenant"}},
+		{name: "missing permission", args: []string{"member", "check", id, "u1"}, wantCode: 1, want: []string{"accepts 3 arg(s), received 2"}},
+	})
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// 输出格式
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// table 表格输出
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// newTable 创建表格
+func newTable(header ...string) *table {
+	return &table{header: header}
+}
+
+// add 添加一行
+func (t *table) add(values ...interface{}) {
+	row := make([]string, 0, len(values))
+	for _, v := range values {
+		row = append(row, fmt.Sprint(v))
+	}
+	t.rows = append(t.rows, row)
+}
+
+// write 写出表格
+func (t *table) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// printer 按输出格式打印结果
+type printer struct {
+	out    io.Writer
+	format string
+}
+
+// print 打印proto消息，table格式下使用tbl
+func (p *printer) print(msg proto.Message, tbl func() *table) error {
+	switch p.format {
+	case outputJSON, outputYAML:
+		content, err := protojson.MarshalOptions{
+			Multiline:       p.format == outputJSON,
+			Indent:          "  ",
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		}.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if p.format == outputJSON {
+			_, err = fmt.Fprintln(p.out, string(content))
+			return err
+		}
+
+		var v interface{}
+		if err := json.Unmarshal(content, &v); err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(p.out)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		return enc.Close()
+	case outputTable, "":
+		return tbl().write(p.out)
+	default:
+		return fmt.Errorf("unsupported output format: %s", p.format)
+	}
+}
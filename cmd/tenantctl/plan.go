@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newPlanCommand 配额套餐命令
+func newPlanCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Inspect quota plans and assign them to tenants",
+	}
+	cmd.AddCommand(
+		newPlanListCommand(c),
+		newPlanShowCommand(c),
+		newPlanAssignCommand(c),
+	)
+	return cmd
+}
+
+// newPlanListCommand plan list
+func newPlanListCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List quota plans",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListPlans(ctx, &pb.ListPlansRequest{})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("PLAN_CODE", "PLAN_NAME", "VERSION", "QUOTAS", "ENTITLEMENTS", "UPDATED_AT")
+				for _, p := range reply.GetPlans() {
+					t.add(p.GetPlanCode(), p.GetPlanName(), p.GetVersion(), len(p.GetQuotas()), len(p.GetEntitlements()), p.GetUpdatedAt())
+				}
+				return t
+			})
+		},
+	}
+}
+
+// newPlanShowCommand plan show
+func newPlanShowCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show PLAN_CODE",
+		Short: "Show quota definitions of a plan",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.GetPlan(ctx, &pb.GetPlanRequest{PlanCode: args[0]})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("QUOTA_TYPE", "LIMIT_TYPE", "SOFT_LIMIT", "HARD_LIMIT", "PRODUCTS")
+				for _, q := range reply.GetPlan().GetQuotas() {
+					t.add(enumName(q.GetQuotaType().String(), "QUOTA_TYPE_"), enumName(q.GetLimitType().String(), "LIMIT_TYPE_"),
+						q.GetSoftLimit(), q.GetHardLimit(), fmt.Sprint(q.GetProductCodes()))
+				}
+				return t
+			})
+		},
+	}
+}
+
+// newPlanAssignCommand plan assign
+func newPlanAssignCommand(c *cli) *cobra.Command {
+	var clearOverrides bool
+	var proration string
+
+	cmd := &cobra.Command{
+		Use:   "assign TENANT_ID PLAN_CODE",
+		Short: "Provision or replace tenant quotas from a plan",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policy, err := parseProrationPolicy(proration)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.AssignPlan(ctx, &pb.AssignPlanRequest{
+				TenantId:       args[0],
+				PlanCode:       args[1],
+				Operator:       c.cfg.Operator,
+				ClearOverrides: clearOverrides,
+				Proration:      policy,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return quotaTable(reply.GetQuotas()...) })
+		},
+	}
+	cmd.Flags().BoolVar(&clearOverrides, "clear-overrides", false, "drop tenant overrides and apply the plan as defined")
+	cmd.Flags().StringVar(&proration, "proration", "", "used count when hard limit changes: carry_over|scale|reset")
+	return cmd
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// saveBasicPlan 保存包含短信月配额和抽奖授权的basic套餐
+func (e *testEnv) saveBasicPlan() {
+	e.t.Helper()
+	if _, err := e.client().SavePlan(context.Background(), &pb.SavePlanRequest{
+		PlanCode: "basic",
+		PlanName: "Basic Plan",
+		Quotas: []*pb.PlanQuota{{
+			QuotaType: pb.QuotaType_QUOTA_TYPE_SMS,
+			LimitType: pb.LimitType_LIMIT_TYPE_MONTHLY,
+			HardLimit: 1000,
+			SoftLimit: 800,
+		}},
+		Entitlements: []*pb.PlanEntitlement{{Key: "can_create_lucky_draw", Value: "true"}},
+	}); err != nil {
+		e.t.Fatalf("save plan: %v", err)
+	}
+}
+
+func TestPlanListCommand(t *testing.T) {
+	e := newTestEnv(t)
+
+	e.runCases([]cmdCase{
+		{name: "empty", args: []string{"plan", "list"}, want: []string{"PLAN_CODE"}, notWant: []string{"basic"}},
+	})
+	e.saveBasicPlan()
+	e.runCases([]cmdCase{
+		{name: "table", args: []string{"plan", "list"}, want: []string{"basic", "Basic Plan", "1"}},
+		{name: "yaml", args: []string{"plan", "list", "-o", "yaml"}, want: []string{"plan_code: basic", "hard_limit: 1000"}},
+		{name: "unexpected argument", args: []string{"plan", "list", "basic"}, wantCode: 1, want: []string{`unknown command "basic"`}},
+	})
+}
+
+func TestPlanShowCommand(t *testing.T) {
+	e := newTestEnv(t)
+	e.saveBasicPlan()
+
+	e.runCases([]cmdCase{
+		{name: "table", args: []string{"plan", "show", "basic"}, want: []string{"QUOTA_TYPE", "SMS", "MONTHLY", "800", "1000"}},
+		{name: "yaml", args: []string{"plan", "show", "basic", "-o", "yaml"}, want: []string{"key: can_create_lucky_draw", "plan_name: Basic Plan"}},
+		{name: "not found", args: []string{"plan", "show", "gold"}, wantCode: 1, want: []string{"NotFound", "plan not found"}},
+		{name: "missing argument", args: []string{"plan", "show"}, wantCode: 1, want: []string{"accepts 1 arg(s), received 0"}},
+	})
+}
+
+func TestPlanAssignCommand(t *testing.T) {
+	e := newTestEnv(t)
+	e.saveBasicPlan()
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "assign", args: []string{"plan", "assign", id, "basic"}, want: []string{"SMS", "MONTHLY", "800", "1000", "basic"}},
+		{name: "quotas provisioned", args: []string{"quota", "show", id}, want: []string{"SMS", "1000", "basic"}},
+		{name: "entitlements granted", args: []string{"entitlement", "get", id, "can_create_lucky_draw"}, want: []string{"true", "PLAN", "basic"}},
+		{name: "reassign with proration", args: []string{"plan", "assign", id, "basic", "--proration", "reset", "--clear-overrides"}, want: []string{"basic"}},
+		{name: "unknown plan", args: []string{"plan", "assign", id, "gold"}, wantCode: 1, want: []string{"NotFound", "plan not found"}},
+		{name: "invalid proration", args: []string{"plan", "assign", id, "basic", "--proration", "double"}, wantCode: 1, want: []string{"invalid proration policy: double"}},
+	})
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newProductCommand 产品线管理命令
+func newProductCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "product",
+		Short: "Manage tenant product lines",
+	}
+	cmd.AddCommand(newProductBindCommand(c))
+	return cmd
+}
+
+// newProductBindCommand product bind
+func newProductBindCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bind TENANT_ID PRODUCT_CODE",
+		Short: "Bind a product line to a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.BindProduct(ctx, &pb.BindProductRequest{TenantId: args[0], ProductCode: args[1]})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("TENANT_ID", "PRODUCT_CODE", "SUCCESS")
+				t.add(args[0], args[1], reply.GetSuccess())
+				return t
+			})
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+
+	"tenant-service/internal/data"
+)
+
+func TestProductBindCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	if err := e.db.Create(&data.ProductModel{ProductCode: "app_mall", ProductName: "Mall"}).Error; err != nil {
+		t.Fatalf("create product: %v", err)
+	}
+
+	e.runCases([]cmdCase{
+		{name: "bind", args: []string{"product", "bind", id, "app_mall"}, want: []string{"PRODUCT_CODE", id, "app_mall", "true"}},
+		{name: "already bound", args: []string{"product", "bind", id, "app_mall", "-o", "yaml"}, want: []string{"success: true"}},
+		{name: "audited", args: []string{"audit", "list", "--action", "product.bind"}, want: []string{id + "/app_mall"}},
+		{name: "unknown product", args: []string{"product", "bind", id, "app_game"}, wantCode: 1, want: []string{"product not found: app_game"}},
+		{name: "missing product", args: []string{"product", "bind", id}, wantCode: 1, want: []string{"accepts 2 arg(s), received 1"}},
+	})
+}
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newQuotaCommand 配额管理命令
+func newQuotaCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota",
+		Short: "Inspect and adjust tenant quotas",
+	}
+	cmd.AddCommand(
+		newQuotaShowCommand(c),
+		newQuotaAdjustCommand(c),
+		newQuotaResetCommand(c),
+		newQuotaChangesCommand(c),
+		newQuotaOveragesCommand(c),
+		newQuotaExplainCommand(c),
+		newQuotaLeasesCommand(c),
+	)
+	return cmd
+}
+
+// quotaTable 配额表格
+func quotaTable(quotas ...*pb.QuotaInfo) *table {
+	t := newTable("QUOTA_ID", "QUOTA_TYPE", "LIMIT_TYPE", "USED", "SOFT_LIMIT", "HARD_LIMIT", "NEXT_RESET", "PRODUCTS", "PLAN")
+	for _, q := range quotas {
+		products := "*"
+		if !q.GetIsGlobal() {
+			products = strings.Join(q.GetProductCodes(), ",")
+		}
+		// 有产品线划分额度时展示为 产品线(已用/额度)
+		if len(q.GetAllocations()) > 0 {
+			slices := make([]string, 0, len(q.GetAllocations())+1)
+			for _, a := range q.GetAllocations() {
+				slices = append(slices, fmt.Sprintf("%s(%d/%d)", a.GetProductCode(), a.GetUsedCount(), a.GetLimit()))
+			}
+			products += " " + strings.Join(append(slices, fmt.Sprintf("shared(%d/%d)", q.GetSharedUsed(), q.GetSharedLimit())), ",")
+		}
+		t.add(q.GetQuotaId(), enumName(q.GetQuotaType().String(), "QUOTA_TYPE_"), enumName(q.GetLimitType().String(), "LIMIT_TYPE_"),
+			q.GetUsedCount(), q.GetSoftLimit(), q.GetHardLimit(), q.GetNextResetTime(), products, q.GetPlanCode())
+	}
+	return t
+}
+
+// newQuotaShowCommand quota show
+func newQuotaShowCommand(c *cli) *cobra.Command {
+	var quotaType string
+
+	cmd := &cobra.Command{
+		Use:   "show TENANT_ID",
+		Short: "Show quotas of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListQuotas(ctx, &pb.ListQuotasRequest{TenantId: args[0], QuotaType: qt})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return quotaTable(reply.GetQuotas()...) })
+		},
+	}
+	cmd.Flags().StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	return cmd
+}
+
+// newQuotaAdjustCommand quota adjust
+func newQuotaAdjustCommand(c *cli) *cobra.Command {
+	var quotaType, limitType, remark, extraConfig, enforcementMode string
+	var hardLimit, softLimit, usedCount, maxOverage int32
+
+	cmd := &cobra.Command{
+		Use:   "adjust TENANT_ID",
+		Short: "Adjust limits or used count of a quota",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+			lt, err := parseLimitType(limitType)
+			if err != nil {
+				return err
+			}
+
+			req := &pb.AdjustQuotaRequest{
+				TenantId:  args[0],
+				QuotaType: qt,
+				LimitType: lt,
+				Operator:  c.cfg.Operator,
+				Remark:    remark,
+			}
+			flags := cmd.Flags()
+			if flags.Changed("hard-limit") {
+				req.HardLimit = ptr(hardLimit)
+			}
+			if flags.Changed("soft-limit") {
+				req.SoftLimit = ptr(softLimit)
+			}
+			if flags.Changed("used") {
+				req.UsedCount = ptr(usedCount)
+			}
+			if flags.Changed("extra-config") {
+				req.ExtraConfig = ptr(extraConfig)
+			}
+			if flags.Changed("enforcement-mode") {
+				mode, err := parseEnforcementMode(enforcementMode)
+				if err != nil {
+					return err
+				}
+				req.EnforcementMode = ptr(mode)
+			}
+			if flags.Changed("max-overage") {
+				req.MaxOverage = ptr(maxOverage)
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.AdjustQuota(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return quotaTable(reply.GetQuota()) })
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	flags.StringVar(&limitType, "limit-type", "", "limit type: daily|monthly|total|concurrent")
+	flags.Int32Var(&hardLimit, "hard-limit", 0, "new hard limit")
+	flags.Int32Var(&softLimit, "soft-limit", 0, "new soft limit")
+	flags.Int32Var(&usedCount, "used", 0, "new used count")
+	flags.StringVar(&enforcementMode, "enforcement-mode", "", "behavior past hard limit: hard|soft_only|overage")
+	flags.Int32Var(&maxOverage, "max-overage", 0, "max units past hard limit in overage mode, 0 means unlimited")
+	flags.StringVar(&extraConfig, "extra-config", "", `extra config JSON, e.g. {"rollover":{"max_percent":20,"expire_days":15}}`)
+	flags.StringVar(&remark, "remark", "", "remark recorded in usage records")
+	_ = cmd.MarkFlagRequired("quota-type")
+	_ = cmd.MarkFlagRequired("limit-type")
+	return cmd
+}
+
+// newQuotaResetCommand quota reset
+func newQuotaResetCommand(c *cli) *cobra.Command {
+	var quotaType, limitType, remark string
+
+	cmd := &cobra.Command{
+		Use:   "reset TENANT_ID",
+		Short: "Reset used count of a quota to zero",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+			lt, err := parseLimitType(limitType)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ResetQuota(ctx, &pb.ResetQuotaRequest{
+				TenantId:  args[0],
+				QuotaType: qt,
+				LimitType: lt,
+				Operator:  c.cfg.Operator,
+				Remark:    remark,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return quotaTable(reply.GetQuota()) })
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	flags.StringVar(&limitType, "limit-type", "", "limit type: daily|monthly|total|concurrent")
+	flags.StringVar(&remark, "remark", "", "remark recorded in usage records")
+	_ = cmd.MarkFlagRequired("quota-type")
+	_ = cmd.MarkFlagRequired("limit-type")
+	return cmd
+}
+
+// newQuotaChangesCommand quota changes
+func newQuotaChangesCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "changes TENANT_ID",
+		Short: "List scheduled quota changes of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListQuotaChanges(ctx, &pb.ListQuotaChangesRequest{TenantId: args[0]})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("CHANGE_ID", "QUOTA_TYPE", "LIMIT_TYPE", "HARD_LIMIT", "SOFT_LIMIT", "PLAN", "APPLY_AT", "PRORATION", "STATUS")
+				for _, ch := range reply.GetChanges() {
+					hard, soft := "-", "-"
+					if ch.HardLimit != nil {
+						hard = strconv.Itoa(int(ch.GetHardLimit()))
+					}
+					if ch.SoftLimit != nil {
+						soft = strconv.Itoa(int(ch.GetSoftLimit()))
+					}
+					t.add(ch.GetChangeId(), enumName(ch.GetQuotaType().String(), "QUOTA_TYPE_"), enumName(ch.GetLimitType().String(), "LIMIT_TYPE_"),
+						hard, soft, ch.GetPlanCode(), ch.GetApplyAt(), enumName(ch.GetProration().String(), "PRORATION_POLICY_"),
+						enumName(ch.GetStatus().String(), "QUOTA_CHANGE_STATUS_"))
+				}
+				return t
+			})
+		},
+	}
+}
+
+// newQuotaOveragesCommand quota overages
+func newQuotaOveragesCommand(c *cli) *cobra.Command {
+	var quotaType, startDate, endDate string
+
+	cmd := &cobra.Command{
+		Use:   "overages [TENANT_ID]",
+		Short: "List billable overage per tenant and period",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+			req := &pb.ListOveragesRequest{QuotaType: qt, StartDate: startDate, EndDate: endDate}
+			if len(args) > 0 {
+				req.TenantId = args[0]
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListOverages(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("TENANT_ID", "QUOTA_ID", "QUOTA_TYPE", "LIMIT_TYPE", "PERIOD_START", "PERIOD_END", "OVERAGE")
+				for _, o := range reply.GetOverages() {
+					t.add(o.GetTenantId(), o.GetQuotaId(), enumName(o.GetQuotaType().String(), "QUOTA_TYPE_"), enumName(o.GetLimitType().String(), "LIMIT_TYPE_"),
+						o.GetPeriodStart(), o.GetPeriodEnd(), o.GetOverage())
+				}
+				return t
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	flags.StringVar(&startDate, "start", "", "earliest period start date YYYY-MM-DD")
+	flags.StringVar(&endDate, "end", "", "latest period start date YYYY-MM-DD")
+	return cmd
+}
+
+// newQuotaExplainCommand quota explain
+func newQuotaExplainCommand(c *cli) *cobra.Command {
+	var quotaType, limitType, productCode string
+
+	cmd := &cobra.Command{
+		Use:   "explain TENANT_ID",
+		Short: "Explain which quota a tenant resolves to and why",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+			lt, err := parseLimitType(limitType)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ExplainQuota(ctx, &pb.ExplainQuotaRequest{
+				TenantId:    args[0],
+				QuotaType:   qt,
+				LimitType:   lt,
+				ProductCode: productCode,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("SELECTED", "QUOTA_ID", "TENANT_ID", "LEVEL", "DEPTH", "PRODUCTS", "REASON")
+				for _, candidate := range reply.GetCandidates() {
+					selected := ""
+					if candidate.GetSelected() {
+						selected = "*"
+					}
+					q := candidate.GetQuota()
+					t.add(selected, q.GetQuotaId(), q.GetTenantId(), enumName(candidate.GetLevel().String(), "QUOTA_MATCH_LEVEL_"),
+						candidate.GetDepth(), strings.Join(q.GetProductCodes(), ","), candidate.GetReason())
+				}
+				return t
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	flags.StringVar(&limitType, "limit-type", "", "limit type: daily|monthly|total|concurrent")
+	flags.StringVar(&productCode, "product", "", "product code, empty matches any product")
+	_ = cmd.MarkFlagRequired("quota-type")
+	_ = cmd.MarkFlagRequired("limit-type")
+	return cmd
+}
+
+// newQuotaLeasesCommand quota leases
+func newQuotaLeasesCommand(c *cli) *cobra.Command {
+	var quotaType, status string
+
+	cmd := &cobra.Command{
+		Use:   "leases TENANT_ID",
+		Short: "List quota blocks leased to client services",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+			st, err := parseQuotaLeaseStatus(status)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListQuotaLeases(ctx, &pb.ListQuotaLeasesRequest{TenantId: args[0], QuotaType: qt, Status: st})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table {
+				t := newTable("LEASE_ID", "QUOTA_ID", "QUOTA_TYPE", "LIMIT_TYPE", "PRODUCT", "HOLDER", "AMOUNT", "USED", "RETURNED", "STATUS", "EXPIRE_TIME")
+				for _, l := range reply.GetLeases() {
+					t.add(l.GetLeaseId(), l.GetQuotaId(), enumName(l.GetQuotaType().String(), "QUOTA_TYPE_"), enumName(l.GetLimitType().String(), "LIMIT_TYPE_"),
+						l.GetProductCode(), l.GetHolder(), l.GetAmount(), l.GetUsedCount(), l.GetReturned(),
+						enumName(l.GetStatus().String(), "QUOTA_LEASE_STATUS_"), l.GetExpireTime())
+				}
+				return t
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	flags.StringVar(&status, "status", "", "lease status: active|returned|reclaimed")
+	return cmd
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// quotaTenantJSONL 带短信月配额和兑换码总配额的租户
+const quotaTenantJSONL = `{"tenant_name":"Acme","tenant_type":"enterprise","quotas":[` +
+	`{"quota_type":"sms","limit_type":"monthly","hard_limit":100,"soft_limit":80},` +
+	`{"quota_type":"redeem_code","limit_type":"total","hard_limit":50}]}`
+
+// consumeSMS 消费租户的短信月配额
+func (e *testEnv) consumeSMS(tenantID string, amount int32, bizID string) {
+	e.t.Helper()
+	reply, err := e.client().ConsumeQuota(context.Background(), &pb.ConsumeQuotaRequest{
+		TenantId:  tenantID,
+		QuotaType: pb.QuotaType_QUOTA_TYPE_SMS,
+		LimitType: pb.LimitType_LIMIT_TYPE_MONTHLY,
+		Amount:    amount,
+		BizId:     bizID,
+		BizType:   "order",
+	})
+	if err != nil || !reply.GetSuccess() {
+		e.t.Fatalf("consume %d sms: %v %v", amount, reply, err)
+	}
+}
+
+func TestQuotaShowCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	e.consumeSMS(id, 30, "order-1")
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"quota", "show", id}, want: []string{"QUOTA_TYPE", "SMS", "MONTHLY", "30", "80", "100", "REDEEM_CODE", "TOTAL", "50"}},
+		{name: "by type", args: []string{"quota", "show", id, "--quota-type", "redeem_code"}, want: []string{"REDEEM_CODE"}, notWant: []string{"SMS"}},
+		{name: "yaml", args: []string{"quota", "show", id, "--quota-type", "sms", "-o", "yaml"}, want: []string{"used_count: 30", "hard_limit: 100"}},
+		{name: "no quotas", args: []string{"quota", "show", "EN_missing"}, want: []string{"QUOTA_ID"}, notWant: []string{"SMS"}},
+		{name: "invalid type", args: []string{"quota", "show", id, "--quota-type", "email"}, wantCode: 1, want: []string{"invalid quota type: email"}},
+	})
+}
+
+func TestQuotaAdjustCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+
+	e.runCases([]cmdCase{
+		{name: "limits and used", args: []string{"quota", "adjust", id, "--quota-type", "sms", "--limit-type", "monthly", "--hard-limit", "200", "--used", "10", "-o", "yaml"},
+			want: []string{"hard_limit: 200", "soft_limit: 80", "used_count: 10"}},
+		{name: "overage mode", args: []string{"quota", "adjust", id, "--quota-type", "sms", "--limit-type", "monthly", "--enforcement-mode", "overage", "--max-overage", "20", "-o", "yaml"},
+			want: []string{"enforcement_mode: ENFORCEMENT_MODE_OVERAGE", "max_overage: 20"}},
+		{name: "records operator", args: []string{"usage", "tail", id}, want: []string{"ADJUST", "ops-test"}},
+		{name: "soft above hard", args: []string{"quota", "adjust", id, "--quota-type", "sms", "--limit-type", "monthly", "--hard-limit", "50"},
+			wantCode: 1, want: []string{"soft limit 80 exceeds hard limit 50"}},
+		{name: "quota not found", args: []string{"quota", "adjust", id, "--quota-type", "sms", "--limit-type", "daily", "--hard-limit", "10"},
+			wantCode: 1, want: []string{"NotFound", "quota not found"}},
+		{name: "invalid enforcement mode", args: []string{"quota", "adjust", id, "--quota-type", "sms", "--limit-type", "monthly", "--enforcement-mode", "strict"},
+			wantCode: 1, want: []string{"invalid enforcement mode: strict"}},
+		{name: "invalid limit type", args: []string{"quota", "adjust", id, "--quota-type", "sms", "--limit-type", "weekly"},
+			wantCode: 1, want: []string{"invalid limit type: weekly"}},
+		{name: "missing limit type", args: []string{"quota", "adjust", id, "--quota-type", "sms"}, wantCode: 1, want: []string{`required flag(s) "limit-type" not set`}},
+	})
+}
+
+func TestQuotaResetCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	e.consumeSMS(id, 30, "order-1")
+
+	e.runCases([]cmdCase{
+		{name: "reset", args: []string{"quota", "reset", id, "--quota-type", "sms", "--limit-type", "monthly", "--remark", "support ticket 42", "-o", "yaml"},
+			want: []string{"used_count: 0", "hard_limit: 100"}},
+		{name: "records remark", args: []string{"usage", "tail", id}, want: []string{"-30", "support ticket 42"}},
+		{name: "quota not found", args: []string{"quota", "reset", "EN_missing", "--quota-type", "sms", "--limit-type", "monthly"},
+			wantCode: 1, want: []string{"NotFound", "quota not found"}},
+		{name: "missing quota type", args: []string{"quota", "reset", id, "--limit-type", "monthly"}, wantCode: 1, want: []string{`required flag(s) "quota-type" not set`}},
+	})
+}
+
+func TestQuotaChangesCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	other := e.importTenant(`{"tenant_name":"Beta","tenant_type":"enterprise"}`)
+	if _, err := e.client().ScheduleQuotaChange(context.Background(), &pb.ScheduleQuotaChangeRequest{
+		TenantId:  id,
+		QuotaType: pb.QuotaType_QUOTA_TYPE_SMS,
+		LimitType: pb.LimitType_LIMIT_TYPE_MONTHLY,
+		HardLimit: ptr(int32(300)),
+		ApplyAt:   "2030-01-01T00:00:00Z",
+	}); err != nil {
+		t.Fatalf("schedule quota change: %v", err)
+	}
+
+	e.runCases([]cmdCase{
+		{name: "pending", args: []string{"quota", "changes", id}, want: []string{"CHANGE_ID", "SMS", "MONTHLY", "300", "2030-01-01T00:00:00Z", "CARRY_OVER", "PENDING"}},
+		{name: "yaml", args: []string{"quota", "changes", id, "-o", "yaml"}, want: []string{"status: QUOTA_CHANGE_STATUS_PENDING", "hard_limit: 300"}},
+		{name: "other tenant", args: []string{"quota", "changes", other}, want: []string{"CHANGE_ID"}, notWant: []string{"PENDING"}},
+		{name: "missing argument", args: []string{"quota", "changes"}, wantCode: 1, want: []string{"accepts 1 arg(s), received 0"}},
+	})
+}
+
+func TestQuotaOveragesCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+
+	e.runCases([]cmdCase{
+		{name: "tenant", args: []string{"quota", "overages", id}, want: []string{"TENANT_ID", "OVERAGE"}, notWant: []string{id}},
+		{name: "all tenants", args: []string{"quota", "overages", "--quota-type", "sms", "--start", "2026-01-01", "--end", "2026-12-31"}, want: []string{"PERIOD_START"}},
+		{name: "invalid date", args: []string{"quota", "overages", "--start", "yesterday"}, wantCode: 1, want: []string{"InvalidArgument", "invalid date range"}},
+		{name: "invalid type", args: []string{"quota", "overages", "--quota-type", "email"}, wantCode: 1, want: []string{"invalid quota type: email"}},
+		{name: "too many arguments", args: []string{"quota", "overages", id, "extra"}, wantCode: 1, want: []string{"accepts at most 1 arg(s), received 2"}},
+	})
+}
+
+func TestQuotaExplainCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+
+	e.runCases([]cmdCase{
+		{name: "selected", args: []string{"quota", "explain", id, "--quota-type", "sms", "--limit-type", "monthly"},
+			want: []string{"SELECTED", "*", id, "TENANT_WILDCARD", "highest priority match"}},
+		{name: "no candidates", args: []string{"quota", "explain", id, "--quota-type", "sms", "--limit-type", "daily"},
+			want: []string{"SELECTED"}, notWant: []string{id}},
+		{name: "invalid limit type", args: []string{"quota", "explain", id, "--quota-type", "sms", "--limit-type", "hourly"},
+			wantCode: 1, want: []string{"invalid limit type: hourly"}},
+		{name: "missing quota type", args: []string{"quota", "explain", id, "--limit-type", "monthly"}, wantCode: 1, want: []string{`required flag(s) "quota-type" not set`}},
+	})
+}
+
+func TestQuotaLeasesCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	reply, err := e.client().LeaseQuotaBlock(context.Background(), &pb.LeaseQuotaBlockRequest{
+		TenantId:  id,
+		QuotaType: pb.QuotaType_QUOTA_TYPE_SMS,
+		LimitType: pb.LimitType_LIMIT_TYPE_MONTHLY,
+		Amount:    10,
+		Holder:    "sender-1",
+	})
+	if err != nil {
+		t.Fatalf("lease quota block: %v", err)
+	}
+	leaseID := reply.GetLease().GetLeaseId()
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"quota", "leases", id}, want: []string{leaseID, "SMS", "MONTHLY", "sender-1", "10", "ACTIVE"}},
+		{name: "active", args: []string{"quota", "leases", id, "--status", "active", "--quota-type", "sms"}, want: []string{leaseID}},
+		{name: "returned", args: []string{"quota", "leases", id, "--status", "returned"}, want: []string{"LEASE_ID"}, notWant: []string{leaseID}},
+		{name: "invalid status", args: []string{"quota", "leases", id, "--status", "expired"}, wantCode: 1, want: []string{"invalid quota lease status: expired"}},
+	})
+}
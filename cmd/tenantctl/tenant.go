@@ -0,0 +1,304 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"tenant-service/api/base"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newTenantCommand 租户管理命令
+func newTenantCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant",
+		Short: "Manage tenants",
+	}
+	cmd.AddCommand(
+		newTenantGetCommand(c),
+		newTenantListCommand(c),
+		newTenantCreateCommand(c),
+		newTenantDisableCommand(c),
+		newTenantLabelCommand(c),
+		newTenantAttrCommand(c),
+	)
+	return cmd
+}
+
+// tenantTable 租户表格
+func tenantTable(tenants ...*pb.TenantInfo) *table {
+	t := newTable("TENANT_ID", "NAME", "TYPE", "PARENT", "STATUS", "LABELS", "CREATED_AT")
+	for _, tenant := range tenants {
+		status := "disabled"
+		if tenant.GetStatus() {
+			status = "enabled"
+		}
+		t.add(tenant.GetTenantId(), tenant.GetTenantName(), enumName(tenant.GetTenantType().String(), "TENANT_TYPE_"),
+			tenant.GetParentTenantId(), status, formatLabels(tenant.GetLabels()), tenant.GetCreatedAt())
+	}
+	return t
+}
+
+// formatLabels 按键排序输出标签，如 region=east,tier=gold
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// parseMetadataArgs 解析 KEY=VALUE 和 KEY- 参数，分别为覆盖和删除
+func parseMetadataArgs(args []string) (map[string]string, []string, error) {
+	set := make(map[string]string)
+	var remove []string
+	for _, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok {
+			set[key] = value
+			continue
+		}
+		if strings.HasSuffix(arg, "-") && len(arg) > 1 {
+			remove = append(remove, strings.TrimSuffix(arg, "-"))
+			continue
+		}
+		return nil, nil, fmt.Errorf("invalid argument %q, expected KEY=VALUE or KEY-", arg)
+	}
+	return set, remove, nil
+}
+
+// newTenantGetCommand tenant get
+func newTenantGetCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get TENANT_ID",
+		Short: "Show a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.GetTenant(ctx, &pb.GetTenantRequest{TenantId: args[0]})
+			if err != nil {
+				return err
+			}
+			if reply.GetTenant() == nil {
+				return fmt.Errorf("tenant not found: %s", args[0])
+			}
+			return c.printer(cmd).print(reply, func() *table { return tenantTable(reply.GetTenant()) })
+		},
+	}
+}
+
+// newTenantListCommand tenant list
+func newTenantListCommand(c *cli) *cobra.Command {
+	var tenantTypes []string
+	var parent, name, status, sortBy, selector string
+	var attributes map[string]string
+	var page, pageSize int32
+	var desc bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tenants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &pb.ListTenantsRequest{
+				ParentTenantId: parent,
+				Name:           name,
+				LabelSelector:  selector,
+				Attributes:     attributes,
+				Page: &base.PageRequest{
+					Page:     page,
+					PageSize: pageSize,
+					SortBy:   sortBy,
+					SortDesc: desc,
+				},
+			}
+			for _, v := range tenantTypes {
+				tenantType, err := parseTenantType(v)
+				if err != nil {
+					return err
+				}
+				req.TenantTypes = append(req.TenantTypes, tenantType)
+			}
+			switch status {
+			case "":
+			case "enabled":
+				req.Status = ptr(true)
+			case "disabled":
+				req.Status = ptr(false)
+			default:
+				return fmt.Errorf("invalid status: %s", status)
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListTenants(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return tenantTable(reply.GetTenants()...) })
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVar(&tenantTypes, "type", nil, "tenant types: platform|channel|enterprise")
+	flags.StringVar(&parent, "parent", "", "parent tenant ID")
+	flags.StringVar(&name, "name", "", "tenant name substring")
+	flags.StringVar(&status, "status", "", "status filter: enabled|disabled")
+	flags.StringVarP(&selector, "selector", "l", "", "label selector, e.g. region=east,tier in (gold,silver),!deprecated")
+	flags.StringToStringVar(&attributes, "attr", nil, "attribute filter KEY=VALUE, repeatable")
+	flags.Int32Var(&page, "page", 1, "page number")
+	flags.Int32Var(&pageSize, "page-size", 20, "page size")
+	flags.StringVar(&sortBy, "sort-by", "", "sort field: tenant_id|tenant_name|tenant_type|created_at|updated_at")
+	flags.BoolVar(&desc, "desc", false, "sort descending")
+	return cmd
+}
+
+// newTenantCreateCommand tenant create
+func newTenantCreateCommand(c *cli) *cobra.Command {
+	var tenantID, name, tenantType, parent string
+	var labels, attributes map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a tenant",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := parseTenantType(tenantType)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.CreateTenant(ctx, &pb.CreateTenantRequest{
+				TenantId:       tenantID,
+				TenantName:     name,
+				TenantType:     t,
+				ParentTenantId: parent,
+				Labels:         labels,
+				Attributes:     attributes,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return tenantTable(reply.GetTenant()) })
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&tenantID, "id", "", "tenant ID (custom ID strategy only)")
+	flags.StringVar(&name, "name", "", "tenant name")
+	flags.StringVar(&tenantType, "type", "", "tenant type: platform|channel|enterprise")
+	flags.StringVar(&parent, "parent", "", "parent tenant ID")
+	flags.StringToStringVar(&labels, "label", nil, "label KEY=VALUE, repeatable")
+	flags.StringToStringVar(&attributes, "attr", nil, "attribute KEY=VALUE, repeatable")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
+
+// newTenantDisableCommand tenant disable
+func newTenantDisableCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable TENANT_ID",
+		Short: "Disable a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			current, err := c.client.GetTenant(ctx, &pb.GetTenantRequest{TenantId: args[0]})
+			if err != nil {
+				return err
+			}
+			if current.GetTenant() == nil {
+				return fmt.Errorf("tenant not found: %s", args[0])
+			}
+
+			reply, err := c.client.UpdateTenant(ctx, &pb.UpdateTenantRequest{
+				TenantId:    args[0],
+				TenantName:  current.GetTenant().GetTenantName(),
+				Status:      false,
+				QuotaConfig: current.GetTenant().GetQuotaConfig(),
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return tenantTable(reply.GetTenant()) })
+		},
+	}
+}
+
+// updateTenantMetadata 读取租户当前信息后更新标签或属性，其余字段保持不变
+func updateTenantMetadata(c *cli, cmd *cobra.Command, tenantID string, patch func(req *pb.UpdateTenantRequest)) error {
+	ctx, cancel := c.context(cmd)
+	defer cancel()
+
+	current, err := c.client.GetTenant(ctx, &pb.GetTenantRequest{TenantId: tenantID})
+	if err != nil {
+		return err
+	}
+	if current.GetTenant() == nil {
+		return fmt.Errorf("tenant not found: %s", tenantID)
+	}
+
+	req := &pb.UpdateTenantRequest{
+		TenantId:    tenantID,
+		TenantName:  current.GetTenant().GetTenantName(),
+		Status:      current.GetTenant().GetStatus(),
+		QuotaConfig: current.GetTenant().GetQuotaConfig(),
+	}
+	patch(req)
+	reply, err := c.client.UpdateTenant(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printer(cmd).print(reply, func() *table { return tenantTable(reply.GetTenant()) })
+}
+
+// newTenantLabelCommand tenant label
+func newTenantLabelCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "label TENANT_ID KEY=VALUE... KEY-...",
+		Short: "Set or remove tenant labels",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			set, remove, err := parseMetadataArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			return updateTenantMetadata(c, cmd, args[0], func(req *pb.UpdateTenantRequest) {
+				req.Labels, req.RemoveLabels = set, remove
+			})
+		},
+	}
+}
+
+// newTenantAttrCommand tenant attr
+func newTenantAttrCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attr TENANT_ID KEY=VALUE... KEY-...",
+		Short: "Set or remove tenant attributes",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			set, remove, err := parseMetadataArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			return updateTenantMetadata(c, cmd, args[0], func(req *pb.UpdateTenantRequest) {
+				req.Attributes, req.RemoveAttributes = set, remove
+			})
+		},
+	}
+}
+
+// ptr 返回值的指针
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTenantGetCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise", "--label", "tier=gold")
+
+	e.runCases([]cmdCase{
+		{name: "table", args: []string{"tenant", "get", id}, want: []string{"TENANT_ID", id, "Acme", "ENTERPRISE", "enabled", "tier=gold"}},
+		{name: "json", args: []string{"tenant", "get", id, "-o", "json"}, want: []string{`"tenant_id":`, id, `"TENANT_TYPE_ENTERPRISE"`}},
+		{name: "yaml", args: []string{"tenant", "get", id, "-o", "yaml"}, want: []string{"tenant_id: " + id, "tenant_name: Acme"}},
+		{name: "not found", args: []string{"tenant", "get", "EN_missing"}, wantCode: 1, want: []string{"Error: tenant not found: EN_missing"}},
+		{name: "missing argument", args: []string{"tenant", "get"}, wantCode: 1, want: []string{"accepts 1 arg(s), received 0"}},
+		{name: "unsupported output", args: []string{"tenant", "get", id, "-o", "xml"}, wantCode: 1, want: []string{"unsupported output format: xml"}},
+	})
+}
+
+func TestTenantListCommand(t *testing.T) {
+	e := newTestEnv(t)
+	acme := e.createTenant("--name", "Acme", "--type", "enterprise", "--label", "tier=gold")
+	beta := e.createTenant("--name", "Beta", "--type", "channel", "--label", "tier=silver")
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"tenant", "list"}, want: []string{acme, beta}},
+		{name: "by type", args: []string{"tenant", "list", "--type", "channel"}, want: []string{beta}, notWant: []string{acme}},
+		{name: "by name", args: []string{"tenant", "list", "--name", "Ac"}, want: []string{acme}, notWant: []string{beta}},
+		{name: "by selector", args: []string{"tenant", "list", "-l", "tier=gold"}, want: []string{acme}, notWant: []string{beta}},
+		{name: "by status", args: []string{"tenant", "list", "--status", "disabled"}, want: []string{"TENANT_ID"}, notWant: []string{acme, beta}},
+		{name: "sorted", args: []string{"tenant", "list", "--sort-by", "tenant_name", "--desc"}, wantOrder: []string{beta, acme}},
+		{name: "invalid status", args: []string{"tenant", "list", "--status", "paused"}, wantCode: 1, want: []string{"invalid status: paused"}},
+		{name: "invalid type", args: []string{"tenant", "list", "--type", "reseller"}, wantCode: 1, want: []string{"invalid tenant type: reseller"}},
+		{name: "invalid sort field", args: []string{"tenant", "list", "--sort-by", "password"}, wantCode: 1, want: []string{"InvalidArgument"}},
+		{name: "page size too large", args: []string{"tenant", "list", "--page-size", "101"}, wantCode: 1, want: []string{"InvalidArgument"}},
+	})
+}
+
+func TestTenantCreateCommand(t *testing.T) {
+	e := newTestEnv(t)
+	parent := e.createTenant("--name", "Parent", "--type", "platform")
+
+	e.runCases([]cmdCase{
+		{name: "enterprise", args: []string{"tenant", "create", "--name", "Acme", "--type", "enterprise", "--label", "region=east"},
+			want: []string{"Acme", "ENTERPRISE", "enabled", "region=east"}},
+		{name: "yaml", args: []string{"tenant", "create", "--name", "Beta", "--type", "channel", "-o", "yaml"},
+			want: []string{"tenant_name: Beta", "tenant_type: TENANT_TYPE_CHANNEL"}},
+		{name: "missing name", args: []string{"tenant", "create", "--type", "enterprise"}, wantCode: 1, want: []string{`required flag(s) "name" not set`}},
+		{name: "invalid type", args: []string{"tenant", "create", "--name", "Gamma", "--type", "reseller"}, wantCode: 1, want: []string{"invalid tenant type: reseller"}},
+		{name: "name too long", args: []string{"tenant", "create", "--name", strings.Repeat("x", 65), "--type", "enterprise"},
+			wantCode: 1, want: []string{"InvalidArgument", "invalid CreateTenantRequest.TenantName"}},
+		{name: "with parent", args: []string{"tenant", "create", "--name", "Delta", "--type", "channel", "--parent", parent}, want: []string{"Delta", parent}},
+	})
+}
+
+func TestTenantDisableCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise", "--label", "tier=gold")
+
+	e.runCases([]cmdCase{
+		{name: "disable", args: []string{"tenant", "disable", id}, want: []string{id, "disabled", "tier=gold"}},
+		{name: "stays disabled", args: []string{"tenant", "get", id}, want: []string{"disabled"}, notWant: []string{"enabled"}},
+		{name: "not found", args: []string{"tenant", "disable", "EN_missing"}, wantCode: 1, want: []string{"Error: tenant not found: EN_missing"}},
+		{name: "missing argument", args: []string{"tenant", "disable"}, wantCode: 1, want: []string{"accepts 1 arg(s), received 0"}},
+	})
+}
+
+func TestTenantLabelCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "set", args: []string{"tenant", "label", id, "tier=gold", "region=east"}, want: []string{"region=east,tier=gold"}},
+		{name: "remove", args: []string{"tenant", "label", id, "tier-"}, want: []string{"region=east"}, notWant: []string{"tier=gold"}},
+		{name: "invalid argument", args: []string{"tenant", "label", id, "tier"}, wantCode: 1, want: []string{`invalid argument "tier", expected KEY=VALUE or KEY-`}},
+		{name: "missing labels", args: []string{"tenant", "label", id}, wantCode: 1, want: []string{"requires at least 2 arg(s)"}},
+		{name: "not found", args: []string{"tenant", "label", "EN_missing", "tier=gold"}, wantCode: 1, want: []string{"Error: tenant not found: EN_missing"}},
+	})
+}
+
+func TestTenantAttrCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "set", args: []string{"tenant", "attr", id, "crm_id=42", "owner=alice", "-o", "yaml"}, want: []string{`crm_id: "42"`, "owner: alice"}},
+		{name: "remove", args: []string{"tenant", "attr", id, "owner-", "-o", "yaml"}, want: []string{`crm_id: "42"`}, notWant: []string{"alice"}},
+		{name: "invalid argument", args: []string{"tenant", "attr", id, "owner"}, wantCode: 1, want: []string{`invalid argument "owner"`}},
+		{name: "not found", args: []string{"tenant", "attr", "EN_missing", "crm_id=1"}, wantCode: 1, want: []string{"Error: tenant not found: EN_missing"}},
+	})
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// transferChunkSize 导入导出分片大小
+const transferChunkSize = 64 * 1024
+
+// parseDataFormat 解析数据格式，未指定时根据文件扩展名推断
+func parseDataFormat(v, path string) (pb.DataFormat, error) {
+	if v == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			return pb.DataFormat_DATA_FORMAT_CSV, nil
+		case ".jsonl", ".ndjson":
+			return pb.DataFormat_DATA_FORMAT_JSONL, nil
+		default:
+			return 0, fmt.Errorf("cannot infer format from %q, use --format", path)
+		}
+	}
+	f, ok := pb.DataFormat_value["DATA_FORMAT_"+strings.ToUpper(v)]
+	if !ok || v == "" {
+		return 0, fmt.Errorf("invalid format: %s", v)
+	}
+	return pb.DataFormat(f), nil
+}
+
+// importTable 导入结果表格
+func importTable(reply *pb.ImportTenantsReply) *table {
+	t := newTable("LINE", "TENANT_ID", "ACTION", "ERROR")
+	for _, r := range reply.GetResults() {
+		t.add(r.GetLine(), r.GetTenantId(), r.GetAction(), r.GetError())
+	}
+	t.add("", fmt.Sprintf("total=%d", reply.GetTotal()),
+		fmt.Sprintf("created=%d updated=%d", reply.GetCreated(), reply.GetUpdated()),
+		fmt.Sprintf("failed=%d dry_run=%t", reply.GetFailed(), reply.GetDryRun()))
+	return t
+}
+
+// newImportCommand import
+func newImportCommand(c *cli) *cobra.Command {
+	var format string
+	var dryRun, upsert bool
+
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Bulk import tenants from a CSV or JSONL file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := parseDataFormat(format, args[0])
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			// 批量导入耗时与文件大小相关，不使用请求超时
+			stream, err := c.client.ImportTenants(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&pb.ImportTenantsRequest{Payload: &pb.ImportTenantsRequest_Options{
+				Options: &pb.ImportOptions{Format: f, DryRun: dryRun, Upsert: upsert},
+			}}); err != nil {
+				return err
+			}
+
+			buf := make([]byte, transferChunkSize)
+			for {
+				n, readErr := file.Read(buf)
+				if n > 0 {
+					chunk := make([]byte, n)
+					copy(chunk, buf[:n])
+					if err := stream.Send(&pb.ImportTenantsRequest{Payload: &pb.ImportTenantsRequest_Chunk{Chunk: chunk}}); err != nil {
+						// 服务端提前结束时，从CloseAndRecv获取真实错误
+						if errors.Is(err, io.EOF) {
+							break
+						}
+						return err
+					}
+				}
+				if readErr == io.EOF {
+					break
+				}
+				if readErr != nil {
+					return readErr
+				}
+			}
+
+			reply, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+			if err := c.printer(cmd).print(reply, func() *table { return importTable(reply) }); err != nil {
+				return err
+			}
+			if reply.GetFailed() > 0 {
+				return fmt.Errorf("%d of %d tenants failed to import", reply.GetFailed(), reply.GetTotal())
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "data format: csv|jsonl (inferred from file extension by default)")
+	flags.BoolVar(&dryRun, "dry-run", false, "validate only, do not write")
+	flags.BoolVar(&upsert, "upsert", false, "update tenants that already exist")
+	return cmd
+}
+
+// newExportCommand export
+func newExportCommand(c *cli) *cobra.Command {
+	var format, parent, status, output string
+	var tenantTypes []string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Bulk export tenants to a CSV or JSONL file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := parseDataFormat(format, output)
+			if err != nil {
+				return err
+			}
+			req := &pb.ExportTenantsRequest{Format: f, ParentTenantId: parent}
+			for _, v := range tenantTypes {
+				tenantType, err := parseTenantType(v)
+				if err != nil {
+					return err
+				}
+				req.TenantTypes = append(req.TenantTypes, tenantType)
+			}
+			switch status {
+			case "":
+			case "enabled":
+				req.Status = ptr(true)
+			case "disabled":
+				req.Status = ptr(false)
+			default:
+				return fmt.Errorf("invalid status: %s", status)
+			}
+
+			w := cmd.OutOrStdout()
+			if output != "" && output != "-" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+
+			stream, err := c.client.ExportTenants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			for {
+				reply, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if _, err := w.Write(reply.GetChunk()); err != nil {
+					return err
+				}
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "data format: csv|jsonl (inferred from --file by default)")
+	flags.StringSliceVar(&tenantTypes, "type", nil, "tenant types: platform|channel|enterprise")
+	flags.StringVar(&parent, "parent", "", "parent tenant ID")
+	flags.StringVar(&status, "status", "", "status filter: enabled|disabled")
+	flags.StringVar(&output, "file", "", "output file, stdout by default")
+	return cmd
+}
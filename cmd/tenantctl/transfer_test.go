@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestImportCommand(t *testing.T) {
+	e := newTestEnv(t)
+	good := e.writeFile("good.jsonl", `{"tenant_name":"Acme","tenant_type":"enterprise"}`+"\n"+`{"tenant_name":"Beta","tenant_type":"channel"}`+"\n")
+	bad := e.writeFile("bad.jsonl", `{"tenant_name":"Gamma","tenant_type":"enterprise"}`+"\n"+`{"tenant_name":"Delta","tenant_type":"reseller"}`+"\n")
+	csv := e.writeFile("tenants.csv", "record_type,tenant_name,tenant_type\ntenant,Echo,enterprise\n")
+
+	e.runCases([]cmdCase{
+		{name: "dry run", args: []string{"import", good, "--dry-run"}, want: []string{"LINE", "total=2", "created=2", "dry_run=true"}},
+		{name: "nothing written", args: []string{"tenant", "list"}, notWant: []string{"Acme"}},
+		{name: "jsonl", args: []string{"import", good}, want: []string{"total=2", "created=2", "failed=0 dry_run=false"}},
+		{name: "written", args: []string{"tenant", "list"}, want: []string{"Acme", "Beta"}},
+		{name: "partial failure", args: []string{"import", bad}, wantCode: 1, want: []string{"failed=1", "1 of 2 tenants failed to import"}},
+		{name: "explicit format", args: []string{"import", csv, "--format", "csv", "-o", "yaml"}, want: []string{"created: 1"}},
+		{name: "unknown format", args: []string{"import", e.writeFile("tenants.txt", "")}, wantCode: 1, want: []string{"use --format"}},
+		{name: "missing file", args: []string{"import", filepath.Join(t.TempDir(), "nope.jsonl")}, wantCode: 1, want: []string{"no such file or directory"}},
+	})
+}
+
+func TestExportCommand(t *testing.T) {
+	e := newTestEnv(t)
+	acme := e.createTenant("--name", "Acme", "--type", "enterprise")
+	beta := e.createTenant("--name", "Beta", "--type", "channel")
+	e.mustRun("tenant", "disable", beta)
+	file := filepath.Join(t.TempDir(), "tenants.csv")
+
+	e.runCases([]cmdCase{
+		{name: "jsonl", args: []string{"export", "--format", "jsonl"}, want: []string{acme, beta, `"Acme"`}},
+		{name: "csv", args: []string{"export", "--format", "csv"}, want: []string{"record_type,tenant_id,", acme, beta}},
+		{name: "by type", args: []string{"export", "--format", "jsonl", "--type", "channel"}, want: []string{beta}, notWant: []string{acme}},
+		{name: "by status", args: []string{"export", "--format", "jsonl", "--status", "enabled"}, want: []string{acme}, notWant: []string{beta}},
+		{name: "to file", args: []string{"export", "--file", file}},
+		{name: "invalid status", args: []string{"export", "--format", "jsonl", "--status", "paused"}, wantCode: 1, want: []string{"invalid status: paused"}},
+		{name: "invalid type", args: []string{"export", "--format", "jsonl", "--type", "reseller"}, wantCode: 1, want: []string{"invalid tenant type: reseller"}},
+	})
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	if !strings.HasPrefix(string(content), "record_type,tenant_id,") || !strings.Contains(string(content), acme) {
+		t.Fatalf("csv export = %q", content)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newUsageCommand 配额使用记录命令
+func newUsageCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Inspect quota usage records",
+	}
+	cmd.AddCommand(newUsageTailCommand(c))
+	return cmd
+}
+
+// usageTable 使用记录表格
+func usageTable(records ...*pb.QuotaUsageRecord) *table {
+	t := newTable("RECORD_ID", "QUOTA_ID", "OPERATION", "DELTA", "USED", "BIZ_TYPE", "BIZ_ID", "OPERATOR", "TIME", "REMARK")
+	for _, r := range records {
+		t.add(r.GetRecordId(), r.GetQuotaId(), enumName(r.GetOperationType().String(), "OPERATION_TYPE_"), r.GetDeltaValue(),
+			r.GetCurrentUsed(), r.GetBizType(), r.GetBizId(), r.GetOperator(), r.GetOperationTime(), r.GetRemark())
+	}
+	return t
+}
+
+// newUsageTailCommand usage tail
+func newUsageTailCommand(c *cli) *cobra.Command {
+	var quotaType string
+	var after int64
+	var limit int32
+	var follow bool
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "tail TENANT_ID",
+		Short: "Print usage records of a tenant, optionally following new ones",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qt, err := parseQuotaType(quotaType)
+			if err != nil {
+				return err
+			}
+
+			pageSize := int(limit)
+			if pageSize == 0 {
+				pageSize = 100
+			}
+			p := c.printer(cmd)
+			req := &pb.ListUsageRecordsRequest{
+				TenantId:      args[0],
+				QuotaType:     qt,
+				AfterRecordId: after,
+				Limit:         limit,
+			}
+			for {
+				reply, err := c.listUsageRecords(cmd, req)
+				if err != nil {
+					return err
+				}
+				records := reply.GetRecords()
+				if len(records) > 0 {
+					if err := p.print(reply, func() *table { return usageTable(records...) }); err != nil {
+						return err
+					}
+					req.AfterRecordId = records[len(records)-1].GetRecordId()
+				}
+				if !follow {
+					return nil
+				}
+				// 未拉满一页时等待下一轮，否则立即继续拉取
+				if len(records) < pageSize {
+					select {
+					case <-cmd.Context().Done():
+						return nil
+					case <-time.After(interval):
+					}
+				}
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&quotaType, "quota-type", "", "quota type: marketing_campaign|redeem_code|sms")
+	flags.Int64Var(&after, "after", 0, "only show records with ID greater than this")
+	flags.Int32Var(&limit, "limit", 100, "max records per request")
+	flags.BoolVarP(&follow, "follow", "f", false, "keep polling for new records")
+	flags.DurationVar(&interval, "interval", 2*time.Second, "poll interval in follow mode")
+	return cmd
+}
+
+// listUsageRecords 拉取一页使用记录
+func (c *cli) listUsageRecords(cmd *cobra.Command, req *pb.ListUsageRecordsRequest) (*pb.ListUsageRecordsReply, error) {
+	ctx, cancel := context.WithTimeout(cmd.Context(), c.cfg.Timeout)
+	defer cancel()
+	return c.client.ListUsageRecords(ctx, req)
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestUsageTailCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.importTenant(quotaTenantJSONL)
+	e.consumeSMS(id, 30, "order-1")
+	e.consumeSMS(id, 5, "order-2")
+
+	out := e.mustRun("usage", "tail", id, "--limit", "1", "-o", "json")
+	first := jsonInt64(t, out, "records", "record_id")
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"usage", "tail", id}, want: []string{"RECORD_ID", "CONSUME", "order-1", "order-2"}, wantOrder: []string{"order-1", "order-2"}},
+		{name: "after", args: []string{"usage", "tail", id, "--after", strconv.FormatInt(first, 10)}, want: []string{"order-2"}, notWant: []string{"order-1"}},
+		{name: "limit", args: []string{"usage", "tail", id, "--limit", "1"}, want: []string{"order-1"}, notWant: []string{"order-2"}},
+		{name: "by type", args: []string{"usage", "tail", id, "--quota-type", "redeem_code"}, notWant: []string{"order-1"}},
+		{name: "invalid type", args: []string{"usage", "tail", id, "--quota-type", "email"}, wantCode: 1, want: []string{"invalid quota type: email"}},
+	})
+}
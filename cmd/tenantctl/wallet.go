@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// newWalletCommand 预付费钱包命令
+func newWalletCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet",
+		Short: "Inspect and operate tenant prepaid wallets",
+	}
+	cmd.AddCommand(
+		newWalletShowCommand(c),
+		newWalletTopUpCommand(c),
+		newWalletRefundCommand(c),
+		newWalletThresholdCommand(c),
+		newWalletTransactionsCommand(c),
+	)
+	return cmd
+}
+
+// walletTable 钱包表格
+func walletTable(wallet *pb.Wallet) *table {
+	t := newTable("TENANT_ID", "BALANCE", "LOW_BALANCE_THRESHOLD", "LOW_BALANCE", "UPDATED_AT")
+	t.add(wallet.GetTenantId(), wallet.GetBalance(), wallet.GetLowBalanceThreshold(), wallet.GetLowBalance(), wallet.GetUpdatedAt())
+	return t
+}
+
+// walletTransactionTable 钱包交易表格
+func walletTransactionTable(txs ...*pb.WalletTransaction) *table {
+	t := newTable("TX_ID", "TYPE", "AMOUNT", "BALANCE_AFTER", "QUOTA_TYPE", "PRODUCT", "REF_TX_ID", "IDEMPOTENCY_KEY", "CREATED_AT")
+	for _, tx := range txs {
+		t.add(tx.GetTxId(), enumName(tx.GetType().String(), "WALLET_TRANSACTION_TYPE_"), tx.GetAmount(), tx.GetBalanceAfter(),
+			enumName(tx.GetQuotaType().String(), "QUOTA_TYPE_"), tx.GetProductCode(), tx.GetRefTxId(), tx.GetIdempotencyKey(), tx.GetCreatedAt())
+	}
+	return t
+}
+
+// parseAmount 解析金额参数，单位为最小计价单位
+func parseAmount(v string) (int64, error) {
+	amount, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || amount <= 0 {
+		return 0, fmt.Errorf("invalid amount: %s", v)
+	}
+	return amount, nil
+}
+
+// newWalletShowCommand wallet show
+func newWalletShowCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show TENANT_ID",
+		Short: "Show wallet balance of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.GetWallet(ctx, &pb.GetWalletRequest{TenantId: args[0]})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return walletTable(reply.GetWallet()) })
+		},
+	}
+}
+
+// newWalletTopUpCommand wallet topup
+func newWalletTopUpCommand(c *cli) *cobra.Command {
+	var idempotencyKey, remark string
+
+	cmd := &cobra.Command{
+		Use:   "topup TENANT_ID AMOUNT",
+		Short: "Top up a tenant wallet, AMOUNT in minor units",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := parseAmount(args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.TopUpWallet(ctx, &pb.TopUpWalletRequest{
+				TenantId:       args[0],
+				Amount:         amount,
+				IdempotencyKey: idempotencyKey,
+				Operator:       c.cfg.Operator,
+				Remark:         remark,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return walletTransactionTable(reply.GetTransaction()) })
+		},
+	}
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "idempotency key, retries with the same key return the original transaction")
+	cmd.Flags().StringVar(&remark, "remark", "", "remark")
+	_ = cmd.MarkFlagRequired("idempotency-key")
+	return cmd
+}
+
+// newWalletRefundCommand wallet refund
+func newWalletRefundCommand(c *cli) *cobra.Command {
+	var idempotencyKey, remark string
+	var amount int64
+
+	cmd := &cobra.Command{
+		Use:   "refund TENANT_ID DEBIT_TX_ID",
+		Short: "Refund all or part of a debit transaction",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			debitTxID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid debit transaction id: %s", args[1])
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.RefundWallet(ctx, &pb.RefundWalletRequest{
+				TenantId:       args[0],
+				DebitTxId:      debitTxID,
+				Amount:         amount,
+				IdempotencyKey: idempotencyKey,
+				Operator:       c.cfg.Operator,
+				Remark:         remark,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return walletTransactionTable(reply.GetTransaction()) })
+		},
+	}
+	cmd.Flags().Int64Var(&amount, "amount", 0, "refund amount in minor units, 0 refunds the whole debit")
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "idempotency key, retries with the same key return the original transaction")
+	cmd.Flags().StringVar(&remark, "remark", "", "remark")
+	_ = cmd.MarkFlagRequired("idempotency-key")
+	return cmd
+}
+
+// newWalletThresholdCommand wallet threshold
+func newWalletThresholdCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "threshold TENANT_ID AMOUNT",
+		Short: "Set the low balance threshold of a tenant wallet, 0 disables alerts",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			threshold, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || threshold < 0 {
+				return fmt.Errorf("invalid threshold: %s", args[1])
+			}
+
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.SetWalletThreshold(ctx, &pb.SetWalletThresholdRequest{TenantId: args[0], LowBalanceThreshold: threshold})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return walletTable(reply.GetWallet()) })
+		},
+	}
+}
+
+// newWalletTransactionsCommand wallet transactions
+func newWalletTransactionsCommand(c *cli) *cobra.Command {
+	var after int64
+	var limit int32
+
+	cmd := &cobra.Command{
+		Use:   "transactions TENANT_ID",
+		Short: "List wallet transactions of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := c.context(cmd)
+			defer cancel()
+
+			reply, err := c.client.ListWalletTransactions(ctx, &pb.ListWalletTransactionsRequest{
+				TenantId:  args[0],
+				AfterTxId: after,
+				Limit:     limit,
+			})
+			if err != nil {
+				return err
+			}
+			return c.printer(cmd).print(reply, func() *table { return walletTransactionTable(reply.GetTransactions()...) })
+		},
+	}
+	cmd.Flags().Int64Var(&after, "after", 0, "only list transactions after this transaction id")
+	cmd.Flags().Int32Var(&limit, "limit", 100, "max transactions to list")
+	return cmd
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// debitSMS 按短信单价从钱包扣费，返回扣费交易ID
+func (e *testEnv) debitSMS(tenantID string, quantity int32, idempotencyKey string) int64 {
+	e.t.Helper()
+	reply, err := e.client().DebitWallet(context.Background(), &pb.DebitWalletRequest{
+		TenantId:       tenantID,
+		QuotaType:      pb.QuotaType_QUOTA_TYPE_SMS,
+		Quantity:       quantity,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		e.t.Fatalf("debit wallet: %v", err)
+	}
+	return reply.GetTransaction().GetTxId()
+}
+
+func TestWalletShowCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("wallet", "topup", id, "1000", "--idempotency-key", "topup-1")
+
+	e.runCases([]cmdCase{
+		{name: "table", args: []string{"wallet", "show", id}, want: []string{"BALANCE", id, "1000", "false"}},
+		{name: "yaml", args: []string{"wallet", "show", id, "-o", "yaml"}, want: []string{`balance: "1000"`}},
+		{name: "no wallet", args: []string{"wallet", "show", "EN_missing"}, wantCode: 1, want: []string{"NotFound", "wallet not found"}},
+	})
+}
+
+func TestWalletTopUpCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+
+	e.runCases([]cmdCase{
+		{name: "topup", args: []string{"wallet", "topup", id, "1000", "--idempotency-key", "topup-1", "--remark", "contract 7"}, want: []string{"TOPUP", "1000", "topup-1"}},
+		{name: "idempotent retry", args: []string{"wallet", "topup", id, "1000", "--idempotency-key", "topup-1"}, want: []string{"TOPUP"}},
+		{name: "balance counted once", args: []string{"wallet", "show", id, "-o", "yaml"}, want: []string{`balance: "1000"`}},
+		{name: "second topup", args: []string{"wallet", "topup", id, "500", "--idempotency-key", "topup-2", "-o", "yaml"}, want: []string{`balance_after: "1500"`}},
+		{name: "invalid amount", args: []string{"wallet", "topup", id, "0", "--idempotency-key", "topup-3"}, wantCode: 1, want: []string{"invalid amount: 0"}},
+		{name: "missing idempotency key", args: []string{"wallet", "topup", id, "100"}, wantCode: 1, want: []string{`required flag(s) "idempotency-key" not set`}},
+	})
+}
+
+func TestWalletRefundCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("wallet", "topup", id, "1000", "--idempotency-key", "topup-1")
+	debit := strconv.FormatInt(e.debitSMS(id, 10, "debit-1"), 10)
+	whole := strconv.FormatInt(e.debitSMS(id, 2, "debit-2"), 10)
+
+	e.runCases([]cmdCase{
+		{name: "whole debit", args: []string{"wallet", "refund", id, whole, "--idempotency-key", "refund-0"}, want: []string{"REFUND", "10", "950", whole}},
+		{name: "partial", args: []string{"wallet", "refund", id, debit, "--amount", "20", "--idempotency-key", "refund-1", "-o", "yaml"},
+			want: []string{"type: WALLET_TRANSACTION_TYPE_REFUND", `amount: "20"`, `balance_after: "970"`, `ref_tx_id: "` + debit + `"`}},
+		{name: "remainder", args: []string{"wallet", "refund", id, debit, "--amount", "30", "--idempotency-key", "refund-2"}, want: []string{"REFUND", "30", "1000"}},
+		{name: "over refund", args: []string{"wallet", "refund", id, debit, "--amount", "1", "--idempotency-key", "refund-3"}, wantCode: 1, want: []string{"InvalidArgument", "refund exceeds refundable amount"}},
+		{name: "unknown transaction", args: []string{"wallet", "refund", id, "99", "--idempotency-key", "refund-4"}, wantCode: 1, want: []string{"NotFound", "wallet transaction not found"}},
+		{name: "invalid transaction id", args: []string{"wallet", "refund", id, "abc", "--idempotency-key", "refund-5"}, wantCode: 1, want: []string{"invalid debit transaction id: abc"}},
+	})
+}
+
+func TestWalletThresholdCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("wallet", "topup", id, "1000", "--idempotency-key", "topup-1")
+
+	e.runCases([]cmdCase{
+		{name: "set", args: []string{"wallet", "threshold", id, "500"}, want: []string{"LOW_BALANCE_THRESHOLD", "500", "false"}},
+		{name: "low balance", args: []string{"wallet", "threshold", id, "2000", "-o", "yaml"}, want: []string{`low_balance_threshold: "2000"`, "low_balance: true"}},
+		{name: "disable", args: []string{"wallet", "threshold", id, "0", "-o", "yaml"}, want: []string{"low_balance: false"}},
+		{name: "negative", args: []string{"wallet", "threshold", "--", id, "-1"}, wantCode: 1, want: []string{"invalid threshold: -1"}},
+		{name: "not a number", args: []string{"wallet", "threshold", id, "lots"}, wantCode: 1, want: []string{"invalid threshold: lots"}},
+	})
+}
+
+func TestWalletTransactionsCommand(t *testing.T) {
+	e := newTestEnv(t)
+	id := e.createTenant("--name", "Acme", "--type", "enterprise")
+	e.mustRun("wallet", "topup", id, "1000", "--idempotency-key", "topup-1")
+	debit := strconv.FormatInt(e.debitSMS(id, 10, "debit-1"), 10)
+
+	e.runCases([]cmdCase{
+		{name: "all", args: []string{"wallet", "transactions", id}, want: []string{"TOPUP", "DEBIT", "SMS", "950"}, wantOrder: []string{"topup-1", "debit-1"}},
+		{name: "after", args: []string{"wallet", "transactions", id, "--after", "1"}, want: []string{"debit-1"}, notWant: []string{"topup-1"}},
+		{name: "limit", args: []string{"wallet", "transactions", id, "--limit", "1"}, want: []string{"topup-1"}, notWant: []string{"debit-1"}},
+		{name: "yaml", args: []string{"wallet", "transactions", id, "--after", "1", "-o", "yaml"}, want: []string{`tx_id: "` + debit + `"`, "quantity: 10"}},
+		{name: "missing argument", args: []string{"wallet", "transactions"}, wantCode: 1, want: []string{"accepts 1 arg(s), received 0"}},
+	})
+}
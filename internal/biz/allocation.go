@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// QuotaAllocation 配额内为产品线划出的额度，产品线消费时先使用自己的额度，再使用共享额度
+type QuotaAllocation struct {
+	ProductCode string // 产品线
+	Limit       int32  // 划分额度
+	UsedCount   int32  // 已使用数量
+}
+
+// Remaining 返回产品线额度的剩余量
+func (a *QuotaAllocation) Remaining() int32 {
+	if a == nil || a.UsedCount >= a.Limit {
+		return 0
+	}
+	return a.Limit - a.UsedCount
+}
+
+// validateAllocations 校验产品线额度配置
+func validateAllocations(allocations map[string]int32) string {
+	for productCode, limit := range allocations {
+		if productCode == "" {
+			return "allocation product code must not be empty"
+		}
+		if limit <= 0 {
+			return fmt.Sprintf("allocation of %s must be positive", productCode)
+		}
+	}
+	return ""
+}
+
+// AllocationTotal 返回产品线划分额度之和
+func (c *QuotaExtraConfig) AllocationTotal() int32 {
+	var total int32
+	for _, limit := range c.Allocations {
+		total += limit
+	}
+	return total
+}
+
+// CheckAllocations 校验产品线额度之和不超过硬限制，且产品线在配额的适用范围内
+func (c *QuotaExtraConfig) CheckAllocations(hardLimit int32, productCodes []string) error {
+	if len(c.Allocations) == 0 {
+		return nil
+	}
+	reason := ""
+	if total := c.AllocationTotal(); total > hardLimit {
+		reason = fmt.Sprintf("allocations total %d exceeds hard limit %d", total, hardLimit)
+	}
+	if len(productCodes) > 0 {
+		allowed := make(map[string]struct{}, len(productCodes))
+		for _, productCode := range productCodes {
+			allowed[productCode] = struct{}{}
+		}
+		for productCode := range c.Allocations {
+			if _, ok := allowed[productCode]; !ok {
+				reason = fmt.Sprintf("allocation product %s is not in quota product codes", productCode)
+			}
+		}
+	}
+	if reason != "" {
+		return ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": reason})
+	}
+	return nil
+}
+
+// BuildAllocations 按额度配置和已用量生成产品线额度，按产品线排序
+func BuildAllocations(limits, used map[string]int32) []*QuotaAllocation {
+	allocations := make([]*QuotaAllocation, 0, len(limits))
+	for productCode, limit := range limits {
+		allocations = append(allocations, &QuotaAllocation{
+			ProductCode: productCode,
+			Limit:       limit,
+			UsedCount:   used[productCode],
+		})
+	}
+	sort.Slice(allocations, func(i, j int) bool { return allocations[i].ProductCode < allocations[j].ProductCode })
+	return allocations
+}
+
+// Allocation 返回产品线的划分额度，未划分时为nil
+func (q *QuotaInfo) Allocation(productCode string) *QuotaAllocation {
+	if productCode == "" {
+		return nil
+	}
+	for _, allocation := range q.Allocations {
+		if allocation.ProductCode == productCode {
+			return allocation
+		}
+	}
+	return nil
+}
+
+// SharedLimit 返回共享额度，即硬限制减去产品线划分额度
+func (q *QuotaInfo) SharedLimit() int32 {
+	limit := q.HardLimit
+	for _, allocation := range q.Allocations {
+		limit -= allocation.Limit
+	}
+	if limit < 0 {
+		return 0
+	}
+	return limit
+}
+
+// SharedUsed 返回共享额度的已用量
+func (q *QuotaInfo) SharedUsed() int32 {
+	used := q.UsedCount
+	for _, allocation := range q.Allocations {
+		used -= allocation.UsedCount
+	}
+	if used < 0 {
+		return 0
+	}
+	return used
+}
+
+// SharedRemaining 返回共享额度的剩余量，包含未失效的结转额度
+func (q *QuotaInfo) SharedRemaining() int32 {
+	return q.SharedLimit() - q.SharedUsed() + q.RolloverRemaining(time.Now())
+}
+
+// RemainingFor 返回产品线可用的剩余量：自己的划分额度加共享额度
+func (q *QuotaInfo) RemainingFor(productCode string) int32 {
+	return q.Allocation(productCode).Remaining() + q.SharedRemaining()
+}
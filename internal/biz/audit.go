@@ -0,0 +1,389 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// auditSystemActor 没有操作人时（如定时任务）记录的操作人
+const auditSystemActor = "system"
+
+// auditExportBatchSize 导出审计事件时每批查询的条数
+const auditExportBatchSize = 500
+
+// AuditAction 审计动作
+type AuditAction string
+
+const (
+	AuditActionTenantCreate        AuditAction = "tenant.create"
+	AuditActionTenantUpdate        AuditAction = "tenant.update"
+	AuditActionTenantDelete        AuditAction = "tenant.delete"
+	AuditActionTenantImport        AuditAction = "tenant.import"
+	AuditActionProductBind         AuditAction = "product.bind"
+	AuditActionQuotaAdjust         AuditAction = "quota.adjust"
+	AuditActionQuotaReset          AuditAction = "quota.reset"
+	AuditActionQuotaChangeSchedule AuditAction = "quota_change.schedule"
+	AuditActionQuotaChangeApply    AuditAction = "quota_change.apply"
+	AuditActionQuotaChangeCancel   AuditAction = "quota_change.cancel"
+	AuditActionPlanSave            AuditAction = "plan.save"
+	AuditActionPlanAssign          AuditAction = "plan.assign"
+	AuditActionWalletThreshold     AuditAction = "wallet.threshold"
+	AuditActionWalletTopUp         AuditAction = "wallet.topup"
+	AuditActionWalletRefund        AuditAction = "wallet.refund"
+	AuditActionMemberAdd           AuditAction = "member.add"
+	AuditActionMemberUpdate        AuditAction = "member.update"
+	AuditActionMemberRemove        AuditAction = "member.remove"
+	AuditActionInvitationCreate    AuditAction = "invitation.create"
+	AuditActionInvitationAccept    AuditAction = "invitation.accept"
+	AuditActionInvitationRevoke    AuditAction = "invitation.revoke"
+	AuditActionEntitlementSet      AuditAction = "entitlement.set"
+	AuditActionEntitlementUnset    AuditAction = "entitlement.unset"
+)
+
+// 审计对象类型
+const (
+	AuditTargetTenant        = "tenant"
+	AuditTargetTenantProduct = "tenant_product"
+	AuditTargetQuota         = "quota"
+	AuditTargetQuotaChange   = "quota_change"
+	AuditTargetPlan          = "plan"
+	AuditTargetTenantPlan    = "tenant_plan"
+	AuditTargetWallet        = "wallet"
+	AuditTargetMember        = "member"
+	AuditTargetInvitation    = "invitation"
+	AuditTargetEntitlement   = "entitlement"
+)
+
+// AuditMetadata 审计请求元数据，由服务端中间件放入上下文
+type AuditMetadata struct {
+	Actor     string // 请求头中的操作人
+	RequestID string // 请求ID
+	SourceIP  string // 来源IP
+}
+
+type auditMetadataKey struct{}
+
+// NewAuditContext 将审计请求元数据放入上下文
+func NewAuditContext(ctx context.Context, md *AuditMetadata) context.Context {
+	return context.WithValue(ctx, auditMetadataKey{}, md)
+}
+
+// AuditFromContext 取出上下文中的审计请求元数据
+func AuditFromContext(ctx context.Context) (*AuditMetadata, bool) {
+	md, ok := ctx.Value(auditMetadataKey{}).(*AuditMetadata)
+	return md, ok && md != nil
+}
+
+// AuditChange 字段变更，取值为JSON
+type AuditChange struct {
+	Field  string // 字段
+	Before string // 变更前的值，新增时为空
+	After  string // 变更后的值，删除时为空
+}
+
+// AuditEvent 审计事件，只追加不修改
+type AuditEvent struct {
+	EventID    int64          // 事件ID，递增
+	Actor      string         // 操作人
+	Action     AuditAction    // 动作
+	TargetType string         // 对象类型
+	TargetID   string         // 对象ID
+	TenantID   string         // 所属租户，套餐等全局对象为空
+	Before     string         // 变更前的快照JSON，新建时为空
+	After      string         // 变更后的快照JSON，删除时为空
+	Changes    []*AuditChange // 快照之间的字段变更
+	RequestID  string         // 请求ID
+	SourceIP   string         // 来源IP
+	Remark     string         // 备注
+	CreatedAt  time.Time      // 记录时间
+}
+
+// AuditRecord 待记录的审计事件
+type AuditRecord struct {
+	Action     AuditAction // 动作
+	TargetType string      // 对象类型
+	TargetID   string      // 对象ID
+	TenantID   string      // 所属租户
+	Operator   string      // 请求中指定的操作人，优先于请求头中的操作人
+	Before     interface{} // 变更前的对象，nil表示新建
+	After      interface{} // 变更后的对象，nil表示删除
+	Remark     string      // 备注
+}
+
+// AuditFilter 审计事件查询条件，空字段不过滤
+type AuditFilter struct {
+	TenantID     string      // 所属租户
+	Actor        string      // 操作人
+	Action       AuditAction // 动作
+	TargetType   string      // 对象类型
+	TargetID     string      // 对象ID
+	StartTime    time.Time   // 记录时间不早于
+	EndTime      time.Time   // 记录时间早于
+	AfterEventID int64       // 只返回事件ID大于该值的事件
+	Limit        int32       // 返回条数
+}
+
+// AuditRepo 审计事件仓储接口，只提供追加和查询
+type AuditRepo interface {
+	// Append 追加审计事件，在上下文中的事务内写入
+	Append(ctx context.Context, event *AuditEvent) error
+	// List 按事件ID升序列出审计事件
+	List(ctx context.Context, filter *AuditFilter) ([]*AuditEvent, error)
+}
+
+// Transaction 事务管理
+type Transaction interface {
+	// InTx 在事务中执行fn，fn中以其ctx调用的仓库操作使用同一事务，fn返回错误时回滚
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// AuditUsecase 审计用例，其他用例在变更所在的事务中通过它追加审计事件
+type AuditUsecase struct {
+	repo AuditRepo
+	tx   Transaction
+	log  *log.Helper
+}
+
+// NewAuditUsecase 创建审计用例
+func NewAuditUsecase(repo AuditRepo, tx Transaction, logger log.Logger) *AuditUsecase {
+	return &AuditUsecase{
+		repo: repo,
+		tx:   tx,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// InTx 在事务中执行变更，变更和Record写入的审计事件同时提交或回滚
+func (uc *AuditUsecase) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return uc.tx.InTx(ctx, fn)
+}
+
+// Record 追加审计事件，须以InTx传入fn的ctx调用；操作人依次取请求中指定的操作人、请求头中的操作人，都没有时为system
+func (uc *AuditUsecase) Record(ctx context.Context, record *AuditRecord) error {
+	before, err := auditSnapshot(record.Before)
+	if err != nil {
+		return err
+	}
+	after, err := auditSnapshot(record.After)
+	if err != nil {
+		return err
+	}
+
+	event := &AuditEvent{
+		Actor:      record.Operator,
+		Action:     record.Action,
+		TargetType: record.TargetType,
+		TargetID:   record.TargetID,
+		TenantID:   record.TenantID,
+		Before:     before,
+		After:      after,
+		Changes:    diffAuditSnapshots(before, after),
+		Remark:     record.Remark,
+	}
+	if md, ok := AuditFromContext(ctx); ok {
+		if event.Actor == "" {
+			event.Actor = md.Actor
+		}
+		event.RequestID = md.RequestID
+		event.SourceIP = md.SourceIP
+	}
+	if event.Actor == "" {
+		event.Actor = auditSystemActor
+	}
+	return uc.repo.Append(ctx, event)
+}
+
+// auditSnapshot 序列化对象快照，nil为空
+func auditSnapshot(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal audit snapshot: %w", err)
+	}
+	if string(data) == "null" {
+		return "", nil
+	}
+	return string(data), nil
+}
+
+// diffAuditSnapshots 按顶层字段比较两个快照，快照不是JSON对象时整体作为一个变更
+func diffAuditSnapshots(before, after string) []*AuditChange {
+	if before == after {
+		return nil
+	}
+	var beforeFields, afterFields map[string]json.RawMessage
+	if (before != "" && json.Unmarshal([]byte(before), &beforeFields) != nil) ||
+		(after != "" && json.Unmarshal([]byte(after), &afterFields) != nil) {
+		return []*AuditChange{{Before: before, After: after}}
+	}
+
+	fields := make([]string, 0, len(beforeFields)+len(afterFields))
+	for field := range beforeFields {
+		fields = append(fields, field)
+	}
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	var changes []*AuditChange
+	for _, field := range fields {
+		b, a := beforeFields[field], afterFields[field]
+		if bytes.Equal(b, a) {
+			continue
+		}
+		changes = append(changes, &AuditChange{Field: field, Before: string(b), After: string(a)})
+	}
+	return changes
+}
+
+// ListAuditEvents 查询审计事件
+func (uc *AuditUsecase) ListAuditEvents(ctx context.Context, filter *AuditFilter) (events []*AuditEvent, err error) {
+	ctx, span := startSpan(ctx, "AuditUsecase.ListAuditEvents", attribute.String("tenant.id", filter.TenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListAuditEvents: tenantID=%v, action=%v, targetType=%v, targetID=%v, afterEventID=%v",
+		filter.TenantID, filter.Action, filter.TargetType, filter.TargetID, filter.AfterEventID)
+
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+	return uc.repo.List(ctx, filter)
+}
+
+// auditCSVHeader 审计事件CSV表头
+var auditCSVHeader = []string{"event_id", "created_at", "actor", "action", "target_type", "target_id", "tenant_id",
+	"request_id", "source_ip", "remark", "changes", "before", "after"}
+
+// auditExportRecord 审计事件JSONL导出格式
+type auditExportRecord struct {
+	EventID    int64               `json:"event_id"`
+	CreatedAt  string              `json:"created_at"`
+	Actor      string              `json:"actor"`
+	Action     string              `json:"action"`
+	TargetType string              `json:"target_type"`
+	TargetID   string              `json:"target_id"`
+	TenantID   string              `json:"tenant_id,omitempty"`
+	RequestID  string              `json:"request_id,omitempty"`
+	SourceIP   string              `json:"source_ip,omitempty"`
+	Remark     string              `json:"remark,omitempty"`
+	Changes    []auditExportChange `json:"changes,omitempty"`
+	Before     json.RawMessage     `json:"before,omitempty"`
+	After      json.RawMessage     `json:"after,omitempty"`
+}
+
+// auditExportChange 字段变更导出格式
+type auditExportChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// newAuditExportRecord 转换审计事件到导出格式
+func newAuditExportRecord(event *AuditEvent) *auditExportRecord {
+	record := &auditExportRecord{
+		EventID:    event.EventID,
+		CreatedAt:  event.CreatedAt.Format(time.RFC3339),
+		Actor:      event.Actor,
+		Action:     string(event.Action),
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		TenantID:   event.TenantID,
+		RequestID:  event.RequestID,
+		SourceIP:   event.SourceIP,
+		Remark:     event.Remark,
+	}
+	if event.Before != "" {
+		record.Before = json.RawMessage(event.Before)
+	}
+	if event.After != "" {
+		record.After = json.RawMessage(event.After)
+	}
+	for _, change := range event.Changes {
+		c := auditExportChange{Field: change.Field}
+		if change.Before != "" {
+			c.Before = json.RawMessage(change.Before)
+		}
+		if change.After != "" {
+			c.After = json.RawMessage(change.After)
+		}
+		record.Changes = append(record.Changes, c)
+	}
+	return record
+}
+
+// ExportAuditEvents 按事件ID顺序导出满足条件的全部审计事件，filter.Limit忽略
+func (uc *AuditUsecase) ExportAuditEvents(ctx context.Context, w io.Writer, format DataFormat, filter *AuditFilter) (err error) {
+	ctx, span := startSpan(ctx, "AuditUsecase.ExportAuditEvents", attribute.String("tenant.id", filter.TenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ExportAuditEvents: format=%v, tenantID=%v, action=%v", format, filter.TenantID, filter.Action)
+
+	var csvWriter *csv.Writer
+	var encoder *json.Encoder
+	switch format {
+	case DataFormatCSV:
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(auditCSVHeader); err != nil {
+			return err
+		}
+	case DataFormatJSONL:
+		encoder = json.NewEncoder(w)
+	default:
+		return fmt.Errorf("unsupported data format: %v", format)
+	}
+
+	page := *filter
+	page.Limit = auditExportBatchSize
+	exported := 0
+	for {
+		events, err := uc.repo.List(ctx, &page)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			record := newAuditExportRecord(event)
+			if encoder != nil {
+				if err := encoder.Encode(record); err != nil {
+					return err
+				}
+				continue
+			}
+			changes, err := json.Marshal(record.Changes)
+			if err != nil {
+				return err
+			}
+			row := []string{strconv.FormatInt(record.EventID, 10), record.CreatedAt, record.Actor, record.Action, record.TargetType,
+				record.TargetID, record.TenantID, record.RequestID, record.SourceIP, record.Remark, string(changes), event.Before, event.After}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		exported += len(events)
+		if len(events) < auditExportBatchSize {
+			break
+		}
+		page.AfterEventID = events[len(events)-1].EventID
+	}
+	span.SetAttributes(attribute.Int("audit.exported", exported))
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		return csvWriter.Error()
+	}
+	return nil
+}
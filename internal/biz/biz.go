@@ -1,7 +1,13 @@
 package biz
 
 import (
+	"context"
+
 	"github.com/google/wire"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ProviderSet is biz providers.
@@ -9,4 +15,41 @@ var ProviderSet = wire.NewSet(
 	NewTenantUsecase,
 	NewQuotaUsecase,
 	NewProductUsecase,
+	NewTenantTransferUsecase,
+	NewUsageReportUsecase,
+	NewPlanUsecase,
+	NewQuotaChangeUsecase,
+	NewWalletUsecase,
+	NewQuotaLeaseUsecase,
+	NewMemberUsecase,
+	NewEntitlementCatalog,
+	NewEntitlementUsecase,
+	NewAuditUsecase,
+	NewLedgerUsecase,
 )
+
+// tracer 用例层链路追踪，使用全局TracerProvider
+var tracer = otel.Tracer("tenant-service/internal/biz")
+
+// startSpan 开始用例span
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan 记录错误并结束span
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// quotaAttrs 配额维度的span属性
+func quotaAttrs(tenantID string, quotaType QuotaType, limitType LimitType) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("tenant.id", tenantID),
+		attribute.String("quota.type", quotaType.String()),
+		attribute.String("quota.limit_type", limitType.String()),
+	}
+}
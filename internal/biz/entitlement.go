@@ -0,0 +1,372 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
+)
+
+var (
+	// ErrEntitlementUnknown 未定义的授权项
+	ErrEntitlementUnknown = errors.BadRequest("ENTITLEMENT_UNKNOWN", "entitlement is not defined")
+	// ErrEntitlementInvalid 授权项取值不合法
+	ErrEntitlementInvalid = errors.BadRequest("ENTITLEMENT_INVALID", "entitlement value is invalid")
+)
+
+// maxEntitlementDepth 向上查找父租户授权的最大层数
+const maxEntitlementDepth = 8
+
+// EntitlementType 授权项值类型
+type EntitlementType int32
+
+const (
+	EntitlementTypeBool EntitlementType = 0 // 布尔，true/false
+	EntitlementTypeEnum EntitlementType = 1 // 枚举，取值限定在可选值内
+)
+
+var entitlementTypeNames = map[EntitlementType]string{
+	EntitlementTypeBool: "bool",
+	EntitlementTypeEnum: "enum",
+}
+
+// String 返回授权项值类型名称
+func (t EntitlementType) String() string {
+	if name, ok := entitlementTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseEntitlementType 解析授权项值类型名称，为空表示布尔
+func ParseEntitlementType(name string) (EntitlementType, bool) {
+	if name == "" {
+		return EntitlementTypeBool, true
+	}
+	for t, n := range entitlementTypeNames {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// EntitlementSource 授权项取值来源
+type EntitlementSource int32
+
+const (
+	EntitlementSourceUnspecified EntitlementSource = 0
+	EntitlementSourceDefault     EntitlementSource = 1 // 授权项定义的默认值
+	EntitlementSourcePlan        EntitlementSource = 2 // 订阅的套餐
+	EntitlementSourceTenant      EntitlementSource = 3 // 租户单独授予
+)
+
+// EntitlementDefinition 授权项定义
+type EntitlementDefinition struct {
+	Key          string          // 授权项键
+	Type         EntitlementType // 值类型
+	Values       []string        // 枚举可选值
+	DefaultValue string          // 未授予时的取值
+	Description  string          // 说明
+}
+
+// Validate 校验授权项取值
+func (d *EntitlementDefinition) Validate(value string) error {
+	switch d.Type {
+	case EntitlementTypeBool:
+		if value != "true" && value != "false" {
+			return ErrEntitlementInvalid.WithMetadata(map[string]string{"key": d.Key, "reason": fmt.Sprintf("value %q is not true or false", value)})
+		}
+	case EntitlementTypeEnum:
+		if !containsString(d.Values, value) {
+			return ErrEntitlementInvalid.WithMetadata(map[string]string{"key": d.Key, "reason": fmt.Sprintf("value %q is not one of %s", value, strings.Join(d.Values, ","))})
+		}
+	}
+	return nil
+}
+
+// EntitlementCatalog 授权项定义目录，来自配置
+type EntitlementCatalog struct {
+	defs map[string]*EntitlementDefinition
+	keys []string
+}
+
+// NewEntitlementCatalog 从配置构建授权项定义目录
+func NewEntitlementCatalog(c *conf.Tenant) (*EntitlementCatalog, error) {
+	catalog := &EntitlementCatalog{defs: make(map[string]*EntitlementDefinition, len(c.GetEntitlements()))}
+	for _, ce := range c.GetEntitlements() {
+		if !labelKeyPattern.MatchString(ce.GetKey()) {
+			return nil, fmt.Errorf("invalid entitlement key: %s", ce.GetKey())
+		}
+		if _, ok := catalog.defs[ce.GetKey()]; ok {
+			return nil, fmt.Errorf("duplicate entitlement key: %s", ce.GetKey())
+		}
+		entitlementType, ok := ParseEntitlementType(ce.GetType())
+		if !ok {
+			return nil, fmt.Errorf("invalid type of entitlement %s: %s", ce.GetKey(), ce.GetType())
+		}
+		def := &EntitlementDefinition{
+			Key:          ce.GetKey(),
+			Type:         entitlementType,
+			Values:       ce.GetValues(),
+			DefaultValue: ce.GetDefaultValue(),
+			Description:  ce.GetDescription(),
+		}
+		if def.DefaultValue == "" {
+			switch def.Type {
+			case EntitlementTypeBool:
+				def.DefaultValue = "false"
+			case EntitlementTypeEnum:
+				if len(def.Values) == 0 {
+					return nil, fmt.Errorf("enum entitlement %s requires values", def.Key)
+				}
+				def.DefaultValue = def.Values[0]
+			}
+		}
+		if err := def.Validate(def.DefaultValue); err != nil {
+			return nil, fmt.Errorf("invalid default value of entitlement %s: %s", def.Key, def.DefaultValue)
+		}
+		catalog.defs[def.Key] = def
+		catalog.keys = append(catalog.keys, def.Key)
+	}
+	sort.Strings(catalog.keys)
+	return catalog, nil
+}
+
+// Validate 校验授权项键和取值
+func (c *EntitlementCatalog) Validate(key, value string) error {
+	def, ok := c.defs[key]
+	if !ok {
+		return ErrEntitlementUnknown.WithMetadata(map[string]string{"key": key})
+	}
+	return def.Validate(value)
+}
+
+// PlanEntitlement 套餐中的授权项
+type PlanEntitlement struct {
+	Key         string // 授权项键
+	ProductCode string // 产品线，为空表示全部产品线
+	Value       string // 取值
+}
+
+// EntitlementGrant 授权记录：租户单独授予，或租户订阅的套餐中的授权项
+type EntitlementGrant struct {
+	TenantID    string    // 租户ID
+	ProductCode string    // 产品线，为空表示全部产品线
+	Key         string    // 授权项键
+	Value       string    // 取值
+	PlanCode    string    // 来自套餐时为套餐编码
+	UpdatedBy   string    // 操作人
+	UpdatedAt   time.Time // 更新时间
+}
+
+// Entitlement 租户生效的授权项
+type Entitlement struct {
+	Key            string            // 授权项键
+	Value          string            // 取值
+	ProductCode    string            // 授权记录的产品线，为空表示全部产品线
+	Source         EntitlementSource // 取值来源
+	SourceTenantID string            // 授权记录所在的租户，继承时为父租户
+	Inherited      bool              // 是否继承自父租户
+	PlanCode       string            // 来自套餐时为套餐编码
+	UpdatedBy      string            // 操作人
+	UpdatedAt      time.Time         // 更新时间
+}
+
+// EntitlementRepo 授权项仓储接口
+type EntitlementRepo interface {
+	// SaveGrant 创建或更新租户单独授予的授权项
+	SaveGrant(ctx context.Context, grant *EntitlementGrant) error
+	// DeleteGrant 删除租户单独授予的授权项
+	DeleteGrant(ctx context.Context, tenantID, productCode, key string) error
+	// ListGrants 列出租户单独授予的和订阅套餐中的授权记录，只返回产品线为空或等于productCode的记录
+	ListGrants(ctx context.Context, tenantIDs []string, productCode string) ([]*EntitlementGrant, error)
+}
+
+// EntitlementUsecase 授权项用例
+type EntitlementUsecase struct {
+	repo       EntitlementRepo
+	tenantRepo TenantRepo
+	catalog    *EntitlementCatalog
+	audit      *AuditUsecase
+	log        *log.Helper
+}
+
+// NewEntitlementUsecase 创建授权项用例
+func NewEntitlementUsecase(repo EntitlementRepo, tenantRepo TenantRepo, catalog *EntitlementCatalog, audit *AuditUsecase, logger log.Logger) *EntitlementUsecase {
+	return &EntitlementUsecase{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		catalog:    catalog,
+		audit:      audit,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// grantRank 授权记录的优先级，越小越优先：先比较租户层级（自身为0），
+// 同层级租户单独授予优先于套餐，同来源指定产品线优先于全部产品线
+func grantRank(grant *EntitlementGrant, levels map[string]int) int {
+	rank := levels[grant.TenantID] * 4
+	if grant.PlanCode != "" {
+		rank += 2
+	}
+	if grant.ProductCode == "" {
+		rank++
+	}
+	return rank
+}
+
+// resolveEntitlements 按优先级为目录中的每个授权项选出生效的取值，tenantIDs为自身及由近及远的父租户；
+// 未定义或取值已不合法的授权记录忽略
+func resolveEntitlements(catalog *EntitlementCatalog, tenantIDs []string, grants []*EntitlementGrant) []*Entitlement {
+	levels := make(map[string]int, len(tenantIDs))
+	for i, id := range tenantIDs {
+		levels[id] = i
+	}
+	best := make(map[string]*EntitlementGrant)
+	for _, grant := range grants {
+		if _, ok := levels[grant.TenantID]; !ok || catalog.Validate(grant.Key, grant.Value) != nil {
+			continue
+		}
+		if current, ok := best[grant.Key]; !ok || grantRank(grant, levels) < grantRank(current, levels) {
+			best[grant.Key] = grant
+		}
+	}
+
+	entitlements := make([]*Entitlement, 0, len(catalog.keys))
+	for _, key := range catalog.keys {
+		grant, ok := best[key]
+		if !ok {
+			entitlements = append(entitlements, &Entitlement{
+				Key:    key,
+				Value:  catalog.defs[key].DefaultValue,
+				Source: EntitlementSourceDefault,
+			})
+			continue
+		}
+		entitlement := &Entitlement{
+			Key:            key,
+			Value:          grant.Value,
+			ProductCode:    grant.ProductCode,
+			Source:         EntitlementSourceTenant,
+			SourceTenantID: grant.TenantID,
+			Inherited:      levels[grant.TenantID] > 0,
+			PlanCode:       grant.PlanCode,
+			UpdatedBy:      grant.UpdatedBy,
+			UpdatedAt:      grant.UpdatedAt,
+		}
+		if grant.PlanCode != "" {
+			entitlement.Source = EntitlementSourcePlan
+		}
+		entitlements = append(entitlements, entitlement)
+	}
+	return entitlements
+}
+
+// GetEntitlements 计算租户在产品线上生效的全部授权项，keys不为空时只返回指定的授权项：
+// 自身及各级父租户中离租户最近的授权记录生效，没有授权记录时取定义的默认值
+func (uc *EntitlementUsecase) GetEntitlements(ctx context.Context, tenantID, productCode string, keys []string) (entitlements []*Entitlement, err error) {
+	ctx, span := startSpan(ctx, "EntitlementUsecase.GetEntitlements", attribute.String("tenant.id", tenantID), attribute.String("product.code", productCode))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("GetEntitlements: tenantID=%v, productCode=%v", tenantID, productCode)
+
+	tenant, err := uc.tenantRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound.WithMetadata(map[string]string{"tenant_id": tenantID})
+	}
+	ancestorIDs, err := tenantAncestors(ctx, uc.tenantRepo, tenant, maxEntitlementDepth)
+	if err != nil {
+		return nil, err
+	}
+	tenantIDs := append([]string{tenantID}, ancestorIDs...)
+
+	grants, err := uc.repo.ListGrants(ctx, tenantIDs, productCode)
+	if err != nil {
+		return nil, err
+	}
+	entitlements = resolveEntitlements(uc.catalog, tenantIDs, grants)
+	if len(keys) == 0 {
+		return entitlements, nil
+	}
+
+	filtered := make([]*Entitlement, 0, len(keys))
+	for _, entitlement := range entitlements {
+		if containsString(keys, entitlement.Key) {
+			filtered = append(filtered, entitlement)
+		}
+	}
+	return filtered, nil
+}
+
+// SetEntitlement 为租户单独授予授权项，unset为true时删除单独授予的记录，恢复为继承、套餐或默认值
+func (uc *EntitlementUsecase) SetEntitlement(ctx context.Context, grant *EntitlementGrant, unset bool) (entitlement *Entitlement, err error) {
+	ctx, span := startSpan(ctx, "EntitlementUsecase.SetEntitlement", attribute.String("tenant.id", grant.TenantID),
+		attribute.String("product.code", grant.ProductCode), attribute.String("entitlement.key", grant.Key))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("SetEntitlement: tenantID=%v, productCode=%v, key=%v, value=%v, unset=%v, operator=%v",
+		grant.TenantID, grant.ProductCode, grant.Key, grant.Value, unset, grant.UpdatedBy)
+
+	if unset {
+		if _, ok := uc.catalog.defs[grant.Key]; !ok {
+			return nil, ErrEntitlementUnknown.WithMetadata(map[string]string{"key": grant.Key})
+		}
+	} else if err := uc.catalog.Validate(grant.Key, grant.Value); err != nil {
+		return nil, err
+	}
+
+	tenant, err := uc.tenantRepo.Get(ctx, grant.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound.WithMetadata(map[string]string{"tenant_id": grant.TenantID})
+	}
+
+	action := AuditActionEntitlementSet
+	if unset {
+		action = AuditActionEntitlementUnset
+	}
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.GetEntitlements(ctx, grant.TenantID, grant.ProductCode, []string{grant.Key})
+		if err != nil {
+			return err
+		}
+		if unset {
+			err = uc.repo.DeleteGrant(ctx, grant.TenantID, grant.ProductCode, grant.Key)
+		} else {
+			err = uc.repo.SaveGrant(ctx, grant)
+		}
+		if err != nil {
+			return err
+		}
+		after, err := uc.GetEntitlements(ctx, grant.TenantID, grant.ProductCode, []string{grant.Key})
+		if err != nil {
+			return err
+		}
+		entitlement = after[0]
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     action,
+			TargetType: AuditTargetEntitlement,
+			TargetID:   grant.TenantID + "/" + grant.ProductCode + "/" + grant.Key,
+			TenantID:   grant.TenantID,
+			Operator:   grant.UpdatedBy,
+			Before:     before[0],
+			After:      entitlement,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entitlement, nil
+}
@@ -0,0 +1,356 @@
+package biz
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"tenant-service/internal/conf"
+)
+
+var (
+	// ErrTenantLabelInvalid 租户标签不合法
+	ErrTenantLabelInvalid = errors.BadRequest("TENANT_LABEL_INVALID", "tenant label is invalid")
+	// ErrTenantAttributeInvalid 租户属性不合法或不符合租户类型的属性定义
+	ErrTenantAttributeInvalid = errors.BadRequest("TENANT_ATTRIBUTE_INVALID", "tenant attribute is invalid")
+	// ErrLabelSelectorInvalid 标签选择器语法错误
+	ErrLabelSelectorInvalid = errors.BadRequest("LABEL_SELECTOR_INVALID", "label selector is invalid")
+)
+
+const (
+	// MaxTenantLabels 单个租户的标签数上限
+	MaxTenantLabels = 32
+	// MaxTenantAttributes 单个租户的属性数上限
+	MaxTenantAttributes = 64
+	// MaxAttributeValueLength 属性值的最大长度（字符）
+	MaxAttributeValueLength = 1024
+)
+
+var (
+	// labelKeyPattern 标签和属性的键：字母数字开头结尾，中间允许._-/，最长63
+	labelKeyPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]{0,61}[A-Za-z0-9])?$`)
+	// labelValuePattern 标签值：可为空，非空时字母数字开头结尾，中间允许._-，最长63
+	labelValuePattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]{0,61}[A-Za-z0-9])?)?$`)
+)
+
+// ValidateLabels 校验租户标签
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > MaxTenantLabels {
+		return ErrTenantLabelInvalid.WithMetadata(map[string]string{
+			"reason": fmt.Sprintf("at most %d labels are allowed", MaxTenantLabels),
+		})
+	}
+	for key, value := range labels {
+		if !labelKeyPattern.MatchString(key) {
+			return ErrTenantLabelInvalid.WithMetadata(map[string]string{"key": key, "reason": "invalid key"})
+		}
+		if !labelValuePattern.MatchString(value) {
+			return ErrTenantLabelInvalid.WithMetadata(map[string]string{"key": key, "reason": fmt.Sprintf("invalid value: %s", value)})
+		}
+	}
+	return nil
+}
+
+// LabelOperator 标签选择器操作符
+type LabelOperator int32
+
+const (
+	LabelOperatorEquals    LabelOperator = 0 // key=value
+	LabelOperatorNotEquals LabelOperator = 1 // key!=value，不含该标签的租户也匹配
+	LabelOperatorIn        LabelOperator = 2 // key in (v1,v2)
+	LabelOperatorNotIn     LabelOperator = 3 // key notin (v1,v2)，不含该标签的租户也匹配
+	LabelOperatorExists    LabelOperator = 4 // key
+	LabelOperatorNotExists LabelOperator = 5 // !key
+)
+
+// LabelRequirement 标签选择器中的单个条件
+type LabelRequirement struct {
+	Key      string        // 标签键
+	Operator LabelOperator // 操作符
+	Values   []string      // 取值，Exists/NotExists时为空
+}
+
+// containsString 判断切片是否包含指定字符串
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// selectorError 标签选择器语法错误
+func selectorError(selector, reason string) error {
+	return ErrLabelSelectorInvalid.WithMetadata(map[string]string{"selector": selector, "reason": reason})
+}
+
+// splitSelector 按顶层逗号切分选择器，括号内的逗号不切分
+func splitSelector(selector string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, selectorError(selector, "nested parentheses")
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, selectorError(selector, "unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, selectorError(selector, "unbalanced parentheses")
+	}
+	return append(parts, selector[start:]), nil
+}
+
+// ParseLabelSelector 解析标签选择器，条件之间以逗号分隔且同时满足，支持：
+// key=value、key==value、key!=value、key in (v1,v2)、key notin (v1,v2)、key、!key
+func ParseLabelSelector(selector string) ([]LabelRequirement, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+	parts, err := splitSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := make([]LabelRequirement, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, selectorError(selector, "empty requirement")
+		}
+		requirement, err := parseLabelRequirement(part)
+		if err != nil {
+			return nil, selectorError(selector, err.Error())
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// parseLabelRequirement 解析单个条件
+func parseLabelRequirement(part string) (LabelRequirement, error) {
+	var r LabelRequirement
+
+	switch {
+	case strings.HasPrefix(part, "!") && !strings.Contains(part, "="):
+		r.Key, r.Operator = strings.TrimSpace(part[1:]), LabelOperatorNotExists
+	case strings.Contains(part, "!="):
+		i := strings.Index(part, "!=")
+		r.Key, r.Operator, r.Values = strings.TrimSpace(part[:i]), LabelOperatorNotEquals, []string{strings.TrimSpace(part[i+2:])}
+	case strings.Contains(part, "=="):
+		i := strings.Index(part, "==")
+		r.Key, r.Operator, r.Values = strings.TrimSpace(part[:i]), LabelOperatorEquals, []string{strings.TrimSpace(part[i+2:])}
+	case strings.Contains(part, "="):
+		i := strings.Index(part, "=")
+		r.Key, r.Operator, r.Values = strings.TrimSpace(part[:i]), LabelOperatorEquals, []string{strings.TrimSpace(part[i+1:])}
+	case strings.Contains(part, "("):
+		open := strings.Index(part, "(")
+		if !strings.HasSuffix(part, ")") {
+			return r, fmt.Errorf("missing ')' in %q", part)
+		}
+		fields := strings.Fields(part[:open])
+		if len(fields) != 2 {
+			return r, fmt.Errorf("invalid set requirement %q", part)
+		}
+		switch strings.ToLower(fields[1]) {
+		case "in":
+			r.Operator = LabelOperatorIn
+		case "notin":
+			r.Operator = LabelOperatorNotIn
+		default:
+			return r, fmt.Errorf("unknown operator %q", fields[1])
+		}
+		r.Key = fields[0]
+		for _, v := range strings.Split(part[open+1:len(part)-1], ",") {
+			r.Values = append(r.Values, strings.TrimSpace(v))
+		}
+	default:
+		r.Key, r.Operator = part, LabelOperatorExists
+	}
+
+	if !labelKeyPattern.MatchString(r.Key) {
+		return r, fmt.Errorf("invalid key %q", r.Key)
+	}
+	for _, v := range r.Values {
+		if !labelValuePattern.MatchString(v) {
+			return r, fmt.Errorf("invalid value %q for key %s", v, r.Key)
+		}
+	}
+	return r, nil
+}
+
+// AttributeType 租户属性值类型
+type AttributeType int32
+
+const (
+	AttributeTypeString AttributeType = 0 // 字符串
+	AttributeTypeInt    AttributeType = 1 // 整数
+	AttributeTypeBool   AttributeType = 2 // 布尔，true/false
+	AttributeTypeEnum   AttributeType = 3 // 枚举，取值限定在可选值内
+)
+
+// attributeTypeNames 属性值类型名称
+var attributeTypeNames = map[AttributeType]string{
+	AttributeTypeString: "string",
+	AttributeTypeInt:    "int",
+	AttributeTypeBool:   "bool",
+	AttributeTypeEnum:   "enum",
+}
+
+// String 返回属性值类型名称
+func (t AttributeType) String() string {
+	if name, ok := attributeTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseAttributeType 解析属性值类型名称，为空表示字符串
+func ParseAttributeType(name string) (AttributeType, bool) {
+	if name == "" {
+		return AttributeTypeString, true
+	}
+	for t, n := range attributeTypeNames {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// AttributeDefinition 租户属性定义
+type AttributeDefinition struct {
+	Key      string        // 属性键
+	Type     AttributeType // 值类型
+	Required bool          // 是否必填
+	Values   []string      // 枚举可选值
+}
+
+// AttributeSchema 租户类型的属性定义
+type AttributeSchema struct {
+	TenantType TenantType                      // 租户类型
+	Strict     bool                            // 不允许未定义的属性
+	Attributes map[string]*AttributeDefinition // 属性定义，按属性键索引
+}
+
+// NewAttributeSchemas 从配置构建各租户类型的属性定义
+func NewAttributeSchemas(c *conf.Tenant) (map[TenantType]*AttributeSchema, error) {
+	schemas := make(map[TenantType]*AttributeSchema, len(c.GetAttributeSchemas()))
+	for _, cs := range c.GetAttributeSchemas() {
+		tenantType, ok := ParseTenantType(cs.GetTenantType())
+		if !ok || tenantType == TenantTypeUnspecified {
+			return nil, fmt.Errorf("invalid attribute schema tenant_type: %s", cs.GetTenantType())
+		}
+		if _, ok := schemas[tenantType]; ok {
+			return nil, fmt.Errorf("duplicate attribute schema for tenant_type: %s", cs.GetTenantType())
+		}
+		schema := &AttributeSchema{
+			TenantType: tenantType,
+			Strict:     cs.GetStrict(),
+			Attributes: make(map[string]*AttributeDefinition, len(cs.GetAttributes())),
+		}
+		for _, ca := range cs.GetAttributes() {
+			if !labelKeyPattern.MatchString(ca.GetKey()) {
+				return nil, fmt.Errorf("invalid attribute key in %s schema: %s", cs.GetTenantType(), ca.GetKey())
+			}
+			if _, ok := schema.Attributes[ca.GetKey()]; ok {
+				return nil, fmt.Errorf("duplicate attribute key in %s schema: %s", cs.GetTenantType(), ca.GetKey())
+			}
+			attrType, ok := ParseAttributeType(ca.GetType())
+			if !ok {
+				return nil, fmt.Errorf("invalid type of attribute %s: %s", ca.GetKey(), ca.GetType())
+			}
+			if attrType == AttributeTypeEnum && len(ca.GetValues()) == 0 {
+				return nil, fmt.Errorf("enum attribute %s requires values", ca.GetKey())
+			}
+			schema.Attributes[ca.GetKey()] = &AttributeDefinition{
+				Key:      ca.GetKey(),
+				Type:     attrType,
+				Required: ca.GetRequired(),
+				Values:   ca.GetValues(),
+			}
+		}
+		schemas[tenantType] = schema
+	}
+	return schemas, nil
+}
+
+// attributeError 租户属性错误
+func attributeError(key, reason string) error {
+	return ErrTenantAttributeInvalid.WithMetadata(map[string]string{"key": key, "reason": reason})
+}
+
+// Validate 按属性定义校验租户属性，schema为nil时只校验键和值长度
+func (s *AttributeSchema) Validate(attributes map[string]string) error {
+	if len(attributes) > MaxTenantAttributes {
+		return attributeError("", fmt.Sprintf("at most %d attributes are allowed", MaxTenantAttributes))
+	}
+
+	// 按键排序，保证错误信息稳定
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := attributes[key]
+		if !labelKeyPattern.MatchString(key) {
+			return attributeError(key, "invalid key")
+		}
+		if utf8.RuneCountInString(value) > MaxAttributeValueLength {
+			return attributeError(key, fmt.Sprintf("value exceeds %d characters", MaxAttributeValueLength))
+		}
+		if s == nil {
+			continue
+		}
+		def, ok := s.Attributes[key]
+		if !ok {
+			if s.Strict {
+				return attributeError(key, fmt.Sprintf("attribute is not defined for tenant type %s", s.TenantType))
+			}
+			continue
+		}
+		switch def.Type {
+		case AttributeTypeInt:
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return attributeError(key, fmt.Sprintf("value %q is not an integer", value))
+			}
+		case AttributeTypeBool:
+			if value != "true" && value != "false" {
+				return attributeError(key, fmt.Sprintf("value %q is not true or false", value))
+			}
+		case AttributeTypeEnum:
+			if !containsString(def.Values, value) {
+				return attributeError(key, fmt.Sprintf("value %q is not one of %s", value, strings.Join(def.Values, ",")))
+			}
+		}
+	}
+
+	if s == nil {
+		return nil
+	}
+	for _, def := range s.Attributes {
+		if _, ok := attributes[def.Key]; def.Required && !ok {
+			return attributeError(def.Key, "attribute is required")
+		}
+	}
+	return nil
+}
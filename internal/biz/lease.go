@@ -0,0 +1,313 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
+)
+
+var (
+	// ErrQuotaLeaseNotFound 配额租约不存在
+	ErrQuotaLeaseNotFound = errors.NotFound("QUOTA_LEASE_NOT_FOUND", "quota lease not found")
+	// ErrQuotaLeaseClosed 配额租约已归还或已回收
+	ErrQuotaLeaseClosed = errors.Conflict("QUOTA_LEASE_CLOSED", "quota lease is not active")
+	// ErrQuotaLeaseInvalid 配额租约参数不合法
+	ErrQuotaLeaseInvalid = errors.BadRequest("QUOTA_LEASE_INVALID", "quota lease is invalid")
+)
+
+const (
+	// defaultLeaseTTL 默认租约有效期
+	defaultLeaseTTL = time.Minute
+	// defaultMaxLeaseTTL 默认租约最长有效期
+	defaultMaxLeaseTTL = 10 * time.Minute
+	// expiredLeaseBatchSize 每轮回收的过期租约数
+	expiredLeaseBatchSize = 100
+	// LeaseBizType 租约消费和释放配额时使用记录的业务类型，业务ID为租约ID
+	LeaseBizType = "quota_lease"
+)
+
+// QuotaLeaseStatus 配额租约状态
+type QuotaLeaseStatus int32
+
+const (
+	QuotaLeaseStatusUnspecified QuotaLeaseStatus = 0
+	QuotaLeaseStatusActive      QuotaLeaseStatus = 1 // 使用中
+	QuotaLeaseStatusReturned    QuotaLeaseStatus = 2 // 客户端已归还
+	QuotaLeaseStatusReclaimed   QuotaLeaseStatus = 3 // 过期后由服务端回收
+)
+
+var quotaLeaseStatusNames = map[QuotaLeaseStatus]string{
+	QuotaLeaseStatusActive:    "ACTIVE",
+	QuotaLeaseStatusReturned:  "RETURNED",
+	QuotaLeaseStatusReclaimed: "RECLAIMED",
+}
+
+// String 返回租约状态名称
+func (s QuotaLeaseStatus) String() string {
+	if name, ok := quotaLeaseStatusNames[s]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// ParseQuotaLeaseStatus 解析租约状态名称，如 active
+func ParseQuotaLeaseStatus(name string) (QuotaLeaseStatus, bool) {
+	for s, n := range quotaLeaseStatusNames {
+		if strings.EqualFold(n, name) {
+			return s, true
+		}
+	}
+	return QuotaLeaseStatusUnspecified, false
+}
+
+// QuotaLease 配额租约：服务端一次消费一块额度交给客户端在本地使用，客户端到期前归还未用部分
+type QuotaLease struct {
+	LeaseID     string           // 租约ID，同时作为使用记录的业务ID
+	TenantID    string           // 租户ID
+	QuotaID     int64            // 消费的配额ID
+	QuotaType   QuotaType        // 配额类型
+	LimitType   LimitType        // 限制类型
+	ProductCode string           // 产品线
+	Holder      string           // 持有方，如服务名和实例
+	Amount      int32            // 租约额度
+	UsedCount   int32            // 客户端上报的已用量
+	Returned    int32            // 关闭时退回配额的数量
+	Status      QuotaLeaseStatus // 状态
+	PeriodStart time.Time        // 消费时配额的周期开始时间，周期变化后不再退回
+	ExpireTime  time.Time        // 过期时间
+	ClosedAt    time.Time        // 归还或回收时间
+	CreatedAt   time.Time        // 创建时间
+}
+
+// Unused 租约未使用的额度
+func (l *QuotaLease) Unused() int32 {
+	if l.UsedCount >= l.Amount {
+		return 0
+	}
+	return l.Amount - l.UsedCount
+}
+
+// QuotaLeaseFilter 配额租约查询条件
+type QuotaLeaseFilter struct {
+	TenantID  string           // 租户ID
+	QuotaType QuotaType        // 配额类型
+	Status    QuotaLeaseStatus // 状态
+}
+
+// QuotaLeaseRepo 配额租约仓储接口
+type QuotaLeaseRepo interface {
+	CreateLease(ctx context.Context, lease *QuotaLease) (*QuotaLease, error)
+	// RenewLease 更新活动租约的已用量和过期时间
+	RenewLease(ctx context.Context, leaseID string, usedCount int32, expireTime time.Time) (*QuotaLease, error)
+	// CloseLease 将活动租约置为归还或回收，usedCount为nil时保留已上报的已用量
+	CloseLease(ctx context.Context, leaseID string, usedCount *int32, status QuotaLeaseStatus) (*QuotaLease, error)
+	// SetLeaseReturned 记录关闭时实际退回配额的数量
+	SetLeaseReturned(ctx context.Context, leaseID string, returned int32) error
+	ListLeases(ctx context.Context, filter *QuotaLeaseFilter) ([]*QuotaLease, error)
+	// ListExpiredLeases 按过期时间升序列出已过期的活动租约
+	ListExpiredLeases(ctx context.Context, now time.Time, limit int) ([]*QuotaLease, error)
+}
+
+// QuotaLeaseUsecase 配额租约用例
+type QuotaLeaseUsecase struct {
+	repo       QuotaLeaseRepo
+	quotaRepo  QuotaRepo
+	tx         Transaction
+	metrics    QuotaMetrics
+	defaultTTL time.Duration
+	maxTTL     time.Duration
+	maxBlock   int32
+	log        *log.Helper
+}
+
+// NewQuotaLeaseUsecase 创建配额租约用例
+func NewQuotaLeaseUsecase(c *conf.Tenant, repo QuotaLeaseRepo, quotaRepo QuotaRepo, tx Transaction, metrics QuotaMetrics, logger log.Logger) *QuotaLeaseUsecase {
+	lc := c.GetQuotaLease()
+	uc := &QuotaLeaseUsecase{
+		repo:       repo,
+		quotaRepo:  quotaRepo,
+		tx:         tx,
+		metrics:    metrics,
+		defaultTTL: defaultLeaseTTL,
+		maxTTL:     defaultMaxLeaseTTL,
+		maxBlock:   lc.GetMaxBlock(),
+		log:        log.NewHelper(logger),
+	}
+	if lc.GetDefaultTtl() != nil {
+		uc.defaultTTL = lc.GetDefaultTtl().AsDuration()
+	}
+	if lc.GetMaxTtl() != nil {
+		uc.maxTTL = lc.GetMaxTtl().AsDuration()
+	}
+	return uc
+}
+
+// ttl 计算租约有效期，未指定时使用默认值，不超过最长有效期
+func (uc *QuotaLeaseUsecase) ttl(requested time.Duration) time.Duration {
+	if requested <= 0 {
+		requested = uc.defaultTTL
+	}
+	if requested > uc.maxTTL {
+		return uc.maxTTL
+	}
+	return requested
+}
+
+// newLeaseID 生成租约ID
+func newLeaseID() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "ql_" + hex.EncodeToString(buf), nil
+}
+
+// LeaseQuotaBlock 从配额中消费一块额度作为租约交给客户端；未归还的租约计入已用量，因此各租约之和不会超出配额
+func (uc *QuotaLeaseUsecase) LeaseQuotaBlock(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, holder string, ttl time.Duration) (lease *QuotaLease, err error) {
+	ctx, span := startSpan(ctx, "QuotaLeaseUsecase.LeaseQuotaBlock", append(quotaAttrs(tenantID, quotaType, limitType),
+		attribute.Int("quota.amount", int(amount)),
+		attribute.String("product.code", productCode),
+		attribute.String("lease.holder", holder),
+	)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("LeaseQuotaBlock: tenantID=%v, quotaType=%v, limitType=%v, amount=%v, holder=%v", tenantID, quotaType, limitType, amount, holder)
+
+	if uc.maxBlock > 0 && amount > uc.maxBlock {
+		return nil, ErrQuotaLeaseInvalid.WithMetadata(map[string]string{"reason": "amount exceeds max block size"})
+	}
+	leaseID, err := newLeaseID()
+	if err != nil {
+		return nil, err
+	}
+
+	// 消费额度和记录租约在同一事务中，租约写入失败时消费一并回滚
+	var quota *QuotaInfo
+	consumed := false
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		quota, err = uc.quotaRepo.ConsumeQuota(ctx, tenantID, quotaType, limitType, amount, productCode, leaseID, LeaseBizType)
+		if err != nil {
+			return err
+		}
+		consumed = true
+		lease, err = uc.repo.CreateLease(ctx, &QuotaLease{
+			LeaseID:     leaseID,
+			TenantID:    tenantID,
+			QuotaID:     quota.QuotaID,
+			QuotaType:   quotaType,
+			LimitType:   limitType,
+			ProductCode: productCode,
+			Holder:      holder,
+			Amount:      amount,
+			Status:      QuotaLeaseStatusActive,
+			PeriodStart: quota.ResetTime,
+			ExpireTime:  time.Now().Add(uc.ttl(ttl)),
+		})
+		return err
+	})
+	if err != nil {
+		if !consumed {
+			uc.metrics.ConsumeDenied(ctx, tenantID, quotaType, limitType, denyReason(err))
+		}
+		return nil, err
+	}
+	uc.metrics.ObserveQuota(ctx, quota)
+	span.SetAttributes(attribute.String("lease.id", lease.LeaseID), attribute.Int64("quota.id", lease.QuotaID))
+	return lease, nil
+}
+
+// RenewQuotaLease 上报租约已用量并延长有效期
+func (uc *QuotaLeaseUsecase) RenewQuotaLease(ctx context.Context, leaseID string, usedCount int32, ttl time.Duration) (lease *QuotaLease, err error) {
+	ctx, span := startSpan(ctx, "QuotaLeaseUsecase.RenewQuotaLease", attribute.String("lease.id", leaseID), attribute.Int("lease.used", int(usedCount)))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("RenewQuotaLease: leaseID=%v, usedCount=%v", leaseID, usedCount)
+	return uc.repo.RenewLease(ctx, leaseID, usedCount, time.Now().Add(uc.ttl(ttl)))
+}
+
+// ReturnQuotaLease 归还租约，退回未使用的额度
+func (uc *QuotaLeaseUsecase) ReturnQuotaLease(ctx context.Context, leaseID string, usedCount int32) (lease *QuotaLease, err error) {
+	ctx, span := startSpan(ctx, "QuotaLeaseUsecase.ReturnQuotaLease", attribute.String("lease.id", leaseID), attribute.Int("lease.used", int(usedCount)))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ReturnQuotaLease: leaseID=%v, usedCount=%v", leaseID, usedCount)
+
+	if err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		lease, err = uc.repo.CloseLease(ctx, leaseID, &usedCount, QuotaLeaseStatusReturned)
+		if err != nil {
+			return err
+		}
+		return uc.release(ctx, lease)
+	}); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// ReclaimExpiredLeases 回收过期未归还的租约，按最近一次上报的已用量退回其余额度，由租约回收任务调用
+func (uc *QuotaLeaseUsecase) ReclaimExpiredLeases(ctx context.Context) (reclaimed int, err error) {
+	ctx, span := startSpan(ctx, "QuotaLeaseUsecase.ReclaimExpiredLeases")
+	defer func() { endSpan(span, err) }()
+
+	leases, err := uc.repo.ListExpiredLeases(ctx, time.Now(), expiredLeaseBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, expired := range leases {
+		// 关闭和退回在同一事务中，退回失败时租约保持使用中，下一轮重试
+		err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+			lease, err := uc.repo.CloseLease(ctx, expired.LeaseID, nil, QuotaLeaseStatusReclaimed)
+			if err != nil {
+				return err
+			}
+			return uc.release(ctx, lease)
+		})
+		if errors.Is(err, ErrQuotaLeaseClosed) {
+			// 客户端在回收前归还或续约
+			continue
+		}
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("reclaim lease %s error, will retry: %v", expired.LeaseID, err)
+			continue
+		}
+		reclaimed++
+	}
+	span.SetAttributes(attribute.Int("lease.reclaimed", reclaimed))
+	return reclaimed, nil
+}
+
+// release 退回已关闭租约的未用额度，须与关闭租约在同一事务中调用；配额已重置进入新周期时未用额度随旧周期作废，不再退回
+func (uc *QuotaLeaseUsecase) release(ctx context.Context, lease *QuotaLease) error {
+	unused := lease.Unused()
+	if unused > 0 {
+		quota, err := uc.quotaRepo.GetQuota(ctx, lease.TenantID, lease.QuotaType, lease.LimitType, lease.ProductCode)
+		if err != nil {
+			return err
+		}
+		if quota == nil || quota.QuotaID != lease.QuotaID || !quota.ResetTime.Equal(lease.PeriodStart) {
+			uc.log.WithContext(ctx).Infof("quota of lease %s changed period, drop %d unused units", lease.LeaseID, unused)
+			unused = 0
+		} else if _, err := uc.quotaRepo.ReleaseQuota(ctx, lease.TenantID, lease.QuotaType, lease.LimitType, unused, lease.ProductCode, lease.LeaseID); err != nil {
+			return err
+		}
+	}
+	lease.Returned = unused
+	return uc.repo.SetLeaseReturned(ctx, lease.LeaseID, unused)
+}
+
+// ListQuotaLeases 列出配额租约
+func (uc *QuotaLeaseUsecase) ListQuotaLeases(ctx context.Context, filter *QuotaLeaseFilter) (leases []*QuotaLease, err error) {
+	ctx, span := startSpan(ctx, "QuotaLeaseUsecase.ListQuotaLeases", attribute.String("tenant.id", filter.TenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListQuotaLeases: tenantID=%v, quotaType=%v, status=%v", filter.TenantID, filter.QuotaType, filter.Status)
+	return uc.repo.ListLeases(ctx, filter)
+}
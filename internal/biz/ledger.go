@@ -0,0 +1,452 @@
+package biz
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
+	"tenant-service/pkg/ledger"
+)
+
+var (
+	// ErrLedgerNotFound 配额没有启用哈希链的使用记录
+	ErrLedgerNotFound = errors.NotFound("LEDGER_NOT_FOUND", "quota ledger not found")
+)
+
+const (
+	// ledgerVerifyBatchSize 校验哈希链时每批读取的记录数
+	ledgerVerifyBatchSize = 1000
+	// ledgerCheckpointBatchSize 每批签发检查点的配额数
+	ledgerCheckpointBatchSize = 500
+	// ledgerSequenceBatchSize 每批定序的使用记录数
+	ledgerSequenceBatchSize = 1000
+	// defaultLedgerMaxSequenceLag 默认允许的最长未入链时长
+	defaultLedgerMaxSequenceLag = time.Minute
+)
+
+// LedgerIssueKind 哈希链校验发现的问题类型
+type LedgerIssueKind int32
+
+const (
+	LedgerIssueUnspecified         LedgerIssueKind = 0
+	LedgerIssueGap                 LedgerIssueKind = 1 // 链序号不连续，记录被删除
+	LedgerIssueHashMismatch        LedgerIssueKind = 2 // 记录内容与其哈希不符，记录被修改
+	LedgerIssueLinkMismatch        LedgerIssueKind = 3 // 前序哈希与上一条记录的哈希不符
+	LedgerIssueHeadMismatch        LedgerIssueKind = 4 // 最后一条记录与链头不符，末尾记录被删除或修改
+	LedgerIssueCheckpointMismatch  LedgerIssueKind = 5 // 检查点的哈希与对应记录不符或对应记录不存在
+	LedgerIssueCheckpointSignature LedgerIssueKind = 6 // 检查点签名无效
+	LedgerIssueUnsequenced         LedgerIssueKind = 7 // 记录写入后超过允许的最长未入链时长仍未入链
+)
+
+var ledgerIssueKindNames = map[LedgerIssueKind]string{
+	LedgerIssueGap:                 "GAP",
+	LedgerIssueHashMismatch:        "HASH_MISMATCH",
+	LedgerIssueLinkMismatch:        "LINK_MISMATCH",
+	LedgerIssueHeadMismatch:        "HEAD_MISMATCH",
+	LedgerIssueCheckpointMismatch:  "CHECKPOINT_MISMATCH",
+	LedgerIssueCheckpointSignature: "CHECKPOINT_SIGNATURE",
+	LedgerIssueUnsequenced:         "UNSEQUENCED",
+}
+
+// String 返回问题类型名称
+func (k LedgerIssueKind) String() string {
+	if name, ok := ledgerIssueKindNames[k]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// LedgerHead 配额哈希链的链头，由定序任务推进
+type LedgerHead struct {
+	QuotaID       int64     // 配额ID
+	TenantID      string    // 租户ID
+	Seq           int64     // 最后一条记录的链序号
+	Hash          string    // 最后一条记录的哈希
+	CheckpointSeq int64     // 最近一次签发检查点时的链序号
+	UpdatedAt     time.Time // 更新时间
+}
+
+// LedgerRecord 哈希链中的使用记录
+type LedgerRecord struct {
+	RecordID   int64          // 记录ID
+	Fields     *ledger.Record // 参与哈希的字段
+	PrevHash   string         // 前序记录哈希
+	RecordHash string         // 记录哈希
+}
+
+// LedgerCheckpoint 签名检查点，可交给渠道方作为用量凭证
+type LedgerCheckpoint struct {
+	CheckpointID int64     // 检查点ID
+	QuotaID      int64     // 配额ID
+	TenantID     string    // 租户ID
+	Seq          int64     // 链头序号
+	Hash         string    // 链头记录哈希
+	KeyID        string    // 签名密钥ID
+	Signature    []byte    // Ed25519签名
+	CreatedAt    time.Time // 签发时间，精确到秒
+}
+
+// message 检查点的签名内容
+func (c *LedgerCheckpoint) message() *ledger.Checkpoint {
+	return &ledger.Checkpoint{
+		QuotaID:   c.QuotaID,
+		TenantID:  c.TenantID,
+		Seq:       c.Seq,
+		Hash:      c.Hash,
+		KeyID:     c.KeyID,
+		CreatedAt: c.CreatedAt.Unix(),
+	}
+}
+
+// LedgerCheckpointFilter 检查点查询条件
+type LedgerCheckpointFilter struct {
+	TenantID          string // 租户ID
+	QuotaID           int64  // 配额ID，0表示全部
+	AfterCheckpointID int64  // 只返回检查点ID大于该值的检查点
+	Limit             int32  // 返回条数
+}
+
+// LedgerIssue 哈希链校验发现的问题
+type LedgerIssue struct {
+	Kind     LedgerIssueKind // 问题类型
+	ChainSeq int64           // 相关的链序号
+	RecordID int64           // 相关的记录ID，记录不存在时为0
+	Detail   string          // 说明
+}
+
+// LedgerVerification 单个配额的哈希链校验结果
+type LedgerVerification struct {
+	QuotaID     int64          // 配额ID
+	TenantID    string         // 租户ID
+	Records     int            // 校验的记录数
+	HeadSeq     int64          // 校验时的链头序号
+	HeadHash    string         // 校验时的链头哈希
+	Checkpoints int            // 核对的检查点数
+	Issues      []*LedgerIssue // 发现的问题
+}
+
+// OK 是否未发现问题
+func (v *LedgerVerification) OK() bool {
+	return len(v.Issues) == 0
+}
+
+// LedgerRepo 使用记录哈希链仓储接口，使用记录写入时不分配链序号，由定序任务在提交后入链
+type LedgerRepo interface {
+	// SequenceRecords 按记录ID顺序为最多limit条未入链的使用记录分配链序号并推进链头，返回入链的记录数
+	SequenceRecords(ctx context.Context, limit int) (int, error)
+	// GetHead 获取配额的链头，没有启用哈希链的记录时返回nil
+	GetHead(ctx context.Context, quotaID int64) (*LedgerHead, error)
+	// ListHeads 列出租户全部配额的链头
+	ListHeads(ctx context.Context, tenantID string) ([]*LedgerHead, error)
+	// ListRecords 按链序号升序列出配额序号在(afterSeq, uptoSeq]内的记录
+	ListRecords(ctx context.Context, quotaID, afterSeq, uptoSeq int64, limit int) ([]*LedgerRecord, error)
+	// ListPendingHeads 列出链序号超过最近检查点的链头
+	ListPendingHeads(ctx context.Context, limit int) ([]*LedgerHead, error)
+	// CreateCheckpoint 写入检查点并推进链头的检查点序号，其他实例已签发更新的检查点时返回false
+	CreateCheckpoint(ctx context.Context, checkpoint *LedgerCheckpoint) (bool, error)
+	ListCheckpoints(ctx context.Context, filter *LedgerCheckpointFilter) ([]*LedgerCheckpoint, error)
+	// OldestUnsequencedTime 最早一条未入链使用记录的操作时间，全部已入链时返回零值
+	OldestUnsequencedTime(ctx context.Context) (time.Time, error)
+	// ListUnsequencedRecords 按记录ID升序列出租户在before之前写入仍未入链的记录，quotaID非0时只列出该配额
+	ListUnsequencedRecords(ctx context.Context, tenantID string, quotaID int64, before time.Time, limit int) ([]*LedgerRecord, error)
+}
+
+// LedgerMetrics 哈希链监控指标
+type LedgerMetrics interface {
+	// SequenceLag 记录最早一条未入链使用记录已等待的时长，exceeded表示超过允许的最长未入链时长
+	SequenceLag(ctx context.Context, lag time.Duration, exceeded bool)
+}
+
+// LedgerUsecase 使用记录哈希链用例：校验哈希链，签发和查询检查点
+type LedgerUsecase struct {
+	repo           LedgerRepo
+	metrics        LedgerMetrics
+	keyID          string
+	signingKey     ed25519.PrivateKey
+	maxSequenceLag time.Duration
+	log            *log.Helper
+}
+
+// NewLedgerUsecase 创建使用记录哈希链用例，未配置签名密钥时不签发检查点
+func NewLedgerUsecase(c *conf.Tenant, repo LedgerRepo, metrics LedgerMetrics, logger log.Logger) (*LedgerUsecase, error) {
+	uc := &LedgerUsecase{
+		repo:           repo,
+		metrics:        metrics,
+		keyID:          c.GetLedger().GetKeyId(),
+		maxSequenceLag: defaultLedgerMaxSequenceLag,
+		log:            log.NewHelper(logger),
+	}
+	if c.GetLedger().GetMaxSequenceLag() != nil {
+		uc.maxSequenceLag = c.GetLedger().GetMaxSequenceLag().AsDuration()
+	}
+	if encoded := c.GetLedger().GetSigningKey(); encoded != "" {
+		seed, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ledger signing_key: %w", err)
+		}
+		if len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("ledger signing_key must be a %d-byte ed25519 seed, got %d bytes", ed25519.SeedSize, len(seed))
+		}
+		uc.signingKey = ed25519.NewKeyFromSeed(seed)
+	}
+	return uc, nil
+}
+
+// SigningEnabled 是否配置了签名密钥
+func (uc *LedgerUsecase) SigningEnabled() bool {
+	return uc.signingKey != nil
+}
+
+// PublicKey 签名公钥和密钥ID，未配置签名密钥时为空
+func (uc *LedgerUsecase) PublicKey() (ed25519.PublicKey, string) {
+	if uc.signingKey == nil {
+		return nil, ""
+	}
+	return uc.signingKey.Public().(ed25519.PublicKey), uc.keyID
+}
+
+// VerifyLedger 校验租户的哈希链，quotaID非0时只校验该配额；写入后超过最长未入链时长仍未入链的记录同样作为问题报告
+func (uc *LedgerUsecase) VerifyLedger(ctx context.Context, tenantID string, quotaID int64) (results []*LedgerVerification, err error) {
+	ctx, span := startSpan(ctx, "LedgerUsecase.VerifyLedger", attribute.String("tenant.id", tenantID), attribute.Int64("quota.id", quotaID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("VerifyLedger: tenantID=%v, quotaID=%v", tenantID, quotaID)
+
+	var heads []*LedgerHead
+	if quotaID != 0 {
+		head, err := uc.repo.GetHead(ctx, quotaID)
+		if err != nil {
+			return nil, err
+		}
+		if head != nil && head.TenantID == tenantID {
+			heads = []*LedgerHead{head}
+		}
+	} else if heads, err = uc.repo.ListHeads(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	byQuota := make(map[int64]*LedgerVerification, len(heads))
+	for _, head := range heads {
+		result, err := uc.verifyChain(ctx, head)
+		if err != nil {
+			return nil, err
+		}
+		byQuota[head.QuotaID] = result
+		results = append(results, result)
+	}
+
+	// 定序任务停滞时记录不在任何链上，单独列出超过最长未入链时长的记录，配额还没有链头时同样报告
+	unsequenced, err := uc.repo.ListUnsequencedRecords(ctx, tenantID, quotaID, time.Now().Add(-uc.maxSequenceLag), ledgerVerifyBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range unsequenced {
+		result, ok := byQuota[record.Fields.QuotaID]
+		if !ok {
+			result = &LedgerVerification{QuotaID: record.Fields.QuotaID, TenantID: tenantID}
+			byQuota[record.Fields.QuotaID] = result
+			results = append(results, result)
+		}
+		result.Issues = append(result.Issues, &LedgerIssue{
+			Kind:     LedgerIssueUnsequenced,
+			RecordID: record.RecordID,
+			Detail:   fmt.Sprintf("record written at %s is not sequenced within %s", time.Unix(record.Fields.OperationTime, 0).Format(time.RFC3339), uc.maxSequenceLag),
+		})
+	}
+	if quotaID != 0 && len(results) == 0 {
+		return nil, ErrLedgerNotFound.WithMetadata(map[string]string{"quota_id": fmt.Sprint(quotaID)})
+	}
+
+	issues := 0
+	for _, result := range results {
+		issues += len(result.Issues)
+	}
+	span.SetAttributes(attribute.Int("ledger.quotas", len(results)), attribute.Int("ledger.issues", issues))
+	if issues > 0 {
+		uc.log.WithContext(ctx).Warnf("ledger of tenant %s has %d issues", tenantID, issues)
+	}
+	return results, nil
+}
+
+// verifyChain 按链序号重算配额的哈希链，核对链头和检查点；校验期间新写入的记录不在范围内
+func (uc *LedgerUsecase) verifyChain(ctx context.Context, head *LedgerHead) (*LedgerVerification, error) {
+	result := &LedgerVerification{QuotaID: head.QuotaID, TenantID: head.TenantID, HeadSeq: head.Seq, HeadHash: head.Hash}
+	addIssue := func(kind LedgerIssueKind, seq, recordID int64, format string, args ...interface{}) {
+		result.Issues = append(result.Issues, &LedgerIssue{Kind: kind, ChainSeq: seq, RecordID: recordID, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	checkpoints, err := uc.listAllCheckpoints(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, keyID := uc.PublicKey()
+	bySeq := make(map[int64][]*LedgerCheckpoint, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		result.Checkpoints++
+		if publicKey != nil && checkpoint.KeyID == keyID && !ledger.Verify(publicKey, checkpoint.message(), checkpoint.Signature) {
+			addIssue(LedgerIssueCheckpointSignature, checkpoint.Seq, 0, "checkpoint %d has an invalid signature", checkpoint.CheckpointID)
+		}
+		bySeq[checkpoint.Seq] = append(bySeq[checkpoint.Seq], checkpoint)
+	}
+
+	expected, prevHash := int64(1), ledger.GenesisHash
+	for expected <= head.Seq {
+		records, err := uc.repo.ListRecords(ctx, head.QuotaID, expected-1, head.Seq, ledgerVerifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			break
+		}
+		for _, record := range records {
+			seq := record.Fields.Seq
+			if seq != expected {
+				// 缺失记录的哈希未知，从当前记录的前序哈希继续核对
+				addIssue(LedgerIssueGap, expected, 0, "records %d-%d are missing", expected, seq-1)
+				prevHash = record.PrevHash
+			}
+			if record.PrevHash != prevHash {
+				addIssue(LedgerIssueLinkMismatch, seq, record.RecordID, "prev_hash does not match the previous record")
+			}
+			if ledger.Hash(record.PrevHash, record.Fields) != record.RecordHash {
+				addIssue(LedgerIssueHashMismatch, seq, record.RecordID, "record content does not match its hash")
+			}
+			for _, checkpoint := range bySeq[seq] {
+				if checkpoint.Hash != record.RecordHash {
+					addIssue(LedgerIssueCheckpointMismatch, seq, record.RecordID, "checkpoint %d expects hash %s", checkpoint.CheckpointID, checkpoint.Hash)
+				}
+			}
+			delete(bySeq, seq)
+			result.Records++
+			expected, prevHash = seq+1, record.RecordHash
+		}
+	}
+
+	if expected <= head.Seq {
+		addIssue(LedgerIssueHeadMismatch, expected, 0, "records %d-%d before the chain head are missing", expected, head.Seq)
+	} else if prevHash != head.Hash {
+		addIssue(LedgerIssueHeadMismatch, head.Seq, 0, "last record does not match the chain head")
+	}
+	for seq, orphans := range bySeq {
+		if seq > head.Seq {
+			// 校验开始后签发的检查点
+			continue
+		}
+		for _, checkpoint := range orphans {
+			addIssue(LedgerIssueCheckpointMismatch, seq, 0, "record of checkpoint %d is missing", checkpoint.CheckpointID)
+		}
+	}
+	return result, nil
+}
+
+// listAllCheckpoints 列出配额的全部检查点
+func (uc *LedgerUsecase) listAllCheckpoints(ctx context.Context, head *LedgerHead) ([]*LedgerCheckpoint, error) {
+	filter := &LedgerCheckpointFilter{TenantID: head.TenantID, QuotaID: head.QuotaID, Limit: ledgerVerifyBatchSize}
+	var all []*LedgerCheckpoint
+	for {
+		checkpoints, err := uc.repo.ListCheckpoints(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, checkpoints...)
+		if len(checkpoints) < int(filter.Limit) {
+			return all, nil
+		}
+		filter.AfterCheckpointID = checkpoints[len(checkpoints)-1].CheckpointID
+	}
+}
+
+// SequenceRecords 为写入后尚未入链的使用记录分配链序号，返回入链数量，由定时任务调用
+func (uc *LedgerUsecase) SequenceRecords(ctx context.Context, maxBatches int) (sequenced int, err error) {
+	ctx, span := startSpan(ctx, "LedgerUsecase.SequenceRecords")
+	defer func() { endSpan(span, err) }()
+
+	for batch := 0; batch < maxBatches; batch++ {
+		count, err := uc.repo.SequenceRecords(ctx, ledgerSequenceBatchSize)
+		sequenced += count
+		if err != nil {
+			return sequenced, err
+		}
+		if count < ledgerSequenceBatchSize {
+			break
+		}
+	}
+	span.SetAttributes(attribute.Int("ledger.sequenced", sequenced))
+	return sequenced, uc.observeSequenceLag(ctx)
+}
+
+// observeSequenceLag 记录最早一条未入链记录的等待时长，超过最长未入链时长时告警
+func (uc *LedgerUsecase) observeSequenceLag(ctx context.Context) error {
+	oldest, err := uc.repo.OldestUnsequencedTime(ctx)
+	if err != nil {
+		return err
+	}
+	var lag time.Duration
+	if !oldest.IsZero() {
+		lag = time.Since(oldest)
+	}
+	exceeded := lag > uc.maxSequenceLag
+	uc.metrics.SequenceLag(ctx, lag, exceeded)
+	if exceeded {
+		uc.log.WithContext(ctx).Warnf("oldest unsequenced ledger record is %s old, exceeds max_sequence_lag %s", lag.Truncate(time.Second), uc.maxSequenceLag)
+	}
+	return nil
+}
+
+// CreateCheckpoints 为自上次检查点以来有新记录的配额签发检查点，返回签发数量，由定时任务调用
+func (uc *LedgerUsecase) CreateCheckpoints(ctx context.Context, maxBatches int) (created int, err error) {
+	ctx, span := startSpan(ctx, "LedgerUsecase.CreateCheckpoints")
+	defer func() { endSpan(span, err) }()
+
+	if uc.signingKey == nil {
+		return 0, nil
+	}
+	for batch := 0; batch < maxBatches; batch++ {
+		heads, err := uc.repo.ListPendingHeads(ctx, ledgerCheckpointBatchSize)
+		if err != nil {
+			return created, err
+		}
+		for _, head := range heads {
+			checkpoint := &LedgerCheckpoint{
+				QuotaID:   head.QuotaID,
+				TenantID:  head.TenantID,
+				Seq:       head.Seq,
+				Hash:      head.Hash,
+				KeyID:     uc.keyID,
+				CreatedAt: time.Now().Truncate(time.Second),
+			}
+			checkpoint.Signature = ledger.Sign(uc.signingKey, checkpoint.message())
+			ok, err := uc.repo.CreateCheckpoint(ctx, checkpoint)
+			if err != nil {
+				return created, err
+			}
+			if ok {
+				created++
+			}
+		}
+		if len(heads) < ledgerCheckpointBatchSize {
+			break
+		}
+	}
+	span.SetAttributes(attribute.Int("ledger.checkpoints", created))
+	return created, nil
+}
+
+// ListCheckpoints 列出租户的检查点
+func (uc *LedgerUsecase) ListCheckpoints(ctx context.Context, filter *LedgerCheckpointFilter) (checkpoints []*LedgerCheckpoint, err error) {
+	ctx, span := startSpan(ctx, "LedgerUsecase.ListCheckpoints", attribute.String("tenant.id", filter.TenantID), attribute.Int64("quota.id", filter.QuotaID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListCheckpoints: tenantID=%v, quotaID=%v, afterCheckpointID=%v", filter.TenantID, filter.QuotaID, filter.AfterCheckpointID)
+
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+	return uc.repo.ListCheckpoints(ctx, filter)
+}
@@ -0,0 +1,586 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
+)
+
+var (
+	// ErrMemberNotFound 租户成员不存在
+	ErrMemberNotFound = errors.NotFound("TENANT_MEMBER_NOT_FOUND", "tenant member not found")
+	// ErrMemberExists 用户已是租户成员
+	ErrMemberExists = errors.Conflict("TENANT_MEMBER_EXISTS", "user is already a member of the tenant")
+	// ErrLastOwner 不能移除或降级租户的最后一个所有者
+	ErrLastOwner = errors.Conflict("TENANT_LAST_OWNER", "tenant must keep at least one owner")
+	// ErrInvitationNotFound 邀请不存在
+	ErrInvitationNotFound = errors.NotFound("TENANT_INVITATION_NOT_FOUND", "tenant invitation not found")
+	// ErrInvitationClosed 邀请已接受、已撤销或已过期
+	ErrInvitationClosed = errors.Conflict("TENANT_INVITATION_CLOSED", "tenant invitation is not pending")
+	// ErrPermissionUnknown 未定义的权限
+	ErrPermissionUnknown = errors.BadRequest("PERMISSION_UNKNOWN", "permission is not defined")
+)
+
+const (
+	// defaultInvitationTTL 默认邀请有效期
+	defaultInvitationTTL = 7 * 24 * time.Hour
+	// defaultMaxInheritDepth 默认向上查找可继承角色的父租户层数
+	defaultMaxInheritDepth = 5
+)
+
+// MemberRole 租户成员角色，数值越小权限越高
+type MemberRole int32
+
+const (
+	MemberRoleUnspecified MemberRole = 0
+	MemberRoleOwner       MemberRole = 1 // 所有者
+	MemberRoleAdmin       MemberRole = 2 // 管理员
+	MemberRoleOperator    MemberRole = 3 // 运营
+	MemberRoleViewer      MemberRole = 4 // 只读
+)
+
+var memberRoleNames = map[MemberRole]string{
+	MemberRoleOwner:    "OWNER",
+	MemberRoleAdmin:    "ADMIN",
+	MemberRoleOperator: "OPERATOR",
+	MemberRoleViewer:   "VIEWER",
+}
+
+// String 返回成员角色名称
+func (r MemberRole) String() string {
+	if name, ok := memberRoleNames[r]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// ParseMemberRole 解析成员角色名称，如 admin
+func ParseMemberRole(name string) (MemberRole, bool) {
+	for r, n := range memberRoleNames {
+		if strings.EqualFold(n, name) {
+			return r, true
+		}
+	}
+	return MemberRoleUnspecified, false
+}
+
+// HigherThan 角色权限是否高于other，未指定的角色低于任何角色
+func (r MemberRole) HigherThan(other MemberRole) bool {
+	if r == MemberRoleUnspecified {
+		return false
+	}
+	return other == MemberRoleUnspecified || r < other
+}
+
+// 权限定义，角色拥有的权限见rolePermissions
+const (
+	PermissionTenantRead   = "tenant.read"
+	PermissionTenantUpdate = "tenant.update"
+	PermissionTenantDelete = "tenant.delete"
+	PermissionQuotaRead    = "quota.read"
+	PermissionQuotaConsume = "quota.consume"
+	PermissionQuotaAdjust  = "quota.adjust"
+	PermissionPlanManage   = "plan.manage"
+	PermissionUsageRead    = "usage.read"
+	PermissionWalletRead   = "wallet.read"
+	PermissionWalletManage = "wallet.manage"
+	PermissionMemberRead   = "member.read"
+	PermissionMemberManage = "member.manage"
+	PermissionOwnerManage  = "owner.manage"
+)
+
+// rolePermissions 各角色新增的权限，高权限角色同时拥有低权限角色的全部权限
+var rolePermissions = map[MemberRole][]string{
+	MemberRoleViewer:   {PermissionTenantRead, PermissionQuotaRead, PermissionUsageRead, PermissionWalletRead, PermissionMemberRead},
+	MemberRoleOperator: {PermissionQuotaConsume},
+	MemberRoleAdmin:    {PermissionTenantUpdate, PermissionQuotaAdjust, PermissionPlanManage, PermissionWalletManage, PermissionMemberManage},
+	MemberRoleOwner:    {PermissionTenantDelete, PermissionOwnerManage},
+}
+
+// minimumRoles 拥有各权限所需的最低角色
+var minimumRoles = func() map[string]MemberRole {
+	roles := make(map[string]MemberRole)
+	for role, permissions := range rolePermissions {
+		for _, permission := range permissions {
+			roles[permission] = role
+		}
+	}
+	return roles
+}()
+
+// Permissions 角色拥有的全部权限，按名称排序
+func (r MemberRole) Permissions() []string {
+	var permissions []string
+	for permission, minimum := range minimumRoles {
+		if r == minimum || r.HigherThan(minimum) {
+			permissions = append(permissions, permission)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+// InvitationStatus 邀请状态
+type InvitationStatus int32
+
+const (
+	InvitationStatusUnspecified InvitationStatus = 0
+	InvitationStatusPending     InvitationStatus = 1 // 待接受
+	InvitationStatusAccepted    InvitationStatus = 2 // 已接受
+	InvitationStatusRevoked     InvitationStatus = 3 // 已撤销
+	InvitationStatusExpired     InvitationStatus = 4 // 已过期，待接受的邀请超过有效期后视为过期
+)
+
+var invitationStatusNames = map[InvitationStatus]string{
+	InvitationStatusPending:  "PENDING",
+	InvitationStatusAccepted: "ACCEPTED",
+	InvitationStatusRevoked:  "REVOKED",
+	InvitationStatusExpired:  "EXPIRED",
+}
+
+// String 返回邀请状态名称
+func (s InvitationStatus) String() string {
+	if name, ok := invitationStatusNames[s]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// Member 租户成员，用户ID由外部身份系统分配
+type Member struct {
+	TenantID    string     // 租户ID
+	UserID      string     // 用户ID
+	Role        MemberRole // 角色
+	DisplayName string     // 显示名称
+	Email       string     // 邮箱
+	Inherit     bool       // 角色是否同时作用于子租户
+	InvitedBy   string     // 邀请人或添加人
+	CreatedAt   time.Time  // 创建时间
+	UpdatedAt   time.Time  // 更新时间
+}
+
+// Invitation 租户邀请，接受时以用户ID加入租户
+type Invitation struct {
+	InvitationID string           // 邀请ID
+	TenantID     string           // 租户ID
+	Email        string           // 被邀请人邮箱
+	Role         MemberRole       // 接受后的角色
+	Inherit      bool             // 角色是否同时作用于子租户
+	Status       InvitationStatus // 状态
+	InvitedBy    string           // 邀请人
+	Token        string           // 邀请令牌，仅创建时返回，服务端只保存摘要
+	TokenHash    string           // 邀请令牌摘要
+	ExpireTime   time.Time        // 过期时间
+	AcceptedBy   string           // 接受邀请的用户ID
+	AcceptedAt   time.Time        // 接受时间
+	CreatedAt    time.Time        // 创建时间
+}
+
+// PermissionDecision 权限检查结果
+type PermissionDecision struct {
+	Allowed        bool       // 是否允许
+	Role           MemberRole // 生效的角色，非成员时为未指定
+	SourceTenantID string     // 角色所在的租户，继承时为父租户
+	Inherited      bool       // 角色是否继承自父租户
+	Reason         string     // 不允许的原因
+}
+
+// MemberRepo 租户成员仓储接口
+type MemberRepo interface {
+	// GetMember 获取成员，不存在时返回nil
+	GetMember(ctx context.Context, tenantID, userID string) (*Member, error)
+	CreateMember(ctx context.Context, member *Member) (*Member, error)
+	// UpdateMember 更新成员角色和继承设置，租户只剩一个所有者时不能将其降级
+	UpdateMember(ctx context.Context, tenantID, userID string, role MemberRole, inherit *bool) (*Member, error)
+	// RemoveMember 移除成员，不能移除最后一个所有者
+	RemoveMember(ctx context.Context, tenantID, userID string) error
+	// ListMembers 列出多个租户的成员，inheritOnly为true时只返回作用于子租户的成员
+	ListMembers(ctx context.Context, tenantIDs []string, role MemberRole, inheritOnly bool) ([]*Member, error)
+	CreateInvitation(ctx context.Context, invitation *Invitation) (*Invitation, error)
+	// AcceptInvitation 按令牌摘要接受待接受的邀请并加入租户；已是成员时保留较高的角色
+	AcceptInvitation(ctx context.Context, tokenHash string, member *Member) (*Member, error)
+	RevokeInvitation(ctx context.Context, invitationID string) (*Invitation, error)
+	ListInvitations(ctx context.Context, tenantID string, status InvitationStatus) ([]*Invitation, error)
+}
+
+// MemberUsecase 租户成员用例
+type MemberUsecase struct {
+	repo            MemberRepo
+	tenantRepo      TenantRepo
+	invitationTTL   time.Duration
+	maxInheritDepth int
+	audit           *AuditUsecase
+	log             *log.Helper
+}
+
+// NewMemberUsecase 创建租户成员用例
+func NewMemberUsecase(c *conf.Tenant, repo MemberRepo, tenantRepo TenantRepo, audit *AuditUsecase, logger log.Logger) *MemberUsecase {
+	mc := c.GetMembership()
+	uc := &MemberUsecase{
+		repo:            repo,
+		tenantRepo:      tenantRepo,
+		invitationTTL:   defaultInvitationTTL,
+		maxInheritDepth: defaultMaxInheritDepth,
+		audit:           audit,
+		log:             log.NewHelper(logger),
+	}
+	if mc.GetInvitationTtl() != nil {
+		uc.invitationTTL = mc.GetInvitationTtl().AsDuration()
+	}
+	if mc.GetMaxInheritDepth() != 0 {
+		uc.maxInheritDepth = int(mc.GetMaxInheritDepth())
+	}
+	return uc
+}
+
+// memberAttrs 成员维度的span属性
+func memberAttrs(tenantID, userID string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("tenant.id", tenantID),
+		attribute.String("member.user_id", userID),
+	}
+}
+
+// getTenant 获取租户，不存在时返回ErrTenantNotFound
+func (uc *MemberUsecase) getTenant(ctx context.Context, tenantID string) (*Tenant, error) {
+	tenant, err := uc.tenantRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound.WithMetadata(map[string]string{"tenant_id": tenantID})
+	}
+	return tenant, nil
+}
+
+// AddMember 添加租户成员
+func (uc *MemberUsecase) AddMember(ctx context.Context, member *Member) (added *Member, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.AddMember", append(memberAttrs(member.TenantID, member.UserID),
+		attribute.String("member.role", member.Role.String()))...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("AddMember: tenantID=%v, userID=%v, role=%v", member.TenantID, member.UserID, member.Role)
+
+	if _, err := uc.getTenant(ctx, member.TenantID); err != nil {
+		return nil, err
+	}
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		added, err = uc.repo.CreateMember(ctx, member)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionMemberAdd,
+			TargetType: AuditTargetMember,
+			TargetID:   memberTargetID(added.TenantID, added.UserID),
+			TenantID:   added.TenantID,
+			Operator:   added.InvitedBy,
+			After:      added,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// UpdateMember 更新成员角色和继承设置，role为未指定时不修改角色，inherit为nil时不修改继承设置
+func (uc *MemberUsecase) UpdateMember(ctx context.Context, tenantID, userID string, role MemberRole, inherit *bool) (member *Member, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.UpdateMember", append(memberAttrs(tenantID, userID),
+		attribute.String("member.role", role.String()))...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("UpdateMember: tenantID=%v, userID=%v, role=%v", tenantID, userID, role)
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.GetMember(ctx, tenantID, userID)
+		if err != nil {
+			return err
+		}
+		member, err = uc.repo.UpdateMember(ctx, tenantID, userID, role, inherit)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionMemberUpdate,
+			TargetType: AuditTargetMember,
+			TargetID:   memberTargetID(tenantID, userID),
+			TenantID:   tenantID,
+			Before:     before,
+			After:      member,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveMember 移除租户成员
+func (uc *MemberUsecase) RemoveMember(ctx context.Context, tenantID, userID string) (err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.RemoveMember", memberAttrs(tenantID, userID)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("RemoveMember: tenantID=%v, userID=%v", tenantID, userID)
+
+	return uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.GetMember(ctx, tenantID, userID)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.RemoveMember(ctx, tenantID, userID); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionMemberRemove,
+			TargetType: AuditTargetMember,
+			TargetID:   memberTargetID(tenantID, userID),
+			TenantID:   tenantID,
+			Before:     before,
+		})
+	})
+}
+
+// memberTargetID 成员的审计对象ID
+func memberTargetID(tenantID, userID string) string {
+	return tenantID + "/" + userID
+}
+
+// redactInvitation 去掉邀请令牌和摘要，用于审计快照
+func redactInvitation(invitation *Invitation) *Invitation {
+	redacted := *invitation
+	redacted.Token = ""
+	redacted.TokenHash = ""
+	return &redacted
+}
+
+// ListMembers 列出租户成员，includeInherited时同时返回父租户中作用于子租户的成员
+func (uc *MemberUsecase) ListMembers(ctx context.Context, tenantID string, role MemberRole, includeInherited bool) (members []*Member, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.ListMembers", attribute.String("tenant.id", tenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListMembers: tenantID=%v, role=%v, includeInherited=%v", tenantID, role, includeInherited)
+
+	members, err = uc.repo.ListMembers(ctx, []string{tenantID}, role, false)
+	if err != nil || !includeInherited {
+		return members, err
+	}
+
+	tenant, err := uc.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	ancestorIDs, err := tenantAncestors(ctx, uc.tenantRepo, tenant, uc.maxInheritDepth)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestorIDs) == 0 {
+		return members, nil
+	}
+	inherited, err := uc.repo.ListMembers(ctx, ancestorIDs, role, true)
+	if err != nil {
+		return nil, err
+	}
+	return append(members, inherited...), nil
+}
+
+// hashInvitationToken 计算邀请令牌摘要
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomHex 生成指定字节数的随机十六进制串
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// CreateInvitation 创建邀请，返回的邀请中携带一次性展示的令牌
+func (uc *MemberUsecase) CreateInvitation(ctx context.Context, invitation *Invitation, ttl time.Duration) (created *Invitation, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.CreateInvitation", attribute.String("tenant.id", invitation.TenantID),
+		attribute.String("member.role", invitation.Role.String()))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("CreateInvitation: tenantID=%v, role=%v, invitedBy=%v", invitation.TenantID, invitation.Role, invitation.InvitedBy)
+
+	if _, err := uc.getTenant(ctx, invitation.TenantID); err != nil {
+		return nil, err
+	}
+
+	id, err := randomHex(12)
+	if err != nil {
+		return nil, err
+	}
+	token, err := randomHex(24)
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 || ttl > uc.invitationTTL {
+		ttl = uc.invitationTTL
+	}
+	invitation.InvitationID = "inv_" + id
+	invitation.TokenHash = hashInvitationToken(token)
+	invitation.Status = InvitationStatusPending
+	invitation.ExpireTime = time.Now().Add(ttl)
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		created, err = uc.repo.CreateInvitation(ctx, invitation)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionInvitationCreate,
+			TargetType: AuditTargetInvitation,
+			TargetID:   created.InvitationID,
+			TenantID:   created.TenantID,
+			Operator:   created.InvitedBy,
+			After:      redactInvitation(created),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	created.Token = token
+	span.SetAttributes(attribute.String("invitation.id", created.InvitationID))
+	return created, nil
+}
+
+// AcceptInvitation 以令牌接受邀请，用户加入邀请所在的租户
+func (uc *MemberUsecase) AcceptInvitation(ctx context.Context, token, userID, displayName string) (member *Member, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.AcceptInvitation", attribute.String("member.user_id", userID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("AcceptInvitation: userID=%v", userID)
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		member, err = uc.repo.AcceptInvitation(ctx, hashInvitationToken(token), &Member{UserID: userID, DisplayName: displayName})
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionInvitationAccept,
+			TargetType: AuditTargetMember,
+			TargetID:   memberTargetID(member.TenantID, member.UserID),
+			TenantID:   member.TenantID,
+			Operator:   userID,
+			After:      member,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RevokeInvitation 撤销待接受的邀请
+func (uc *MemberUsecase) RevokeInvitation(ctx context.Context, invitationID string) (invitation *Invitation, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.RevokeInvitation", attribute.String("invitation.id", invitationID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("RevokeInvitation: invitationID=%v", invitationID)
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		invitation, err = uc.repo.RevokeInvitation(ctx, invitationID)
+		if err != nil {
+			return err
+		}
+		before := redactInvitation(invitation)
+		before.Status = InvitationStatusPending
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionInvitationRevoke,
+			TargetType: AuditTargetInvitation,
+			TargetID:   invitationID,
+			TenantID:   invitation.TenantID,
+			Before:     before,
+			After:      redactInvitation(invitation),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// ListInvitations 列出租户的邀请
+func (uc *MemberUsecase) ListInvitations(ctx context.Context, tenantID string, status InvitationStatus) (invitations []*Invitation, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.ListInvitations", attribute.String("tenant.id", tenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListInvitations: tenantID=%v, status=%v", tenantID, status)
+	return uc.repo.ListInvitations(ctx, tenantID, status)
+}
+
+// CheckPermission 检查用户在租户上是否拥有权限：取租户自身的成员角色和父租户中作用于子租户的角色中最高的一个
+func (uc *MemberUsecase) CheckPermission(ctx context.Context, tenantID, userID, permission string) (decision *PermissionDecision, err error) {
+	ctx, span := startSpan(ctx, "MemberUsecase.CheckPermission", append(memberAttrs(tenantID, userID),
+		attribute.String("permission", permission))...)
+	defer func() {
+		if decision != nil {
+			span.SetAttributes(attribute.Bool("permission.allowed", decision.Allowed))
+		}
+		endSpan(span, err)
+	}()
+
+	required, ok := minimumRoles[permission]
+	if !ok {
+		return nil, ErrPermissionUnknown.WithMetadata(map[string]string{"permission": permission})
+	}
+
+	tenant, err := uc.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !tenant.Status {
+		return &PermissionDecision{Reason: "tenant is disabled"}, nil
+	}
+
+	decision = &PermissionDecision{}
+	member, err := uc.repo.GetMember(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil {
+		decision.Role, decision.SourceTenantID = member.Role, tenantID
+	}
+
+	// 自身角色已满足时不再查找父租户
+	if decision.Role != required && !decision.Role.HigherThan(required) {
+		ancestorIDs, err := tenantAncestors(ctx, uc.tenantRepo, tenant, uc.maxInheritDepth)
+		if err != nil {
+			return nil, err
+		}
+		for _, ancestorID := range ancestorIDs {
+			inherited, err := uc.repo.GetMember(ctx, ancestorID, userID)
+			if err != nil {
+				return nil, err
+			}
+			if inherited != nil && inherited.Inherit && inherited.Role.HigherThan(decision.Role) {
+				decision.Role, decision.SourceTenantID, decision.Inherited = inherited.Role, ancestorID, true
+			}
+		}
+	}
+
+	switch {
+	case decision.Role == MemberRoleUnspecified:
+		decision.Reason = "user is not a member of the tenant"
+	case decision.Role == required || decision.Role.HigherThan(required):
+		decision.Allowed = true
+	default:
+		decision.Reason = "role " + decision.Role.String() + " lacks permission " + permission
+	}
+	return decision, nil
+}
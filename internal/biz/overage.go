@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// EnforcementMode 配额超出硬限制时的处理方式
+type EnforcementMode int32
+
+const (
+	EnforcementModeUnspecified EnforcementMode = 0 // 未指定，按HARD处理
+	EnforcementModeHard        EnforcementMode = 1 // 超出硬限制时拒绝消费
+	EnforcementModeSoftOnly    EnforcementMode = 2 // 只做软限制告警，不拒绝消费
+	EnforcementModeOverage     EnforcementMode = 3 // 允许超出硬限制，超出部分记为计费超额
+)
+
+var enforcementModeNames = map[EnforcementMode]string{
+	EnforcementModeHard:     "HARD",
+	EnforcementModeSoftOnly: "SOFT_ONLY",
+	EnforcementModeOverage:  "OVERAGE",
+}
+
+// String 返回执行模式名称
+func (m EnforcementMode) String() string {
+	if name, ok := enforcementModeNames[m]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// ParseEnforcementMode 解析执行模式名称，如 overage
+func ParseEnforcementMode(name string) (EnforcementMode, bool) {
+	for m, n := range enforcementModeNames {
+		if strings.EqualFold(n, name) {
+			return m, true
+		}
+	}
+	return EnforcementModeUnspecified, false
+}
+
+// Overage 返回当前周期超出硬限制的计费数量，非OVERAGE模式为0
+func (q *QuotaInfo) Overage() int32 {
+	if q.EnforcementMode != EnforcementModeOverage || q.UsedCount <= q.HardLimit {
+		return 0
+	}
+	return q.UsedCount - q.HardLimit
+}
+
+// validateEnforcement 校验配额执行模式和超额上限
+func validateEnforcement(limitType LimitType, mode EnforcementMode, maxOverage int32) error {
+	reason := ""
+	switch {
+	case maxOverage < 0:
+		reason = "max_overage must not be negative"
+	case mode != EnforcementModeUnspecified && mode != EnforcementModeHard && limitType == LimitTypeConcurrent:
+		reason = fmt.Sprintf("enforcement mode %s is not supported for %s quota", mode, limitType)
+	}
+	if reason != "" {
+		return ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": reason})
+	}
+	return nil
+}
+
+// QuotaOverage 配额在一个周期内的计费超额
+type QuotaOverage struct {
+	QuotaID     int64     // 配额ID
+	TenantID    string    // 租户ID
+	QuotaType   QuotaType // 配额类型
+	LimitType   LimitType // 限制类型
+	PeriodStart time.Time // 周期开始时间（上次重置时间或生效时间）
+	PeriodEnd   time.Time // 周期结束时间（下次重置时间），总量配额为过期时间
+	Overage     int32     // 超额数量
+	UpdatedAt   time.Time // 最近更新时间
+}
+
+// OverageFilter 超额查询条件，按周期开始时间过滤
+type OverageFilter struct {
+	TenantID  string    // 租户ID
+	QuotaType QuotaType // 配额类型
+	StartTime time.Time // 周期开始时间不早于该时间，零值表示不限制
+	EndTime   time.Time // 周期开始时间早于该时间，零值表示不限制
+}
+
+// ListOverages 列出租户各周期的计费超额
+func (uc *QuotaUsecase) ListOverages(ctx context.Context, filter *OverageFilter) (overages []*QuotaOverage, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ListOverages", attribute.String("tenant.id", filter.TenantID), attribute.String("quota.type", filter.QuotaType.String()))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListOverages: tenantID=%v, quotaType=%v, start=%v, end=%v", filter.TenantID, filter.QuotaType, filter.StartTime, filter.EndTime)
+
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && !filter.StartTime.Before(filter.EndTime) {
+		return nil, ErrInvalidDateRange
+	}
+	return uc.repo.ListOverages(ctx, filter)
+}
@@ -0,0 +1,336 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	// ErrPlanNotFound 套餐不存在
+	ErrPlanNotFound = errors.NotFound("PLAN_NOT_FOUND", "plan not found")
+	// ErrPlanInvalid 套餐配置不合法
+	ErrPlanInvalid = errors.BadRequest("PLAN_INVALID", "plan is invalid")
+	// ErrTenantNotFound 租户不存在
+	ErrTenantNotFound = errors.NotFound("TENANT_NOT_FOUND", "tenant not found")
+)
+
+// PlanQuota 套餐中的配额定义
+type PlanQuota struct {
+	QuotaType    QuotaType // 配额类型
+	LimitType    LimitType // 限制类型
+	HardLimit    int32     // 硬限制
+	SoftLimit    int32     // 软限制
+	ProductCodes []string  // 适用产品线
+}
+
+// QuotaPlan 配额套餐
+type QuotaPlan struct {
+	PlanCode     string             // 套餐编码
+	PlanName     string             // 套餐名称
+	Description  string             // 描述
+	Version      int32              // 版本，每次更新递增
+	Quotas       []*PlanQuota       // 配额定义
+	Entitlements []*PlanEntitlement // 授权项
+	CreatedBy    string             // 创建人
+	CreatedAt    time.Time          // 创建时间
+	UpdatedAt    time.Time          // 更新时间
+}
+
+// TenantPlan 租户套餐订阅
+type TenantPlan struct {
+	TenantID    string    // 租户ID
+	PlanCode    string    // 套餐编码
+	PlanVersion int32     // 已应用的套餐版本
+	AssignedBy  string    // 操作人
+	AssignedAt  time.Time // 最近一次应用时间
+}
+
+// QuotaOverride 租户级配额覆盖，nil字段沿用套餐配置
+type QuotaOverride struct {
+	TenantID  string    // 租户ID
+	QuotaType QuotaType // 配额类型
+	LimitType LimitType // 限制类型
+	HardLimit *int32    // 硬限制
+	SoftLimit *int32    // 软限制
+}
+
+// PlanAssignment 套餐应用参数
+type PlanAssignment struct {
+	TenantID       string          // 租户ID
+	Plan           *QuotaPlan      // 套餐
+	Operator       string          // 操作人
+	ClearOverrides bool            // 清除租户级覆盖
+	Proration      ProrationPolicy // 硬限制变化时的已用量折算策略，未指定时保留已用量
+}
+
+// PlanPropagationFailure 套餐同步失败的租户
+type PlanPropagationFailure struct {
+	TenantID string // 租户ID
+	Err      error  // 错误
+}
+
+// SavePlanResult 保存套餐结果
+type SavePlanResult struct {
+	Plan       *QuotaPlan                // 保存后的套餐
+	Propagated int                       // 已同步的租户数
+	Failures   []*PlanPropagationFailure // 同步失败的租户
+}
+
+// Resolve 合并租户级覆盖，生成租户的目标配额
+func (p *QuotaPlan) Resolve(tenantID string, overrides []*QuotaOverride) ([]*QuotaInfo, error) {
+	byKey := make(map[string]*QuotaOverride, len(overrides))
+	for _, override := range overrides {
+		byKey[quotaKey(override.QuotaType, override.LimitType)] = override
+	}
+
+	quotas := make([]*QuotaInfo, 0, len(p.Quotas))
+	for _, item := range p.Quotas {
+		quota := &QuotaInfo{
+			TenantID:     tenantID,
+			QuotaType:    item.QuotaType,
+			LimitType:    item.LimitType,
+			HardLimit:    item.HardLimit,
+			SoftLimit:    item.SoftLimit,
+			ProductCodes: item.ProductCodes,
+			PlanCode:     p.PlanCode,
+		}
+		if override, ok := byKey[quotaKey(item.QuotaType, item.LimitType)]; ok {
+			if override.HardLimit != nil {
+				quota.HardLimit = *override.HardLimit
+			}
+			if override.SoftLimit != nil {
+				quota.SoftLimit = *override.SoftLimit
+			}
+		}
+		if quota.SoftLimit > quota.HardLimit {
+			return nil, ErrPlanInvalid.WithMetadata(map[string]string{
+				"quota":  quotaKey(quota.QuotaType, quota.LimitType),
+				"reason": fmt.Sprintf("soft limit %d exceeds hard limit %d", quota.SoftLimit, quota.HardLimit),
+			})
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas, nil
+}
+
+// quotaKey 配额维度键
+func quotaKey(quotaType QuotaType, limitType LimitType) string {
+	return quotaType.String() + "/" + limitType.String()
+}
+
+// NextResetTime 计算周期配额的下次重置时间，日配额为次日零点，月配额为下月1日零点，其他类型为零值
+func NextResetTime(limitType LimitType, now time.Time) time.Time {
+	y, m, d := now.Date()
+	switch limitType {
+	case LimitTypeDaily:
+		return time.Date(y, m, d+1, 0, 0, 0, 0, now.Location())
+	case LimitTypeMonthly:
+		return time.Date(y, m+1, 1, 0, 0, 0, 0, now.Location())
+	default:
+		return time.Time{}
+	}
+}
+
+// PlanRepo 套餐仓储接口
+type PlanRepo interface {
+	// SavePlan 创建或更新套餐，更新时版本递增并替换配额定义和授权项
+	SavePlan(ctx context.Context, plan *QuotaPlan) (*QuotaPlan, error)
+	// GetPlan 获取套餐，不存在时返回nil
+	GetPlan(ctx context.Context, planCode string) (*QuotaPlan, error)
+	ListPlans(ctx context.Context) ([]*QuotaPlan, error)
+	// GetSubscription 获取租户的套餐订阅，未订阅时返回nil
+	GetSubscription(ctx context.Context, tenantID string) (*TenantPlan, error)
+	// ApplyPlan 在同一事务中按套餐和租户级覆盖创建或替换租户配额，并记录订阅
+	ApplyPlan(ctx context.Context, assignment *PlanAssignment) (*TenantPlan, []*QuotaInfo, error)
+	// ListStaleSubscribers 列出订阅了套餐但应用版本低于version的租户
+	ListStaleSubscribers(ctx context.Context, planCode string, version int32) ([]string, error)
+}
+
+// PlanUsecase 套餐用例
+type PlanUsecase struct {
+	repo       PlanRepo
+	tenantRepo TenantRepo
+	catalog    *EntitlementCatalog
+	audit      *AuditUsecase
+	log        *log.Helper
+}
+
+// NewPlanUsecase 创建套餐用例
+func NewPlanUsecase(repo PlanRepo, tenantRepo TenantRepo, catalog *EntitlementCatalog, audit *AuditUsecase, logger log.Logger) *PlanUsecase {
+	return &PlanUsecase{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		catalog:    catalog,
+		audit:      audit,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// validatePlan 校验套餐配额定义和授权项
+func validatePlan(plan *QuotaPlan, catalog *EntitlementCatalog) error {
+	seen := make(map[string]bool, len(plan.Quotas))
+	for _, item := range plan.Quotas {
+		key := quotaKey(item.QuotaType, item.LimitType)
+		if seen[key] {
+			return ErrPlanInvalid.WithMetadata(map[string]string{"quota": key, "reason": "duplicate quota"})
+		}
+		seen[key] = true
+		if item.SoftLimit > item.HardLimit {
+			return ErrPlanInvalid.WithMetadata(map[string]string{
+				"quota":  key,
+				"reason": fmt.Sprintf("soft limit %d exceeds hard limit %d", item.SoftLimit, item.HardLimit),
+			})
+		}
+	}
+
+	seenEntitlements := make(map[string]bool, len(plan.Entitlements))
+	for _, item := range plan.Entitlements {
+		key := item.ProductCode + "/" + item.Key
+		if seenEntitlements[key] {
+			return ErrPlanInvalid.WithMetadata(map[string]string{"entitlement": key, "reason": "duplicate entitlement"})
+		}
+		seenEntitlements[key] = true
+		if err := catalog.Validate(item.Key, item.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePlan 保存套餐并同步到应用版本落后的订阅租户，单个租户同步失败不影响其他租户
+func (uc *PlanUsecase) SavePlan(ctx context.Context, plan *QuotaPlan, operator string) (result *SavePlanResult, err error) {
+	ctx, span := startSpan(ctx, "PlanUsecase.SavePlan", attribute.String("plan.code", plan.PlanCode))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("SavePlan: planCode=%v, operator=%v", plan.PlanCode, operator)
+
+	if err := validatePlan(plan, uc.catalog); err != nil {
+		return nil, err
+	}
+	plan.CreatedBy = operator
+	var saved *QuotaPlan
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.GetPlan(ctx, plan.PlanCode)
+		if err != nil {
+			return err
+		}
+		saved, err = uc.repo.SavePlan(ctx, plan)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionPlanSave,
+			TargetType: AuditTargetPlan,
+			TargetID:   saved.PlanCode,
+			Operator:   operator,
+			Before:     before,
+			After:      saved,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tenantIDs, err := uc.repo.ListStaleSubscribers(ctx, saved.PlanCode, saved.Version)
+	if err != nil {
+		return nil, err
+	}
+	result = &SavePlanResult{Plan: saved}
+	for _, tenantID := range tenantIDs {
+		_, _, err := uc.applyPlan(ctx, &PlanAssignment{TenantID: tenantID, Plan: saved, Operator: operator})
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("propagate plan %s v%d to tenant %s error: %v", saved.PlanCode, saved.Version, tenantID, err)
+			result.Failures = append(result.Failures, &PlanPropagationFailure{TenantID: tenantID, Err: err})
+			continue
+		}
+		result.Propagated++
+	}
+	span.SetAttributes(attribute.Int("plan.version", int(saved.Version)), attribute.Int("plan.propagated", result.Propagated))
+	return result, nil
+}
+
+// GetPlan 获取套餐
+func (uc *PlanUsecase) GetPlan(ctx context.Context, planCode string) (plan *QuotaPlan, err error) {
+	ctx, span := startSpan(ctx, "PlanUsecase.GetPlan", attribute.String("plan.code", planCode))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("GetPlan: planCode=%v", planCode)
+
+	plan, err = uc.repo.GetPlan(ctx, planCode)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, ErrPlanNotFound
+	}
+	return plan, nil
+}
+
+// ListPlans 列出套餐
+func (uc *PlanUsecase) ListPlans(ctx context.Context) (plans []*QuotaPlan, err error) {
+	ctx, span := startSpan(ctx, "PlanUsecase.ListPlans")
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Info("ListPlans")
+	return uc.repo.ListPlans(ctx)
+}
+
+// AssignPlan 为租户分配套餐，套餐管理的配额按套餐替换，已用量按折算策略处理
+func (uc *PlanUsecase) AssignPlan(ctx context.Context, tenantID, planCode, operator string, clearOverrides bool, proration ProrationPolicy) (assignment *TenantPlan, quotas []*QuotaInfo, err error) {
+	ctx, span := startSpan(ctx, "PlanUsecase.AssignPlan", attribute.String("tenant.id", tenantID), attribute.String("plan.code", planCode))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("AssignPlan: tenantID=%v, planCode=%v, operator=%v", tenantID, planCode, operator)
+
+	tenant, err := uc.tenantRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tenant == nil {
+		return nil, nil, ErrTenantNotFound
+	}
+	plan, err := uc.GetPlan(ctx, planCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return uc.applyPlan(ctx, &PlanAssignment{
+		TenantID:       tenantID,
+		Plan:           plan,
+		Operator:       operator,
+		ClearOverrides: clearOverrides,
+		Proration:      proration,
+	})
+}
+
+// applyPlan 在同一事务中应用套餐并记录订阅变更的审计事件
+func (uc *PlanUsecase) applyPlan(ctx context.Context, assignment *PlanAssignment) (subscription *TenantPlan, quotas []*QuotaInfo, err error) {
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.GetSubscription(ctx, assignment.TenantID)
+		if err != nil {
+			return err
+		}
+		subscription, quotas, err = uc.repo.ApplyPlan(ctx, assignment)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionPlanAssign,
+			TargetType: AuditTargetTenantPlan,
+			TargetID:   assignment.TenantID,
+			TenantID:   assignment.TenantID,
+			Operator:   assignment.Operator,
+			Before:     before,
+			After:      subscription,
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return subscription, quotas, nil
+}
@@ -34,15 +34,17 @@ type ProductRepo interface {
 
 // ProductUsecase u4ea7u54c1u7528u4f8b
 type ProductUsecase struct {
-	repo ProductRepo
-	log  *log.Helper
+	repo  ProductRepo
+	audit *AuditUsecase
+	log   *log.Helper
 }
 
 // NewProductUsecase u521bu5efau4ea7u54c1u7528u4f8b
-func NewProductUsecase(repo ProductRepo, logger log.Logger) *ProductUsecase {
+func NewProductUsecase(repo ProductRepo, audit *AuditUsecase, logger log.Logger) *ProductUsecase {
 	return &ProductUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:  repo,
+		audit: audit,
+		log:   log.NewHelper(logger),
 	}
 }
 
@@ -57,3 +59,20 @@ func (uc *ProductUsecase) ListProductsByTenant(ctx context.Context, tenantID str
 	uc.log.WithContext(ctx).Infof("ListProductsByTenant: tenantID=%v", tenantID)
 	return uc.repo.ListProductsByTenant(ctx, tenantID)
 }
+
+// BindProduct 关联产品到租户
+func (uc *ProductUsecase) BindProduct(ctx context.Context, tenantID, productCode string) error {
+	uc.log.WithContext(ctx).Infof("BindProduct: tenantID=%v, productCode=%v", tenantID, productCode)
+	return uc.audit.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.AssociateProductToTenant(ctx, tenantID, productCode); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionProductBind,
+			TargetType: AuditTargetTenantProduct,
+			TargetID:   tenantID + "/" + productCode,
+			TenantID:   tenantID,
+			After:      &TenantProduct{TenantID: tenantID, ProductCode: productCode},
+		})
+	})
+}
@@ -2,9 +2,26 @@ package biz
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	// ErrQuotaNotFound 配额不存在
+	ErrQuotaNotFound = errors.NotFound("QUOTA_NOT_FOUND", "quota not found")
+	// ErrQuotaExceeded 配额不足
+	ErrQuotaExceeded = errors.Forbidden("QUOTA_EXCEEDED", "quota exceeded")
+)
+
+// 配额消费被拒绝的原因
+const (
+	DenyReasonNotFound = "not_found" // 配额不存在
+	DenyReasonExceeded = "exceeded"  // 超出硬限制
+	DenyReasonError    = "error"     // 内部错误
 )
 
 // QuotaType 配额类型
@@ -54,6 +71,18 @@ type QuotaInfo struct {
 	IsGlobal      bool      // 是否全局
 	ProductCodes  []string  // 产品代码列表
 	ExtraConfig   string    // 额外配置
+	PlanCode      string    // 来源套餐，为空表示单独配置
+
+	RolloverGranted    int32     // 上次重置时结转的数量
+	RolloverUsed       int32     // 已使用的结转数量，消费时优先使用结转额度
+	RolloverExpireTime time.Time // 结转额度失效时间
+
+	EnforcementMode EnforcementMode // 超出硬限制时的处理方式
+	MaxOverage      int32           // OVERAGE模式下最多超出硬限制的数量，0表示不限制
+
+	Allocations []*QuotaAllocation // 产品线划分额度，由extra_config的allocations配置
+
+	Shards int32 // 分片计数的分片数，由extra_config的shards配置，0表示不分片
 }
 
 // QuotaUsageRecord 配额使用记录
@@ -70,39 +99,103 @@ type QuotaUsageRecord struct {
 	OperationTime time.Time     // 操作时间
 	ExpireTime    time.Time     // 过期时间
 	Remark        string        // 备注
+
+	ChainSeq   int64  // 配额内的哈希链序号，0表示定序任务尚未分配
+	PrevHash   string // 前序记录哈希
+	RecordHash string // 记录哈希
+}
+
+// QuotaAdjustment 配额调整，nil字段表示不修改
+type QuotaAdjustment struct {
+	HardLimit   *int32  // 硬限制
+	SoftLimit   *int32  // 软限制
+	UsedCount   *int32  // 已使用数量
+	ExtraConfig *string // 额外配置
+	Operator    string  // 操作人
+	Remark      string  // 备注
+
+	EnforcementMode *EnforcementMode // 执行模式
+	MaxOverage      *int32           // 超额上限
+
+	EffectiveTime *time.Time // 生效时间
+	ExpireTime    *time.Time // 过期时间
+	ProductCodes  []string   // 适用产品线，nil表示不修改
+}
+
+// UsageRecordFilter 配额使用记录查询条件
+type UsageRecordFilter struct {
+	TenantID      string    // 租户ID
+	QuotaType     QuotaType // 配额类型
+	AfterRecordID int64     // 只返回记录ID大于该值的记录
+	Limit         int32     // 返回条数
+}
+
+// QuotaResetResult 配额重置结果
+type QuotaResetResult struct {
+	Quotas    []*QuotaInfo // 已重置的配额
+	OldestDue time.Time    // 最早的应重置时间，无待重置配额时为零值
 }
 
 // QuotaRepo 配额仓储接口
 type QuotaRepo interface {
 	CreateQuota(ctx context.Context, quota *QuotaInfo) (*QuotaInfo, error)
+	// GetQuota 按ResolveQuota的顺序获取租户在产品线下使用的配额，没有匹配时返回nil
 	GetQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, productCode string) (*QuotaInfo, error)
-	UpdateQuota(ctx context.Context, quota *QuotaInfo) (*QuotaInfo, error)
+	// ResolveQuota 加载租户、上级租户和全局的候选配额并解析
+	ResolveQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, productCode string) (*QuotaResolution, error)
 	DeleteQuota(ctx context.Context, quotaID int64) error
 	ListQuotas(ctx context.Context, tenantID string, quotaType QuotaType) ([]*QuotaInfo, error)
-	ConsumeQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID, bizType string) (bool, int32, error)
-	ReleaseQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID string) (bool, int32, error)
-	ResetQuotas(ctx context.Context, limitType LimitType) error
+	// ConsumeQuota 消费配额，按配额执行模式检查硬限制，返回消费后的配额；配额不足时返回当前配额和ErrQuotaExceeded
+	ConsumeQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID, bizType string) (*QuotaInfo, error)
+	// ReleaseQuota 释放配额，返回释放后的配额
+	ReleaseQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID string) (*QuotaInfo, error)
+	ResetQuotas(ctx context.Context, limitType LimitType) (*QuotaResetResult, error)
+	AdjustQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, adjustment *QuotaAdjustment) (*QuotaInfo, error)
+	ListUsageRecords(ctx context.Context, filter *UsageRecordFilter) ([]*QuotaUsageRecord, error)
+	// ListOverages 列出超额数量大于0的周期，按周期开始时间降序
+	ListOverages(ctx context.Context, filter *OverageFilter) ([]*QuotaOverage, error)
+}
+
+// QuotaMetrics 配额监控指标
+type QuotaMetrics interface {
+	// ObserveQuota 记录配额的已用量和剩余量
+	ObserveQuota(ctx context.Context, quota *QuotaInfo)
+	// ConsumeDenied 记录被拒绝的配额消费
+	ConsumeDenied(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, reason string)
+	// SoftLimitCrossed 记录已用量越过软限制
+	SoftLimitCrossed(ctx context.Context, quota *QuotaInfo)
+	// ResetFinished 记录配额重置任务的耗时和延迟
+	ResetFinished(ctx context.Context, limitType LimitType, count int, duration, lag time.Duration)
+	// LockWait 记录配额行锁等待时间
+	LockWait(ctx context.Context, quotaType QuotaType, limitType LimitType, wait time.Duration)
 }
 
 // QuotaUsecase 配额用例
 type QuotaUsecase struct {
-	repo QuotaRepo
-	log  *log.Helper
+	repo    QuotaRepo
+	metrics QuotaMetrics
+	audit   *AuditUsecase
+	log     *log.Helper
 }
 
 // NewQuotaUsecase 创建配额用例
-func NewQuotaUsecase(repo QuotaRepo, logger log.Logger) *QuotaUsecase {
+func NewQuotaUsecase(repo QuotaRepo, metrics QuotaMetrics, audit *AuditUsecase, logger log.Logger) *QuotaUsecase {
 	return &QuotaUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:    repo,
+		metrics: metrics,
+		audit:   audit,
+		log:     log.NewHelper(logger),
 	}
 }
 
-// CheckQuota 检查配额
-func (uc *QuotaUsecase) CheckQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, productCode string) (*QuotaInfo, bool, int32, error) {
+// CheckQuota 检查配额，可用量为产品线划分额度的剩余量加共享额度的剩余量
+func (uc *QuotaUsecase) CheckQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, productCode string) (quota *QuotaInfo, hasQuota bool, available int32, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.CheckQuota", quotaAttrs(tenantID, quotaType, limitType)...)
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("CheckQuota: tenantID=%v, quotaType=%v, limitType=%v", tenantID, quotaType, limitType)
 
-	quota, err := uc.repo.GetQuota(ctx, tenantID, quotaType, limitType, productCode)
+	quota, err = uc.repo.GetQuota(ctx, tenantID, quotaType, limitType, productCode)
 	if err != nil {
 		return nil, false, 0, err
 	}
@@ -111,26 +204,212 @@ func (uc *QuotaUsecase) CheckQuota(ctx context.Context, tenantID string, quotaTy
 		return nil, false, 0, nil
 	}
 
-	available := quota.HardLimit - quota.UsedCount
-	hasQuota := available > 0
+	available = quota.RemainingFor(productCode)
+	hasQuota = available > 0
 
 	return quota, hasQuota, available, nil
 }
 
-// ConsumeQuota 消费配额
-func (uc *QuotaUsecase) ConsumeQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID, bizType string) (bool, int32, error) {
+// ConsumeQuota 消费配额，overage为本次消费中超出硬限制计费的数量
+func (uc *QuotaUsecase) ConsumeQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID, bizType string) (success bool, remaining, overage int32, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ConsumeQuota", append(quotaAttrs(tenantID, quotaType, limitType),
+		attribute.Int("quota.amount", int(amount)),
+		attribute.String("product.code", productCode),
+		attribute.String("biz.id", bizID),
+		attribute.String("biz.type", bizType),
+	)...)
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("ConsumeQuota: tenantID=%v, quotaType=%v, limitType=%v, amount=%v", tenantID, quotaType, limitType, amount)
-	return uc.repo.ConsumeQuota(ctx, tenantID, quotaType, limitType, amount, productCode, bizID, bizType)
+
+	quota, err := uc.repo.ConsumeQuota(ctx, tenantID, quotaType, limitType, amount, productCode, bizID, bizType)
+	if err != nil {
+		uc.metrics.ConsumeDenied(ctx, tenantID, quotaType, limitType, denyReason(err))
+		if quota != nil {
+			return false, quota.RemainingFor(productCode), 0, err
+		}
+		return false, 0, 0, err
+	}
+
+	uc.metrics.ObserveQuota(ctx, quota)
+	if quota.SoftLimit > 0 && quota.UsedCount >= quota.SoftLimit && quota.UsedCount-amount < quota.SoftLimit {
+		uc.metrics.SoftLimitCrossed(ctx, quota)
+	}
+	// 消费先用满硬限制内的额度，超出部分位于已用量的顶部
+	overage = quota.Overage()
+	if overage > amount {
+		overage = amount
+	}
+	remaining = quota.RemainingFor(productCode)
+	span.SetAttributes(attribute.Int64("quota.id", quota.QuotaID), attribute.Int("quota.remaining", int(remaining)), attribute.Int("quota.overage", int(overage)))
+	return true, remaining, overage, nil
+}
+
+// denyReason 消费失败原因
+func denyReason(err error) string {
+	switch {
+	case errors.Is(err, ErrQuotaNotFound):
+		return DenyReasonNotFound
+	case errors.Is(err, ErrQuotaExceeded):
+		return DenyReasonExceeded
+	default:
+		return DenyReasonError
+	}
 }
 
 // ReleaseQuota 释放配额
-func (uc *QuotaUsecase) ReleaseQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID string) (bool, int32, error) {
+func (uc *QuotaUsecase) ReleaseQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, amount int32, productCode, bizID string) (success bool, remaining int32, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ReleaseQuota", append(quotaAttrs(tenantID, quotaType, limitType),
+		attribute.Int("quota.amount", int(amount)),
+		attribute.String("product.code", productCode),
+		attribute.String("biz.id", bizID),
+	)...)
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("ReleaseQuota: tenantID=%v, quotaType=%v, limitType=%v, amount=%v", tenantID, quotaType, limitType, amount)
-	return uc.repo.ReleaseQuota(ctx, tenantID, quotaType, limitType, amount, productCode, bizID)
+
+	quota, err := uc.repo.ReleaseQuota(ctx, tenantID, quotaType, limitType, amount, productCode, bizID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	uc.metrics.ObserveQuota(ctx, quota)
+	return true, quota.RemainingFor(productCode), nil
 }
 
 // ResetQuotas 重置配额
-func (uc *QuotaUsecase) ResetQuotas(ctx context.Context, limitType LimitType) error {
+func (uc *QuotaUsecase) ResetQuotas(ctx context.Context, limitType LimitType) (err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ResetQuotas", attribute.String("quota.limit_type", limitType.String()))
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("ResetQuotas: limitType=%v", limitType)
-	return uc.repo.ResetQuotas(ctx, limitType)
+
+	start := time.Now()
+	result, err := uc.repo.ResetQuotas(ctx, limitType)
+	if err != nil {
+		return err
+	}
+
+	// 延迟为最早应重置时间到任务开始执行的间隔
+	var lag time.Duration
+	if !result.OldestDue.IsZero() && start.After(result.OldestDue) {
+		lag = start.Sub(result.OldestDue)
+	}
+	uc.metrics.ResetFinished(ctx, limitType, len(result.Quotas), time.Since(start), lag)
+	for _, quota := range result.Quotas {
+		uc.metrics.ObserveQuota(ctx, quota)
+	}
+	return nil
+}
+
+// ListQuotas 列出租户配额
+func (uc *QuotaUsecase) ListQuotas(ctx context.Context, tenantID string, quotaType QuotaType) (quotas []*QuotaInfo, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ListQuotas", attribute.String("tenant.id", tenantID), attribute.String("quota.type", quotaType.String()))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListQuotas: tenantID=%v, quotaType=%v", tenantID, quotaType)
+	return uc.repo.ListQuotas(ctx, tenantID, quotaType)
+}
+
+// AdjustQuota 调整配额
+func (uc *QuotaUsecase) AdjustQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, adjustment *QuotaAdjustment) (quota *QuotaInfo, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.AdjustQuota", quotaAttrs(tenantID, quotaType, limitType)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("AdjustQuota: tenantID=%v, quotaType=%v, limitType=%v, operator=%v", tenantID, quotaType, limitType, adjustment.Operator)
+
+	if adjustment.ExtraConfig != nil {
+		if _, err := ParseQuotaExtraConfig(limitType, *adjustment.ExtraConfig); err != nil {
+			return nil, err
+		}
+	}
+	if adjustment.EnforcementMode != nil || adjustment.MaxOverage != nil {
+		mode, maxOverage := EnforcementModeUnspecified, int32(0)
+		if adjustment.EnforcementMode != nil {
+			mode = *adjustment.EnforcementMode
+		}
+		if adjustment.MaxOverage != nil {
+			maxOverage = *adjustment.MaxOverage
+		}
+		if err := validateEnforcement(limitType, mode, maxOverage); err != nil {
+			return nil, err
+		}
+	}
+	quota, err = uc.adjustAudited(ctx, AuditActionQuotaAdjust, tenantID, quotaType, limitType, adjustment)
+	if err != nil {
+		return nil, err
+	}
+	uc.metrics.ObserveQuota(ctx, quota)
+	return quota, nil
+}
+
+// ResetQuota 重置配额已用量
+func (uc *QuotaUsecase) ResetQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, operator, remark string) (quota *QuotaInfo, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ResetQuota", quotaAttrs(tenantID, quotaType, limitType)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ResetQuota: tenantID=%v, quotaType=%v, limitType=%v, operator=%v", tenantID, quotaType, limitType, operator)
+
+	if remark == "" {
+		remark = "manual reset"
+	}
+	usedCount := int32(0)
+	quota, err = uc.adjustAudited(ctx, AuditActionQuotaReset, tenantID, quotaType, limitType, &QuotaAdjustment{
+		UsedCount: &usedCount,
+		Operator:  operator,
+		Remark:    remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.metrics.ObserveQuota(ctx, quota)
+	return quota, nil
+}
+
+// adjustAudited 在同一事务中调整配额并记录审计事件
+func (uc *QuotaUsecase) adjustAudited(ctx context.Context, action AuditAction, tenantID string, quotaType QuotaType, limitType LimitType, adjustment *QuotaAdjustment) (quota *QuotaInfo, err error) {
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		quotas, err := uc.repo.ListQuotas(ctx, tenantID, quotaType)
+		if err != nil {
+			return err
+		}
+		var before *QuotaInfo
+		for _, item := range quotas {
+			if item.LimitType == limitType {
+				before = item
+				break
+			}
+		}
+		quota, err = uc.repo.AdjustQuota(ctx, tenantID, quotaType, limitType, adjustment)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     action,
+			TargetType: AuditTargetQuota,
+			TargetID:   strconv.FormatInt(quota.QuotaID, 10),
+			TenantID:   tenantID,
+			Operator:   adjustment.Operator,
+			Before:     before,
+			After:      quota,
+			Remark:     adjustment.Remark,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// ListUsageRecords 列出配额使用记录
+func (uc *QuotaUsecase) ListUsageRecords(ctx context.Context, filter *UsageRecordFilter) (records []*QuotaUsageRecord, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ListUsageRecords", attribute.String("tenant.id", filter.TenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListUsageRecords: tenantID=%v, afterRecordID=%v", filter.TenantID, filter.AfterRecordID)
+
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+	return uc.repo.ListUsageRecords(ctx, filter)
 }
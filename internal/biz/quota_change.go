@@ -0,0 +1,356 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
+)
+
+var (
+	// ErrQuotaChangeNotFound 配额变更不存在
+	ErrQuotaChangeNotFound = errors.NotFound("QUOTA_CHANGE_NOT_FOUND", "quota change not found")
+	// ErrQuotaChangeNotPending 配额变更已生效或已取消
+	ErrQuotaChangeNotPending = errors.Conflict("QUOTA_CHANGE_NOT_PENDING", "quota change is not pending")
+	// ErrQuotaChangeInvalid 配额变更参数不合法
+	ErrQuotaChangeInvalid = errors.BadRequest("QUOTA_CHANGE_INVALID", "quota change is invalid")
+)
+
+// dueChangeBatchSize 每轮处理的到期变更数
+const dueChangeBatchSize = 100
+
+// ProrationPolicy 已用量折算策略
+type ProrationPolicy int32
+
+const (
+	ProrationUnspecified ProrationPolicy = 0
+	ProrationCarryOver   ProrationPolicy = 1 // 保留已用量
+	ProrationScale       ProrationPolicy = 2 // 按新旧硬限制比例折算
+	ProrationReset       ProrationPolicy = 3 // 已用量清零
+)
+
+var prorationNames = map[ProrationPolicy]string{
+	ProrationCarryOver: "CARRY_OVER",
+	ProrationScale:     "SCALE",
+	ProrationReset:     "RESET",
+}
+
+// String 返回折算策略名称
+func (p ProrationPolicy) String() string {
+	if name, ok := prorationNames[p]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// ParseProrationPolicy 解析折算策略名称，如 carry_over
+func ParseProrationPolicy(name string) (ProrationPolicy, bool) {
+	for p, n := range prorationNames {
+		if strings.EqualFold(n, name) {
+			return p, true
+		}
+	}
+	return ProrationUnspecified, false
+}
+
+// Apply 按策略计算硬限制从oldHard变为newHard后的已用量，未指定时保留已用量
+func (p ProrationPolicy) Apply(used, oldHard, newHard int32) int32 {
+	switch p {
+	case ProrationReset:
+		return 0
+	case ProrationScale:
+		if oldHard <= 0 || oldHard == newHard {
+			return used
+		}
+		return int32(math.Round(float64(used) * float64(newHard) / float64(oldHard)))
+	default:
+		return used
+	}
+}
+
+// QuotaChangeStatus 配额变更状态
+type QuotaChangeStatus int32
+
+const (
+	QuotaChangeStatusUnspecified QuotaChangeStatus = 0
+	QuotaChangeStatusPending     QuotaChangeStatus = 1 // 待生效
+	QuotaChangeStatusApplied     QuotaChangeStatus = 2 // 已生效
+	QuotaChangeStatusCanceled    QuotaChangeStatus = 3 // 已取消
+	QuotaChangeStatusFailed      QuotaChangeStatus = 4 // 生效失败
+)
+
+// QuotaChange 计划配额变更，PlanCode非空时为套餐切换，否则修改单个配额的限制
+type QuotaChange struct {
+	ChangeID  int64             // 变更ID
+	TenantID  string            // 租户ID
+	QuotaType QuotaType         // 配额类型
+	LimitType LimitType         // 限制类型
+	HardLimit *int32            // 新硬限制
+	SoftLimit *int32            // 新软限制
+	PlanCode  string            // 切换到的套餐
+	ApplyAt   time.Time         // 生效时间
+	Proration ProrationPolicy   // 已用量折算策略
+	Status    QuotaChangeStatus // 状态
+	Operator  string            // 操作人
+	Remark    string            // 备注
+	Error     string            // 生效失败原因
+	AppliedAt time.Time         // 实际生效时间
+	CreatedAt time.Time         // 创建时间
+}
+
+// QuotaChangeFilter 配额变更查询条件
+type QuotaChangeFilter struct {
+	TenantID string            // 租户ID
+	Status   QuotaChangeStatus // 状态
+}
+
+// QuotaChangeRepo 配额变更仓储接口
+type QuotaChangeRepo interface {
+	CreateQuotaChange(ctx context.Context, change *QuotaChange) (*QuotaChange, error)
+	ListQuotaChanges(ctx context.Context, filter *QuotaChangeFilter) ([]*QuotaChange, error)
+	// ListDueQuotaChanges 按生效时间升序列出到期的待生效变更
+	ListDueQuotaChanges(ctx context.Context, now time.Time, limit int) ([]*QuotaChange, error)
+	// CancelQuotaChange 取消租户的待生效变更，不存在返回ErrQuotaChangeNotFound，非待生效返回ErrQuotaChangeNotPending
+	CancelQuotaChange(ctx context.Context, tenantID string, changeID int64, operator string) (*QuotaChange, error)
+	// ApplyQuotaChange 在同一事务中应用变更、写入ADJUST记录并标记为已生效，plan非nil时按套餐替换租户配额
+	ApplyQuotaChange(ctx context.Context, change *QuotaChange, plan *QuotaPlan) ([]*QuotaInfo, error)
+	// FailQuotaChange 标记变更生效失败
+	FailQuotaChange(ctx context.Context, changeID int64, reason string) error
+}
+
+// QuotaChangeUsecase 计划配额变更用例
+type QuotaChangeUsecase struct {
+	repo             QuotaChangeRepo
+	quotaRepo        QuotaRepo
+	planRepo         PlanRepo
+	metrics          QuotaMetrics
+	defaultProration ProrationPolicy
+	audit            *AuditUsecase
+	log              *log.Helper
+}
+
+// NewQuotaChangeUsecase 创建计划配额变更用例
+func NewQuotaChangeUsecase(c *conf.Tenant, repo QuotaChangeRepo, quotaRepo QuotaRepo, planRepo PlanRepo, metrics QuotaMetrics, audit *AuditUsecase, logger log.Logger) (*QuotaChangeUsecase, error) {
+	uc := &QuotaChangeUsecase{
+		repo:             repo,
+		quotaRepo:        quotaRepo,
+		planRepo:         planRepo,
+		metrics:          metrics,
+		defaultProration: ProrationCarryOver,
+		audit:            audit,
+		log:              log.NewHelper(logger),
+	}
+	if name := c.GetQuotaChange().GetDefaultProration(); name != "" {
+		policy, ok := ParseProrationPolicy(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown default proration policy: %s", name)
+		}
+		uc.defaultProration = policy
+	}
+	return uc, nil
+}
+
+// ScheduleQuotaChange 计划配额变更，生效时间为零值且未指定atNextReset时立即生效并返回变更后的配额
+func (uc *QuotaChangeUsecase) ScheduleQuotaChange(ctx context.Context, change *QuotaChange, atNextReset bool) (scheduled *QuotaChange, quotas []*QuotaInfo, err error) {
+	ctx, span := startSpan(ctx, "QuotaChangeUsecase.ScheduleQuotaChange", append(quotaAttrs(change.TenantID, change.QuotaType, change.LimitType),
+		attribute.String("plan.code", change.PlanCode),
+	)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ScheduleQuotaChange: tenantID=%v, quotaType=%v, limitType=%v, planCode=%v, applyAt=%v, atNextReset=%v",
+		change.TenantID, change.QuotaType, change.LimitType, change.PlanCode, change.ApplyAt, atNextReset)
+
+	now := time.Now()
+	var plan *QuotaPlan
+	if change.PlanCode != "" {
+		if change.QuotaType != QuotaTypeUnspecified || change.LimitType != LimitTypeUnspecified || change.HardLimit != nil || change.SoftLimit != nil {
+			return nil, nil, ErrQuotaChangeInvalid.WithMetadata(map[string]string{"reason": "plan change must not specify quota limits"})
+		}
+		if plan, err = uc.planRepo.GetPlan(ctx, change.PlanCode); err != nil {
+			return nil, nil, err
+		}
+		if plan == nil {
+			return nil, nil, ErrPlanNotFound
+		}
+		if atNextReset {
+			change.ApplyAt = NextResetTime(LimitTypeMonthly, now)
+		}
+	} else {
+		if change.QuotaType == QuotaTypeUnspecified || change.LimitType == LimitTypeUnspecified {
+			return nil, nil, ErrQuotaChangeInvalid.WithMetadata(map[string]string{"reason": "quota_type and limit_type are required"})
+		}
+		if change.HardLimit == nil && change.SoftLimit == nil {
+			return nil, nil, ErrQuotaChangeInvalid.WithMetadata(map[string]string{"reason": "hard_limit or soft_limit is required"})
+		}
+		quota, err := uc.quotaRepo.GetQuota(ctx, change.TenantID, change.QuotaType, change.LimitType, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		if quota == nil || quota.IsGlobal || quota.TenantID != change.TenantID {
+			return nil, nil, ErrQuotaNotFound
+		}
+		if atNextReset {
+			if quota.NextResetTime.IsZero() {
+				return nil, nil, ErrQuotaChangeInvalid.WithMetadata(map[string]string{"reason": "quota has no scheduled reset"})
+			}
+			change.ApplyAt = quota.NextResetTime
+		}
+	}
+
+	immediate := change.ApplyAt.IsZero() || !change.ApplyAt.After(now)
+	if immediate {
+		change.ApplyAt = now
+	}
+	if change.Proration == ProrationUnspecified {
+		change.Proration = uc.defaultProration
+	}
+	change.Status = QuotaChangeStatusPending
+
+	// 立即生效的变更先提交再应用，应用失败时变更保留为生效失败
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		scheduled, err = uc.repo.CreateQuotaChange(ctx, change)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionQuotaChangeSchedule,
+			TargetType: AuditTargetQuotaChange,
+			TargetID:   strconv.FormatInt(scheduled.ChangeID, 10),
+			TenantID:   scheduled.TenantID,
+			Operator:   scheduled.Operator,
+			After:      scheduled,
+			Remark:     scheduled.Remark,
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	span.SetAttributes(attribute.Int64("quota_change.id", scheduled.ChangeID))
+	if !immediate {
+		return scheduled, nil, nil
+	}
+
+	quotas, err = uc.apply(ctx, scheduled, plan, scheduled.Operator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scheduled, quotas, nil
+}
+
+// apply 应用变更，业务错误标记为失败不再重试，其他错误保持待生效由定时任务重试；operator为空时审计操作人取请求头或system
+func (uc *QuotaChangeUsecase) apply(ctx context.Context, change *QuotaChange, plan *QuotaPlan, operator string) ([]*QuotaInfo, error) {
+	var quotas []*QuotaInfo
+	err := uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before := *change
+		var err error
+		quotas, err = uc.repo.ApplyQuotaChange(ctx, change, plan)
+		if err != nil {
+			return err
+		}
+		after := *change
+		after.Status = QuotaChangeStatusApplied
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionQuotaChangeApply,
+			TargetType: AuditTargetQuotaChange,
+			TargetID:   strconv.FormatInt(change.ChangeID, 10),
+			TenantID:   change.TenantID,
+			Operator:   operator,
+			Before:     &before,
+			After:      &after,
+		})
+	})
+	if err != nil {
+		if se := errors.FromError(err); se.Code >= 400 && se.Code < 500 {
+			if failErr := uc.repo.FailQuotaChange(ctx, change.ChangeID, se.Reason+": "+se.Message); failErr != nil {
+				uc.log.WithContext(ctx).Errorf("mark quota change %d failed error: %v", change.ChangeID, failErr)
+			}
+			change.Status = QuotaChangeStatusFailed
+		}
+		return nil, err
+	}
+
+	change.Status = QuotaChangeStatusApplied
+	for _, quota := range quotas {
+		uc.metrics.ObserveQuota(ctx, quota)
+	}
+	return quotas, nil
+}
+
+// ApplyDueQuotaChanges 应用到期的待生效变更，返回成功应用的数量，由定时重置任务在重置前调用
+func (uc *QuotaChangeUsecase) ApplyDueQuotaChanges(ctx context.Context) (applied int, err error) {
+	ctx, span := startSpan(ctx, "QuotaChangeUsecase.ApplyDueQuotaChanges")
+	defer func() { endSpan(span, err) }()
+
+	changes, err := uc.repo.ListDueQuotaChanges(ctx, time.Now(), dueChangeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, change := range changes {
+		var plan *QuotaPlan
+		if change.PlanCode != "" {
+			if plan, err = uc.planRepo.GetPlan(ctx, change.PlanCode); err != nil {
+				return applied, err
+			}
+			if plan == nil {
+				if err := uc.repo.FailQuotaChange(ctx, change.ChangeID, ErrPlanNotFound.Reason); err != nil {
+					return applied, err
+				}
+				continue
+			}
+		}
+		if _, err := uc.apply(ctx, change, plan, ""); err != nil {
+			uc.log.WithContext(ctx).Errorf("apply quota change %d error: %v", change.ChangeID, err)
+			continue
+		}
+		applied++
+	}
+	span.SetAttributes(attribute.Int("quota_change.applied", applied))
+	return applied, nil
+}
+
+// ListQuotaChanges 列出租户的配额变更
+func (uc *QuotaChangeUsecase) ListQuotaChanges(ctx context.Context, filter *QuotaChangeFilter) (changes []*QuotaChange, err error) {
+	ctx, span := startSpan(ctx, "QuotaChangeUsecase.ListQuotaChanges", attribute.String("tenant.id", filter.TenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListQuotaChanges: tenantID=%v, status=%v", filter.TenantID, filter.Status)
+	return uc.repo.ListQuotaChanges(ctx, filter)
+}
+
+// CancelQuotaChange 取消待生效的配额变更
+func (uc *QuotaChangeUsecase) CancelQuotaChange(ctx context.Context, tenantID string, changeID int64, operator string) (change *QuotaChange, err error) {
+	ctx, span := startSpan(ctx, "QuotaChangeUsecase.CancelQuotaChange", attribute.String("tenant.id", tenantID), attribute.Int64("quota_change.id", changeID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("CancelQuotaChange: tenantID=%v, changeID=%v, operator=%v", tenantID, changeID, operator)
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		change, err = uc.repo.CancelQuotaChange(ctx, tenantID, changeID, operator)
+		if err != nil {
+			return err
+		}
+		before := *change
+		before.Status = QuotaChangeStatusPending
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionQuotaChangeCancel,
+			TargetType: AuditTargetQuotaChange,
+			TargetID:   strconv.FormatInt(changeID, 10),
+			TenantID:   tenantID,
+			Operator:   operator,
+			Before:     &before,
+			After:      change,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// QuotaMatchLevel 配额匹配层级，数值越小优先级越高
+type QuotaMatchLevel int32
+
+const (
+	QuotaMatchLevelUnspecified      QuotaMatchLevel = 0
+	QuotaMatchLevelTenantProduct    QuotaMatchLevel = 1 // 租户配额，产品线在product_codes中
+	QuotaMatchLevelTenantWildcard   QuotaMatchLevel = 2 // 租户配额，适用全部产品线
+	QuotaMatchLevelAncestorProduct  QuotaMatchLevel = 3 // 上级租户配额，产品线在product_codes中
+	QuotaMatchLevelAncestorWildcard QuotaMatchLevel = 4 // 上级租户配额，适用全部产品线
+	QuotaMatchLevelGlobalProduct    QuotaMatchLevel = 5 // 全局默认配额，产品线在product_codes中
+	QuotaMatchLevelGlobalWildcard   QuotaMatchLevel = 6 // 全局默认配额，适用全部产品线
+)
+
+var quotaMatchLevelNames = map[QuotaMatchLevel]string{
+	QuotaMatchLevelTenantProduct:    "TENANT_PRODUCT",
+	QuotaMatchLevelTenantWildcard:   "TENANT_WILDCARD",
+	QuotaMatchLevelAncestorProduct:  "ANCESTOR_PRODUCT",
+	QuotaMatchLevelAncestorWildcard: "ANCESTOR_WILDCARD",
+	QuotaMatchLevelGlobalProduct:    "GLOBAL_PRODUCT",
+	QuotaMatchLevelGlobalWildcard:   "GLOBAL_WILDCARD",
+}
+
+// String 返回匹配层级名称
+func (l QuotaMatchLevel) String() string {
+	if name, ok := quotaMatchLevelNames[l]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// QuotaCandidate 配额解析时考虑的候选配额
+type QuotaCandidate struct {
+	Quota    *QuotaInfo      // 候选配额
+	Level    QuotaMatchLevel // 匹配层级，未匹配时为UNSPECIFIED
+	Depth    int32           // 所属租户的层级：0为租户本身，1为父租户，依次递增；全局配额为-1
+	Selected bool            // 是否被选中
+	Reason   string          // 选中或未选中的原因
+}
+
+// QuotaResolution 配额解析结果
+type QuotaResolution struct {
+	Selected   *QuotaInfo        // 选中的配额，没有匹配时为nil
+	Ancestors  []string          // 上级租户ID，由近及远
+	Candidates []*QuotaCandidate // 候选配额，按优先级排序，未匹配的排在最后
+}
+
+// ResolveQuota 按固定顺序解析租户在某产品线下使用的配额：
+// 租户配额（产品线明确列出优先于适用全部产品线）、上级租户配额（由近及远）、全局默认配额；
+// 同一层级按配额ID升序。productCode为空时不按产品线过滤，配额均按适用全部产品线处理
+func ResolveQuota(tenantID string, ancestors []string, productCode string, quotas []*QuotaInfo) *QuotaResolution {
+	depths := make(map[string]int32, len(ancestors)+1)
+	depths[tenantID] = 0
+	for i, ancestor := range ancestors {
+		if _, ok := depths[ancestor]; !ok {
+			depths[ancestor] = int32(i + 1)
+		}
+	}
+
+	resolution := &QuotaResolution{Ancestors: ancestors}
+	for _, quota := range quotas {
+		candidate := &QuotaCandidate{Quota: quota, Depth: -1}
+		depth, inChain := depths[quota.TenantID]
+		switch {
+		case inChain:
+			candidate.Depth = depth
+		case !quota.IsGlobal:
+			candidate.Reason = fmt.Sprintf("tenant %s is not %s or its ancestor", quota.TenantID, tenantID)
+			resolution.Candidates = append(resolution.Candidates, candidate)
+			continue
+		}
+
+		specific := false
+		if productCode != "" && len(quota.ProductCodes) > 0 {
+			for _, code := range quota.ProductCodes {
+				if code == productCode {
+					specific = true
+					break
+				}
+			}
+			if !specific {
+				candidate.Reason = fmt.Sprintf("product %s not in product_codes [%s]", productCode, strings.Join(quota.ProductCodes, ","))
+				resolution.Candidates = append(resolution.Candidates, candidate)
+				continue
+			}
+		}
+
+		switch {
+		case inChain && depth == 0:
+			candidate.Level = QuotaMatchLevelTenantProduct
+		case inChain:
+			candidate.Level = QuotaMatchLevelAncestorProduct
+		default:
+			candidate.Level = QuotaMatchLevelGlobalProduct
+		}
+		if !specific {
+			// 每个层级的WILDCARD紧跟在PRODUCT之后
+			candidate.Level++
+		}
+		resolution.Candidates = append(resolution.Candidates, candidate)
+	}
+
+	sort.SliceStable(resolution.Candidates, func(i, j int) bool {
+		a, b := resolution.Candidates[i], resolution.Candidates[j]
+		if (a.Level == QuotaMatchLevelUnspecified) != (b.Level == QuotaMatchLevelUnspecified) {
+			return b.Level == QuotaMatchLevelUnspecified
+		}
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		return a.Quota.QuotaID < b.Quota.QuotaID
+	})
+
+	for _, candidate := range resolution.Candidates {
+		if candidate.Level == QuotaMatchLevelUnspecified {
+			continue
+		}
+		if resolution.Selected == nil {
+			candidate.Selected = true
+			candidate.Reason = fmt.Sprintf("highest priority match (%s)", candidate.Level)
+			resolution.Selected = candidate.Quota
+			continue
+		}
+		candidate.Reason = fmt.Sprintf("shadowed by quota %d", resolution.Selected.QuotaID)
+	}
+	return resolution
+}
+
+// ExplainQuota 解释租户在某产品线下使用哪条配额，列出所有候选配额及原因
+func (uc *QuotaUsecase) ExplainQuota(ctx context.Context, tenantID string, quotaType QuotaType, limitType LimitType, productCode string) (resolution *QuotaResolution, err error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.ExplainQuota", append(quotaAttrs(tenantID, quotaType, limitType),
+		attribute.String("product.code", productCode),
+	)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ExplainQuota: tenantID=%v, quotaType=%v, limitType=%v, productCode=%v", tenantID, quotaType, limitType, productCode)
+
+	return uc.repo.ResolveQuota(ctx, tenantID, quotaType, limitType, productCode)
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrQuotaConfigInvalid 配额额外配置不合法
+var ErrQuotaConfigInvalid = errors.BadRequest("QUOTA_CONFIG_INVALID", "quota extra config is invalid")
+
+// QuotaExtraConfig 配额额外配置（extra_config），未识别的字段忽略
+type QuotaExtraConfig struct {
+	Rollover    *RolloverRule    `json:"rollover,omitempty"`    // 结转规则
+	Allocations map[string]int32 `json:"allocations,omitempty"` // 产品线划分额度，产品线 -> 额度
+	Shards      int32            `json:"shards,omitempty"`      // 分片计数的分片数，0表示不分片
+}
+
+// RolloverRule 周期配额结转规则，重置时未用完的额度结转到下一周期
+type RolloverRule struct {
+	MaxAmount  int32 `json:"max_amount,omitempty"`  // 最多结转数量，0表示不按数量限制
+	MaxPercent int32 `json:"max_percent,omitempty"` // 最多结转硬限制的百分比，0表示不按比例限制
+	ExpireDays int32 `json:"expire_days,omitempty"` // 结转额度自重置起的有效天数，0表示到下次重置失效
+}
+
+// ParseQuotaExtraConfig 解析并校验配额额外配置，空字符串返回空配置
+func ParseQuotaExtraConfig(limitType LimitType, raw string) (*QuotaExtraConfig, error) {
+	config := &QuotaExtraConfig{}
+	if raw == "" {
+		return config, nil
+	}
+	if err := json.Unmarshal([]byte(raw), config); err != nil {
+		return nil, ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": err.Error()})
+	}
+
+	if rule := config.Rollover; rule != nil {
+		reason := ""
+		switch {
+		case limitType != LimitTypeDaily && limitType != LimitTypeMonthly:
+			reason = fmt.Sprintf("rollover is not supported for %s quota", limitType)
+		case rule.MaxAmount < 0 || rule.ExpireDays < 0:
+			reason = "rollover max_amount and expire_days must not be negative"
+		case rule.MaxPercent < 0 || rule.MaxPercent > 100:
+			reason = "rollover max_percent must be between 0 and 100"
+		case rule.MaxAmount == 0 && rule.MaxPercent == 0:
+			reason = "rollover requires max_amount or max_percent"
+		}
+		if reason != "" {
+			return nil, ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": reason})
+		}
+	}
+	if reason := validateShards(config); reason != "" {
+		return nil, ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": reason})
+	}
+	if reason := validateAllocations(config.Allocations); reason != "" {
+		return nil, ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": reason})
+	}
+	return config, nil
+}
+
+// Grant 计算重置时结转的数量，只结转本周期基础额度中未用完的部分
+func (r *RolloverRule) Grant(hardLimit, usedCount int32) int32 {
+	granted := hardLimit - usedCount
+	if granted <= 0 {
+		return 0
+	}
+	if r.MaxAmount > 0 && granted > r.MaxAmount {
+		granted = r.MaxAmount
+	}
+	if r.MaxPercent > 0 {
+		if limit := int32(int64(hardLimit) * int64(r.MaxPercent) / 100); granted > limit {
+			granted = limit
+		}
+	}
+	return granted
+}
+
+// ExpireTime 计算结转额度的失效时间，不晚于下次重置
+func (r *RolloverRule) ExpireTime(resetTime, nextResetTime time.Time) time.Time {
+	if r.ExpireDays > 0 {
+		if expire := resetTime.AddDate(0, 0, int(r.ExpireDays)); expire.Before(nextResetTime) {
+			return expire
+		}
+	}
+	return nextResetTime
+}
+
+// RolloverRemaining 返回now时刻可用的结转额度，已失效时为0
+func RolloverRemaining(granted, used int32, expireTime, now time.Time) int32 {
+	if granted <= used || !now.Before(expireTime) {
+		return 0
+	}
+	return granted - used
+}
+
+// RolloverRemaining 返回now时刻可用的结转额度
+func (q *QuotaInfo) RolloverRemaining(now time.Time) int32 {
+	return RolloverRemaining(q.RolloverGranted, q.RolloverUsed, q.RolloverExpireTime, now)
+}
+
+// Remaining 返回当前剩余可用量，包含未失效的结转额度
+func (q *QuotaInfo) Remaining() int32 {
+	return q.HardLimit - q.UsedCount + q.RolloverRemaining(time.Now())
+}
@@ -0,0 +1,45 @@
+package biz
+
+import "fmt"
+
+// 分片数范围
+const (
+	MinQuotaShards = 2
+	MaxQuotaShards = 64
+)
+
+// validateShards 校验分片计数配置：分片后消费只做硬限制检查，不能与结转、产品线划分额度同时使用
+func validateShards(config *QuotaExtraConfig) string {
+	switch {
+	case config.Shards == 0:
+		return ""
+	case config.Shards < MinQuotaShards || config.Shards > MaxQuotaShards:
+		return fmt.Sprintf("shards must be between %d and %d", MinQuotaShards, MaxQuotaShards)
+	case config.Rollover != nil:
+		return "shards cannot be combined with rollover"
+	case len(config.Allocations) > 0:
+		return "shards cannot be combined with allocations"
+	}
+	return ""
+}
+
+// SplitShardCapacity 将硬限制内的剩余额度平均分配到各分片，各分片容量为已用量加分得的剩余额度，容量之和等于硬限制
+func SplitShardCapacity(hardLimit int32, used []int32) []int32 {
+	n := int32(len(used))
+	remaining := hardLimit
+	for _, u := range used {
+		remaining -= u
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	capacities := make([]int32, n)
+	for i := range used {
+		capacities[i] = used[i] + remaining/n
+		if int32(i) < remaining%n {
+			capacities[i]++
+		}
+	}
+	return capacities
+}
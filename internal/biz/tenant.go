@@ -2,11 +2,35 @@ package biz
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
 )
 
+var (
+	// ErrTenantIDRequired 未指定租户ID
+	ErrTenantIDRequired = errors.BadRequest("TENANT_ID_REQUIRED", "tenant id is required")
+	// ErrTenantIDInvalid 租户ID格式不合法
+	ErrTenantIDInvalid = errors.BadRequest("TENANT_ID_INVALID", "tenant id is invalid")
+	// ErrTenantIDConflict 租户ID已存在
+	ErrTenantIDConflict = errors.Conflict("TENANT_ID_CONFLICT", "tenant id already exists")
+	// ErrSortFieldInvalid 不支持的排序字段
+	ErrSortFieldInvalid = errors.BadRequest("SORT_FIELD_INVALID", "unsupported sort field")
+	// ErrPageSizeInvalid 页大小超过上限
+	ErrPageSizeInvalid = errors.BadRequest("PAGE_SIZE_INVALID", "page size exceeds the maximum")
+)
+
+// MaxPageSize 分页查询的最大页大小
+const MaxPageSize = 100
+
+// TenantSortFields 租户列表允许排序的字段
+var TenantSortFields = []string{"tenant_id", "tenant_name", "tenant_type", "created_at", "updated_at"}
+
 // TenantType 租户类型
 type TenantType int32
 
@@ -27,6 +51,57 @@ type Tenant struct {
 	QuotaConfig    map[string]string // 配额配置
 	CreatedAt      time.Time         // 创建时间
 	UpdatedAt      time.Time         // 更新时间
+
+	Labels     map[string]string // 标签，可用于列表过滤
+	Attributes map[string]string // 自定义属性，按租户类型的属性定义校验
+}
+
+// ChannelProfile 渠道扩展信息
+type ChannelProfile struct {
+	TenantID       string  // 租户ID
+	ChannelCode    string  // 渠道编码
+	ChannelName    string  // 渠道名称
+	ContactName    string  // 联系人
+	ContactPhone   string  // 联系电话
+	CommissionRate float64 // 佣金比例
+	SalesTarget    float64 // 销售目标
+}
+
+// TenantFilter 租户查询条件
+type TenantFilter struct {
+	TenantTypes    []TenantType // 租户类型，为空表示不过滤
+	ParentTenantID string       // 父租户ID
+	Status         *bool        // 状态，nil表示不过滤
+	Name           string       // 租户名称模糊搜索
+	CreatedAfter   time.Time    // 创建时间起（含）
+	CreatedBefore  time.Time    // 创建时间止（不含）
+
+	LabelSelector []LabelRequirement // 标签选择器，条件之间同时满足
+	Attributes    map[string]string  // 属性精确匹配
+}
+
+// PageQuery 分页排序参数
+type PageQuery struct {
+	Page     int32  // 页码，从1开始
+	PageSize int32  // 每页数量
+	SortBy   string // 排序字段
+	SortDesc bool   // 是否降序
+}
+
+// Validate 校验页大小和排序字段，sortFields为允许排序的字段
+func (p *PageQuery) Validate(sortFields []string) error {
+	if p.PageSize > MaxPageSize {
+		return ErrPageSizeInvalid.WithMetadata(map[string]string{"page_size": strconv.Itoa(int(p.PageSize)), "max": strconv.Itoa(MaxPageSize)})
+	}
+	if p.SortBy == "" {
+		return nil
+	}
+	for _, field := range sortFields {
+		if p.SortBy == field {
+			return nil
+		}
+	}
+	return ErrSortFieldInvalid.WithMetadata(map[string]string{"sort_by": p.SortBy, "supported": strings.Join(sortFields, ",")})
 }
 
 // TenantRepo 租户仓储接口
@@ -35,49 +110,194 @@ type TenantRepo interface {
 	Get(ctx context.Context, id string) (*Tenant, error)
 	Update(ctx context.Context, tenant *Tenant) (*Tenant, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, tenantType TenantType, parentID string, status bool, pageNum, pageSize int32) ([]*Tenant, int32, error)
+	List(ctx context.Context, filter *TenantFilter, page *PageQuery) ([]*Tenant, int32, error)
+	GetChannel(ctx context.Context, tenantID string) (*ChannelProfile, error)
+	SaveChannel(ctx context.Context, channel *ChannelProfile) (*ChannelProfile, error)
+}
+
+// tenantAncestors 按由近及远的顺序返回租户的父租户ID，最多maxDepth层，遇到环或不存在的父租户时停止
+func tenantAncestors(ctx context.Context, repo TenantRepo, tenant *Tenant, maxDepth int) ([]string, error) {
+	var ids []string
+	seen := map[string]bool{tenant.TenantID: true}
+	parentID := tenant.ParentTenantID
+	for depth := 0; depth < maxDepth && parentID != "" && !seen[parentID]; depth++ {
+		parent, err := repo.Get(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			break
+		}
+		seen[parentID] = true
+		ids = append(ids, parentID)
+		parentID = parent.ParentTenantID
+	}
+	return ids, nil
+}
+
+// TenantIDGenerator 租户ID生成器
+type TenantIDGenerator interface {
+	// Generate 为租户生成ID，tenant.TenantID为调用方指定的ID（可能为空）
+	Generate(ctx context.Context, tenant *Tenant) (string, error)
 }
 
 // TenantUsecase 租户用例
 type TenantUsecase struct {
-	repo TenantRepo
-	log  *log.Helper
+	repo    TenantRepo
+	idGen   TenantIDGenerator
+	schemas map[TenantType]*AttributeSchema
+	audit   *AuditUsecase
+	log     *log.Helper
 }
 
 // NewTenantUsecase 创建租户用例
-func NewTenantUsecase(repo TenantRepo, logger log.Logger) *TenantUsecase {
+func NewTenantUsecase(c *conf.Tenant, repo TenantRepo, idGen TenantIDGenerator, audit *AuditUsecase, logger log.Logger) (*TenantUsecase, error) {
+	schemas, err := NewAttributeSchemas(c)
+	if err != nil {
+		return nil, err
+	}
 	return &TenantUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:    repo,
+		idGen:   idGen,
+		schemas: schemas,
+		audit:   audit,
+		log:     log.NewHelper(logger),
+	}, nil
+}
+
+// validateMetadata 校验租户标签和属性
+func (uc *TenantUsecase) validateMetadata(tenant *Tenant) error {
+	if err := ValidateLabels(tenant.Labels); err != nil {
+		return err
 	}
+	return uc.schemas[tenant.TenantType].Validate(tenant.Attributes)
 }
 
 // CreateTenant 创建租户
-func (uc *TenantUsecase) CreateTenant(ctx context.Context, tenant *Tenant) (*Tenant, error) {
+func (uc *TenantUsecase) CreateTenant(ctx context.Context, tenant *Tenant) (created *Tenant, err error) {
+	ctx, span := startSpan(ctx, "TenantUsecase.CreateTenant", attribute.String("tenant.type", tenant.TenantType.String()))
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("CreateTenant: %v", tenant.TenantName)
-	return uc.repo.Create(ctx, tenant)
+
+	if err := uc.validateMetadata(tenant); err != nil {
+		return nil, err
+	}
+
+	tenantID, err := uc.idGen.Generate(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	tenant.TenantID = tenantID
+	span.SetAttributes(attribute.String("tenant.id", tenantID))
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		created, err = uc.repo.Create(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionTenantCreate,
+			TargetType: AuditTargetTenant,
+			TargetID:   created.TenantID,
+			TenantID:   created.TenantID,
+			After:      created,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // GetTenant 获取租户
-func (uc *TenantUsecase) GetTenant(ctx context.Context, id string) (*Tenant, error) {
+func (uc *TenantUsecase) GetTenant(ctx context.Context, id string) (tenant *Tenant, err error) {
+	ctx, span := startSpan(ctx, "TenantUsecase.GetTenant", attribute.String("tenant.id", id))
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("GetTenant: %v", id)
 	return uc.repo.Get(ctx, id)
 }
 
 // UpdateTenant 更新租户
-func (uc *TenantUsecase) UpdateTenant(ctx context.Context, tenant *Tenant) (*Tenant, error) {
+func (uc *TenantUsecase) UpdateTenant(ctx context.Context, tenant *Tenant) (updated *Tenant, err error) {
+	ctx, span := startSpan(ctx, "TenantUsecase.UpdateTenant", attribute.String("tenant.id", tenant.TenantID))
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("UpdateTenant: %v", tenant.TenantID)
-	return uc.repo.Update(ctx, tenant)
+
+	if err := uc.validateMetadata(tenant); err != nil {
+		return nil, err
+	}
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.Get(ctx, tenant.TenantID)
+		if err != nil {
+			return err
+		}
+		updated, err = uc.repo.Update(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionTenantUpdate,
+			TargetType: AuditTargetTenant,
+			TargetID:   updated.TenantID,
+			TenantID:   updated.TenantID,
+			Before:     before,
+			After:      updated,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
 // DeleteTenant 删除租户
-func (uc *TenantUsecase) DeleteTenant(ctx context.Context, id string) error {
+func (uc *TenantUsecase) DeleteTenant(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "TenantUsecase.DeleteTenant", attribute.String("tenant.id", id))
+	defer func() { endSpan(span, err) }()
+
 	uc.log.WithContext(ctx).Infof("DeleteTenant: %v", id)
-	return uc.repo.Delete(ctx, id)
+
+	return uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		// 租户不存在时删除为空操作，不记录
+		if before == nil {
+			return nil
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionTenantDelete,
+			TargetType: AuditTargetTenant,
+			TargetID:   id,
+			TenantID:   id,
+			Before:     before,
+		})
+	})
 }
 
 // ListTenants 列出租户
-func (uc *TenantUsecase) ListTenants(ctx context.Context, tenantType TenantType, parentID string, status bool, pageNum, pageSize int32) ([]*Tenant, int32, error) {
-	uc.log.WithContext(ctx).Infof("ListTenants: type=%v, parentID=%v", tenantType, parentID)
-	return uc.repo.List(ctx, tenantType, parentID, status, pageNum, pageSize)
+func (uc *TenantUsecase) ListTenants(ctx context.Context, filter *TenantFilter, page *PageQuery) (tenants []*Tenant, total int32, err error) {
+	ctx, span := startSpan(ctx, "TenantUsecase.ListTenants")
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListTenants: types=%v, parentID=%v, name=%v", filter.TenantTypes, filter.ParentTenantID, filter.Name)
+
+	if err := page.Validate(TenantSortFields); err != nil {
+		return nil, 0, err
+	}
+
+	// 属性过滤只校验键和值长度
+	var schema *AttributeSchema
+	if err := schema.Validate(filter.Attributes); err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.List(ctx, filter, page)
 }
@@ -0,0 +1,989 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DataFormat 导入导出数据格式
+type DataFormat int32
+
+const (
+	DataFormatUnspecified DataFormat = 0
+	DataFormatCSV         DataFormat = 1 // CSV
+	DataFormatJSONL       DataFormat = 2 // JSON Lines
+)
+
+// 导入动作
+const (
+	ImportActionCreate = "CREATE"
+	ImportActionUpdate = "UPDATE"
+	ImportActionSkip   = "SKIP"
+)
+
+// CSV记录类型
+const (
+	recordTypeTenant  = "TENANT"
+	recordTypeChannel = "CHANNEL"
+	recordTypeProduct = "PRODUCT"
+	recordTypeQuota   = "QUOTA"
+)
+
+// csvHeader CSV列定义
+var csvHeader = []string{
+	"record_type", "tenant_id", "tenant_name", "tenant_type", "parent_tenant_id", "status",
+	"channel_code", "channel_name", "contact_name", "contact_phone", "commission_rate", "sales_target",
+	"product_code",
+	"quota_type", "limit_type", "hard_limit", "soft_limit", "effective_time", "expire_time", "quota_product_codes", "quota_extra_config",
+}
+
+// TenantBundle 租户导入导出单元，包含租户、渠道信息、产品关联和配额
+type TenantBundle struct {
+	Line     int32           // 起始行号
+	Tenant   *Tenant         // 租户
+	Channel  *ChannelProfile // 渠道扩展信息
+	Products []string        // 关联产品代码
+	Quotas   []*QuotaInfo    // 配额
+	Errors   []*ImportError  // 解析错误
+
+	partial bool // 仅包含渠道/产品/配额记录，不更新租户本身
+}
+
+// ImportError 导入行错误
+type ImportError struct {
+	Line    int32  // 行号
+	Message string // 错误信息
+}
+
+// ImportOptions 导入选项
+type ImportOptions struct {
+	Format DataFormat // 数据格式
+	DryRun bool       // 仅校验不写入
+	Upsert bool       // 租户已存在时更新
+}
+
+// ImportRowResult 导入行结果
+type ImportRowResult struct {
+	Line     int32  // 行号
+	TenantID string // 租户ID
+	Action   string // 动作
+	Error    string // 错误信息
+}
+
+// ImportReport 导入报告
+type ImportReport struct {
+	DryRun  bool               // 是否仅校验
+	Total   int32              // 租户总数
+	Created int32              // 新建数
+	Updated int32              // 更新数
+	Failed  int32              // 失败数
+	Results []*ImportRowResult // 行结果
+}
+
+// tenantBundleJSON JSONL行结构
+type tenantBundleJSON struct {
+	TenantID       string             `json:"tenant_id,omitempty"`
+	TenantName     string             `json:"tenant_name"`
+	TenantType     string             `json:"tenant_type"`
+	ParentTenantID string             `json:"parent_tenant_id,omitempty"`
+	Status         *bool              `json:"status,omitempty"`
+	Channel        *channelJSON       `json:"channel,omitempty"`
+	Products       []string           `json:"products,omitempty"`
+	Quotas         []*quotaConfigJSON `json:"quotas,omitempty"`
+}
+
+// channelJSON 渠道信息JSON结构
+type channelJSON struct {
+	ChannelCode    string  `json:"channel_code,omitempty"`
+	ChannelName    string  `json:"channel_name,omitempty"`
+	ContactName    string  `json:"contact_name,omitempty"`
+	ContactPhone   string  `json:"contact_phone,omitempty"`
+	CommissionRate float64 `json:"commission_rate,omitempty"`
+	SalesTarget    float64 `json:"sales_target,omitempty"`
+}
+
+// quotaConfigJSON 配额JSON结构
+type quotaConfigJSON struct {
+	QuotaType     string   `json:"quota_type"`
+	LimitType     string   `json:"limit_type"`
+	HardLimit     int32    `json:"hard_limit"`
+	SoftLimit     int32    `json:"soft_limit,omitempty"`
+	EffectiveTime string   `json:"effective_time,omitempty"`
+	ExpireTime    string   `json:"expire_time,omitempty"`
+	ProductCodes  []string `json:"product_codes,omitempty"`
+	ExtraConfig   string   `json:"extra_config,omitempty"`
+}
+
+var tenantTypeNames = map[TenantType]string{
+	TenantTypePlatform:   "PLATFORM",
+	TenantTypeChannel:    "CHANNEL",
+	TenantTypeEnterprise: "ENTERPRISE",
+}
+
+var quotaTypeNames = map[QuotaType]string{
+	QuotaTypeMarketingCampaign: "MARKETING_CAMPAIGN",
+	QuotaTypeRedeemCode:        "REDEEM_CODE",
+	QuotaTypeSMS:               "SMS",
+}
+
+var limitTypeNames = map[LimitType]string{
+	LimitTypeDaily:      "DAILY",
+	LimitTypeMonthly:    "MONTHLY",
+	LimitTypeTotal:      "TOTAL",
+	LimitTypeConcurrent: "CONCURRENT",
+}
+
+// String 返回租户类型名称
+func (t TenantType) String() string {
+	if name, ok := tenantTypeNames[t]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// String 返回配额类型名称
+func (t QuotaType) String() string {
+	if name, ok := quotaTypeNames[t]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// String 返回限制类型名称
+func (t LimitType) String() string {
+	if name, ok := limitTypeNames[t]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+// ParseTenantType 解析租户类型名称
+func ParseTenantType(name string) (TenantType, bool) {
+	for t, n := range tenantTypeNames {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return TenantTypeUnspecified, false
+}
+
+// ParseQuotaType 解析配额类型名称
+func ParseQuotaType(name string) (QuotaType, bool) {
+	for t, n := range quotaTypeNames {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return QuotaTypeUnspecified, false
+}
+
+// ParseLimitType 解析限制类型名称
+func ParseLimitType(name string) (LimitType, bool) {
+	for t, n := range limitTypeNames {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return LimitTypeUnspecified, false
+}
+
+// TenantTransferUsecase 租户批量导入导出用例
+type TenantTransferUsecase struct {
+	tenantRepo  TenantRepo
+	productRepo ProductRepo
+	quotaRepo   QuotaRepo
+	quotas      *QuotaUsecase
+	idGen       TenantIDGenerator
+	audit       *AuditUsecase
+	log         *log.Helper
+}
+
+// NewTenantTransferUsecase 创建租户批量导入导出用例
+func NewTenantTransferUsecase(tenantRepo TenantRepo, productRepo ProductRepo, quotaRepo QuotaRepo, quotas *QuotaUsecase, idGen TenantIDGenerator, audit *AuditUsecase, logger log.Logger) *TenantTransferUsecase {
+	return &TenantTransferUsecase{
+		tenantRepo:  tenantRepo,
+		productRepo: productRepo,
+		quotaRepo:   quotaRepo,
+		quotas:      quotas,
+		idGen:       idGen,
+		audit:       audit,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// importProgressInterval 导入时每处理该数量的租户记录一次进度日志
+const importProgressInterval = 100
+
+// ImportTenants 批量导入租户，边解码边写入，上传内容不整体读入内存，每个租户一个事务
+func (uc *TenantTransferUsecase) ImportTenants(ctx context.Context, r io.Reader, opts *ImportOptions) (*ImportReport, error) {
+	uc.log.WithContext(ctx).Infof("ImportTenants: format=%v, dryRun=%v, upsert=%v", opts.Format, opts.DryRun, opts.Upsert)
+
+	var decoder bundleDecoder
+	switch opts.Format {
+	case DataFormatCSV:
+		csvDecoder, err := newCSVBundleDecoder(r)
+		if err != nil {
+			return nil, err
+		}
+		decoder = csvDecoder
+	case DataFormatJSONL:
+		decoder = newJSONLBundleDecoder(r)
+	default:
+		return nil, fmt.Errorf("unsupported data format: %v", opts.Format)
+	}
+
+	report := &ImportReport{DryRun: opts.DryRun}
+	// 记录本次导入中已出现的租户，用于校验父租户引用
+	seen := make(map[string]bool)
+	for {
+		bundle, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		report.Total++
+		result := uc.importBundle(ctx, bundle, opts, seen)
+		switch {
+		case result.Error != "":
+			report.Failed++
+		case result.Action == ImportActionCreate:
+			report.Created++
+		case result.Action == ImportActionUpdate:
+			report.Updated++
+		}
+		report.Results = append(report.Results, result)
+		if report.Total%importProgressInterval == 0 {
+			uc.logImportProgress(ctx, report)
+		}
+	}
+	if report.Total%importProgressInterval != 0 {
+		uc.logImportProgress(ctx, report)
+	}
+
+	return report, nil
+}
+
+// logImportProgress 记录导入进度
+func (uc *TenantTransferUsecase) logImportProgress(ctx context.Context, report *ImportReport) {
+	uc.log.WithContext(ctx).Infof("ImportTenants progress: total=%d, created=%d, updated=%d, failed=%d", report.Total, report.Created, report.Updated, report.Failed)
+}
+
+// importBundle 校验并导入单个租户，租户写入成功（试运行时校验通过）后才记入seen，供后续行引用为父租户或判断重复
+func (uc *TenantTransferUsecase) importBundle(ctx context.Context, bundle *TenantBundle, opts *ImportOptions, seen map[string]bool) *ImportRowResult {
+	result := &ImportRowResult{
+		Line:     bundle.Line,
+		TenantID: bundle.Tenant.TenantID,
+		Action:   ImportActionSkip,
+	}
+	fail := func(line int32, format string, args ...interface{}) *ImportRowResult {
+		result.Line = line
+		result.Error = fmt.Sprintf(format, args...)
+		return result
+	}
+
+	if len(bundle.Errors) > 0 {
+		return fail(bundle.Errors[0].Line, "%s", bundle.Errors[0].Message)
+	}
+
+	// 查询已有租户
+	var existing *Tenant
+	if bundle.Tenant.TenantID != "" {
+		var err error
+		existing, err = uc.tenantRepo.Get(ctx, bundle.Tenant.TenantID)
+		if err != nil {
+			return fail(bundle.Line, "get tenant: %v", err)
+		}
+		if seen[bundle.Tenant.TenantID] {
+			return fail(bundle.Line, "duplicate tenant in import: %s", bundle.Tenant.TenantID)
+		}
+	}
+	if existing != nil && !opts.Upsert {
+		return fail(bundle.Line, "tenant already exists: %s", existing.TenantID)
+	}
+
+	// 校验字段
+	tenant := bundle.Tenant
+	if bundle.partial {
+		if existing == nil {
+			return fail(bundle.Line, "tenant record is required for new tenant: %s", tenant.TenantID)
+		}
+		tenant.TenantName = existing.TenantName
+		tenant.Status = existing.Status
+	}
+	if existing != nil {
+		// 更新时类型和父租户以已有数据为准
+		if tenant.TenantType != TenantTypeUnspecified && tenant.TenantType != existing.TenantType {
+			return fail(bundle.Line, "tenant type cannot be changed: %v -> %v", existing.TenantType, tenant.TenantType)
+		}
+		tenant.TenantType = existing.TenantType
+		tenant.ParentTenantID = existing.ParentTenantID
+	} else {
+		if tenant.TenantType == TenantTypeUnspecified {
+			return fail(bundle.Line, "tenant_type is required")
+		}
+		if tenant.ParentTenantID != "" && !seen[tenant.ParentTenantID] {
+			parent, err := uc.tenantRepo.Get(ctx, tenant.ParentTenantID)
+			if err != nil {
+				return fail(bundle.Line, "get parent tenant: %v", err)
+			}
+			if parent == nil {
+				return fail(bundle.Line, "parent tenant not found: %s", tenant.ParentTenantID)
+			}
+		}
+	}
+	if tenant.TenantName == "" || len([]rune(tenant.TenantName)) > 64 {
+		return fail(bundle.Line, "tenant_name must be 1-64 characters")
+	}
+	if bundle.Channel != nil && tenant.TenantType != TenantTypeChannel {
+		return fail(bundle.Line, "channel profile is only allowed for CHANNEL tenants")
+	}
+	if bundle.Channel != nil && (bundle.Channel.CommissionRate < 0 || bundle.Channel.CommissionRate > 100) {
+		return fail(bundle.Line, "commission_rate must be between 0 and 100")
+	}
+	for _, code := range bundle.Products {
+		product, err := uc.productRepo.GetProduct(ctx, code)
+		if err != nil {
+			return fail(bundle.Line, "get product: %v", err)
+		}
+		if product == nil {
+			return fail(bundle.Line, "product not found: %s", code)
+		}
+	}
+	quotaKeys := make(map[string]bool)
+	for _, quota := range bundle.Quotas {
+		key := fmt.Sprintf("%v/%v", quota.QuotaType, quota.LimitType)
+		if quotaKeys[key] {
+			return fail(bundle.Line, "duplicate quota: %s", key)
+		}
+		quotaKeys[key] = true
+		if quota.HardLimit < 0 || quota.SoftLimit < 0 || quota.SoftLimit > quota.HardLimit {
+			return fail(bundle.Line, "invalid quota limits for %s: hard=%d soft=%d", key, quota.HardLimit, quota.SoftLimit)
+		}
+	}
+
+	if existing != nil {
+		result.Action = ImportActionUpdate
+	} else {
+		result.Action = ImportActionCreate
+	}
+	if opts.DryRun {
+		if tenant.TenantID != "" {
+			seen[tenant.TenantID] = true
+		}
+		return result
+	}
+
+	// 新租户的ID在事务外生成，同一行的写入和审计事件在同一事务中
+	if existing == nil {
+		tenantID, err := uc.idGen.Generate(ctx, tenant)
+		if err != nil {
+			return fail(bundle.Line, "generate tenant id: %v", err)
+		}
+		tenant.TenantID = tenantID
+	}
+	var before *Tenant
+	if existing != nil {
+		snapshot := *existing
+		before = &snapshot
+	}
+	err := uc.audit.InTx(ctx, func(ctx context.Context) error {
+		return uc.writeBundle(ctx, bundle, tenant, existing, before)
+	})
+	if err != nil {
+		return fail(bundle.Line, "%v", err)
+	}
+	seen[tenant.TenantID] = true
+	result.TenantID = tenant.TenantID
+
+	return result
+}
+
+// writeBundle 写入单个租户的基本信息、渠道信息、产品关联和配额，并记录导入的审计事件
+func (uc *TenantTransferUsecase) writeBundle(ctx context.Context, bundle *TenantBundle, tenant, existing, before *Tenant) error {
+	// 写入租户
+	if existing == nil {
+		if _, err := uc.tenantRepo.Create(ctx, tenant); err != nil {
+			return fmt.Errorf("create tenant: %w", err)
+		}
+	} else if !bundle.partial {
+		existing.TenantName = tenant.TenantName
+		existing.Status = tenant.Status
+		if _, err := uc.tenantRepo.Update(ctx, existing); err != nil {
+			return fmt.Errorf("update tenant: %w", err)
+		}
+	}
+
+	// 写入渠道信息
+	if bundle.Channel != nil {
+		bundle.Channel.TenantID = tenant.TenantID
+		if bundle.Channel.ChannelName == "" {
+			bundle.Channel.ChannelName = tenant.TenantName
+		}
+		if _, err := uc.tenantRepo.SaveChannel(ctx, bundle.Channel); err != nil {
+			return fmt.Errorf("save channel: %w", err)
+		}
+	}
+
+	// 写入产品关联
+	for _, code := range bundle.Products {
+		if err := uc.productRepo.AssociateProductToTenant(ctx, tenant.TenantID, code); err != nil {
+			return fmt.Errorf("associate product %s: %w", code, err)
+		}
+	}
+
+	// 写入配额，按配额类型和限制类型匹配已有配额
+	for _, quota := range bundle.Quotas {
+		quota.TenantID = tenant.TenantID
+		if err := uc.upsertQuota(ctx, quota, bundle.Line); err != nil {
+			return fmt.Errorf("save quota %v/%v: %w", quota.QuotaType, quota.LimitType, err)
+		}
+	}
+
+	after, err := uc.tenantRepo.Get(ctx, tenant.TenantID)
+	if err != nil {
+		return fmt.Errorf("get tenant: %w", err)
+	}
+	return uc.audit.Record(ctx, &AuditRecord{
+		Action:     AuditActionTenantImport,
+		TargetType: AuditTargetTenant,
+		TargetID:   tenant.TenantID,
+		TenantID:   tenant.TenantID,
+		Before:     before,
+		After:      after,
+		Remark:     fmt.Sprintf("line %d, products=%d, quotas=%d", bundle.Line, len(bundle.Products), len(bundle.Quotas)),
+	})
+}
+
+// upsertQuota 创建或更新租户配额；已有配额经AdjustQuota更新，与手工调整一样校验配置、合并分片、记录套餐覆盖，并保留已用量
+func (uc *TenantTransferUsecase) upsertQuota(ctx context.Context, quota *QuotaInfo, line int32) error {
+	quotas, err := uc.quotaRepo.ListQuotas(ctx, quota.TenantID, quota.QuotaType)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range quotas {
+		if existing.LimitType != quota.LimitType || existing.IsGlobal {
+			continue
+		}
+		adjustment := &QuotaAdjustment{
+			HardLimit:     &quota.HardLimit,
+			SoftLimit:     &quota.SoftLimit,
+			EffectiveTime: &quota.EffectiveTime,
+			ExpireTime:    &quota.ExpireTime,
+			ProductCodes:  quota.ProductCodes,
+			Remark:        fmt.Sprintf("import line %d", line),
+		}
+		if adjustment.ProductCodes == nil {
+			adjustment.ProductCodes = []string{}
+		}
+		if quota.ExtraConfig != "" {
+			adjustment.ExtraConfig = &quota.ExtraConfig
+		}
+		_, err := uc.quotas.AdjustQuota(ctx, quota.TenantID, quota.QuotaType, quota.LimitType, adjustment)
+		return err
+	}
+
+	_, err = uc.quotaRepo.CreateQuota(ctx, quota)
+	return err
+}
+
+// ExportTenants 批量导出租户
+func (uc *TenantTransferUsecase) ExportTenants(ctx context.Context, w io.Writer, format DataFormat, filter *TenantFilter) error {
+	uc.log.WithContext(ctx).Infof("ExportTenants: format=%v", format)
+
+	var csvWriter *csv.Writer
+	switch format {
+	case DataFormatCSV:
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(csvHeader); err != nil {
+			return err
+		}
+	case DataFormatJSONL:
+	default:
+		return fmt.Errorf("unsupported data format: %v", format)
+	}
+
+	page := &PageQuery{Page: 1, PageSize: 200, SortBy: "tenant_id"}
+	for {
+		tenants, _, err := uc.tenantRepo.List(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+
+		for _, tenant := range tenants {
+			bundle, err := uc.loadBundle(ctx, tenant)
+			if err != nil {
+				return err
+			}
+			if csvWriter != nil {
+				if err := csvWriter.WriteAll(bundleToCSV(bundle)); err != nil {
+					return err
+				}
+			} else {
+				line, err := json.Marshal(bundleToJSON(bundle))
+				if err != nil {
+					return err
+				}
+				if _, err := w.Write(append(line, '\n')); err != nil {
+					return err
+				}
+			}
+		}
+
+		if int32(len(tenants)) < page.PageSize {
+			break
+		}
+		page.Page++
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		return csvWriter.Error()
+	}
+	return nil
+}
+
+// loadBundle 加载租户的渠道信息、产品关联和配额
+func (uc *TenantTransferUsecase) loadBundle(ctx context.Context, tenant *Tenant) (*TenantBundle, error) {
+	bundle := &TenantBundle{Tenant: tenant}
+
+	if tenant.TenantType == TenantTypeChannel {
+		channel, err := uc.tenantRepo.GetChannel(ctx, tenant.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Channel = channel
+	}
+
+	products, err := uc.productRepo.ListProductsByTenant(ctx, tenant.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, product := range products {
+		bundle.Products = append(bundle.Products, product.ProductCode)
+	}
+
+	quotas, err := uc.quotaRepo.ListQuotas(ctx, tenant.TenantID, QuotaTypeUnspecified)
+	if err != nil {
+		return nil, err
+	}
+	for _, quota := range quotas {
+		if !quota.IsGlobal {
+			bundle.Quotas = append(bundle.Quotas, quota)
+		}
+	}
+
+	return bundle, nil
+}
+
+// bundleDecoder 逐个解码导入的租户，解码完毕时返回io.EOF
+type bundleDecoder interface {
+	Next() (*TenantBundle, error)
+}
+
+// jsonlBundleDecoder 解码JSON Lines格式，每行一个租户
+type jsonlBundleDecoder struct {
+	scanner *bufio.Scanner
+	line    int32
+}
+
+// newJSONLBundleDecoder 创建JSON Lines解码器
+func newJSONLBundleDecoder(r io.Reader) *jsonlBundleDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	return &jsonlBundleDecoder{scanner: scanner}
+}
+
+// Next 解码下一个租户，行内容错误记录在租户的解析错误中
+func (d *jsonlBundleDecoder) Next() (*TenantBundle, error) {
+	for d.scanner.Scan() {
+		d.line++
+		text := strings.TrimSpace(d.scanner.Text())
+		if text == "" {
+			continue
+		}
+		return parseJSONLBundle(d.line, text), nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// parseJSONLBundle 解析一行JSON
+func parseJSONLBundle(line int32, text string) *TenantBundle {
+	bundle := &TenantBundle{Line: line, Tenant: &Tenant{Status: true}}
+	addError := func(format string, args ...interface{}) {
+		bundle.Errors = append(bundle.Errors, &ImportError{Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var item tenantBundleJSON
+	if err := json.Unmarshal([]byte(text), &item); err != nil {
+		addError("invalid json: %v", err)
+		return bundle
+	}
+
+	bundle.Tenant.TenantID = item.TenantID
+	bundle.Tenant.TenantName = item.TenantName
+	bundle.Tenant.ParentTenantID = item.ParentTenantID
+	if item.Status != nil {
+		bundle.Tenant.Status = *item.Status
+	}
+	if item.TenantType != "" {
+		tenantType, ok := ParseTenantType(item.TenantType)
+		if !ok {
+			addError("invalid tenant_type: %s", item.TenantType)
+		}
+		bundle.Tenant.TenantType = tenantType
+	}
+	if item.Channel != nil {
+		bundle.Channel = &ChannelProfile{
+			ChannelCode:    item.Channel.ChannelCode,
+			ChannelName:    item.Channel.ChannelName,
+			ContactName:    item.Channel.ContactName,
+			ContactPhone:   item.Channel.ContactPhone,
+			CommissionRate: item.Channel.CommissionRate,
+			SalesTarget:    item.Channel.SalesTarget,
+		}
+	}
+	bundle.Products = item.Products
+	for _, q := range item.Quotas {
+		quota, err := parseQuotaConfig(q)
+		if err != nil {
+			addError("%v", err)
+			continue
+		}
+		bundle.Quotas = append(bundle.Quotas, quota)
+	}
+	return bundle
+}
+
+// csvBundleDecoder 解码CSV格式，同一租户的记录须连续出现，遇到其他租户的记录时输出当前租户
+type csvBundleDecoder struct {
+	reader  *csv.Reader
+	columns map[string]int
+	line    int32
+	current *TenantBundle
+	done    map[string]bool // 已输出的租户ID，用于发现不连续的记录
+}
+
+// newCSVBundleDecoder 创建CSV解码器并读取表头
+func newCSVBundleDecoder(r io.Reader) (*csvBundleDecoder, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	if _, ok := columns["record_type"]; !ok {
+		return nil, fmt.Errorf("csv header missing column: record_type")
+	}
+	return &csvBundleDecoder{reader: reader, columns: columns, line: 1, done: make(map[string]bool)}, nil
+}
+
+// Next 解码下一个租户
+func (d *csvBundleDecoder) Next() (*TenantBundle, error) {
+	for {
+		record, err := d.reader.Read()
+		if err == io.EOF {
+			if d.current == nil {
+				return nil, io.EOF
+			}
+			return d.flush(nil), nil
+		}
+		d.line++
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			// 格式错误的行单独报告，不影响其他租户
+			bundle := &TenantBundle{Line: d.line, Tenant: &Tenant{}, partial: true}
+			bundle.Errors = append(bundle.Errors, &ImportError{Line: d.line, Message: fmt.Sprintf("invalid csv: %v", parseErr.Err)})
+			if flushed := d.flush(nil); flushed != nil {
+				d.current = bundle
+				return flushed, nil
+			}
+			return bundle, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv line %d: %w", d.line, err)
+		}
+		get := func(name string) string {
+			if i, ok := d.columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		// 未填写tenant_id的非TENANT记录归属于上一个租户
+		recordType := strings.ToUpper(get("record_type"))
+		tenantID := get("tenant_id")
+		bundle := d.current
+		belongs := bundle != nil && ((tenantID != "" && tenantID == bundle.Tenant.TenantID) || (tenantID == "" && recordType != recordTypeTenant))
+		var flushed *TenantBundle
+		if !belongs {
+			bundle = &TenantBundle{Line: d.line, Tenant: &Tenant{TenantID: tenantID, Status: true}, partial: recordType != recordTypeTenant}
+			if tenantID != "" && d.done[tenantID] {
+				bundle.Errors = append(bundle.Errors, &ImportError{Line: d.line, Message: fmt.Sprintf("records of tenant %s must be contiguous", tenantID)})
+			}
+			flushed = d.flush(bundle)
+		} else if recordType == recordTypeTenant {
+			if !bundle.partial {
+				bundle.Errors = append(bundle.Errors, &ImportError{Line: d.line, Message: fmt.Sprintf("duplicate tenant record: %s", tenantID)})
+				continue
+			}
+			bundle.partial = false
+		}
+		d.applyRecord(bundle, recordType, get)
+		if flushed != nil {
+			return flushed, nil
+		}
+	}
+}
+
+// flush 以next替换当前租户，返回被替换的租户
+func (d *csvBundleDecoder) flush(next *TenantBundle) *TenantBundle {
+	flushed := d.current
+	d.current = next
+	if flushed != nil && flushed.Tenant.TenantID != "" {
+		d.done[flushed.Tenant.TenantID] = true
+	}
+	return flushed
+}
+
+// applyRecord 把一行CSV记录合并到租户
+func (d *csvBundleDecoder) applyRecord(bundle *TenantBundle, recordType string, get func(name string) string) {
+	line := d.line
+	addError := func(format string, args ...interface{}) {
+		bundle.Errors = append(bundle.Errors, &ImportError{Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var err error
+	switch recordType {
+	case recordTypeTenant:
+		bundle.Tenant.TenantName = get("tenant_name")
+		bundle.Tenant.ParentTenantID = get("parent_tenant_id")
+		if v := get("tenant_type"); v != "" {
+			tenantType, ok := ParseTenantType(v)
+			if !ok {
+				addError("invalid tenant_type: %s", v)
+			}
+			bundle.Tenant.TenantType = tenantType
+		}
+		if v := get("status"); v != "" {
+			status, err := strconv.ParseBool(v)
+			if err != nil {
+				addError("invalid status: %s", v)
+			}
+			bundle.Tenant.Status = status
+		}
+	case recordTypeChannel:
+		channel := &ChannelProfile{
+			ChannelCode:  get("channel_code"),
+			ChannelName:  get("channel_name"),
+			ContactName:  get("contact_name"),
+			ContactPhone: get("contact_phone"),
+		}
+		if v := get("commission_rate"); v != "" {
+			if channel.CommissionRate, err = strconv.ParseFloat(v, 64); err != nil {
+				addError("invalid commission_rate: %s", v)
+			}
+		}
+		if v := get("sales_target"); v != "" {
+			if channel.SalesTarget, err = strconv.ParseFloat(v, 64); err != nil {
+				addError("invalid sales_target: %s", v)
+			}
+		}
+		bundle.Channel = channel
+	case recordTypeProduct:
+		if code := get("product_code"); code != "" {
+			bundle.Products = append(bundle.Products, code)
+		} else {
+			addError("product_code is required")
+		}
+	case recordTypeQuota:
+		q := &quotaConfigJSON{
+			QuotaType:     get("quota_type"),
+			LimitType:     get("limit_type"),
+			EffectiveTime: get("effective_time"),
+			ExpireTime:    get("expire_time"),
+			ExtraConfig:   get("quota_extra_config"),
+		}
+		if v := get("quota_product_codes"); v != "" {
+			q.ProductCodes = strings.Split(v, ";")
+		}
+		hardLimit, err := strconv.ParseInt(get("hard_limit"), 10, 32)
+		if err != nil {
+			addError("invalid hard_limit: %s", get("hard_limit"))
+			return
+		}
+		q.HardLimit = int32(hardLimit)
+		if v := get("soft_limit"); v != "" {
+			softLimit, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				addError("invalid soft_limit: %s", v)
+				return
+			}
+			q.SoftLimit = int32(softLimit)
+		}
+		quota, err := parseQuotaConfig(q)
+		if err != nil {
+			addError("%v", err)
+			return
+		}
+		bundle.Quotas = append(bundle.Quotas, quota)
+	default:
+		addError("invalid record_type: %s", recordType)
+	}
+}
+
+// parseQuotaConfig 解析配额配置
+func parseQuotaConfig(q *quotaConfigJSON) (*QuotaInfo, error) {
+	quotaType, ok := ParseQuotaType(q.QuotaType)
+	if !ok {
+		return nil, fmt.Errorf("invalid quota_type: %s", q.QuotaType)
+	}
+	limitType, ok := ParseLimitType(q.LimitType)
+	if !ok {
+		return nil, fmt.Errorf("invalid limit_type: %s", q.LimitType)
+	}
+	if _, err := ParseQuotaExtraConfig(limitType, q.ExtraConfig); err != nil {
+		return nil, fmt.Errorf("invalid extra_config: %s", q.ExtraConfig)
+	}
+
+	quota := &QuotaInfo{
+		QuotaType:     quotaType,
+		LimitType:     limitType,
+		HardLimit:     q.HardLimit,
+		SoftLimit:     q.SoftLimit,
+		EffectiveTime: time.Now(),
+		ProductCodes:  q.ProductCodes,
+		ExtraConfig:   q.ExtraConfig,
+	}
+	if q.EffectiveTime != "" {
+		t, err := time.Parse(time.RFC3339, q.EffectiveTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid effective_time: %s", q.EffectiveTime)
+		}
+		quota.EffectiveTime = t
+	}
+	if q.ExpireTime != "" {
+		t, err := time.Parse(time.RFC3339, q.ExpireTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expire_time: %s", q.ExpireTime)
+		}
+		quota.ExpireTime = t
+	}
+
+	return quota, nil
+}
+
+// formatTime 格式化时间，零值返回空字符串
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// bundleToJSON 转换为JSONL行结构
+func bundleToJSON(bundle *TenantBundle) *tenantBundleJSON {
+	status := bundle.Tenant.Status
+	item := &tenantBundleJSON{
+		TenantID:       bundle.Tenant.TenantID,
+		TenantName:     bundle.Tenant.TenantName,
+		TenantType:     bundle.Tenant.TenantType.String(),
+		ParentTenantID: bundle.Tenant.ParentTenantID,
+		Status:         &status,
+		Products:       bundle.Products,
+	}
+	if bundle.Channel != nil {
+		item.Channel = &channelJSON{
+			ChannelCode:    bundle.Channel.ChannelCode,
+			ChannelName:    bundle.Channel.ChannelName,
+			ContactName:    bundle.Channel.ContactName,
+			ContactPhone:   bundle.Channel.ContactPhone,
+			CommissionRate: bundle.Channel.CommissionRate,
+			SalesTarget:    bundle.Channel.SalesTarget,
+		}
+	}
+	for _, quota := range bundle.Quotas {
+		item.Quotas = append(item.Quotas, &quotaConfigJSON{
+			QuotaType:     quota.QuotaType.String(),
+			LimitType:     quota.LimitType.String(),
+			HardLimit:     quota.HardLimit,
+			SoftLimit:     quota.SoftLimit,
+			EffectiveTime: formatTime(quota.EffectiveTime),
+			ExpireTime:    formatTime(quota.ExpireTime),
+			ProductCodes:  quota.ProductCodes,
+			ExtraConfig:   quota.ExtraConfig,
+		})
+	}
+	return item
+}
+
+// bundleToCSV 转换为CSV记录
+func bundleToCSV(bundle *TenantBundle) [][]string {
+	newRecord := func(recordType string, values map[string]string) []string {
+		record := make([]string, len(csvHeader))
+		record[0] = recordType
+		record[1] = bundle.Tenant.TenantID
+		for i, name := range csvHeader {
+			if v, ok := values[name]; ok {
+				record[i] = v
+			}
+		}
+		return record
+	}
+
+	records := [][]string{newRecord(recordTypeTenant, map[string]string{
+		"tenant_name":      bundle.Tenant.TenantName,
+		"tenant_type":      bundle.Tenant.TenantType.String(),
+		"parent_tenant_id": bundle.Tenant.ParentTenantID,
+		"status":           strconv.FormatBool(bundle.Tenant.Status),
+	})}
+	if bundle.Channel != nil {
+		records = append(records, newRecord(recordTypeChannel, map[string]string{
+			"channel_code":    bundle.Channel.ChannelCode,
+			"channel_name":    bundle.Channel.ChannelName,
+			"contact_name":    bundle.Channel.ContactName,
+			"contact_phone":   bundle.Channel.ContactPhone,
+			"commission_rate": strconv.FormatFloat(bundle.Channel.CommissionRate, 'f', -1, 64),
+			"sales_target":    strconv.FormatFloat(bundle.Channel.SalesTarget, 'f', -1, 64),
+		}))
+	}
+	for _, code := range bundle.Products {
+		records = append(records, newRecord(recordTypeProduct, map[string]string{"product_code": code}))
+	}
+	for _, quota := range bundle.Quotas {
+		records = append(records, newRecord(recordTypeQuota, map[string]string{
+			"quota_type":          quota.QuotaType.String(),
+			"limit_type":          quota.LimitType.String(),
+			"hard_limit":          strconv.FormatInt(int64(quota.HardLimit), 10),
+			"soft_limit":          strconv.FormatInt(int64(quota.SoftLimit), 10),
+			"effective_time":      formatTime(quota.EffectiveTime),
+			"expire_time":         formatTime(quota.ExpireTime),
+			"quota_product_codes": strings.Join(quota.ProductCodes, ";"),
+			"quota_extra_config":  quota.ExtraConfig,
+		}))
+	}
+	return records
+}
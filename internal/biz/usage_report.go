@@ -0,0 +1,394 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ErrInvalidDateRange 日期范围无效
+var ErrInvalidDateRange = errors.BadRequest("INVALID_DATE_RANGE", "invalid date range")
+
+const (
+	// defaultReportDays 默认报表天数
+	defaultReportDays = 30
+	// maxReportDays 报表最大天数
+	maxReportDays = 366
+	// defaultTopN 默认消耗排行条数
+	defaultTopN = 10
+	// forecastWindowDays 耗尽预测使用的近期天数
+	forecastWindowDays = 7
+	// maxForecastDays 预测耗尽的最远天数，超出视为不会耗尽
+	maxForecastDays = 3650
+)
+
+// utilizationBounds 软限制利用率分布区间边界（百分比）
+var utilizationBounds = []int32{50, 80, 100}
+
+// DailyUsage 配额日用量汇总
+type DailyUsage struct {
+	Date         time.Time // 日期
+	QuotaID      int64     // 配额ID
+	TenantID     string    // 租户ID
+	QuotaType    QuotaType // 配额类型
+	LimitType    LimitType // 限制类型
+	Consumed     int64     // 消耗量
+	Released     int64     // 释放量
+	ConsumeCount int64     // 消费次数
+	PeakUsed     int32     // 当日峰值已用量
+	EndUsed      int32     // 当日结束时已用量
+	HardLimit    int32     // 硬限制
+	SoftLimit    int32     // 软限制
+}
+
+// UsageReportFilter 用量报表查询条件，日期均为当天零点，EndDate包含在内
+type UsageReportFilter struct {
+	TenantID  string    // 租户ID
+	QuotaType QuotaType // 配额类型
+	LimitType LimitType // 限制类型
+	StartDate time.Time // 开始日期
+	EndDate   time.Time // 结束日期
+}
+
+// TenantConsumption 租户消耗汇总
+type TenantConsumption struct {
+	TenantID     string // 租户ID
+	Consumed     int64  // 消耗量
+	Released     int64  // 释放量
+	ConsumeCount int64  // 消费次数
+}
+
+// QuotaTypeTopConsumers 单个配额类型的消耗排行
+type QuotaTypeTopConsumers struct {
+	QuotaType QuotaType            // 配额类型
+	Consumers []*TenantConsumption // 按消耗量降序
+}
+
+// UtilizationBucket 软限制利用率分布区间
+type UtilizationBucket struct {
+	LowerPercent int32 // 区间下界（含）
+	UpperPercent int32 // 区间上界（不含），0表示无上界
+	Count        int64 // 配额日数
+}
+
+// ExhaustionForecast 月配额耗尽预测
+type ExhaustionForecast struct {
+	TenantID           string    // 租户ID
+	QuotaType          QuotaType // 配额类型
+	HardLimit          int32     // 硬限制
+	UsedCount          int32     // 当前已用量
+	DailyRate          float64   // 近期日均净消耗
+	ExhaustTime        time.Time // 预计耗尽时间，零值表示不会耗尽
+	NextResetTime      time.Time // 下次重置时间
+	ExhaustBeforeReset bool      // 是否在下次重置前耗尽
+}
+
+// UsageRollupState 用量汇总进度
+type UsageRollupState struct {
+	LastRecordID int64     // 已处理到的使用记录ID
+	UpdatedAt    time.Time // 最近一次汇总时间
+}
+
+// UsageReport 用量报表
+type UsageReport struct {
+	StartDate            time.Time                // 开始日期
+	EndDate              time.Time                // 结束日期
+	TopConsumers         []*QuotaTypeTopConsumers // 各配额类型消耗排行
+	SoftLimitUtilization []*UtilizationBucket     // 软限制利用率分布
+	Forecasts            []*ExhaustionForecast    // 月配额耗尽预测
+	Rollup               *UsageRollupState        // 汇总进度
+}
+
+// UsageSeries 单个配额的按日用量序列
+type UsageSeries struct {
+	QuotaID   int64         // 配额ID
+	QuotaType QuotaType     // 配额类型
+	LimitType LimitType     // 限制类型
+	Points    []*DailyUsage // 按日期升序
+}
+
+// UsageTimeSeries 用量序列
+type UsageTimeSeries struct {
+	StartDate time.Time         // 开始日期
+	EndDate   time.Time         // 结束日期
+	Series    []*UsageSeries    // 用量序列
+	Rollup    *UsageRollupState // 汇总进度
+}
+
+// UsageRollupResult 一批用量汇总的结果
+type UsageRollupResult struct {
+	Records      int   // 处理的使用记录数
+	LastRecordID int64 // 已处理到的使用记录ID
+}
+
+// UsageReportRepo 用量报表仓储接口，查询只读取日汇总表
+type UsageReportRepo interface {
+	// RollupUsage 将早于before的一批使用记录累加到日汇总表并推进进度
+	RollupUsage(ctx context.Context, before time.Time, batchSize int) (*UsageRollupResult, error)
+	GetRollupState(ctx context.Context) (*UsageRollupState, error)
+	// ListDailyUsage 按配额和日期升序列出日汇总
+	ListDailyUsage(ctx context.Context, filter *UsageReportFilter) ([]*DailyUsage, error)
+	// TopConsumers 按消耗量降序列出租户，filter.QuotaType必须指定
+	TopConsumers(ctx context.Context, filter *UsageReportFilter, limit int) ([]*TenantConsumption, error)
+	// CountSoftLimitUtilization 按利用率区间统计配额日数，返回长度为len(bounds)+1
+	CountSoftLimitUtilization(ctx context.Context, filter *UsageReportFilter, bounds []int32) ([]int64, error)
+}
+
+// UsageReportUsecase 用量报表用例
+type UsageReportUsecase struct {
+	repo      UsageReportRepo
+	quotaRepo QuotaRepo
+	log       *log.Helper
+}
+
+// NewUsageReportUsecase 创建用量报表用例
+func NewUsageReportUsecase(repo UsageReportRepo, quotaRepo QuotaRepo, logger log.Logger) *UsageReportUsecase {
+	return &UsageReportUsecase{
+		repo:      repo,
+		quotaRepo: quotaRepo,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// RollupUsage 汇总使用记录直到追平或达到批次上限，返回处理的记录数
+func (uc *UsageReportUsecase) RollupUsage(ctx context.Context, settleDelay time.Duration, batchSize, maxBatches int) (records int, err error) {
+	ctx, span := startSpan(ctx, "UsageReportUsecase.RollupUsage")
+	defer func() { endSpan(span, err) }()
+
+	before := time.Now().Add(-settleDelay)
+	for i := 0; i < maxBatches; i++ {
+		result, err := uc.repo.RollupUsage(ctx, before, batchSize)
+		if err != nil {
+			return records, err
+		}
+		records += result.Records
+		if result.Records < batchSize {
+			break
+		}
+	}
+	span.SetAttributes(attribute.Int("rollup.records", records))
+	return records, nil
+}
+
+// normalizeDateRange 校验日期范围并填充默认值
+func normalizeDateRange(filter *UsageReportFilter) error {
+	if filter.EndDate.IsZero() {
+		filter.EndDate = truncateDay(time.Now())
+	}
+	if filter.StartDate.IsZero() {
+		filter.StartDate = filter.EndDate.AddDate(0, 0, -(defaultReportDays - 1))
+	}
+	if filter.StartDate.After(filter.EndDate) {
+		return ErrInvalidDateRange.WithMetadata(map[string]string{"reason": "start_date after end_date"})
+	}
+	if filter.EndDate.Sub(filter.StartDate) >= maxReportDays*24*time.Hour {
+		return ErrInvalidDateRange.WithMetadata(map[string]string{"reason": "range exceeds 366 days"})
+	}
+	return nil
+}
+
+// truncateDay 截断到本地时区当天零点
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// GetUsageReport 获取用量报表
+func (uc *UsageReportUsecase) GetUsageReport(ctx context.Context, filter *UsageReportFilter, topN int) (report *UsageReport, err error) {
+	ctx, span := startSpan(ctx, "UsageReportUsecase.GetUsageReport",
+		attribute.String("tenant.id", filter.TenantID), attribute.String("quota.type", filter.QuotaType.String()))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("GetUsageReport: tenantID=%v, quotaType=%v", filter.TenantID, filter.QuotaType)
+
+	if err := normalizeDateRange(filter); err != nil {
+		return nil, err
+	}
+	if topN <= 0 {
+		topN = defaultTopN
+	}
+
+	report = &UsageReport{StartDate: filter.StartDate, EndDate: filter.EndDate}
+	if report.Rollup, err = uc.repo.GetRollupState(ctx); err != nil {
+		return nil, err
+	}
+
+	// 各配额类型分别排行
+	quotaTypes := []QuotaType{filter.QuotaType}
+	if filter.QuotaType == QuotaTypeUnspecified {
+		quotaTypes = quotaTypes[:0]
+		for t := range quotaTypeNames {
+			quotaTypes = append(quotaTypes, t)
+		}
+		sort.Slice(quotaTypes, func(i, j int) bool { return quotaTypes[i] < quotaTypes[j] })
+	}
+	for _, quotaType := range quotaTypes {
+		typeFilter := *filter
+		typeFilter.QuotaType = quotaType
+		consumers, err := uc.repo.TopConsumers(ctx, &typeFilter, topN)
+		if err != nil {
+			return nil, err
+		}
+		report.TopConsumers = append(report.TopConsumers, &QuotaTypeTopConsumers{QuotaType: quotaType, Consumers: consumers})
+	}
+
+	counts, err := uc.repo.CountSoftLimitUtilization(ctx, filter, utilizationBounds)
+	if err != nil {
+		return nil, err
+	}
+	var lower int32
+	for i, count := range counts {
+		bucket := &UtilizationBucket{LowerPercent: lower, Count: count}
+		if i < len(utilizationBounds) {
+			bucket.UpperPercent = utilizationBounds[i]
+			lower = utilizationBounds[i]
+		}
+		report.SoftLimitUtilization = append(report.SoftLimitUtilization, bucket)
+	}
+
+	// 未指定租户时预测排行中出现的租户
+	tenantIDs := []string{filter.TenantID}
+	if filter.TenantID == "" {
+		tenantIDs = tenantIDs[:0]
+		seen := make(map[string]bool)
+		for _, top := range report.TopConsumers {
+			for _, consumer := range top.Consumers {
+				if !seen[consumer.TenantID] {
+					seen[consumer.TenantID] = true
+					tenantIDs = append(tenantIDs, consumer.TenantID)
+				}
+			}
+		}
+	}
+	for _, tenantID := range tenantIDs {
+		forecasts, err := uc.forecastMonthly(ctx, tenantID, filter.QuotaType)
+		if err != nil {
+			return nil, err
+		}
+		report.Forecasts = append(report.Forecasts, forecasts...)
+	}
+
+	return report, nil
+}
+
+// forecastMonthly 按近期日均净消耗预测租户月配额的耗尽时间
+func (uc *UsageReportUsecase) forecastMonthly(ctx context.Context, tenantID string, quotaType QuotaType) ([]*ExhaustionForecast, error) {
+	quotas, err := uc.quotaRepo.ListQuotas(ctx, tenantID, quotaType)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	today := truncateDay(now)
+	usages, err := uc.repo.ListDailyUsage(ctx, &UsageReportFilter{
+		TenantID:  tenantID,
+		QuotaType: quotaType,
+		LimitType: LimitTypeMonthly,
+		StartDate: today.AddDate(0, 0, -(forecastWindowDays - 1)),
+		EndDate:   today,
+	})
+	if err != nil {
+		return nil, err
+	}
+	net := make(map[int64]int64)
+	for _, usage := range usages {
+		net[usage.QuotaID] += usage.Consumed - usage.Released
+	}
+
+	var forecasts []*ExhaustionForecast
+	for _, quota := range quotas {
+		if quota.LimitType != LimitTypeMonthly || quota.IsGlobal {
+			continue
+		}
+		forecast := &ExhaustionForecast{
+			TenantID:      quota.TenantID,
+			QuotaType:     quota.QuotaType,
+			HardLimit:     quota.HardLimit,
+			UsedCount:     quota.UsedCount,
+			DailyRate:     float64(net[quota.QuotaID]) / forecastWindowDays,
+			NextResetTime: quota.NextResetTime,
+		}
+		remaining := quota.Remaining()
+		switch {
+		case remaining <= 0:
+			forecast.ExhaustTime = now
+		case forecast.DailyRate > 0:
+			if days := float64(remaining) / forecast.DailyRate; days <= maxForecastDays {
+				forecast.ExhaustTime = now.Add(time.Duration(days * 24 * float64(time.Hour)))
+			}
+		}
+		forecast.ExhaustBeforeReset = !forecast.ExhaustTime.IsZero() &&
+			(quota.NextResetTime.IsZero() || forecast.ExhaustTime.Before(quota.NextResetTime))
+		forecasts = append(forecasts, forecast)
+	}
+	return forecasts, nil
+}
+
+// GetUsageTimeSeries 获取租户按日用量序列，无记录的日期补零并沿用前一日的已用量
+func (uc *UsageReportUsecase) GetUsageTimeSeries(ctx context.Context, filter *UsageReportFilter) (series *UsageTimeSeries, err error) {
+	ctx, span := startSpan(ctx, "UsageReportUsecase.GetUsageTimeSeries", quotaAttrs(filter.TenantID, filter.QuotaType, filter.LimitType)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("GetUsageTimeSeries: tenantID=%v, quotaType=%v, limitType=%v", filter.TenantID, filter.QuotaType, filter.LimitType)
+
+	if err := normalizeDateRange(filter); err != nil {
+		return nil, err
+	}
+
+	series = &UsageTimeSeries{StartDate: filter.StartDate, EndDate: filter.EndDate}
+	if series.Rollup, err = uc.repo.GetRollupState(ctx); err != nil {
+		return nil, err
+	}
+	usages, err := uc.repo.ListDailyUsage(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按配额分组，结果已按配额和日期升序
+	var current *UsageSeries
+	var byDate map[time.Time]*DailyUsage
+	flush := func() {
+		if current != nil {
+			current.Points = fillDays(current, byDate, filter.StartDate, filter.EndDate)
+			series.Series = append(series.Series, current)
+		}
+	}
+	for _, usage := range usages {
+		if current == nil || current.QuotaID != usage.QuotaID {
+			flush()
+			current = &UsageSeries{QuotaID: usage.QuotaID, QuotaType: usage.QuotaType, LimitType: usage.LimitType}
+			byDate = make(map[time.Time]*DailyUsage)
+		}
+		byDate[truncateDay(usage.Date)] = usage
+	}
+	flush()
+
+	return series, nil
+}
+
+// fillDays 生成逐日数据点，缺失日期的消耗为零，已用量和限制沿用前一日
+func fillDays(s *UsageSeries, byDate map[time.Time]*DailyUsage, start, end time.Time) []*DailyUsage {
+	var points []*DailyUsage
+	var prev *DailyUsage
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if usage, ok := byDate[day]; ok {
+			points = append(points, usage)
+			prev = usage
+			continue
+		}
+		point := &DailyUsage{Date: day, QuotaID: s.QuotaID, QuotaType: s.QuotaType, LimitType: s.LimitType}
+		if prev != nil {
+			point.TenantID = prev.TenantID
+			point.PeakUsed = prev.EndUsed
+			point.EndUsed = prev.EndUsed
+			point.HardLimit = prev.HardLimit
+			point.SoftLimit = prev.SoftLimit
+		}
+		points = append(points, point)
+	}
+	return points
+}
@@ -0,0 +1,472 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"tenant-service/internal/conf"
+)
+
+var (
+	// ErrWalletNotFound 钱包不存在
+	ErrWalletNotFound = errors.NotFound("WALLET_NOT_FOUND", "wallet not found")
+	// ErrInsufficientBalance 钱包余额不足
+	ErrInsufficientBalance = errors.Forbidden("INSUFFICIENT_BALANCE", "insufficient wallet balance")
+	// ErrWalletPriceNotFound 未配置单价
+	ErrWalletPriceNotFound = errors.NotFound("WALLET_PRICE_NOT_FOUND", "unit price not configured")
+	// ErrWalletTransactionNotFound 钱包交易不存在
+	ErrWalletTransactionNotFound = errors.NotFound("WALLET_TRANSACTION_NOT_FOUND", "wallet transaction not found")
+	// ErrRefundExceeded 退款金额超过可退金额
+	ErrRefundExceeded = errors.BadRequest("REFUND_EXCEEDED", "refund exceeds refundable amount")
+	// ErrWalletTransactionInvalid 钱包交易参数不合法
+	ErrWalletTransactionInvalid = errors.BadRequest("WALLET_TRANSACTION_INVALID", "wallet transaction is invalid")
+	// ErrIdempotencyConflict 幂等键已用于参数不同的请求
+	ErrIdempotencyConflict = errors.Conflict("IDEMPOTENCY_KEY_CONFLICT", "idempotency key reused with different parameters")
+)
+
+// 记账科目
+const (
+	AccountFunding = "funding" // 外部资金来源，充值时借记
+)
+
+// WalletAccount 租户钱包科目
+func WalletAccount(tenantID string) string {
+	return "wallet:" + tenantID
+}
+
+// RevenueAccount 消费收入科目，按配额类型和产品线区分
+func RevenueAccount(quotaType QuotaType, productCode string) string {
+	return "revenue:" + strings.ToLower(quotaType.String()) + ":" + productCode
+}
+
+// WalletTransactionType 钱包交易类型
+type WalletTransactionType int32
+
+const (
+	WalletTransactionTypeUnspecified WalletTransactionType = 0
+	WalletTransactionTypeTopUp       WalletTransactionType = 1 // 充值
+	WalletTransactionTypeDebit       WalletTransactionType = 2 // 扣费
+	WalletTransactionTypeRefund      WalletTransactionType = 3 // 退款
+)
+
+// LedgerDirection 分录方向
+type LedgerDirection int32
+
+const (
+	LedgerDirectionUnspecified LedgerDirection = 0
+	LedgerDirectionDebit       LedgerDirection = 1 // 借
+	LedgerDirectionCredit      LedgerDirection = 2 // 贷
+)
+
+// Wallet 租户钱包，金额单位为最小计价单位（分或点）
+type Wallet struct {
+	TenantID            string    // 租户ID
+	Balance             int64     // 余额
+	LowBalanceThreshold int64     // 低余额阈值，0表示不告警
+	CreatedAt           time.Time // 创建时间
+	UpdatedAt           time.Time // 更新时间
+}
+
+// IsLow 余额是否低于阈值
+func (w *Wallet) IsLow() bool {
+	return w.LowBalanceThreshold > 0 && w.Balance < w.LowBalanceThreshold
+}
+
+// LedgerEntry 复式记账分录，写入后不可修改
+type LedgerEntry struct {
+	EntryID   int64           // 分录ID
+	TxID      int64           // 交易ID
+	Account   string          // 科目
+	Direction LedgerDirection // 方向
+	Amount    int64           // 金额
+	CreatedAt time.Time       // 创建时间
+}
+
+// WalletTransaction 钱包交易，写入后不可修改，每笔交易包含一借一贷两条分录
+type WalletTransaction struct {
+	TxID           int64                 // 交易ID
+	TenantID       string                // 租户ID
+	Type           WalletTransactionType // 交易类型
+	Amount         int64                 // 金额
+	BalanceAfter   int64                 // 交易后余额
+	IdempotencyKey string                // 幂等键，同一租户内唯一
+	QuotaType      QuotaType             // 扣费/退款的计费项
+	ProductCode    string                // 扣费/退款的产品线
+	Quantity       int32                 // 扣费数量
+	UnitPrice      int64                 // 扣费单价
+	RefTxID        int64                 // 退款对应的扣费交易ID
+	BizID          string                // 业务ID
+	Operator       string                // 操作人
+	Remark         string                // 备注
+	CreatedAt      time.Time             // 创建时间
+	Entries        []*LedgerEntry        // 分录
+}
+
+// sameRequest 幂等重放时判断是否为同一请求
+func (t *WalletTransaction) sameRequest(other *WalletTransaction) bool {
+	return t.Type == other.Type && t.Amount == other.Amount && t.RefTxID == other.RefTxID &&
+		t.QuotaType == other.QuotaType && t.ProductCode == other.ProductCode
+}
+
+// buildEntries 生成交易的借贷分录：充值借资金来源贷钱包，扣费借钱包贷收入，退款借收入贷钱包
+func (t *WalletTransaction) buildEntries() {
+	wallet := WalletAccount(t.TenantID)
+	var debit, credit string
+	switch t.Type {
+	case WalletTransactionTypeTopUp:
+		debit, credit = AccountFunding, wallet
+	case WalletTransactionTypeDebit:
+		debit, credit = wallet, RevenueAccount(t.QuotaType, t.ProductCode)
+	case WalletTransactionTypeRefund:
+		debit, credit = RevenueAccount(t.QuotaType, t.ProductCode), wallet
+	}
+	t.Entries = []*LedgerEntry{
+		{Account: debit, Direction: LedgerDirectionDebit, Amount: t.Amount},
+		{Account: credit, Direction: LedgerDirectionCredit, Amount: t.Amount},
+	}
+}
+
+// WalletResult 钱包交易结果
+type WalletResult struct {
+	Transaction *WalletTransaction // 交易
+	Wallet      *Wallet            // 交易后的钱包
+	Replayed    bool               // 幂等键重放，返回的是已有交易
+}
+
+// WalletDebit 扣费请求
+type WalletDebit struct {
+	TenantID       string    // 租户ID
+	QuotaType      QuotaType // 计费项
+	ProductCode    string    // 产品线，决定单价
+	Quantity       int32     // 数量
+	IdempotencyKey string    // 幂等键
+	BizID          string    // 业务ID
+	Remark         string    // 备注
+}
+
+// WalletTransactionFilter 钱包交易查询条件
+type WalletTransactionFilter struct {
+	TenantID  string                // 租户ID
+	Type      WalletTransactionType // 交易类型
+	AfterTxID int64                 // 只返回交易ID大于该值的交易
+	Limit     int32                 // 返回条数
+}
+
+// WalletRepo 钱包仓储接口
+type WalletRepo interface {
+	// GetWallet 获取钱包，不存在时返回nil
+	GetWallet(ctx context.Context, tenantID string) (*Wallet, error)
+	// SetLowBalanceThreshold 设置低余额阈值，钱包不存在时创建
+	SetLowBalanceThreshold(ctx context.Context, tenantID string, threshold int64) (*Wallet, error)
+	// EnsureWallet 钱包不存在时按低余额阈值创建，已存在时不修改
+	EnsureWallet(ctx context.Context, tenantID string, threshold int64) error
+	// ApplyTransaction 锁定钱包后写入交易和分录并更新余额：充值时钱包不存在则创建，扣费余额不足返回ErrInsufficientBalance，
+	// 退款超过扣费交易的可退金额返回ErrRefundExceeded；幂等键已存在时不做修改，返回已有交易和replayed=true
+	ApplyTransaction(ctx context.Context, tx *WalletTransaction) (applied *WalletTransaction, wallet *Wallet, replayed bool, err error)
+	// GetTransaction 获取租户的交易，不存在时返回nil
+	GetTransaction(ctx context.Context, tenantID string, txID int64) (*WalletTransaction, error)
+	ListTransactions(ctx context.Context, filter *WalletTransactionFilter) ([]*WalletTransaction, error)
+}
+
+// WalletMetrics 钱包监控指标
+type WalletMetrics interface {
+	// ObserveWallet 记录钱包余额
+	ObserveWallet(ctx context.Context, wallet *Wallet)
+	// LowBalanceCrossed 记录余额降到低余额阈值以下
+	LowBalanceCrossed(ctx context.Context, wallet *Wallet)
+	// LockWait 记录钱包行锁等待时间
+	LockWait(ctx context.Context, wait time.Duration)
+}
+
+// WalletUsecase 预付费钱包用例
+type WalletUsecase struct {
+	repo             WalletRepo
+	tenantRepo       TenantRepo
+	metrics          WalletMetrics
+	prices           map[string]int64
+	defaultThreshold int64
+	audit            *AuditUsecase
+	log              *log.Helper
+}
+
+// priceKey 单价键，产品线为空表示该计费项的默认单价
+func priceKey(quotaType QuotaType, productCode string) string {
+	return quotaType.String() + "/" + productCode
+}
+
+// NewWalletUsecase 创建预付费钱包用例
+func NewWalletUsecase(c *conf.Tenant, repo WalletRepo, tenantRepo TenantRepo, metrics WalletMetrics, audit *AuditUsecase, logger log.Logger) (*WalletUsecase, error) {
+	uc := &WalletUsecase{
+		repo:             repo,
+		tenantRepo:       tenantRepo,
+		metrics:          metrics,
+		prices:           make(map[string]int64, len(c.GetWallet().GetPrices())),
+		defaultThreshold: c.GetWallet().GetDefaultLowBalanceThreshold(),
+		audit:            audit,
+		log:              log.NewHelper(logger),
+	}
+	for _, price := range c.GetWallet().GetPrices() {
+		quotaType, ok := ParseQuotaType(price.GetQuotaType())
+		if !ok {
+			return nil, fmt.Errorf("invalid wallet price quota_type: %s", price.GetQuotaType())
+		}
+		if price.GetUnitPrice() <= 0 {
+			return nil, fmt.Errorf("wallet price of %s must be positive", priceKey(quotaType, price.GetProductCode()))
+		}
+		uc.prices[priceKey(quotaType, price.GetProductCode())] = price.GetUnitPrice()
+	}
+	return uc, nil
+}
+
+// UnitPrice 查询单价，产品线未单独配置时使用计费项的默认单价
+func (uc *WalletUsecase) UnitPrice(quotaType QuotaType, productCode string) (int64, error) {
+	if price, ok := uc.prices[priceKey(quotaType, productCode)]; ok {
+		return price, nil
+	}
+	if price, ok := uc.prices[priceKey(quotaType, "")]; ok {
+		return price, nil
+	}
+	return 0, ErrWalletPriceNotFound.WithMetadata(map[string]string{"quota_type": quotaType.String(), "product_code": productCode})
+}
+
+// GetWallet 获取钱包
+func (uc *WalletUsecase) GetWallet(ctx context.Context, tenantID string) (wallet *Wallet, err error) {
+	ctx, span := startSpan(ctx, "WalletUsecase.GetWallet", attribute.String("tenant.id", tenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("GetWallet: tenantID=%v", tenantID)
+
+	wallet, err = uc.repo.GetWallet(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if wallet == nil {
+		return nil, ErrWalletNotFound
+	}
+	return wallet, nil
+}
+
+// SetLowBalanceThreshold 设置低余额阈值
+func (uc *WalletUsecase) SetLowBalanceThreshold(ctx context.Context, tenantID string, threshold int64) (wallet *Wallet, err error) {
+	ctx, span := startSpan(ctx, "WalletUsecase.SetLowBalanceThreshold", attribute.String("tenant.id", tenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("SetLowBalanceThreshold: tenantID=%v, threshold=%v", tenantID, threshold)
+
+	if err := uc.checkTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	err = uc.audit.InTx(ctx, func(ctx context.Context) error {
+		before, err := uc.repo.GetWallet(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		wallet, err = uc.repo.SetLowBalanceThreshold(ctx, tenantID, threshold)
+		if err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     AuditActionWalletThreshold,
+			TargetType: AuditTargetWallet,
+			TargetID:   tenantID,
+			TenantID:   tenantID,
+			Before:     before,
+			After:      wallet,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wallet, nil
+}
+
+// checkTenant 检查租户是否存在
+func (uc *WalletUsecase) checkTenant(ctx context.Context, tenantID string) error {
+	tenant, err := uc.tenantRepo.Get(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if tenant == nil {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+// TopUp 充值，钱包不存在时按默认低余额阈值创建
+func (uc *WalletUsecase) TopUp(ctx context.Context, tenantID string, amount int64, idempotencyKey, operator, remark string) (result *WalletResult, err error) {
+	ctx, span := startSpan(ctx, "WalletUsecase.TopUp", attribute.String("tenant.id", tenantID), attribute.Int64("wallet.amount", amount))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("TopUp: tenantID=%v, amount=%v, idempotencyKey=%v, operator=%v", tenantID, amount, idempotencyKey, operator)
+
+	if amount <= 0 {
+		return nil, ErrWalletTransactionInvalid.WithMetadata(map[string]string{"reason": "amount must be positive"})
+	}
+	if err := uc.checkTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	return uc.apply(ctx, &WalletTransaction{
+		TenantID:       tenantID,
+		Type:           WalletTransactionTypeTopUp,
+		Amount:         amount,
+		IdempotencyKey: idempotencyKey,
+		Operator:       operator,
+		Remark:         remark,
+	}, AuditActionWalletTopUp)
+}
+
+// Debit 按产品线单价扣费
+func (uc *WalletUsecase) Debit(ctx context.Context, debit *WalletDebit) (result *WalletResult, err error) {
+	ctx, span := startSpan(ctx, "WalletUsecase.Debit", append(quotaAttrs(debit.TenantID, debit.QuotaType, LimitTypeUnspecified),
+		attribute.String("product.code", debit.ProductCode),
+		attribute.Int("wallet.quantity", int(debit.Quantity)),
+		attribute.String("biz.id", debit.BizID),
+	)...)
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("Debit: tenantID=%v, quotaType=%v, productCode=%v, quantity=%v, idempotencyKey=%v",
+		debit.TenantID, debit.QuotaType, debit.ProductCode, debit.Quantity, debit.IdempotencyKey)
+
+	if debit.Quantity <= 0 {
+		return nil, ErrWalletTransactionInvalid.WithMetadata(map[string]string{"reason": "quantity must be positive"})
+	}
+	unitPrice, err := uc.UnitPrice(debit.QuotaType, debit.ProductCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.apply(ctx, &WalletTransaction{
+		TenantID:       debit.TenantID,
+		Type:           WalletTransactionTypeDebit,
+		Amount:         unitPrice * int64(debit.Quantity),
+		IdempotencyKey: debit.IdempotencyKey,
+		QuotaType:      debit.QuotaType,
+		ProductCode:    debit.ProductCode,
+		Quantity:       debit.Quantity,
+		UnitPrice:      unitPrice,
+		BizID:          debit.BizID,
+		Remark:         debit.Remark,
+	}, "")
+}
+
+// Refund 退回扣费交易的全部或部分金额，amount为0时按扣费金额全额退回
+func (uc *WalletUsecase) Refund(ctx context.Context, tenantID string, debitTxID, amount int64, idempotencyKey, operator, remark string) (result *WalletResult, err error) {
+	ctx, span := startSpan(ctx, "WalletUsecase.Refund", attribute.String("tenant.id", tenantID), attribute.Int64("wallet.ref_tx_id", debitTxID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("Refund: tenantID=%v, debitTxID=%v, amount=%v, idempotencyKey=%v", tenantID, debitTxID, amount, idempotencyKey)
+
+	if amount < 0 {
+		return nil, ErrWalletTransactionInvalid.WithMetadata(map[string]string{"reason": "amount must not be negative"})
+	}
+	original, err := uc.repo.GetTransaction(ctx, tenantID, debitTxID)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil || original.Type != WalletTransactionTypeDebit {
+		return nil, ErrWalletTransactionNotFound
+	}
+
+	// 退款金额为0表示全额，仓储在锁定钱包后按已退款金额校验
+	if amount == 0 {
+		amount = original.Amount
+	}
+	return uc.apply(ctx, &WalletTransaction{
+		TenantID:       tenantID,
+		Type:           WalletTransactionTypeRefund,
+		Amount:         amount,
+		IdempotencyKey: idempotencyKey,
+		QuotaType:      original.QuotaType,
+		ProductCode:    original.ProductCode,
+		RefTxID:        original.TxID,
+		BizID:          original.BizID,
+		Operator:       operator,
+		Remark:         remark,
+	}, AuditActionWalletRefund)
+}
+
+// apply 写入交易，处理幂等重放和低余额告警；action非空时在同一事务中记录审计事件，幂等重放不记录
+func (uc *WalletUsecase) apply(ctx context.Context, tx *WalletTransaction, action AuditAction) (*WalletResult, error) {
+	if tx.IdempotencyKey == "" {
+		return nil, ErrWalletTransactionInvalid.WithMetadata(map[string]string{"reason": "idempotency_key is required"})
+	}
+	tx.buildEntries()
+	var applied *WalletTransaction
+	var wallet *Wallet
+	var replayed bool
+	write := func(ctx context.Context) error {
+		var before *Wallet
+		var err error
+		if action != "" {
+			if before, err = uc.repo.GetWallet(ctx, tx.TenantID); err != nil {
+				return err
+			}
+		}
+		// 首次充值在同一事务中按默认低余额阈值创建钱包
+		if tx.Type == WalletTransactionTypeTopUp && before == nil && uc.defaultThreshold > 0 {
+			if err := uc.repo.EnsureWallet(ctx, tx.TenantID, uc.defaultThreshold); err != nil {
+				return err
+			}
+		}
+		applied, wallet, replayed, err = uc.repo.ApplyTransaction(ctx, tx)
+		if err != nil || replayed || action == "" {
+			return err
+		}
+		return uc.audit.Record(ctx, &AuditRecord{
+			Action:     action,
+			TargetType: AuditTargetWallet,
+			TargetID:   tx.TenantID,
+			TenantID:   tx.TenantID,
+			Operator:   tx.Operator,
+			Before:     before,
+			After:      wallet,
+			Remark:     fmt.Sprintf("tx_id=%d, amount=%d", applied.TxID, applied.Amount),
+		})
+	}
+
+	var err error
+	if action == "" {
+		// 扣费属于数据面高频操作，已有交易流水，不记录审计事件
+		err = write(ctx)
+	} else {
+		err = uc.audit.InTx(ctx, write)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if replayed && !applied.sameRequest(tx) {
+		return nil, ErrIdempotencyConflict.WithMetadata(map[string]string{"idempotency_key": tx.IdempotencyKey})
+	}
+
+	result := &WalletResult{Transaction: applied, Wallet: wallet, Replayed: replayed}
+	if replayed {
+		return result, nil
+	}
+	uc.metrics.ObserveWallet(ctx, wallet)
+	if tx.Type == WalletTransactionTypeDebit && wallet.IsLow() && wallet.Balance+tx.Amount >= wallet.LowBalanceThreshold {
+		uc.log.WithContext(ctx).Warnf("wallet of tenant %s is below low balance threshold: balance=%d, threshold=%d",
+			wallet.TenantID, wallet.Balance, wallet.LowBalanceThreshold)
+		uc.metrics.LowBalanceCrossed(ctx, wallet)
+	}
+	return result, nil
+}
+
+// ListTransactions 列出钱包交易及分录
+func (uc *WalletUsecase) ListTransactions(ctx context.Context, filter *WalletTransactionFilter) (txs []*WalletTransaction, err error) {
+	ctx, span := startSpan(ctx, "WalletUsecase.ListTransactions", attribute.String("tenant.id", filter.TenantID))
+	defer func() { endSpan(span, err) }()
+
+	uc.log.WithContext(ctx).Infof("ListTransactions: tenantID=%v, afterTxID=%v", filter.TenantID, filter.AfterTxID)
+
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+	return uc.repo.ListTransactions(ctx, filter)
+}
@@ -27,6 +27,9 @@ type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Metrics       *Metrics               `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Trace         *Trace                 `protobuf:"bytes,5,opt,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,13 +78,35 @@ func (x *Bootstrap) GetData() *Data {
 	return nil
 }
 
+func (x *Bootstrap) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
 // Server 服务配置
 type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Http           *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc           *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	TrustedProxies []string               `protobuf:"bytes,3,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"` // 可信反向代理的IP或CIDR，只有来自这些地址的请求才采信X-Forwarded-For
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
@@ -128,6 +153,13 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 // Data 数据配置
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -181,6 +213,261 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+// Tenant 租户配置
+type Tenant struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	IdGenerator      *Tenant_IDGenerator       `protobuf:"bytes,1,opt,name=id_generator,json=idGenerator,proto3" json:"id_generator,omitempty"`
+	QuotaReset       *Tenant_QuotaReset        `protobuf:"bytes,2,opt,name=quota_reset,json=quotaReset,proto3" json:"quota_reset,omitempty"`
+	UsageRollup      *Tenant_UsageRollup       `protobuf:"bytes,3,opt,name=usage_rollup,json=usageRollup,proto3" json:"usage_rollup,omitempty"`
+	QuotaChange      *Tenant_QuotaChange       `protobuf:"bytes,4,opt,name=quota_change,json=quotaChange,proto3" json:"quota_change,omitempty"`
+	Wallet           *Tenant_Wallet            `protobuf:"bytes,5,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	QuotaLease       *Tenant_QuotaLease        `protobuf:"bytes,6,opt,name=quota_lease,json=quotaLease,proto3" json:"quota_lease,omitempty"`
+	AttributeSchemas []*Tenant_AttributeSchema `protobuf:"bytes,7,rep,name=attribute_schemas,json=attributeSchemas,proto3" json:"attribute_schemas,omitempty"` // 未配置的租户类型不校验属性定义
+	Membership       *Tenant_Membership        `protobuf:"bytes,8,opt,name=membership,proto3" json:"membership,omitempty"`
+	Entitlements     []*Tenant_Entitlement     `protobuf:"bytes,9,rep,name=entitlements,proto3" json:"entitlements,omitempty"` // 只能授予已定义的授权项
+	Ledger           *Tenant_Ledger            `protobuf:"bytes,10,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Tenant) GetIdGenerator() *Tenant_IDGenerator {
+	if x != nil {
+		return x.IdGenerator
+	}
+	return nil
+}
+
+func (x *Tenant) GetQuotaReset() *Tenant_QuotaReset {
+	if x != nil {
+		return x.QuotaReset
+	}
+	return nil
+}
+
+func (x *Tenant) GetUsageRollup() *Tenant_UsageRollup {
+	if x != nil {
+		return x.UsageRollup
+	}
+	return nil
+}
+
+func (x *Tenant) GetQuotaChange() *Tenant_QuotaChange {
+	if x != nil {
+		return x.QuotaChange
+	}
+	return nil
+}
+
+func (x *Tenant) GetWallet() *Tenant_Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *Tenant) GetQuotaLease() *Tenant_QuotaLease {
+	if x != nil {
+		return x.QuotaLease
+	}
+	return nil
+}
+
+func (x *Tenant) GetAttributeSchemas() []*Tenant_AttributeSchema {
+	if x != nil {
+		return x.AttributeSchemas
+	}
+	return nil
+}
+
+func (x *Tenant) GetMembership() *Tenant_Membership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+func (x *Tenant) GetEntitlements() []*Tenant_Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *Tenant) GetLedger() *Tenant_Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+// Metrics 监控指标配置
+type Metrics struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Path            string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                              // 指标暴露路径，默认/metrics
+	TenantLabel     string                 `protobuf:"bytes,2,opt,name=tenant_label,json=tenantLabel,proto3" json:"tenant_label,omitempty"`             // 租户标签模式：full(默认，每个租户独立)/limited/none(不输出租户标签)
+	MaxTenants      int32                  `protobuf:"varint,3,opt,name=max_tenants,json=maxTenants,proto3" json:"max_tenants,omitempty"`               // limited模式下独立统计的租户数上限，超出的租户归入other，默认100
+	TenantAllowlist []string               `protobuf:"bytes,4,rep,name=tenant_allowlist,json=tenantAllowlist,proto3" json:"tenant_allowlist,omitempty"` // limited模式下始终独立统计的租户，不占用max_tenants
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Metrics) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Metrics) GetTenantLabel() string {
+	if x != nil {
+		return x.TenantLabel
+	}
+	return ""
+}
+
+func (x *Metrics) GetMaxTenants() int32 {
+	if x != nil {
+		return x.MaxTenants
+	}
+	return 0
+}
+
+func (x *Metrics) GetTenantAllowlist() []string {
+	if x != nil {
+		return x.TenantAllowlist
+	}
+	return nil
+}
+
+// Trace 链路追踪配置
+type Trace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                            // OTLP gRPC接收地址，如127.0.0.1:4317，为空表示不导出
+	Insecure      bool                   `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`                           // 不使用TLS连接接收端
+	SampleRatio   float64                `protobuf:"fixed64,3,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"` // 根span采样率(0,1]，默认1；上游已采样的请求始终跟随上游决定
+	Timeout       *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`                              // 导出超时，默认10s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Trace) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Trace) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Trace) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+func (x *Trace) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -192,7 +479,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -204,7 +491,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +539,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +551,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +601,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +613,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +681,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +693,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,44 +772,913 @@ func (x *Data_Redis) GetMinIdleConns() int32 {
 	return 0
 }
 
-var File_internal_conf_conf_proto protoreflect.FileDescriptor
+// IDGenerator 租户ID生成策略
+type Tenant_IDGenerator struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Strategy         string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`                                         // 生成策略：ulid(默认)/uuid/snowflake/sequence/custom
+	NodeId           *int64                 `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3,oneof" json:"node_id,omitempty"`                        // snowflake节点ID（0~1023），各实例不同；不配置时取StatefulSet的Pod序号，无法推导时启动失败
+	SequenceWidth    int32                  `protobuf:"varint,3,opt,name=sequence_width,json=sequenceWidth,proto3" json:"sequence_width,omitempty"`         // sequence策略的序号位数，默认8
+	FallbackStrategy string                 `protobuf:"bytes,4,opt,name=fallback_strategy,json=fallbackStrategy,proto3" json:"fallback_strategy,omitempty"` // custom策略下调用方未指定ID时使用的策略，为空则必须指定
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-const file_internal_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x18internal/conf/conf.proto\x12\vtenant.conf\x1a\x1egoogle/protobuf/duration.proto\"_\n" +
-	"\tBootstrap\x12+\n" +
-	"\x06server\x18\x01 \x01(\v2\x13.tenant.conf.ServerR\x06server\x12%\n" +
-	"\x04data\x18\x02 \x01(\v2\x11.tenant.conf.DataR\x04data\"\xba\x02\n" +
-	"\x06Server\x12,\n" +
-	"\x04http\x18\x01 \x01(\v2\x18.tenant.conf.Server.HTTPR\x04http\x12,\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x18.tenant.conf.Server.GRPCR\x04grpc\x1ai\n" +
-	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
-	"\x04GRPC\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xa0\x05\n" +
-	"\x04Data\x126\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x1a.tenant.conf.Data.DatabaseR\bdatabase\x12-\n" +
-	"\x05redis\x18\x02 \x01(\v2\x17.tenant.conf.Data.RedisR\x05redis\x1a\xcd\x01\n" +
-	"\bDatabase\x12\x16\n" +
-	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
-	"\x0emax_idle_conns\x18\x03 \x01(\x05R\fmaxIdleConns\x12$\n" +
-	"\x0emax_open_conns\x18\x04 \x01(\x05R\fmaxOpenConns\x12E\n" +
-	"\x11conn_max_lifetime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x1a\xe0\x02\n" +
-	"\x05Redis\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x0e\n" +
-	"\x02db\x18\x04 \x01(\x05R\x02db\x12<\n" +
-	"\fdial_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12<\n" +
-	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1b\n" +
-	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x12$\n" +
-	"\x0emin_idle_conns\x18\t \x01(\x05R\fminIdleConnsBEZCtenant-service/internal/conf;confb\x06proto3"
+func (x *Tenant_IDGenerator) Reset() {
+	*x = Tenant_IDGenerator{}
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_IDGenerator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_IDGenerator) ProtoMessage() {}
+
+func (x *Tenant_IDGenerator) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_IDGenerator.ProtoReflect.Descriptor instead.
+func (*Tenant_IDGenerator) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Tenant_IDGenerator) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *Tenant_IDGenerator) GetNodeId() int64 {
+	if x != nil && x.NodeId != nil {
+		return *x.NodeId
+	}
+	return 0
+}
+
+func (x *Tenant_IDGenerator) GetSequenceWidth() int32 {
+	if x != nil {
+		return x.SequenceWidth
+	}
+	return 0
+}
+
+func (x *Tenant_IDGenerator) GetFallbackStrategy() string {
+	if x != nil {
+		return x.FallbackStrategy
+	}
+	return ""
+}
+
+// QuotaReset 配额定时重置
+type Tenant_QuotaReset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"` // 关闭本实例的定时重置
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`  // 扫描间隔，默认1m
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_QuotaReset) Reset() {
+	*x = Tenant_QuotaReset{}
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_QuotaReset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_QuotaReset) ProtoMessage() {}
+
+func (x *Tenant_QuotaReset) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_QuotaReset.ProtoReflect.Descriptor instead.
+func (*Tenant_QuotaReset) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Tenant_QuotaReset) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Tenant_QuotaReset) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// UsageRollup 配额用量日汇总
+type Tenant_UsageRollup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`                         // 关闭本实例的汇总任务
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                          // 汇总间隔，默认1m
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`      // 每批处理的使用记录数，默认1000
+	SettleDelay   *durationpb.Duration   `protobuf:"bytes,4,opt,name=settle_delay,json=settleDelay,proto3" json:"settle_delay,omitempty"` // 只汇总早于该时长的记录，避免跳过未提交的事务，默认30s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_UsageRollup) Reset() {
+	*x = Tenant_UsageRollup{}
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_UsageRollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_UsageRollup) ProtoMessage() {}
+
+func (x *Tenant_UsageRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_UsageRollup.ProtoReflect.Descriptor instead.
+func (*Tenant_UsageRollup) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Tenant_UsageRollup) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Tenant_UsageRollup) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Tenant_UsageRollup) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Tenant_UsageRollup) GetSettleDelay() *durationpb.Duration {
+	if x != nil {
+		return x.SettleDelay
+	}
+	return nil
+}
+
+// QuotaChange 计划配额变更
+type Tenant_QuotaChange struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DefaultProration string                 `protobuf:"bytes,1,opt,name=default_proration,json=defaultProration,proto3" json:"default_proration,omitempty"` // 未指定时的已用量折算策略：carry_over(默认)/scale/reset
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Tenant_QuotaChange) Reset() {
+	*x = Tenant_QuotaChange{}
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_QuotaChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_QuotaChange) ProtoMessage() {}
+
+func (x *Tenant_QuotaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_QuotaChange.ProtoReflect.Descriptor instead.
+func (*Tenant_QuotaChange) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *Tenant_QuotaChange) GetDefaultProration() string {
+	if x != nil {
+		return x.DefaultProration
+	}
+	return ""
+}
+
+// Wallet 预付费钱包
+type Tenant_Wallet struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	DefaultLowBalanceThreshold int64                  `protobuf:"varint,1,opt,name=default_low_balance_threshold,json=defaultLowBalanceThreshold,proto3" json:"default_low_balance_threshold,omitempty"` // 新建钱包的低余额阈值，0表示不告警
+	Prices                     []*Tenant_Wallet_Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`                                                                                // 单价表
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Tenant_Wallet) Reset() {
+	*x = Tenant_Wallet{}
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_Wallet) ProtoMessage() {}
+
+func (x *Tenant_Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_Wallet.ProtoReflect.Descriptor instead.
+func (*Tenant_Wallet) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *Tenant_Wallet) GetDefaultLowBalanceThreshold() int64 {
+	if x != nil {
+		return x.DefaultLowBalanceThreshold
+	}
+	return 0
+}
+
+func (x *Tenant_Wallet) GetPrices() []*Tenant_Wallet_Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// QuotaLease 配额租约
+type Tenant_QuotaLease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`                      // 关闭本实例的过期租约回收任务
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                       // 回收扫描间隔，默认30s
+	DefaultTtl    *durationpb.Duration   `protobuf:"bytes,3,opt,name=default_ttl,json=defaultTtl,proto3" json:"default_ttl,omitempty"` // 未指定时的租约有效期，默认1m
+	MaxTtl        *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_ttl,json=maxTtl,proto3" json:"max_ttl,omitempty"`             // 租约最长有效期，默认10m
+	MaxBlock      int32                  `protobuf:"varint,5,opt,name=max_block,json=maxBlock,proto3" json:"max_block,omitempty"`      // 单个租约的最大额度，0表示不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_QuotaLease) Reset() {
+	*x = Tenant_QuotaLease{}
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_QuotaLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_QuotaLease) ProtoMessage() {}
+
+func (x *Tenant_QuotaLease) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_QuotaLease.ProtoReflect.Descriptor instead.
+func (*Tenant_QuotaLease) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *Tenant_QuotaLease) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Tenant_QuotaLease) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Tenant_QuotaLease) GetDefaultTtl() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultTtl
+	}
+	return nil
+}
+
+func (x *Tenant_QuotaLease) GetMaxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTtl
+	}
+	return nil
+}
+
+func (x *Tenant_QuotaLease) GetMaxBlock() int32 {
+	if x != nil {
+		return x.MaxBlock
+	}
+	return 0
+}
+
+// AttributeSchema 租户类型的自定义属性定义
+type Tenant_AttributeSchema struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	TenantType    string                              `protobuf:"bytes,1,opt,name=tenant_type,json=tenantType,proto3" json:"tenant_type,omitempty"` // 租户类型：platform/channel/enterprise
+	Strict        bool                                `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`                          // 不允许未定义的属性
+	Attributes    []*Tenant_AttributeSchema_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`                   // 属性定义
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_AttributeSchema) Reset() {
+	*x = Tenant_AttributeSchema{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_AttributeSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_AttributeSchema) ProtoMessage() {}
+
+func (x *Tenant_AttributeSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_AttributeSchema.ProtoReflect.Descriptor instead.
+func (*Tenant_AttributeSchema) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 6}
+}
+
+func (x *Tenant_AttributeSchema) GetTenantType() string {
+	if x != nil {
+		return x.TenantType
+	}
+	return ""
+}
+
+func (x *Tenant_AttributeSchema) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *Tenant_AttributeSchema) GetAttributes() []*Tenant_AttributeSchema_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Membership 租户成员
+type Tenant_Membership struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InvitationTtl   *durationpb.Duration   `protobuf:"bytes,1,opt,name=invitation_ttl,json=invitationTtl,proto3" json:"invitation_ttl,omitempty"`          // 邀请有效期，默认168h
+	MaxInheritDepth int32                  `protobuf:"varint,2,opt,name=max_inherit_depth,json=maxInheritDepth,proto3" json:"max_inherit_depth,omitempty"` // 向上查找可继承角色的父租户层数，默认5，负数表示不继承
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tenant_Membership) Reset() {
+	*x = Tenant_Membership{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_Membership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_Membership) ProtoMessage() {}
+
+func (x *Tenant_Membership) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_Membership.ProtoReflect.Descriptor instead.
+func (*Tenant_Membership) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 7}
+}
+
+func (x *Tenant_Membership) GetInvitationTtl() *durationpb.Duration {
+	if x != nil {
+		return x.InvitationTtl
+	}
+	return nil
+}
+
+func (x *Tenant_Membership) GetMaxInheritDepth() int32 {
+	if x != nil {
+		return x.MaxInheritDepth
+	}
+	return 0
+}
+
+// Entitlement 功能授权项定义
+type Tenant_Entitlement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                       // 授权项键，如 can_create_lucky_draw
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                     // 值类型：bool(默认)/enum
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`                                 // enum类型的可选值
+	DefaultValue  string                 `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"` // 未授予时的取值，bool默认false，enum默认第一个可选值
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                       // 说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_Entitlement) Reset() {
+	*x = Tenant_Entitlement{}
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_Entitlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_Entitlement) ProtoMessage() {}
+
+func (x *Tenant_Entitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_Entitlement.ProtoReflect.Descriptor instead.
+func (*Tenant_Entitlement) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 8}
+}
+
+func (x *Tenant_Entitlement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Tenant_Entitlement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Tenant_Entitlement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Tenant_Entitlement) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *Tenant_Entitlement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Ledger 使用记录哈希链检查点
+type Tenant_Ledger struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Disabled           bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`                                              // 关闭本实例的定序任务和检查点任务
+	CheckpointInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"` // 检查点签发间隔，默认1h
+	SigningKey         string                 `protobuf:"bytes,3,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`                         // Ed25519私钥种子（32字节，base64），为空时不签发检查点
+	KeyId              string                 `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                                        // 签名密钥ID，写入检查点，便于轮换密钥
+	SequenceInterval   *durationpb.Duration   `protobuf:"bytes,5,opt,name=sequence_interval,json=sequenceInterval,proto3" json:"sequence_interval,omitempty"`       // 定序任务间隔，使用记录写入后最迟经过该间隔进入哈希链，默认5s
+	MaxSequenceLag     *durationpb.Duration   `protobuf:"bytes,6,opt,name=max_sequence_lag,json=maxSequenceLag,proto3" json:"max_sequence_lag,omitempty"`           // 使用记录允许的最长未入链时长，默认1m，超过时告警且VerifyLedger报告UNSEQUENCED
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Tenant_Ledger) Reset() {
+	*x = Tenant_Ledger{}
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_Ledger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_Ledger) ProtoMessage() {}
+
+func (x *Tenant_Ledger) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_Ledger.ProtoReflect.Descriptor instead.
+func (*Tenant_Ledger) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 9}
+}
+
+func (x *Tenant_Ledger) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Tenant_Ledger) GetCheckpointInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CheckpointInterval
+	}
+	return nil
+}
+
+func (x *Tenant_Ledger) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *Tenant_Ledger) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Tenant_Ledger) GetSequenceInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SequenceInterval
+	}
+	return nil
+}
+
+func (x *Tenant_Ledger) GetMaxSequenceLag() *durationpb.Duration {
+	if x != nil {
+		return x.MaxSequenceLag
+	}
+	return nil
+}
+
+// Price 计费项单价
+type Tenant_Wallet_Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuotaType     string                 `protobuf:"bytes,1,opt,name=quota_type,json=quotaType,proto3" json:"quota_type,omitempty"`       // 配额类型，如 sms
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品线，为空表示该配额类型的默认单价
+	UnitPrice     int64                  `protobuf:"varint,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`      // 单价，最小计价单位
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_Wallet_Price) Reset() {
+	*x = Tenant_Wallet_Price{}
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_Wallet_Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_Wallet_Price) ProtoMessage() {}
+
+func (x *Tenant_Wallet_Price) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_Wallet_Price.ProtoReflect.Descriptor instead.
+func (*Tenant_Wallet_Price) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 4, 0}
+}
+
+func (x *Tenant_Wallet_Price) GetQuotaType() string {
+	if x != nil {
+		return x.QuotaType
+	}
+	return ""
+}
+
+func (x *Tenant_Wallet_Price) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *Tenant_Wallet_Price) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+// Attribute 属性定义
+type Tenant_AttributeSchema_Attribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`            // 属性键
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`          // 值类型：string(默认)/int/bool/enum
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"` // 是否必填
+	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`      // enum类型的可选值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant_AttributeSchema_Attribute) Reset() {
+	*x = Tenant_AttributeSchema_Attribute{}
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant_AttributeSchema_Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant_AttributeSchema_Attribute) ProtoMessage() {}
+
+func (x *Tenant_AttributeSchema_Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant_AttributeSchema_Attribute.ProtoReflect.Descriptor instead.
+func (*Tenant_AttributeSchema_Attribute) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 6, 0}
+}
+
+func (x *Tenant_AttributeSchema_Attribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Tenant_AttributeSchema_Attribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Tenant_AttributeSchema_Attribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Tenant_AttributeSchema_Attribute) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_internal_conf_conf_proto protoreflect.FileDescriptor
+
+const file_internal_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x18internal/conf/conf.proto\x12\vtenant.conf\x1a\x1egoogle/protobuf/duration.proto\"\xe6\x01\n" +
+	"\tBootstrap\x12+\n" +
+	"\x06server\x18\x01 \x01(\v2\x13.tenant.conf.ServerR\x06server\x12%\n" +
+	"\x04data\x18\x02 \x01(\v2\x11.tenant.conf.DataR\x04data\x12+\n" +
+	"\x06tenant\x18\x03 \x01(\v2\x13.tenant.conf.TenantR\x06tenant\x12.\n" +
+	"\ametrics\x18\x04 \x01(\v2\x14.tenant.conf.MetricsR\ametrics\x12(\n" +
+	"\x05trace\x18\x05 \x01(\v2\x12.tenant.conf.TraceR\x05trace\"\xe3\x02\n" +
+	"\x06Server\x12,\n" +
+	"\x04http\x18\x01 \x01(\v2\x18.tenant.conf.Server.HTTPR\x04http\x12,\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x18.tenant.conf.Server.GRPCR\x04grpc\x12'\n" +
+	"\x0ftrusted_proxies\x18\x03 \x03(\tR\x0etrustedProxies\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xa0\x05\n" +
+	"\x04Data\x126\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x1a.tenant.conf.Data.DatabaseR\bdatabase\x12-\n" +
+	"\x05redis\x18\x02 \x01(\v2\x17.tenant.conf.Data.RedisR\x05redis\x1a\xcd\x01\n" +
+	"\bDatabase\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
+	"\x0emax_idle_conns\x18\x03 \x01(\x05R\fmaxIdleConns\x12$\n" +
+	"\x0emax_open_conns\x18\x04 \x01(\x05R\fmaxOpenConns\x12E\n" +
+	"\x11conn_max_lifetime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x1a\xe0\x02\n" +
+	"\x05Redis\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x0e\n" +
+	"\x02db\x18\x04 \x01(\x05R\x02db\x12<\n" +
+	"\fdial_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12<\n" +
+	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1b\n" +
+	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x12$\n" +
+	"\x0emin_idle_conns\x18\t \x01(\x05R\fminIdleConns\"\xc9\x13\n" +
+	"\x06Tenant\x12B\n" +
+	"\fid_generator\x18\x01 \x01(\v2\x1f.tenant.conf.Tenant.IDGeneratorR\vidGenerator\x12?\n" +
+	"\vquota_reset\x18\x02 \x01(\v2\x1e.tenant.conf.Tenant.QuotaResetR\n" +
+	"quotaReset\x12B\n" +
+	"\fusage_rollup\x18\x03 \x01(\v2\x1f.tenant.conf.Tenant.UsageRollupR\vusageRollup\x12B\n" +
+	"\fquota_change\x18\x04 \x01(\v2\x1f.tenant.conf.Tenant.QuotaChangeR\vquotaChange\x122\n" +
+	"\x06wallet\x18\x05 \x01(\v2\x1a.tenant.conf.Tenant.WalletR\x06wallet\x12?\n" +
+	"\vquota_lease\x18\x06 \x01(\v2\x1e.tenant.conf.Tenant.QuotaLeaseR\n" +
+	"quotaLease\x12P\n" +
+	"\x11attribute_schemas\x18\a \x03(\v2#.tenant.conf.Tenant.AttributeSchemaR\x10attributeSchemas\x12>\n" +
+	"\n" +
+	"membership\x18\b \x01(\v2\x1e.tenant.conf.Tenant.MembershipR\n" +
+	"membership\x12C\n" +
+	"\fentitlements\x18\t \x03(\v2\x1f.tenant.conf.Tenant.EntitlementR\fentitlements\x122\n" +
+	"\x06ledger\x18\n" +
+	" \x01(\v2\x1a.tenant.conf.Tenant.LedgerR\x06ledger\x1a\xa7\x01\n" +
+	"\vIDGenerator\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12\x1c\n" +
+	"\anode_id\x18\x02 \x01(\x03H\x00R\x06nodeId\x88\x01\x01\x12%\n" +
+	"\x0esequence_width\x18\x03 \x01(\x05R\rsequenceWidth\x12+\n" +
+	"\x11fallback_strategy\x18\x04 \x01(\tR\x10fallbackStrategyB\n" +
+	"\n" +
+	"\b_node_id\x1a_\n" +
+	"\n" +
+	"QuotaReset\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x1a\xbd\x01\n" +
+	"\vUsageRollup\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12<\n" +
+	"\fsettle_delay\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vsettleDelay\x1a:\n" +
+	"\vQuotaChange\x12+\n" +
+	"\x11default_proration\x18\x01 \x01(\tR\x10defaultProration\x1a\xef\x01\n" +
+	"\x06Wallet\x12A\n" +
+	"\x1ddefault_low_balance_threshold\x18\x01 \x01(\x03R\x1adefaultLowBalanceThreshold\x128\n" +
+	"\x06prices\x18\x02 \x03(\v2 .tenant.conf.Tenant.Wallet.PriceR\x06prices\x1ah\n" +
+	"\x05Price\x12\x1d\n" +
+	"\n" +
+	"quota_type\x18\x01 \x01(\tR\tquotaType\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x03R\tunitPrice\x1a\xec\x01\n" +
+	"\n" +
+	"QuotaLease\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12:\n" +
+	"\vdefault_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"defaultTtl\x122\n" +
+	"\amax_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x06maxTtl\x12\x1b\n" +
+	"\tmax_block\x18\x05 \x01(\x05R\bmaxBlock\x1a\x80\x02\n" +
+	"\x0fAttributeSchema\x12\x1f\n" +
+	"\vtenant_type\x18\x01 \x01(\tR\n" +
+	"tenantType\x12\x16\n" +
+	"\x06strict\x18\x02 \x01(\bR\x06strict\x12M\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2-.tenant.conf.Tenant.AttributeSchema.AttributeR\n" +
+	"attributes\x1ae\n" +
+	"\tAttribute\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\tR\x06values\x1az\n" +
+	"\n" +
+	"Membership\x12@\n" +
+	"\x0einvitation_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rinvitationTtl\x12*\n" +
+	"\x11max_inherit_depth\x18\x02 \x01(\x05R\x0fmaxInheritDepth\x1a\x92\x01\n" +
+	"\vEntitlement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x1a\xb5\x02\n" +
+	"\x06Ledger\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12J\n" +
+	"\x13checkpoint_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x12checkpointInterval\x12\x1f\n" +
+	"\vsigning_key\x18\x03 \x01(\tR\n" +
+	"signingKey\x12\x15\n" +
+	"\x06key_id\x18\x04 \x01(\tR\x05keyId\x12F\n" +
+	"\x11sequence_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x10sequenceInterval\x12C\n" +
+	"\x10max_sequence_lag\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0emaxSequenceLag\"\x8c\x01\n" +
+	"\aMetrics\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12!\n" +
+	"\ftenant_label\x18\x02 \x01(\tR\vtenantLabel\x12\x1f\n" +
+	"\vmax_tenants\x18\x03 \x01(\x05R\n" +
+	"maxTenants\x12)\n" +
+	"\x10tenant_allowlist\x18\x04 \x03(\tR\x0ftenantAllowlist\"\x97\x01\n" +
+	"\x05Trace\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x02 \x01(\bR\binsecure\x12!\n" +
+	"\fsample_ratio\x18\x03 \x01(\x01R\vsampleRatio\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeoutB#Z!tenant-service/internal/conf;confb\x06proto3"
 
 var (
 	file_internal_conf_conf_proto_rawDescOnce sync.Once
@@ -536,35 +1692,76 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_internal_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: tenant.conf.Bootstrap
-	(*Server)(nil),              // 1: tenant.conf.Server
-	(*Data)(nil),                // 2: tenant.conf.Data
-	(*Server_HTTP)(nil),         // 3: tenant.conf.Server.HTTP
-	(*Server_GRPC)(nil),         // 4: tenant.conf.Server.GRPC
-	(*Data_Database)(nil),       // 5: tenant.conf.Data.Database
-	(*Data_Redis)(nil),          // 6: tenant.conf.Data.Redis
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(*Bootstrap)(nil),                        // 0: tenant.conf.Bootstrap
+	(*Server)(nil),                           // 1: tenant.conf.Server
+	(*Data)(nil),                             // 2: tenant.conf.Data
+	(*Tenant)(nil),                           // 3: tenant.conf.Tenant
+	(*Metrics)(nil),                          // 4: tenant.conf.Metrics
+	(*Trace)(nil),                            // 5: tenant.conf.Trace
+	(*Server_HTTP)(nil),                      // 6: tenant.conf.Server.HTTP
+	(*Server_GRPC)(nil),                      // 7: tenant.conf.Server.GRPC
+	(*Data_Database)(nil),                    // 8: tenant.conf.Data.Database
+	(*Data_Redis)(nil),                       // 9: tenant.conf.Data.Redis
+	(*Tenant_IDGenerator)(nil),               // 10: tenant.conf.Tenant.IDGenerator
+	(*Tenant_QuotaReset)(nil),                // 11: tenant.conf.Tenant.QuotaReset
+	(*Tenant_UsageRollup)(nil),               // 12: tenant.conf.Tenant.UsageRollup
+	(*Tenant_QuotaChange)(nil),               // 13: tenant.conf.Tenant.QuotaChange
+	(*Tenant_Wallet)(nil),                    // 14: tenant.conf.Tenant.Wallet
+	(*Tenant_QuotaLease)(nil),                // 15: tenant.conf.Tenant.QuotaLease
+	(*Tenant_AttributeSchema)(nil),           // 16: tenant.conf.Tenant.AttributeSchema
+	(*Tenant_Membership)(nil),                // 17: tenant.conf.Tenant.Membership
+	(*Tenant_Entitlement)(nil),               // 18: tenant.conf.Tenant.Entitlement
+	(*Tenant_Ledger)(nil),                    // 19: tenant.conf.Tenant.Ledger
+	(*Tenant_Wallet_Price)(nil),              // 20: tenant.conf.Tenant.Wallet.Price
+	(*Tenant_AttributeSchema_Attribute)(nil), // 21: tenant.conf.Tenant.AttributeSchema.Attribute
+	(*durationpb.Duration)(nil),              // 22: google.protobuf.Duration
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: tenant.conf.Bootstrap.server:type_name -> tenant.conf.Server
 	2,  // 1: tenant.conf.Bootstrap.data:type_name -> tenant.conf.Data
-	3,  // 2: tenant.conf.Server.http:type_name -> tenant.conf.Server.HTTP
-	4,  // 3: tenant.conf.Server.grpc:type_name -> tenant.conf.Server.GRPC
-	5,  // 4: tenant.conf.Data.database:type_name -> tenant.conf.Data.Database
-	6,  // 5: tenant.conf.Data.redis:type_name -> tenant.conf.Data.Redis
-	7,  // 6: tenant.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7,  // 7: tenant.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	7,  // 8: tenant.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	7,  // 9: tenant.conf.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	7,  // 10: tenant.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	7,  // 11: tenant.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	3,  // 2: tenant.conf.Bootstrap.tenant:type_name -> tenant.conf.Tenant
+	4,  // 3: tenant.conf.Bootstrap.metrics:type_name -> tenant.conf.Metrics
+	5,  // 4: tenant.conf.Bootstrap.trace:type_name -> tenant.conf.Trace
+	6,  // 5: tenant.conf.Server.http:type_name -> tenant.conf.Server.HTTP
+	7,  // 6: tenant.conf.Server.grpc:type_name -> tenant.conf.Server.GRPC
+	8,  // 7: tenant.conf.Data.database:type_name -> tenant.conf.Data.Database
+	9,  // 8: tenant.conf.Data.redis:type_name -> tenant.conf.Data.Redis
+	10, // 9: tenant.conf.Tenant.id_generator:type_name -> tenant.conf.Tenant.IDGenerator
+	11, // 10: tenant.conf.Tenant.quota_reset:type_name -> tenant.conf.Tenant.QuotaReset
+	12, // 11: tenant.conf.Tenant.usage_rollup:type_name -> tenant.conf.Tenant.UsageRollup
+	13, // 12: tenant.conf.Tenant.quota_change:type_name -> tenant.conf.Tenant.QuotaChange
+	14, // 13: tenant.conf.Tenant.wallet:type_name -> tenant.conf.Tenant.Wallet
+	15, // 14: tenant.conf.Tenant.quota_lease:type_name -> tenant.conf.Tenant.QuotaLease
+	16, // 15: tenant.conf.Tenant.attribute_schemas:type_name -> tenant.conf.Tenant.AttributeSchema
+	17, // 16: tenant.conf.Tenant.membership:type_name -> tenant.conf.Tenant.Membership
+	18, // 17: tenant.conf.Tenant.entitlements:type_name -> tenant.conf.Tenant.Entitlement
+	19, // 18: tenant.conf.Tenant.ledger:type_name -> tenant.conf.Tenant.Ledger
+	22, // 19: tenant.conf.Trace.timeout:type_name -> google.protobuf.Duration
+	22, // 20: tenant.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	22, // 21: tenant.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	22, // 22: tenant.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	22, // 23: tenant.conf.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	22, // 24: tenant.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	22, // 25: tenant.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	22, // 26: tenant.conf.Tenant.QuotaReset.interval:type_name -> google.protobuf.Duration
+	22, // 27: tenant.conf.Tenant.UsageRollup.interval:type_name -> google.protobuf.Duration
+	22, // 28: tenant.conf.Tenant.UsageRollup.settle_delay:type_name -> google.protobuf.Duration
+	20, // 29: tenant.conf.Tenant.Wallet.prices:type_name -> tenant.conf.Tenant.Wallet.Price
+	22, // 30: tenant.conf.Tenant.QuotaLease.interval:type_name -> google.protobuf.Duration
+	22, // 31: tenant.conf.Tenant.QuotaLease.default_ttl:type_name -> google.protobuf.Duration
+	22, // 32: tenant.conf.Tenant.QuotaLease.max_ttl:type_name -> google.protobuf.Duration
+	21, // 33: tenant.conf.Tenant.AttributeSchema.attributes:type_name -> tenant.conf.Tenant.AttributeSchema.Attribute
+	22, // 34: tenant.conf.Tenant.Membership.invitation_ttl:type_name -> google.protobuf.Duration
+	22, // 35: tenant.conf.Tenant.Ledger.checkpoint_interval:type_name -> google.protobuf.Duration
+	22, // 36: tenant.conf.Tenant.Ledger.sequence_interval:type_name -> google.protobuf.Duration
+	22, // 37: tenant.conf.Tenant.Ledger.max_sequence_lag:type_name -> google.protobuf.Duration
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -572,13 +1769,14 @@ func file_internal_conf_conf_proto_init() {
 	if File_internal_conf_conf_proto != nil {
 		return
 	}
+	file_internal_conf_conf_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
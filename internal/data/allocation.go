@@ -0,0 +1,66 @@
+package data
+
+import (
+	"encoding/json"
+
+	"tenant-service/internal/biz"
+)
+
+// quotaAllocations 解析配额的产品线划分额度和各产品线已用量，extra_config不合法时视为未划分
+func quotaAllocations(model *QuotaModel) (limits, used map[string]int32) {
+	config, err := biz.ParseQuotaExtraConfig(convertLimitTypeToEnum(model.LimitType), model.ExtraConfig)
+	if err != nil || len(config.Allocations) == 0 {
+		return nil, nil
+	}
+	used = make(map[string]int32, len(config.Allocations))
+	if model.AllocationUsed != "" {
+		// 已用量损坏时按未使用处理，不影响消费
+		_ = json.Unmarshal([]byte(model.AllocationUsed), &used)
+	}
+	return config.Allocations, used
+}
+
+// setAllocationUsed 写回各产品线已用量，只保留仍有划分额度的产品线
+func setAllocationUsed(model *QuotaModel, limits, used map[string]int32) error {
+	kept := make(map[string]int32, len(limits))
+	for productCode := range limits {
+		if used[productCode] > 0 {
+			kept[productCode] = used[productCode]
+		}
+	}
+	if len(kept) == 0 {
+		model.AllocationUsed = ""
+		return nil
+	}
+	raw, err := json.Marshal(kept)
+	if err != nil {
+		return err
+	}
+	model.AllocationUsed = string(raw)
+	return nil
+}
+
+// sharedUsage 返回共享额度的已用量和上限
+func sharedUsage(model *QuotaModel, limits, used map[string]int32) (sharedUsed, sharedLimit int32) {
+	quota := &biz.QuotaInfo{
+		HardLimit:   model.HardLimit,
+		UsedCount:   model.UsedCount,
+		Allocations: biz.BuildAllocations(limits, used),
+	}
+	return quota.SharedUsed(), quota.SharedLimit()
+}
+
+// checkQuotaAllocations 校验产品线划分额度之和不超过硬限制
+func checkQuotaAllocations(model *QuotaModel) error {
+	config, err := biz.ParseQuotaExtraConfig(convertLimitTypeToEnum(model.LimitType), model.ExtraConfig)
+	if err != nil {
+		return err
+	}
+	var productCodes []string
+	if model.ProductCodes != "" {
+		if err := json.Unmarshal([]byte(model.ProductCodes), &productCodes); err != nil {
+			return err
+		}
+	}
+	return config.CheckAllocations(model.HardLimit, productCodes)
+}
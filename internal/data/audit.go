@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"tenant-service/internal/biz"
+)
+
+// AuditEventModel 审计事件数据模型，只插入不更新
+type AuditEventModel struct {
+	EventID    int64     `gorm:"column:event_id;primaryKey;autoIncrement"`
+	Actor      string    `gorm:"column:actor;not null"`
+	Action     string    `gorm:"column:action;not null"`
+	TargetType string    `gorm:"column:target_type;not null"`
+	TargetID   string    `gorm:"column:target_id;not null"`
+	TenantID   string    `gorm:"column:tenant_id"`
+	BeforeData *string   `gorm:"column:before_data;type:json"`
+	AfterData  *string   `gorm:"column:after_data;type:json"`
+	Changes    *string   `gorm:"column:changes;type:json"`
+	RequestID  string    `gorm:"column:request_id"`
+	SourceIP   string    `gorm:"column:source_ip"`
+	Remark     string    `gorm:"column:remark"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName 表名
+func (AuditEventModel) TableName() string {
+	return "audit_events"
+}
+
+// auditChangeJSON 字段变更的存储格式
+type auditChangeJSON struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// auditRepo 审计事件仓库实现
+type auditRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAuditRepo 创建审计事件仓库
+func NewAuditRepo(data *Data, logger log.Logger) biz.AuditRepo {
+	return &auditRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// optionalJSON 空串存为NULL
+func optionalJSON(raw string) *string {
+	if raw == "" {
+		return nil
+	}
+	return &raw
+}
+
+// marshalAuditChanges 序列化字段变更
+func marshalAuditChanges(changes []*biz.AuditChange) (*string, error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	items := make([]auditChangeJSON, 0, len(changes))
+	for _, change := range changes {
+		item := auditChangeJSON{Field: change.Field}
+		if change.Before != "" {
+			item.Before = json.RawMessage(change.Before)
+		}
+		if change.After != "" {
+			item.After = json.RawMessage(change.After)
+		}
+		items = append(items, item)
+	}
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	return optionalJSON(string(raw)), nil
+}
+
+// unmarshalAuditChanges 解析字段变更
+func unmarshalAuditChanges(raw *string) ([]*biz.AuditChange, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	var items []auditChangeJSON
+	if err := json.Unmarshal([]byte(*raw), &items); err != nil {
+		return nil, fmt.Errorf("invalid audit changes: %w", err)
+	}
+	changes := make([]*biz.AuditChange, 0, len(items))
+	for _, item := range items {
+		changes = append(changes, &biz.AuditChange{Field: item.Field, Before: string(item.Before), After: string(item.After)})
+	}
+	return changes, nil
+}
+
+// Append 追加审计事件
+func (r *auditRepo) Append(ctx context.Context, event *biz.AuditEvent) error {
+	changes, err := marshalAuditChanges(event.Changes)
+	if err != nil {
+		return err
+	}
+	model := &AuditEventModel{
+		Actor:      event.Actor,
+		Action:     string(event.Action),
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		TenantID:   event.TenantID,
+		BeforeData: optionalJSON(event.Before),
+		AfterData:  optionalJSON(event.After),
+		Changes:    changes,
+		RequestID:  event.RequestID,
+		SourceIP:   event.SourceIP,
+		Remark:     event.Remark,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	event.EventID = model.EventID
+	event.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// List 按事件ID升序列出审计事件
+func (r *auditRepo) List(ctx context.Context, filter *biz.AuditFilter) ([]*biz.AuditEvent, error) {
+	query := r.data.DB(ctx).Model(&AuditEventModel{})
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", string(filter.Action))
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at < ?", filter.EndTime)
+	}
+	if filter.AfterEventID > 0 {
+		query = query.Where("event_id > ?", filter.AfterEventID)
+	}
+
+	var models []*AuditEventModel
+	if err := query.Order("event_id ASC").Limit(int(filter.Limit)).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]*biz.AuditEvent, 0, len(models))
+	for _, model := range models {
+		changes, err := unmarshalAuditChanges(model.Changes)
+		if err != nil {
+			return nil, err
+		}
+		event := &biz.AuditEvent{
+			EventID:    model.EventID,
+			Actor:      model.Actor,
+			Action:     biz.AuditAction(model.Action),
+			TargetType: model.TargetType,
+			TargetID:   model.TargetID,
+			TenantID:   model.TenantID,
+			Changes:    changes,
+			RequestID:  model.RequestID,
+			SourceIP:   model.SourceIP,
+			Remark:     model.Remark,
+			CreatedAt:  model.CreatedAt,
+		}
+		if model.BeforeData != nil {
+			event.Before = *model.BeforeData
+		}
+		if model.AfterData != nil {
+			event.After = *model.AfterData
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"tenant-service/internal/biz"
+)
+
+// errOptimisticMiss 条件更新未命中或更新后配额不再满足快速路径条件，回滚后走加锁路径
+var errOptimisticMiss = errors.New("optimistic consume missed")
+
+// optimisticEligible 配额是否可以走条件更新：HARD模式、没有产品线划分额度且没有可用的结转额度
+func optimisticEligible(quota *biz.QuotaInfo) bool {
+	if quota.EnforcementMode != biz.EnforcementModeHard && quota.EnforcementMode != biz.EnforcementModeUnspecified {
+		return false
+	}
+	return len(quota.Allocations) == 0 && quota.RolloverRemaining(time.Now()) == 0
+}
+
+// consumeOptimistic 以条件UPDATE消费解析出的配额，不先加锁读取配额行；
+// 返回nil配额且无错误时表示需要回退到consumeLocked（额度不足或并发修改）
+func (r *quotaRepo) consumeOptimistic(ctx context.Context, selected *biz.QuotaInfo, tenantID string, amount int32, bizID, bizType string) (*biz.QuotaInfo, error) {
+	quotaID, quotaType, limitType := selected.QuotaID, selected.QuotaType, selected.LimitType
+
+	var quota *biz.QuotaInfo
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 额度足够时原子累加已用量，affected rows为0表示额度不足或配额已变化；语句耗时包含行锁等待
+		lockStart := time.Now()
+		result := tx.Model(&QuotaModel{}).
+			Where("quota_id = ? AND used_count + ? <= hard_limit", quotaID, amount).
+			UpdateColumns(map[string]interface{}{
+				"used_count": gorm.Expr("used_count + ?", amount),
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		r.metrics.LockWait(ctx, quotaType, limitType, time.Since(lockStart))
+		if result.RowsAffected == 0 {
+			return errOptimisticMiss
+		}
+
+		// 条件更新后已持有行锁，重新读取确认配额仍满足快速路径条件
+		var model QuotaModel
+		if err := tx.Where("quota_id = ?", quotaID).First(&model).Error; err != nil {
+			return err
+		}
+		converted, err := convertQuotaModelToBiz(&model)
+		if err != nil {
+			return err
+		}
+		if !optimisticEligible(converted) || converted.Shards > 0 {
+			return errOptimisticMiss
+		}
+		quota = converted
+
+		// 记录使用记录
+		return tx.Create(&QuotaUsageModel{
+			QuotaID:       model.QuotaID,
+			TenantID:      tenantID,
+			OperationType: convertOperationTypeToString(biz.OperationTypeConsume),
+			DeltaValue:    amount,
+			CurrentUsed:   model.UsedCount,
+			BizID:         bizID,
+			BizType:       bizType,
+		}).Error
+	})
+	if errors.Is(err, errOptimisticMiss) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
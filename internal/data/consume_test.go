@@ -0,0 +1,277 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/metrics"
+)
+
+// benchMySQLDSNEnv 压测使用的MySQL连接串，未设置时使用sqlite；sqlite以库级锁串行写入，只能比较单次往返开销
+const benchMySQLDSNEnv = "TENANT_BENCH_MYSQL_DSN"
+
+// benchTenantID 压测配额所属租户
+const benchTenantID = "EN_bench"
+
+// newBenchQuotaRepo 创建压测用的配额仓储和一条足够大的短信月配额，所有并发消费都落在这一行上
+func newBenchQuotaRepo(b *testing.B) (*quotaRepo, *biz.QuotaInfo) {
+	b.Helper()
+	config := &gorm.Config{
+		Logger:         gormlogger.Discard,
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+	}
+
+	var db *gorm.DB
+	var err error
+	if dsn := os.Getenv(benchMySQLDSNEnv); dsn != "" {
+		db, err = gorm.Open(mysql.Open(dsn), config)
+	} else {
+		db, err = gorm.Open(sqlite.Open(filepath.Join(b.TempDir(), "bench.db")+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"), config)
+	}
+	if err != nil {
+		b.Fatalf("open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("get sql db: %v", err)
+	}
+	b.Cleanup(func() { _ = sqlDB.Close() })
+	if db.Dialector.Name() == "sqlite" {
+		// sqlite读后写的事务并发升级写锁会直接返回SQLITE_BUSY，单连接串行执行
+		sqlDB.SetMaxOpenConns(1)
+	}
+	if err := db.AutoMigrate(&TenantModel{}, &QuotaModel{}, &QuotaUsageModel{}, &QuotaShardModel{}, &QuotaOverageModel{}); err != nil {
+		b.Fatalf("migrate: %v", err)
+	}
+	if err := db.Where("tenant_id = ?", benchTenantID).Delete(&TenantModel{}).Error; err != nil {
+		b.Fatalf("clean tenant: %v", err)
+	}
+	if err := db.Where("tenant_id = ?", benchTenantID).Delete(&QuotaModel{}).Error; err != nil {
+		b.Fatalf("clean quotas: %v", err)
+	}
+	if err := db.Where("tenant_id = ?", benchTenantID).Delete(&QuotaUsageModel{}).Error; err != nil {
+		b.Fatalf("clean usage records: %v", err)
+	}
+
+	// 加锁路径按租户层级解析配额
+	if err := db.Create(&TenantModel{TenantID: benchTenantID, TenantName: "Bench", TenantType: "ENTERPRISE", Status: true, QuotaConfig: "{}"}).Error; err != nil {
+		b.Fatalf("create tenant: %v", err)
+	}
+
+	now := time.Now()
+	model := &QuotaModel{
+		TenantID:      benchTenantID,
+		QuotaType:     convertQuotaTypeToString(biz.QuotaTypeSMS),
+		LimitType:     convertLimitTypeToString(biz.LimitTypeMonthly),
+		HardLimit:     1 << 30,
+		ResetTime:     now,
+		NextResetTime: now.AddDate(0, 1, 0),
+		EffectiveTime: now.Add(-time.Hour),
+		ProductCodes:  "[]",
+		ExtraConfig:   "{}",
+	}
+	if err := db.Create(model).Error; err != nil {
+		b.Fatalf("create quota: %v", err)
+	}
+	quota, err := convertQuotaModelToBiz(model)
+	if err != nil {
+		b.Fatalf("convert quota: %v", err)
+	}
+
+	quotaMetrics, err := metrics.NewQuotaMetrics(&conf.Metrics{}, metricnoop.NewMeterProvider().Meter("bench"))
+	if err != nil {
+		b.Fatalf("new quota metrics: %v", err)
+	}
+	repo := NewQuotaRepo(&Data{db: db}, quotaMetrics, log.NewStdLogger(&bytes.Buffer{})).(*quotaRepo)
+	return repo, quota
+}
+
+// BenchmarkConsumeOptimistic 并发以条件UPDATE消费同一配额行
+func BenchmarkConsumeOptimistic(b *testing.B) {
+	repo, quota := newBenchQuotaRepo(b)
+	ctx := context.Background()
+	var seq atomic.Int64
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			bizID := "order-" + strconv.FormatInt(seq.Add(1), 10)
+			consumed, err := repo.consumeOptimistic(ctx, quota, benchTenantID, 1, bizID, "order")
+			if err != nil {
+				b.Errorf("consume optimistic: %v", err)
+				return
+			}
+			if consumed == nil {
+				b.Error("consume optimistic missed")
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkConsumeLocked 并发以SELECT ... FOR UPDATE加锁后消费同一配额行
+func BenchmarkConsumeLocked(b *testing.B) {
+	repo, quota := newBenchQuotaRepo(b)
+	ctx := context.Background()
+	var seq atomic.Int64
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			bizID := "order-" + strconv.FormatInt(seq.Add(1), 10)
+			if _, err := repo.consumeLocked(ctx, benchTenantID, quota.QuotaType, quota.LimitType, 1, "", bizID, "order"); err != nil {
+				b.Errorf("consume locked: %v", err)
+				return
+			}
+		}
+	})
+}
+
+// consumeFunc 一种消费路径
+type consumeFunc func(ctx context.Context, repo *quotaRepo, quota *biz.QuotaInfo, amount int32, bizID string) error
+
+// consumeWithFallback 先条件更新，未命中时回退到加锁路径，与ConsumeQuota对非分片配额的处理一致
+func consumeWithFallback(ctx context.Context, repo *quotaRepo, quota *biz.QuotaInfo, amount int32, bizID string) error {
+	consumed, err := repo.consumeOptimistic(ctx, quota, quota.TenantID, amount, bizID, "order")
+	if err != nil || consumed != nil {
+		return err
+	}
+	_, err = repo.consumeLocked(ctx, quota.TenantID, quota.QuotaType, quota.LimitType, amount, "", bizID, "order")
+	return err
+}
+
+// consumeLockedOnly 只走加锁路径
+func consumeLockedOnly(ctx context.Context, repo *quotaRepo, quota *biz.QuotaInfo, amount int32, bizID string) error {
+	_, err := repo.consumeLocked(ctx, quota.TenantID, quota.QuotaType, quota.LimitType, amount, "", bizID, "order")
+	return err
+}
+
+// newConsumeTestQuota 创建租户EN_acme和指定硬限制的短信月配额
+func newConsumeTestQuota(t *testing.T, d *Data, hardLimit int32) (*quotaRepo, *biz.QuotaInfo) {
+	t.Helper()
+	createTestTenant(t, d, "EN_acme")
+	model := createTestQuota(t, d, &QuotaModel{TenantID: "EN_acme", HardLimit: hardLimit})
+	quota, err := convertQuotaModelToBiz(model)
+	if err != nil {
+		t.Fatalf("convert quota: %v", err)
+	}
+	return NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger).(*quotaRepo), quota
+}
+
+// consumeOutcome 一次消费后的结果和配额状态
+type consumeOutcome struct {
+	reason   string
+	used     int32
+	records  int64
+	lastUsed int32
+}
+
+func TestConsumeOptimisticMatchesLocked(t *testing.T) {
+	amounts := []int32{30, 50, 25, 20, 1}
+	run := func(consume consumeFunc) []consumeOutcome {
+		d := newTestData(t)
+		repo, quota := newConsumeTestQuota(t, d, 100)
+		var outcomes []consumeOutcome
+		for i, amount := range amounts {
+			err := consume(context.Background(), repo, quota, amount, fmt.Sprintf("order-%d", i))
+			outcome := consumeOutcome{reason: errors.Reason(err), used: loadTestQuota(t, d, quota.QuotaID).UsedCount}
+			outcome.records = countUsageRecords(t, d, quota.QuotaID, "CONSUME")
+			var last QuotaUsageModel
+			if err := d.db.Where("quota_id = ?", quota.QuotaID).Order("record_id DESC").Limit(1).Find(&last).Error; err != nil {
+				t.Fatalf("load last record: %v", err)
+			}
+			outcome.lastUsed = last.CurrentUsed
+			outcomes = append(outcomes, outcome)
+		}
+		return outcomes
+	}
+
+	optimistic, locked := run(consumeWithFallback), run(consumeLockedOnly)
+	for i := range amounts {
+		if optimistic[i] != locked[i] {
+			t.Errorf("consume %d of %d: optimistic %+v, locked %+v", i, amounts[i], optimistic[i], locked[i])
+		}
+	}
+	if want := (consumeOutcome{reason: "QUOTA_EXCEEDED", used: 100, records: 3, lastUsed: 100}); locked[len(amounts)-1] != want {
+		t.Errorf("consume beyond the limit = %+v, want %+v", locked[len(amounts)-1], want)
+	}
+}
+
+func TestConsumeConcurrentOptimisticMatchesLocked(t *testing.T) {
+	const limit, workers, attempts = 50, 8, 10
+	run := func(consume consumeFunc) (int32, []int32, int) {
+		d := newTestData(t)
+		// sqlite读后写的事务并发升级写锁会直接返回SQLITE_BUSY，单连接串行执行
+		sqlDB, err := d.db.DB()
+		if err != nil {
+			t.Fatalf("get sql db: %v", err)
+		}
+		sqlDB.SetMaxOpenConns(1)
+		repo, quota := newConsumeTestQuota(t, d, limit)
+
+		var wg sync.WaitGroup
+		var exceeded atomic.Int32
+		var seq atomic.Int64
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < attempts; i++ {
+					err := consume(context.Background(), repo, quota, 1, "order-"+strconv.FormatInt(seq.Add(1), 10))
+					if errors.Reason(err) == "QUOTA_EXCEEDED" {
+						exceeded.Add(1)
+					} else if err != nil {
+						t.Errorf("consume: %v", err)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		var records []*QuotaUsageModel
+		if err := d.db.Where("quota_id = ?", quota.QuotaID).Find(&records).Error; err != nil {
+			t.Fatalf("list records: %v", err)
+		}
+		used := make([]int32, 0, len(records))
+		for _, record := range records {
+			used = append(used, record.CurrentUsed)
+		}
+		sort.Slice(used, func(i, j int) bool { return used[i] < used[j] })
+		return loadTestQuota(t, d, quota.QuotaID).UsedCount, used, int(exceeded.Load())
+	}
+
+	for name, consume := range map[string]consumeFunc{"optimistic": consumeWithFallback, "locked": consumeLockedOnly} {
+		used, records, exceeded := run(consume)
+		if used != limit || exceeded != workers*attempts-limit {
+			t.Errorf("%s: used %d exceeded %d, want %d and %d", name, used, exceeded, limit, workers*attempts-limit)
+		}
+		// 每次成功消费恰好一条记录，记录的已用量依次为1..limit
+		if len(records) != limit {
+			t.Fatalf("%s: %d usage records, want %d", name, len(records), limit)
+		}
+		for i, current := range records {
+			if current != int32(i+1) {
+				t.Fatalf("%s: usage record current_used %v, want 1..%d", name, records, limit)
+			}
+		}
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/extra/redisotel/v8"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
+	"gorm.io/plugin/opentelemetry/tracing"
+	"tenant-service/internal/biz"
 	"tenant-service/internal/conf"
+	"tenant-service/internal/health"
 )
 
 // ProviderSet is data providers.
@@ -22,6 +27,17 @@ var ProviderSet = wire.NewSet(
 	NewTenantRepo,
 	NewQuotaRepo,
 	NewProductRepo,
+	NewUsageReportRepo,
+	NewPlanRepo,
+	NewQuotaChangeRepo,
+	NewWalletRepo,
+	NewQuotaLeaseRepo,
+	NewMemberRepo,
+	NewEntitlementRepo,
+	NewAuditRepo,
+	NewLedgerRepo,
+	NewTransaction,
+	NewTenantIDGenerator,
 )
 
 // Data ..
@@ -41,7 +57,7 @@ func (w *GormWriter) Printf(format string, args ...interface{}) {
 }
 
 // NewDB creates a new database connection.
-func NewDB(conf *conf.Data, l log.Logger) *gorm.DB {
+func NewDB(conf *conf.Data, tp trace.TracerProvider, l log.Logger) *gorm.DB {
 	logHelper := log.NewHelper(l)
 
 	// u521bu5efa GORM u65e5u5fd7u914du7f6e
@@ -61,11 +77,21 @@ func NewDB(conf *conf.Data, l log.Logger) *gorm.DB {
 		NamingStrategy: schema.NamingStrategy{
 			SingularTable: true, // u4f7fu7528u5355u6570u8868u540d
 		},
+		TranslateError: true, // 翻译数据库错误（如唯一键冲突）
 	})
 	if err != nil {
 		log.Fatalf("failed opening connection to mysql: %v", err)
 	}
 
+	// 链路追踪，SQL参数可能包含敏感信息，不记录
+	if err := db.Use(tracing.NewPlugin(
+		tracing.WithTracerProvider(tp),
+		tracing.WithoutMetrics(),
+		tracing.WithoutQueryVariables(),
+	)); err != nil {
+		log.Fatalf("failed to register gorm tracing: %v", err)
+	}
+
 	sqlDB, err := db.DB()
 	if err != nil {
 		log.Fatalf("failed to get db: %v", err)
@@ -80,7 +106,7 @@ func NewDB(conf *conf.Data, l log.Logger) *gorm.DB {
 }
 
 // NewRedis creates a new redis client.
-func NewRedis(conf *conf.Data, l log.Logger) *redis.Client {
+func NewRedis(conf *conf.Data, tp trace.TracerProvider, l log.Logger) *redis.Client {
 	logHelper := log.NewHelper(l)
 
 	client := redis.NewClient(&redis.Options{
@@ -93,13 +119,16 @@ func NewRedis(conf *conf.Data, l log.Logger) *redis.Client {
 		PoolSize:     int(conf.Redis.PoolSize),
 		MinIdleConns: int(conf.Redis.MinIdleConns),
 	})
+	client.AddHook(redisotel.NewTracingHook(redisotel.WithTracerProvider(tp)))
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
+	// Redis不可用时降级启动，由就绪检查上报未就绪，客户端会自动重连
 	_, err := client.Ping(ctx).Result()
 	if err != nil {
-		logHelper.Fatalf("failed to connect redis: %v", err)
+		logHelper.Warnf("redis unavailable, starting degraded: %v", err)
+		return client
 	}
 
 	logHelper.Info("redis connected")
@@ -107,7 +136,7 @@ func NewRedis(conf *conf.Data, l log.Logger) *redis.Client {
 }
 
 // NewData .
-func NewData(db *gorm.DB, redis *redis.Client, l log.Logger) (*Data, func(), error) {
+func NewData(db *gorm.DB, redis *redis.Client, checker *health.Checker, l log.Logger) (*Data, func(), error) {
 	logHelper := log.NewHelper(l)
 	logHelper.Info("creating data resources")
 
@@ -115,6 +144,7 @@ func NewData(db *gorm.DB, redis *redis.Client, l log.Logger) (*Data, func(), err
 		db:    db,
 		redis: redis,
 	}
+	d.registerHealthChecks(checker)
 
 	return d, func() {
 		logHelper.Info("closing data resources")
@@ -135,3 +165,26 @@ func NewData(db *gorm.DB, redis *redis.Client, l log.Logger) (*Data, func(), err
 		}
 	}, nil
 }
+
+// contextTxKey 上下文中的事务
+type contextTxKey struct{}
+
+// DB 返回上下文中的事务，不在事务中时返回数据库连接；仓库通过它访问数据库，以便参与用例开启的事务
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return d.db.WithContext(ctx)
+}
+
+// InTx 在事务中执行fn，fn中以其ctx调用的仓库操作使用同一事务，仓库自身的事务作为保存点嵌套执行
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+// NewTransaction 创建用例使用的事务管理
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/metrics"
+)
+
+// testLogger 丢弃日志输出
+var testLogger = log.NewStdLogger(&bytes.Buffer{})
+
+// testMeter 不上报的监控指标
+var testMeter = metricnoop.NewMeterProvider().Meter("data-test")
+
+// newTestData 创建sqlite支持的数据层并迁移全部表，不连接Redis
+func newTestData(t testing.TB) *Data {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "tenant.db")+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"), &gorm.Config{
+		Logger:         gormlogger.Discard,
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get sql db: %v", err)
+	}
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(
+		&TenantModel{}, &ChannelModel{}, &TenantLabelModel{}, &TenantIDSequenceModel{},
+		&ProductModel{}, &TenantProductModel{},
+		&QuotaModel{}, &QuotaUsageModel{}, &QuotaShardModel{}, &QuotaOverageModel{}, &QuotaChangeModel{}, &QuotaLeaseModel{},
+		&PlanModel{}, &PlanQuotaModel{}, &PlanEntitlementModel{}, &TenantPlanModel{}, &QuotaOverrideModel{},
+		&WalletModel{}, &WalletTransactionModel{}, &WalletLedgerEntryModel{},
+		&MemberModel{}, &InvitationModel{}, &EntitlementModel{},
+		&AuditEventModel{}, &LedgerHeadModel{}, &LedgerCheckpointModel{},
+		&UsageDailyModel{}, &UsageRollupStateModel{}, &SchemaMigrationModel{},
+	); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return &Data{db: db}
+}
+
+// createTestTenant 创建启用状态的企业租户
+func createTestTenant(t testing.TB, d *Data, tenantID string) {
+	t.Helper()
+	if err := d.db.Create(&TenantModel{TenantID: tenantID, TenantName: tenantID, TenantType: "ENTERPRISE", Status: true, QuotaConfig: "{}"}).Error; err != nil {
+		t.Fatalf("create tenant %s: %v", tenantID, err)
+	}
+}
+
+// newTestAudit 创建写入同一数据层的审计用例
+func newTestAudit(d *Data) *biz.AuditUsecase {
+	return biz.NewAuditUsecase(NewAuditRepo(d, testLogger), NewTransaction(d), testLogger)
+}
+
+// newTestQuotaMetrics 创建不上报的配额监控指标
+func newTestQuotaMetrics(t testing.TB) biz.QuotaMetrics {
+	t.Helper()
+	m, err := metrics.NewQuotaMetrics(&conf.Metrics{}, testMeter)
+	if err != nil {
+		t.Fatalf("new quota metrics: %v", err)
+	}
+	return m
+}
+
+// createTestQuota 创建配额，未设置的字段取短信月配额的默认值
+func createTestQuota(t testing.TB, d *Data, model *QuotaModel) *QuotaModel {
+	t.Helper()
+	now := time.Now()
+	if model.QuotaType == "" {
+		model.QuotaType = convertQuotaTypeToString(biz.QuotaTypeSMS)
+	}
+	if model.LimitType == "" {
+		model.LimitType = convertLimitTypeToString(biz.LimitTypeMonthly)
+	}
+	if model.ResetTime.IsZero() {
+		model.ResetTime = now.AddDate(0, -1, 0)
+	}
+	if model.NextResetTime.IsZero() {
+		model.NextResetTime = now.AddDate(0, 1, 0)
+	}
+	if model.EffectiveTime.IsZero() {
+		model.EffectiveTime = now.Add(-time.Hour)
+	}
+	if model.ProductCodes == "" {
+		model.ProductCodes = "[]"
+	}
+	if model.ExtraConfig == "" {
+		model.ExtraConfig = "{}"
+	}
+	if err := d.db.Create(model).Error; err != nil {
+		t.Fatalf("create quota: %v", err)
+	}
+	return model
+}
+
+// loadTestQuota 重新读取配额行
+func loadTestQuota(t testing.TB, d *Data, quotaID int64) *QuotaModel {
+	t.Helper()
+	var model QuotaModel
+	if err := d.db.Where("quota_id = ?", quotaID).First(&model).Error; err != nil {
+		t.Fatalf("load quota %d: %v", quotaID, err)
+	}
+	return &model
+}
+
+// countUsageRecords 统计配额某类操作的使用记录数
+func countUsageRecords(t testing.TB, d *Data, quotaID int64, operationType string) int64 {
+	t.Helper()
+	var n int64
+	if err := d.db.Model(&QuotaUsageModel{}).Where("quota_id = ? AND operation_type = ?", quotaID, operationType).Count(&n).Error; err != nil {
+		t.Fatalf("count usage records: %v", err)
+	}
+	return n
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// EntitlementModel 租户授权项数据模型
+type EntitlementModel struct {
+	TenantID       string    `gorm:"column:tenant_id;primaryKey"`
+	ProductCode    string    `gorm:"column:product_code;primaryKey"`
+	EntitlementKey string    `gorm:"column:entitlement_key;primaryKey"`
+	Value          string    `gorm:"column:value;not null"`
+	UpdatedBy      string    `gorm:"column:updated_by"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (EntitlementModel) TableName() string {
+	return "tenant_entitlements"
+}
+
+// planEntitlementRow 租户订阅套餐中的授权项查询结果
+type planEntitlementRow struct {
+	TenantID       string    `gorm:"column:tenant_id"`
+	PlanCode       string    `gorm:"column:plan_code"`
+	ProductCode    string    `gorm:"column:product_code"`
+	EntitlementKey string    `gorm:"column:entitlement_key"`
+	Value          string    `gorm:"column:value"`
+	AssignedBy     string    `gorm:"column:assigned_by"`
+	AssignedAt     time.Time `gorm:"column:assigned_at"`
+}
+
+// entitlementRepo 授权项仓库实现
+type entitlementRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEntitlementRepo 创建授权项仓库
+func NewEntitlementRepo(data *Data, logger log.Logger) biz.EntitlementRepo {
+	return &entitlementRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveGrant 创建或更新租户单独授予的授权项
+func (r *entitlementRepo) SaveGrant(ctx context.Context, grant *biz.EntitlementGrant) error {
+	model := &EntitlementModel{
+		TenantID:       grant.TenantID,
+		ProductCode:    grant.ProductCode,
+		EntitlementKey: grant.Key,
+		Value:          grant.Value,
+		UpdatedBy:      grant.UpdatedBy,
+	}
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_by", "updated_at"}),
+	}).Create(model).Error
+}
+
+// DeleteGrant 删除租户单独授予的授权项
+func (r *entitlementRepo) DeleteGrant(ctx context.Context, tenantID, productCode, key string) error {
+	return r.data.DB(ctx).
+		Where("tenant_id = ? AND product_code = ? AND entitlement_key = ?", tenantID, productCode, key).
+		Delete(&EntitlementModel{}).Error
+}
+
+// ListGrants 列出租户单独授予的和订阅套餐中的授权记录
+func (r *entitlementRepo) ListGrants(ctx context.Context, tenantIDs []string, productCode string) ([]*biz.EntitlementGrant, error) {
+	productCodes := []string{""}
+	if productCode != "" {
+		productCodes = append(productCodes, productCode)
+	}
+
+	var models []*EntitlementModel
+	err := r.data.DB(ctx).
+		Where("tenant_id IN ? AND product_code IN ?", tenantIDs, productCodes).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*planEntitlementRow
+	err = r.data.DB(ctx).Table("tenant_plans AS tp").
+		Select("tp.tenant_id, tp.plan_code, pe.product_code, pe.entitlement_key, pe.value, tp.assigned_by, tp.assigned_at").
+		Joins("JOIN quota_plan_entitlements AS pe ON pe.plan_code = tp.plan_code").
+		Where("tp.tenant_id IN ? AND pe.product_code IN ?", tenantIDs, productCodes).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]*biz.EntitlementGrant, 0, len(models)+len(rows))
+	for _, model := range models {
+		grants = append(grants, &biz.EntitlementGrant{
+			TenantID:    model.TenantID,
+			ProductCode: model.ProductCode,
+			Key:         model.EntitlementKey,
+			Value:       model.Value,
+			UpdatedBy:   model.UpdatedBy,
+			UpdatedAt:   model.UpdatedAt,
+		})
+	}
+	for _, row := range rows {
+		grants = append(grants, &biz.EntitlementGrant{
+			TenantID:    row.TenantID,
+			ProductCode: row.ProductCode,
+			Key:         row.EntitlementKey,
+			Value:       row.Value,
+			PlanCode:    row.PlanCode,
+			UpdatedBy:   row.AssignedBy,
+			UpdatedAt:   row.AssignedAt,
+		})
+	}
+	return grants, nil
+}
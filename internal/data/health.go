@@ -0,0 +1,56 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"tenant-service/internal/health"
+)
+
+// SchemaVersion 代码要求的数据库结构版本，修改docs/db.sql时需同步递增并写入schema_migrations
+const SchemaVersion = 15
+
+// SchemaMigrationModel 数据库结构版本数据模型
+type SchemaMigrationModel struct {
+	Version     int32  `gorm:"column:version;primaryKey"`
+	Description string `gorm:"column:description"`
+}
+
+// TableName 表名
+func (SchemaMigrationModel) TableName() string {
+	return "schema_migrations"
+}
+
+// registerHealthChecks 注册数据层依赖检查
+func (d *Data) registerHealthChecks(checker *health.Checker) {
+	checker.Register("mysql", d.pingDB)
+	checker.Register("redis", d.pingRedis)
+	checker.Register("schema_version", d.checkSchemaVersion)
+}
+
+// pingDB 检查MySQL连接
+func (d *Data) pingDB(ctx context.Context) error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// pingRedis 检查Redis连接
+func (d *Data) pingRedis(ctx context.Context) error {
+	return d.redis.Ping(ctx).Err()
+}
+
+// checkSchemaVersion 检查数据库结构版本是否满足要求
+func (d *Data) checkSchemaVersion(ctx context.Context) error {
+	var version int32
+	err := d.db.WithContext(ctx).Model(&SchemaMigrationModel{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	if err != nil {
+		return err
+	}
+	if version < SchemaVersion {
+		return fmt.Errorf("schema version %d is behind required %d", version, SchemaVersion)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/gorm"
+	"tenant-service/internal/biz"
+)
+
+// TenantLabelModel 租户标签数据模型，按标签键值建立索引以支持标签选择器
+type TenantLabelModel struct {
+	TenantID   string `gorm:"column:tenant_id;primaryKey"`
+	LabelKey   string `gorm:"column:label_key;primaryKey"`
+	LabelValue string `gorm:"column:label_value;not null"`
+}
+
+// TableName 表名
+func (TenantLabelModel) TableName() string {
+	return "tenant_labels"
+}
+
+// saveLabels 以labels替换租户的全部标签
+func saveLabels(tx *gorm.DB, tenantID string, labels map[string]string) error {
+	if err := tx.Where("tenant_id = ?", tenantID).Delete(&TenantLabelModel{}).Error; err != nil {
+		return err
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	models := make([]*TenantLabelModel, 0, len(labels))
+	for key, value := range labels {
+		models = append(models, &TenantLabelModel{TenantID: tenantID, LabelKey: key, LabelValue: value})
+	}
+	return tx.Create(&models).Error
+}
+
+// loadLabels 批量查询租户标签，按租户ID索引
+func loadLabels(db *gorm.DB, tenantIDs []string) (map[string]map[string]string, error) {
+	labels := make(map[string]map[string]string, len(tenantIDs))
+	if len(tenantIDs) == 0 {
+		return labels, nil
+	}
+	var models []*TenantLabelModel
+	if err := db.Where("tenant_id IN ?", tenantIDs).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	for _, model := range models {
+		if labels[model.TenantID] == nil {
+			labels[model.TenantID] = make(map[string]string)
+		}
+		labels[model.TenantID][model.LabelKey] = model.LabelValue
+	}
+	return labels, nil
+}
+
+// fillLabels 为租户填充标签
+func fillLabels(db *gorm.DB, tenants []*biz.Tenant) error {
+	tenantIDs := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		tenantIDs = append(tenantIDs, tenant.TenantID)
+	}
+	labels, err := loadLabels(db, tenantIDs)
+	if err != nil {
+		return err
+	}
+	for _, tenant := range tenants {
+		tenant.Labels = labels[tenant.TenantID]
+	}
+	return nil
+}
+
+// labelExists 租户存在满足条件的标签行
+const labelExists = "EXISTS (SELECT 1 FROM tenant_labels WHERE tenant_labels.tenant_id = tenants.tenant_id AND tenant_labels.label_key = ?"
+
+// applyLabelSelector 将标签选择器转换为EXISTS子查询条件；
+// 不等和notin条件对不含该标签的租户同样成立
+func applyLabelSelector(query *gorm.DB, requirements []biz.LabelRequirement) (*gorm.DB, error) {
+	for _, r := range requirements {
+		switch r.Operator {
+		case biz.LabelOperatorExists:
+			query = query.Where(labelExists+")", r.Key)
+		case biz.LabelOperatorNotExists:
+			query = query.Where("NOT "+labelExists+")", r.Key)
+		case biz.LabelOperatorEquals, biz.LabelOperatorIn:
+			query = query.Where(labelExists+" AND tenant_labels.label_value IN ?)", r.Key, r.Values)
+		case biz.LabelOperatorNotEquals, biz.LabelOperatorNotIn:
+			query = query.Where("NOT "+labelExists+" AND tenant_labels.label_value IN ?)", r.Key, r.Values)
+		default:
+			return nil, fmt.Errorf("unsupported label operator: %d", r.Operator)
+		}
+	}
+	return query, nil
+}
+
+// marshalAttributes 序列化租户属性，空属性存储为{}
+func marshalAttributes(attributes map[string]string) (string, error) {
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	raw, err := json.Marshal(attributes)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// unmarshalAttributes 解析租户属性，历史数据为NULL时返回nil
+func unmarshalAttributes(raw *string) (map[string]string, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	var attributes map[string]string
+	if err := json.Unmarshal([]byte(*raw), &attributes); err != nil {
+		return nil, fmt.Errorf("invalid tenant attributes: %w", err)
+	}
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	return attributes, nil
+}
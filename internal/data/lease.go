@@ -0,0 +1,247 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// QuotaLeaseModel 配额租约数据模型
+type QuotaLeaseModel struct {
+	LeaseID     string     `gorm:"column:lease_id;primaryKey"`
+	TenantID    string     `gorm:"column:tenant_id;not null"`
+	QuotaID     int64      `gorm:"column:quota_id;not null"`
+	QuotaType   string     `gorm:"column:quota_type;not null"`
+	LimitType   string     `gorm:"column:limit_type;not null"`
+	ProductCode string     `gorm:"column:product_code"`
+	Holder      string     `gorm:"column:holder"`
+	Amount      int32      `gorm:"column:amount;not null"`
+	UsedCount   int32      `gorm:"column:used_count"`
+	Returned    int32      `gorm:"column:returned"`
+	Status      string     `gorm:"column:status;not null"`
+	PeriodStart time.Time  `gorm:"column:period_start"`
+	ExpireTime  time.Time  `gorm:"column:expire_time;not null"`
+	ClosedAt    *time.Time `gorm:"column:closed_at"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (QuotaLeaseModel) TableName() string {
+	return "quota_leases"
+}
+
+// quotaLeaseRepo 配额租约仓库实现
+type quotaLeaseRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewQuotaLeaseRepo 创建配额租约仓库
+func NewQuotaLeaseRepo(data *Data, logger log.Logger) biz.QuotaLeaseRepo {
+	return &quotaLeaseRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// convertQuotaLeaseStatusToString 转换租约状态到字符串
+func convertQuotaLeaseStatusToString(status biz.QuotaLeaseStatus) string {
+	switch status {
+	case biz.QuotaLeaseStatusActive:
+		return "ACTIVE"
+	case biz.QuotaLeaseStatusReturned:
+		return "RETURNED"
+	case biz.QuotaLeaseStatusReclaimed:
+		return "RECLAIMED"
+	default:
+		return ""
+	}
+}
+
+// convertQuotaLeaseStatusToEnum 转换字符串到租约状态
+func convertQuotaLeaseStatusToEnum(status string) biz.QuotaLeaseStatus {
+	switch status {
+	case "ACTIVE":
+		return biz.QuotaLeaseStatusActive
+	case "RETURNED":
+		return biz.QuotaLeaseStatusReturned
+	case "RECLAIMED":
+		return biz.QuotaLeaseStatusReclaimed
+	default:
+		return biz.QuotaLeaseStatusUnspecified
+	}
+}
+
+// convertQuotaLeaseModelToBiz 转换配额租约数据模型到业务模型
+func convertQuotaLeaseModelToBiz(model *QuotaLeaseModel) *biz.QuotaLease {
+	lease := &biz.QuotaLease{
+		LeaseID:     model.LeaseID,
+		TenantID:    model.TenantID,
+		QuotaID:     model.QuotaID,
+		QuotaType:   convertQuotaTypeToEnum(model.QuotaType),
+		LimitType:   convertLimitTypeToEnum(model.LimitType),
+		ProductCode: model.ProductCode,
+		Holder:      model.Holder,
+		Amount:      model.Amount,
+		UsedCount:   model.UsedCount,
+		Returned:    model.Returned,
+		Status:      convertQuotaLeaseStatusToEnum(model.Status),
+		PeriodStart: model.PeriodStart,
+		ExpireTime:  model.ExpireTime,
+		CreatedAt:   model.CreatedAt,
+	}
+	if model.ClosedAt != nil {
+		lease.ClosedAt = *model.ClosedAt
+	}
+	return lease
+}
+
+// CreateLease 创建配额租约
+func (r *quotaLeaseRepo) CreateLease(ctx context.Context, lease *biz.QuotaLease) (*biz.QuotaLease, error) {
+	model := &QuotaLeaseModel{
+		LeaseID:     lease.LeaseID,
+		TenantID:    lease.TenantID,
+		QuotaID:     lease.QuotaID,
+		QuotaType:   convertQuotaTypeToString(lease.QuotaType),
+		LimitType:   convertLimitTypeToString(lease.LimitType),
+		ProductCode: lease.ProductCode,
+		Holder:      lease.Holder,
+		Amount:      lease.Amount,
+		Status:      convertQuotaLeaseStatusToString(lease.Status),
+		PeriodStart: lease.PeriodStart,
+		ExpireTime:  lease.ExpireTime,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+	return convertQuotaLeaseModelToBiz(model), nil
+}
+
+// lockActiveLease 锁定活动租约
+func lockActiveLease(tx *gorm.DB, leaseID string, model *QuotaLeaseModel) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("lease_id = ?", leaseID).First(model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.ErrQuotaLeaseNotFound
+		}
+		return err
+	}
+	if model.Status != convertQuotaLeaseStatusToString(biz.QuotaLeaseStatusActive) {
+		return biz.ErrQuotaLeaseClosed.WithMetadata(map[string]string{"status": model.Status})
+	}
+	return nil
+}
+
+// checkLeaseUsed 上报的已用量不能超过租约额度，也不能小于已上报的已用量
+func checkLeaseUsed(model *QuotaLeaseModel, usedCount int32) error {
+	if usedCount > model.Amount || usedCount < model.UsedCount {
+		return biz.ErrQuotaLeaseInvalid.WithMetadata(map[string]string{
+			"reason": fmt.Sprintf("used count %d must be between %d and %d", usedCount, model.UsedCount, model.Amount),
+		})
+	}
+	return nil
+}
+
+// RenewLease 更新活动租约的已用量和过期时间
+func (r *quotaLeaseRepo) RenewLease(ctx context.Context, leaseID string, usedCount int32, expireTime time.Time) (*biz.QuotaLease, error) {
+	var model QuotaLeaseModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockActiveLease(tx, leaseID, &model); err != nil {
+			return err
+		}
+		if err := checkLeaseUsed(&model, usedCount); err != nil {
+			return err
+		}
+		model.UsedCount = usedCount
+		model.ExpireTime = expireTime
+		return tx.Model(&model).Select("used_count", "expire_time", "updated_at").Updates(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertQuotaLeaseModelToBiz(&model), nil
+}
+
+// CloseLease 关闭活动租约
+func (r *quotaLeaseRepo) CloseLease(ctx context.Context, leaseID string, usedCount *int32, status biz.QuotaLeaseStatus) (*biz.QuotaLease, error) {
+	var model QuotaLeaseModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockActiveLease(tx, leaseID, &model); err != nil {
+			return err
+		}
+		now := time.Now()
+		if status == biz.QuotaLeaseStatusReclaimed && model.ExpireTime.After(now) {
+			// 列出过期租约后客户端已续约
+			return biz.ErrQuotaLeaseClosed.WithMetadata(map[string]string{"status": model.Status})
+		}
+		if usedCount != nil {
+			if err := checkLeaseUsed(&model, *usedCount); err != nil {
+				return err
+			}
+			model.UsedCount = *usedCount
+		}
+		model.Status = convertQuotaLeaseStatusToString(status)
+		model.ClosedAt = &now
+		return tx.Model(&model).Select("used_count", "status", "closed_at", "updated_at").Updates(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertQuotaLeaseModelToBiz(&model), nil
+}
+
+// SetLeaseReturned 记录关闭时实际退回配额的数量
+func (r *quotaLeaseRepo) SetLeaseReturned(ctx context.Context, leaseID string, returned int32) error {
+	return r.data.DB(ctx).Model(&QuotaLeaseModel{}).Where("lease_id = ?", leaseID).Update("returned", returned).Error
+}
+
+// ListLeases 列出配额租约
+func (r *quotaLeaseRepo) ListLeases(ctx context.Context, filter *biz.QuotaLeaseFilter) ([]*biz.QuotaLease, error) {
+	var models []*QuotaLeaseModel
+
+	query := r.data.DB(ctx).Where("tenant_id = ?", filter.TenantID)
+	if filter.QuotaType != biz.QuotaTypeUnspecified {
+		query = query.Where("quota_type = ?", convertQuotaTypeToString(filter.QuotaType))
+	}
+	if filter.Status != biz.QuotaLeaseStatusUnspecified {
+		query = query.Where("status = ?", convertQuotaLeaseStatusToString(filter.Status))
+	}
+	if err := query.Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	leases := make([]*biz.QuotaLease, 0, len(models))
+	for _, model := range models {
+		leases = append(leases, convertQuotaLeaseModelToBiz(model))
+	}
+	return leases, nil
+}
+
+// ListExpiredLeases 列出已过期的活动租约
+func (r *quotaLeaseRepo) ListExpiredLeases(ctx context.Context, now time.Time, limit int) ([]*biz.QuotaLease, error) {
+	var models []*QuotaLeaseModel
+
+	err := r.data.DB(ctx).
+		Where("status = ? AND expire_time <= ?", convertQuotaLeaseStatusToString(biz.QuotaLeaseStatusActive), now).
+		Order("expire_time ASC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	leases := make([]*biz.QuotaLease, 0, len(models))
+	for _, model := range models {
+		leases = append(leases, convertQuotaLeaseModelToBiz(model))
+	}
+	return leases, nil
+}
@@ -0,0 +1,371 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+	"tenant-service/pkg/ledger"
+)
+
+// LedgerHeadModel 配额哈希链链头数据模型，由定序任务加锁推进，保证链序号连续
+type LedgerHeadModel struct {
+	QuotaID       int64     `gorm:"column:quota_id;primaryKey"`
+	TenantID      string    `gorm:"column:tenant_id;index;not null"`
+	ChainSeq      int64     `gorm:"column:chain_seq;not null"`
+	RecordHash    string    `gorm:"column:record_hash;not null"`
+	CheckpointSeq int64     `gorm:"column:checkpoint_seq;not null;default:0"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (LedgerHeadModel) TableName() string {
+	return "quota_ledger_heads"
+}
+
+// LedgerCheckpointModel 签名检查点数据模型，只插入不更新
+type LedgerCheckpointModel struct {
+	CheckpointID int64     `gorm:"column:checkpoint_id;primaryKey;autoIncrement"`
+	QuotaID      int64     `gorm:"column:quota_id;index;not null"`
+	TenantID     string    `gorm:"column:tenant_id;index;not null"`
+	ChainSeq     int64     `gorm:"column:chain_seq;not null"`
+	RecordHash   string    `gorm:"column:record_hash;not null"`
+	KeyID        string    `gorm:"column:key_id;not null"`
+	Signature    []byte    `gorm:"column:signature;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null"`
+}
+
+// TableName 表名
+func (LedgerCheckpointModel) TableName() string {
+	return "quota_ledger_checkpoints"
+}
+
+// lockLedgerHead 加锁读取链头，不存在时以创世哈希创建
+func lockLedgerHead(tx *gorm.DB, quotaID int64, tenantID string) (*LedgerHeadModel, error) {
+	head := &LedgerHeadModel{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", quotaID).First(head).Error
+	if err == nil {
+		return head, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	// 并发创建时只有一个成功，其余在随后的加锁读取上等待
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&LedgerHeadModel{
+		QuotaID:    quotaID,
+		TenantID:   tenantID,
+		RecordHash: ledger.GenesisHash,
+	}).Error; err != nil {
+		return nil, err
+	}
+	head = &LedgerHeadModel{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", quotaID).First(head).Error; err != nil {
+		return nil, err
+	}
+	return head, nil
+}
+
+// usageModelToLedger 取使用记录参与哈希的字段，操作类型使用存储的原始值
+func usageModelToLedger(model *QuotaUsageModel) *ledger.Record {
+	var seq int64
+	if model.ChainSeq != nil {
+		seq = *model.ChainSeq
+	}
+	return &ledger.Record{
+		QuotaID:       model.QuotaID,
+		Seq:           seq,
+		TenantID:      model.TenantID,
+		OperationType: model.OperationType,
+		DeltaValue:    model.DeltaValue,
+		CurrentUsed:   model.CurrentUsed,
+		BizID:         model.BizID,
+		BizType:       model.BizType,
+		Operator:      model.Operator,
+		OperationTime: model.OperationTime.Unix(),
+		Remark:        model.Remark,
+	}
+}
+
+// ledgerRepo 使用记录哈希链仓库实现
+type ledgerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLedgerRepo 创建使用记录哈希链仓库
+func NewLedgerRepo(data *Data, logger log.Logger) biz.LedgerRepo {
+	return &ledgerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// convertLedgerHeadModelToBiz 转换链头数据模型到业务模型
+func convertLedgerHeadModelToBiz(model *LedgerHeadModel) *biz.LedgerHead {
+	return &biz.LedgerHead{
+		QuotaID:       model.QuotaID,
+		TenantID:      model.TenantID,
+		Seq:           model.ChainSeq,
+		Hash:          model.RecordHash,
+		CheckpointSeq: model.CheckpointSeq,
+		UpdatedAt:     model.UpdatedAt,
+	}
+}
+
+// convertLedgerCheckpointModelToBiz 转换检查点数据模型到业务模型
+func convertLedgerCheckpointModelToBiz(model *LedgerCheckpointModel) *biz.LedgerCheckpoint {
+	return &biz.LedgerCheckpoint{
+		CheckpointID: model.CheckpointID,
+		QuotaID:      model.QuotaID,
+		TenantID:     model.TenantID,
+		Seq:          model.ChainSeq,
+		Hash:         model.RecordHash,
+		KeyID:        model.KeyID,
+		Signature:    model.Signature,
+		CreatedAt:    model.CreatedAt,
+	}
+}
+
+// GetHead 获取配额的链头
+func (r *ledgerRepo) GetHead(ctx context.Context, quotaID int64) (*biz.LedgerHead, error) {
+	var model LedgerHeadModel
+	err := r.data.DB(ctx).Where("quota_id = ?", quotaID).First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertLedgerHeadModelToBiz(&model), nil
+}
+
+// ListHeads 列出租户全部配额的链头
+func (r *ledgerRepo) ListHeads(ctx context.Context, tenantID string) ([]*biz.LedgerHead, error) {
+	var models []*LedgerHeadModel
+	if err := r.data.DB(ctx).Where("tenant_id = ?", tenantID).Order("quota_id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	heads := make([]*biz.LedgerHead, 0, len(models))
+	for _, model := range models {
+		heads = append(heads, convertLedgerHeadModelToBiz(model))
+	}
+	return heads, nil
+}
+
+// SequenceRecords 为尚未分配链序号的使用记录分配链序号并计算哈希，返回处理的记录数；
+// 写入使用记录的事务不接触链头，链头行只在定序任务之间加锁
+func (r *ledgerRepo) SequenceRecords(ctx context.Context, limit int) (int, error) {
+	var pending []*QuotaUsageModel
+	if err := r.data.DB(ctx).
+		Select("record_id", "quota_id", "tenant_id").
+		Where("chain_seq IS NULL").
+		Order("record_id ASC").
+		Limit(limit).
+		Find(&pending).Error; err != nil {
+		return 0, err
+	}
+
+	// 按配额分组，组内按记录ID顺序入链
+	var quotaIDs []int64
+	tenants := make(map[int64]string)
+	for _, record := range pending {
+		if _, ok := tenants[record.QuotaID]; !ok {
+			quotaIDs = append(quotaIDs, record.QuotaID)
+			tenants[record.QuotaID] = record.TenantID
+		}
+	}
+
+	sequenced := 0
+	for _, quotaID := range quotaIDs {
+		count, err := r.sequenceQuota(ctx, quotaID, tenants[quotaID], limit)
+		if err != nil {
+			return sequenced, err
+		}
+		sequenced += count
+	}
+	return sequenced, nil
+}
+
+// sequenceQuota 在一个事务中推进单个配额的哈希链
+func (r *ledgerRepo) sequenceQuota(ctx context.Context, quotaID int64, tenantID string, limit int) (int, error) {
+	count := 0
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		head, err := lockLedgerHead(tx, quotaID, tenantID)
+		if err != nil {
+			return err
+		}
+
+		// 持有链头锁之后再读取待定序的记录，其他实例已处理的记录不会再次读到；
+		// 记录不加锁，避免间隙锁阻塞同一配额的写入
+		var records []*QuotaUsageModel
+		if err := tx.
+			Where("quota_id = ? AND chain_seq IS NULL", quotaID).
+			Order("record_id ASC").
+			Limit(limit).
+			Find(&records).Error; err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		seq, prevHash := head.ChainSeq, head.RecordHash
+		for _, record := range records {
+			seq++
+			recordSeq := seq
+			record.ChainSeq = &recordSeq
+			record.PrevHash = prevHash
+			record.RecordHash = ledger.Hash(prevHash, usageModelToLedger(record))
+			result := tx.Model(&QuotaUsageModel{}).
+				Where("record_id = ? AND chain_seq IS NULL", record.RecordID).
+				UpdateColumns(map[string]interface{}{
+					"chain_seq":   seq,
+					"prev_hash":   record.PrevHash,
+					"record_hash": record.RecordHash,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("usage record %d of quota %d was sequenced concurrently", record.RecordID, quotaID)
+			}
+			prevHash = record.RecordHash
+		}
+		count = len(records)
+		return tx.Model(head).UpdateColumns(map[string]interface{}{
+			"chain_seq":   seq,
+			"record_hash": prevHash,
+			"updated_at":  time.Now(),
+		}).Error
+	})
+	return count, err
+}
+
+// ListRecords 按链序号升序列出配额的记录
+func (r *ledgerRepo) ListRecords(ctx context.Context, quotaID, afterSeq, uptoSeq int64, limit int) ([]*biz.LedgerRecord, error) {
+	var models []*QuotaUsageModel
+	if err := r.data.DB(ctx).
+		Where("quota_id = ? AND chain_seq > ? AND chain_seq <= ?", quotaID, afterSeq, uptoSeq).
+		Order("chain_seq ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	records := make([]*biz.LedgerRecord, 0, len(models))
+	for _, model := range models {
+		records = append(records, &biz.LedgerRecord{
+			RecordID:   model.RecordID,
+			Fields:     usageModelToLedger(model),
+			PrevHash:   model.PrevHash,
+			RecordHash: model.RecordHash,
+		})
+	}
+	return records, nil
+}
+
+// ListPendingHeads 列出链序号超过最近检查点的链头
+func (r *ledgerRepo) ListPendingHeads(ctx context.Context, limit int) ([]*biz.LedgerHead, error) {
+	var models []*LedgerHeadModel
+	if err := r.data.DB(ctx).
+		Where("chain_seq > checkpoint_seq").
+		Order("quota_id ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	heads := make([]*biz.LedgerHead, 0, len(models))
+	for _, model := range models {
+		heads = append(heads, convertLedgerHeadModelToBiz(model))
+	}
+	return heads, nil
+}
+
+// CreateCheckpoint 写入检查点并推进链头的检查点序号，检查点序号已不小于该检查点时不写入
+func (r *ledgerRepo) CreateCheckpoint(ctx context.Context, checkpoint *biz.LedgerCheckpoint) (bool, error) {
+	created := false
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&LedgerHeadModel{}).
+			Where("quota_id = ? AND checkpoint_seq < ?", checkpoint.QuotaID, checkpoint.Seq).
+			UpdateColumn("checkpoint_seq", checkpoint.Seq)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		model := &LedgerCheckpointModel{
+			QuotaID:    checkpoint.QuotaID,
+			TenantID:   checkpoint.TenantID,
+			ChainSeq:   checkpoint.Seq,
+			RecordHash: checkpoint.Hash,
+			KeyID:      checkpoint.KeyID,
+			Signature:  checkpoint.Signature,
+			CreatedAt:  checkpoint.CreatedAt,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		checkpoint.CheckpointID = model.CheckpointID
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+// ListCheckpoints 按检查点ID升序列出检查点
+func (r *ledgerRepo) ListCheckpoints(ctx context.Context, filter *biz.LedgerCheckpointFilter) ([]*biz.LedgerCheckpoint, error) {
+	query := r.data.DB(ctx).Where("tenant_id = ? AND checkpoint_id > ?", filter.TenantID, filter.AfterCheckpointID)
+	if filter.QuotaID != 0 {
+		query = query.Where("quota_id = ?", filter.QuotaID)
+	}
+	var models []*LedgerCheckpointModel
+	if err := query.Order("checkpoint_id ASC").Limit(int(filter.Limit)).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	checkpoints := make([]*biz.LedgerCheckpoint, 0, len(models))
+	for _, model := range models {
+		checkpoints = append(checkpoints, convertLedgerCheckpointModelToBiz(model))
+	}
+	return checkpoints, nil
+}
+
+// OldestUnsequencedTime 最早一条未入链使用记录的操作时间，全部已入链时返回零值
+func (r *ledgerRepo) OldestUnsequencedTime(ctx context.Context) (time.Time, error) {
+	var model QuotaUsageModel
+	err := r.data.DB(ctx).
+		Select("record_id", "operation_time").
+		Where("chain_seq IS NULL").
+		Order("record_id ASC").
+		First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return model.OperationTime, nil
+}
+
+// ListUnsequencedRecords 按记录ID升序列出租户在before之前写入仍未入链的记录
+func (r *ledgerRepo) ListUnsequencedRecords(ctx context.Context, tenantID string, quotaID int64, before time.Time, limit int) ([]*biz.LedgerRecord, error) {
+	query := r.data.DB(ctx).Where("tenant_id = ? AND chain_seq IS NULL AND operation_time < ?", tenantID, before)
+	if quotaID != 0 {
+		query = query.Where("quota_id = ?", quotaID)
+	}
+	var models []*QuotaUsageModel
+	if err := query.Order("record_id ASC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	records := make([]*biz.LedgerRecord, 0, len(models))
+	for _, model := range models {
+		records = append(records, &biz.LedgerRecord{
+			RecordID: model.RecordID,
+			Fields:   usageModelToLedger(model),
+		})
+	}
+	return records, nil
+}
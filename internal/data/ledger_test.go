@@ -0,0 +1,222 @@
+package data
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+)
+
+// recordingLedgerMetrics 记录最近一次上报的未入链等待时长
+type recordingLedgerMetrics struct {
+	lag      time.Duration
+	exceeded bool
+}
+
+// SequenceLag 记录未入链等待时长
+func (m *recordingLedgerMetrics) SequenceLag(ctx context.Context, lag time.Duration, exceeded bool) {
+	m.lag, m.exceeded = lag, exceeded
+}
+
+// newTestLedger 创建租户EN_acme的短信配额并消费n次，返回哈希链用例和配额
+func newTestLedger(t *testing.T, d *Data, c *conf.Tenant, n int) (*biz.LedgerUsecase, *recordingLedgerMetrics, *QuotaModel) {
+	t.Helper()
+	createTestTenant(t, d, "EN_acme")
+	quota := createTestQuota(t, d, &QuotaModel{TenantID: "EN_acme", HardLimit: 100})
+	repo := NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger)
+	for i := 0; i < n; i++ {
+		if _, err := repo.ConsumeQuota(context.Background(), "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 1, "", fmt.Sprintf("order-%d", i), "order"); err != nil {
+			t.Fatalf("consume: %v", err)
+		}
+	}
+	m := &recordingLedgerMetrics{}
+	uc, err := biz.NewLedgerUsecase(c, NewLedgerRepo(d, testLogger), m, testLogger)
+	if err != nil {
+		t.Fatalf("new ledger usecase: %v", err)
+	}
+	return uc, m, quota
+}
+
+// verifyTestLedger 校验配额的哈希链，返回发现的问题类型
+func verifyTestLedger(t *testing.T, uc *biz.LedgerUsecase, quotaID int64) []biz.LedgerIssueKind {
+	t.Helper()
+	results, err := uc.VerifyLedger(context.Background(), "EN_acme", quotaID)
+	if err != nil {
+		t.Fatalf("verify ledger: %v", err)
+	}
+	var kinds []biz.LedgerIssueKind
+	for _, result := range results {
+		for _, issue := range result.Issues {
+			kinds = append(kinds, issue.Kind)
+		}
+	}
+	return kinds
+}
+
+func TestLedgerSequencesRecordsInOrder(t *testing.T) {
+	d := newTestData(t)
+	uc, m, quota := newTestLedger(t, d, &conf.Tenant{}, 3)
+	ctx := context.Background()
+
+	sequenced, err := uc.SequenceRecords(ctx, 1)
+	if err != nil {
+		t.Fatalf("sequence records: %v", err)
+	}
+	if sequenced != 3 {
+		t.Fatalf("sequenced = %d, want 3", sequenced)
+	}
+	if m.lag != 0 || m.exceeded {
+		t.Fatalf("sequence lag = %s exceeded=%v after sequencing everything, want 0", m.lag, m.exceeded)
+	}
+
+	var records []*QuotaUsageModel
+	if err := d.db.Where("quota_id = ?", quota.QuotaID).Order("record_id ASC").Find(&records).Error; err != nil {
+		t.Fatalf("list records: %v", err)
+	}
+	prevHash := ""
+	for i, record := range records {
+		if record.ChainSeq == nil || *record.ChainSeq != int64(i+1) {
+			t.Fatalf("record %d chain_seq = %v, want %d", record.RecordID, record.ChainSeq, i+1)
+		}
+		if i > 0 && record.PrevHash != prevHash {
+			t.Fatalf("record %d prev_hash does not link to record %d", record.RecordID, records[i-1].RecordID)
+		}
+		prevHash = record.RecordHash
+	}
+
+	// 再次定序不会重复入链
+	if sequenced, err := uc.SequenceRecords(ctx, 1); err != nil || sequenced != 0 {
+		t.Fatalf("second sequence = %d, %v, want 0", sequenced, err)
+	}
+	if kinds := verifyTestLedger(t, uc, 0); len(kinds) != 0 {
+		t.Fatalf("issues = %v, want none", kinds)
+	}
+}
+
+func TestVerifyLedgerDetectsTampering(t *testing.T) {
+	cases := []struct {
+		name   string
+		tamper func(d *Data, quotaID int64) error
+		want   biz.LedgerIssueKind
+	}{
+		{
+			name: "modified record",
+			tamper: func(d *Data, quotaID int64) error {
+				return d.db.Model(&QuotaUsageModel{}).Where("quota_id = ? AND chain_seq = 2", quotaID).UpdateColumn("delta_value", 50).Error
+			},
+			want: biz.LedgerIssueHashMismatch,
+		},
+		{
+			name: "deleted record",
+			tamper: func(d *Data, quotaID int64) error {
+				return d.db.Where("quota_id = ? AND chain_seq = 2", quotaID).Delete(&QuotaUsageModel{}).Error
+			},
+			want: biz.LedgerIssueGap,
+		},
+		{
+			name: "deleted last record",
+			tamper: func(d *Data, quotaID int64) error {
+				return d.db.Where("quota_id = ? AND chain_seq = 3", quotaID).Delete(&QuotaUsageModel{}).Error
+			},
+			want: biz.LedgerIssueHeadMismatch,
+		},
+		{
+			name: "relinked record",
+			tamper: func(d *Data, quotaID int64) error {
+				return d.db.Model(&QuotaUsageModel{}).Where("quota_id = ? AND chain_seq = 3", quotaID).UpdateColumn("prev_hash", "00").Error
+			},
+			want: biz.LedgerIssueLinkMismatch,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := newTestData(t)
+			uc, _, quota := newTestLedger(t, d, &conf.Tenant{}, 3)
+			if _, err := uc.SequenceRecords(context.Background(), 1); err != nil {
+				t.Fatalf("sequence records: %v", err)
+			}
+			if err := c.tamper(d, quota.QuotaID); err != nil {
+				t.Fatalf("tamper: %v", err)
+			}
+			kinds := verifyTestLedger(t, uc, quota.QuotaID)
+			found := false
+			for _, kind := range kinds {
+				found = found || kind == c.want
+			}
+			if !found {
+				t.Fatalf("issues = %v, want %s", kinds, c.want)
+			}
+		})
+	}
+}
+
+func TestVerifyLedgerChecksCheckpoints(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	c := &conf.Tenant{Ledger: &conf.Tenant_Ledger{SigningKey: base64.StdEncoding.EncodeToString(seed), KeyId: "test"}}
+	d := newTestData(t)
+	uc, _, quota := newTestLedger(t, d, c, 2)
+	ctx := context.Background()
+
+	if _, err := uc.SequenceRecords(ctx, 1); err != nil {
+		t.Fatalf("sequence records: %v", err)
+	}
+	if created, err := uc.CreateCheckpoints(ctx, 1); err != nil || created != 1 {
+		t.Fatalf("create checkpoints = %d, %v, want 1", created, err)
+	}
+	// 链头未推进时不重复签发
+	if created, err := uc.CreateCheckpoints(ctx, 1); err != nil || created != 0 {
+		t.Fatalf("second create checkpoints = %d, %v, want 0", created, err)
+	}
+	if kinds := verifyTestLedger(t, uc, quota.QuotaID); len(kinds) != 0 {
+		t.Fatalf("issues = %v, want none", kinds)
+	}
+
+	if err := d.db.Model(&LedgerCheckpointModel{}).Where("quota_id = ?", quota.QuotaID).UpdateColumn("chain_seq", 1).Error; err != nil {
+		t.Fatalf("tamper checkpoint: %v", err)
+	}
+	kinds := verifyTestLedger(t, uc, quota.QuotaID)
+	if len(kinds) != 2 || kinds[0] != biz.LedgerIssueCheckpointSignature || kinds[1] != biz.LedgerIssueCheckpointMismatch {
+		t.Fatalf("issues = %v, want CHECKPOINT_SIGNATURE and CHECKPOINT_MISMATCH", kinds)
+	}
+}
+
+func TestVerifyLedgerReportsStaleUnsequencedRecords(t *testing.T) {
+	c := &conf.Tenant{Ledger: &conf.Tenant_Ledger{MaxSequenceLag: durationpb.New(time.Minute)}}
+	d := newTestData(t)
+	uc, m, quota := newTestLedger(t, d, c, 2)
+	ctx := context.Background()
+
+	// 刚写入的记录仍在允许的未入链窗口内，配额没有链头
+	if _, err := uc.VerifyLedger(ctx, "EN_acme", quota.QuotaID); errors.Reason(err) != "LEDGER_NOT_FOUND" {
+		t.Fatalf("verify fresh records error = %v, want LEDGER_NOT_FOUND", err)
+	}
+
+	// 定序任务停滞，第一条记录写入已超过一分钟
+	if err := d.db.Model(&QuotaUsageModel{}).Where("quota_id = ? AND biz_id = ?", quota.QuotaID, "order-0").
+		UpdateColumn("operation_time", time.Now().Add(-2*time.Minute)).Error; err != nil {
+		t.Fatalf("age record: %v", err)
+	}
+	for _, quotaID := range []int64{quota.QuotaID, 0} {
+		if kinds := verifyTestLedger(t, uc, quotaID); len(kinds) != 1 || kinds[0] != biz.LedgerIssueUnsequenced {
+			t.Fatalf("quota %d issues = %v, want one UNSEQUENCED", quotaID, kinds)
+		}
+	}
+
+	// 定序后记录入链，告警指标和校验均恢复
+	if _, err := uc.SequenceRecords(ctx, 1); err != nil {
+		t.Fatalf("sequence records: %v", err)
+	}
+	if m.exceeded {
+		t.Fatalf("sequence lag exceeded after sequencing")
+	}
+	if kinds := verifyTestLedger(t, uc, quota.QuotaID); len(kinds) != 0 {
+		t.Fatalf("issues = %v, want none", kinds)
+	}
+}
@@ -0,0 +1,410 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// MemberModel 租户成员数据模型
+type MemberModel struct {
+	TenantID    string    `gorm:"column:tenant_id;primaryKey"`
+	UserID      string    `gorm:"column:user_id;primaryKey"`
+	Role        string    `gorm:"column:role;not null"`
+	DisplayName string    `gorm:"column:display_name"`
+	Email       string    `gorm:"column:email"`
+	Inherit     bool      `gorm:"column:inherit"`
+	InvitedBy   string    `gorm:"column:invited_by"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (MemberModel) TableName() string {
+	return "tenant_members"
+}
+
+// InvitationModel 租户邀请数据模型
+type InvitationModel struct {
+	InvitationID string     `gorm:"column:invitation_id;primaryKey"`
+	TenantID     string     `gorm:"column:tenant_id;not null"`
+	Email        string     `gorm:"column:email"`
+	Role         string     `gorm:"column:role;not null"`
+	Inherit      bool       `gorm:"column:inherit"`
+	Status       string     `gorm:"column:status;not null"`
+	InvitedBy    string     `gorm:"column:invited_by"`
+	TokenHash    string     `gorm:"column:token_hash;not null"`
+	ExpireTime   time.Time  `gorm:"column:expire_time;not null"`
+	AcceptedBy   string     `gorm:"column:accepted_by"`
+	AcceptedAt   *time.Time `gorm:"column:accepted_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (InvitationModel) TableName() string {
+	return "tenant_invitations"
+}
+
+// memberRepo 租户成员仓库实现
+type memberRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMemberRepo 创建租户成员仓库
+func NewMemberRepo(data *Data, logger log.Logger) biz.MemberRepo {
+	return &memberRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// convertMemberRoleToString 转换成员角色到字符串
+func convertMemberRoleToString(role biz.MemberRole) string {
+	switch role {
+	case biz.MemberRoleOwner:
+		return "OWNER"
+	case biz.MemberRoleAdmin:
+		return "ADMIN"
+	case biz.MemberRoleOperator:
+		return "OPERATOR"
+	case biz.MemberRoleViewer:
+		return "VIEWER"
+	default:
+		return ""
+	}
+}
+
+// convertMemberRoleToEnum 转换字符串到成员角色
+func convertMemberRoleToEnum(role string) biz.MemberRole {
+	switch role {
+	case "OWNER":
+		return biz.MemberRoleOwner
+	case "ADMIN":
+		return biz.MemberRoleAdmin
+	case "OPERATOR":
+		return biz.MemberRoleOperator
+	case "VIEWER":
+		return biz.MemberRoleViewer
+	default:
+		return biz.MemberRoleUnspecified
+	}
+}
+
+// convertInvitationStatusToString 转换邀请状态到字符串，过期状态不落库
+func convertInvitationStatusToString(status biz.InvitationStatus) string {
+	switch status {
+	case biz.InvitationStatusPending:
+		return "PENDING"
+	case biz.InvitationStatusAccepted:
+		return "ACCEPTED"
+	case biz.InvitationStatusRevoked:
+		return "REVOKED"
+	default:
+		return ""
+	}
+}
+
+// convertInvitationStatusToEnum 转换字符串到邀请状态
+func convertInvitationStatusToEnum(status string) biz.InvitationStatus {
+	switch status {
+	case "PENDING":
+		return biz.InvitationStatusPending
+	case "ACCEPTED":
+		return biz.InvitationStatusAccepted
+	case "REVOKED":
+		return biz.InvitationStatusRevoked
+	default:
+		return biz.InvitationStatusUnspecified
+	}
+}
+
+// convertMemberModelToBiz 转换租户成员数据模型到业务模型
+func convertMemberModelToBiz(model *MemberModel) *biz.Member {
+	return &biz.Member{
+		TenantID:    model.TenantID,
+		UserID:      model.UserID,
+		Role:        convertMemberRoleToEnum(model.Role),
+		DisplayName: model.DisplayName,
+		Email:       model.Email,
+		Inherit:     model.Inherit,
+		InvitedBy:   model.InvitedBy,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
+
+// convertInvitationModelToBiz 转换租户邀请数据模型到业务模型，超过有效期的待接受邀请视为过期
+func convertInvitationModelToBiz(model *InvitationModel, now time.Time) *biz.Invitation {
+	invitation := &biz.Invitation{
+		InvitationID: model.InvitationID,
+		TenantID:     model.TenantID,
+		Email:        model.Email,
+		Role:         convertMemberRoleToEnum(model.Role),
+		Inherit:      model.Inherit,
+		Status:       convertInvitationStatusToEnum(model.Status),
+		InvitedBy:    model.InvitedBy,
+		TokenHash:    model.TokenHash,
+		ExpireTime:   model.ExpireTime,
+		AcceptedBy:   model.AcceptedBy,
+		CreatedAt:    model.CreatedAt,
+	}
+	if model.AcceptedAt != nil {
+		invitation.AcceptedAt = *model.AcceptedAt
+	}
+	if invitation.Status == biz.InvitationStatusPending && !model.ExpireTime.After(now) {
+		invitation.Status = biz.InvitationStatusExpired
+	}
+	return invitation
+}
+
+// GetMember 获取成员
+func (r *memberRepo) GetMember(ctx context.Context, tenantID, userID string) (*biz.Member, error) {
+	var model MemberModel
+	err := r.data.DB(ctx).Where("tenant_id = ? AND user_id = ?", tenantID, userID).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return convertMemberModelToBiz(&model), nil
+}
+
+// CreateMember 添加成员
+func (r *memberRepo) CreateMember(ctx context.Context, member *biz.Member) (*biz.Member, error) {
+	model := &MemberModel{
+		TenantID:    member.TenantID,
+		UserID:      member.UserID,
+		Role:        convertMemberRoleToString(member.Role),
+		DisplayName: member.DisplayName,
+		Email:       member.Email,
+		Inherit:     member.Inherit,
+		InvitedBy:   member.InvitedBy,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, biz.ErrMemberExists
+		}
+		return nil, err
+	}
+	return convertMemberModelToBiz(model), nil
+}
+
+// lockMember 锁定成员，并在成员为所有者时锁定租户的全部所有者，返回所有者数量
+func lockMember(tx *gorm.DB, tenantID, userID string, model *MemberModel) (int, error) {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("tenant_id = ? AND user_id = ?", tenantID, userID).First(model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, biz.ErrMemberNotFound
+		}
+		return 0, err
+	}
+	if model.Role != convertMemberRoleToString(biz.MemberRoleOwner) {
+		return 0, nil
+	}
+	var owners []*MemberModel
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND role = ?", tenantID, model.Role).Find(&owners).Error; err != nil {
+		return 0, err
+	}
+	return len(owners), nil
+}
+
+// UpdateMember 更新成员角色和继承设置
+func (r *memberRepo) UpdateMember(ctx context.Context, tenantID, userID string, role biz.MemberRole, inherit *bool) (*biz.Member, error) {
+	var model MemberModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		owners, err := lockMember(tx, tenantID, userID, &model)
+		if err != nil {
+			return err
+		}
+		if role != biz.MemberRoleUnspecified {
+			if owners == 1 && role != biz.MemberRoleOwner {
+				return biz.ErrLastOwner
+			}
+			model.Role = convertMemberRoleToString(role)
+		}
+		if inherit != nil {
+			model.Inherit = *inherit
+		}
+		return tx.Model(&model).Select("role", "inherit", "updated_at").Updates(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertMemberModelToBiz(&model), nil
+}
+
+// RemoveMember 移除成员
+func (r *memberRepo) RemoveMember(ctx context.Context, tenantID, userID string) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var model MemberModel
+		owners, err := lockMember(tx, tenantID, userID, &model)
+		if err != nil {
+			return err
+		}
+		if owners == 1 {
+			return biz.ErrLastOwner
+		}
+		return tx.Where("tenant_id = ? AND user_id = ?", tenantID, userID).Delete(&MemberModel{}).Error
+	})
+}
+
+// ListMembers 列出成员
+func (r *memberRepo) ListMembers(ctx context.Context, tenantIDs []string, role biz.MemberRole, inheritOnly bool) ([]*biz.Member, error) {
+	var models []*MemberModel
+
+	query := r.data.DB(ctx).Where("tenant_id IN ?", tenantIDs)
+	if role != biz.MemberRoleUnspecified {
+		query = query.Where("role = ?", convertMemberRoleToString(role))
+	}
+	if inheritOnly {
+		query = query.Where("inherit = ?", true)
+	}
+	if err := query.Order("tenant_id ASC").Order("created_at ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	members := make([]*biz.Member, 0, len(models))
+	for _, model := range models {
+		members = append(members, convertMemberModelToBiz(model))
+	}
+	return members, nil
+}
+
+// CreateInvitation 创建邀请
+func (r *memberRepo) CreateInvitation(ctx context.Context, invitation *biz.Invitation) (*biz.Invitation, error) {
+	model := &InvitationModel{
+		InvitationID: invitation.InvitationID,
+		TenantID:     invitation.TenantID,
+		Email:        invitation.Email,
+		Role:         convertMemberRoleToString(invitation.Role),
+		Inherit:      invitation.Inherit,
+		Status:       convertInvitationStatusToString(invitation.Status),
+		InvitedBy:    invitation.InvitedBy,
+		TokenHash:    invitation.TokenHash,
+		ExpireTime:   invitation.ExpireTime,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+	return convertInvitationModelToBiz(model, time.Now()), nil
+}
+
+// lockPendingInvitation 锁定待接受的邀请
+func lockPendingInvitation(tx *gorm.DB, query string, arg interface{}, model *InvitationModel) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(query, arg).First(model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.ErrInvitationNotFound
+		}
+		return err
+	}
+	invitation := convertInvitationModelToBiz(model, time.Now())
+	if invitation.Status != biz.InvitationStatusPending {
+		return biz.ErrInvitationClosed.WithMetadata(map[string]string{"status": invitation.Status.String()})
+	}
+	return nil
+}
+
+// AcceptInvitation 接受邀请
+func (r *memberRepo) AcceptInvitation(ctx context.Context, tokenHash string, member *biz.Member) (*biz.Member, error) {
+	var model MemberModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var invitation InvitationModel
+		if err := lockPendingInvitation(tx, "token_hash = ?", tokenHash, &invitation); err != nil {
+			return err
+		}
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("tenant_id = ? AND user_id = ?", invitation.TenantID, member.UserID).First(&model).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			model = MemberModel{
+				TenantID:    invitation.TenantID,
+				UserID:      member.UserID,
+				Role:        invitation.Role,
+				DisplayName: member.DisplayName,
+				Email:       invitation.Email,
+				Inherit:     invitation.Inherit,
+				InvitedBy:   invitation.InvitedBy,
+			}
+			if err := tx.Create(&model).Error; err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		default:
+			// 已是成员时保留较高的角色
+			if convertMemberRoleToEnum(invitation.Role).HigherThan(convertMemberRoleToEnum(model.Role)) {
+				model.Role = invitation.Role
+			}
+			model.Inherit = model.Inherit || invitation.Inherit
+			if err := tx.Model(&model).Select("role", "inherit", "updated_at").Updates(&model).Error; err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		invitation.Status = convertInvitationStatusToString(biz.InvitationStatusAccepted)
+		invitation.AcceptedBy = member.UserID
+		invitation.AcceptedAt = &now
+		return tx.Model(&invitation).Select("status", "accepted_by", "accepted_at", "updated_at").Updates(&invitation).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertMemberModelToBiz(&model), nil
+}
+
+// RevokeInvitation 撤销邀请
+func (r *memberRepo) RevokeInvitation(ctx context.Context, invitationID string) (*biz.Invitation, error) {
+	var model InvitationModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockPendingInvitation(tx, "invitation_id = ?", invitationID, &model); err != nil {
+			return err
+		}
+		model.Status = convertInvitationStatusToString(biz.InvitationStatusRevoked)
+		return tx.Model(&model).Select("status", "updated_at").Updates(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertInvitationModelToBiz(&model, time.Now()), nil
+}
+
+// ListInvitations 列出邀请
+func (r *memberRepo) ListInvitations(ctx context.Context, tenantID string, status biz.InvitationStatus) ([]*biz.Invitation, error) {
+	var models []*InvitationModel
+
+	now := time.Now()
+	query := r.data.DB(ctx).Where("tenant_id = ?", tenantID)
+	switch status {
+	case biz.InvitationStatusUnspecified:
+	case biz.InvitationStatusPending:
+		query = query.Where("status = ? AND expire_time > ?", convertInvitationStatusToString(status), now)
+	case biz.InvitationStatusExpired:
+		query = query.Where("status = ? AND expire_time <= ?", convertInvitationStatusToString(biz.InvitationStatusPending), now)
+	default:
+		query = query.Where("status = ?", convertInvitationStatusToString(status))
+	}
+	if err := query.Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*biz.Invitation, 0, len(models))
+	for _, model := range models {
+		invitations = append(invitations, convertInvitationModelToBiz(model, now))
+	}
+	return invitations, nil
+}
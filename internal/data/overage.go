@@ -0,0 +1,136 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// QuotaOverageModel 配额周期超额数据模型
+type QuotaOverageModel struct {
+	QuotaID     int64     `gorm:"column:quota_id;primaryKey"`
+	PeriodStart time.Time `gorm:"column:period_start;primaryKey"`
+	TenantID    string    `gorm:"column:tenant_id;not null"`
+	QuotaType   string    `gorm:"column:quota_type;not null"`
+	LimitType   string    `gorm:"column:limit_type;not null"`
+	PeriodEnd   time.Time `gorm:"column:period_end"`
+	Overage     int32     `gorm:"column:overage;not null"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (QuotaOverageModel) TableName() string {
+	return "quota_overages"
+}
+
+// convertEnforcementModeToEnum 转换执行模式为枚举
+func convertEnforcementModeToEnum(mode string) biz.EnforcementMode {
+	switch mode {
+	case "HARD":
+		return biz.EnforcementModeHard
+	case "SOFT_ONLY":
+		return biz.EnforcementModeSoftOnly
+	case "OVERAGE":
+		return biz.EnforcementModeOverage
+	default:
+		return biz.EnforcementModeUnspecified
+	}
+}
+
+// convertEnforcementModeToString 转换执行模式为字符串，未指定时为HARD
+func convertEnforcementModeToString(mode biz.EnforcementMode) string {
+	switch mode {
+	case biz.EnforcementModeSoftOnly:
+		return "SOFT_ONLY"
+	case biz.EnforcementModeOverage:
+		return "OVERAGE"
+	default:
+		return "HARD"
+	}
+}
+
+// overageOf 返回已用量超出硬限制的数量
+func overageOf(usedCount, hardLimit int32) int32 {
+	if usedCount <= hardLimit {
+		return 0
+	}
+	return usedCount - hardLimit
+}
+
+// recordOverage 在事务中按变更后的已用量和硬限制重算OVERAGE模式配额当前周期的超额，
+// 已用量或硬限制变化时都应调用；oldUsed、oldHard为变更前的值，变更前后都没有超额时不写入
+func recordOverage(tx *gorm.DB, model *QuotaModel, oldUsed, oldHard int32) error {
+	if convertEnforcementModeToEnum(model.EnforcementMode) != biz.EnforcementModeOverage {
+		return nil
+	}
+	current := overageOf(model.UsedCount, model.HardLimit)
+	if current == 0 && overageOf(oldUsed, oldHard) == 0 {
+		return nil
+	}
+
+	// 周期为上次重置到下次重置，从未重置的以生效时间开始，都没有时以创建时间开始；总量配额以过期时间结束
+	overage := &QuotaOverageModel{
+		QuotaID:     model.QuotaID,
+		PeriodStart: model.ResetTime,
+		TenantID:    model.TenantID,
+		QuotaType:   model.QuotaType,
+		LimitType:   model.LimitType,
+		PeriodEnd:   model.NextResetTime,
+		Overage:     current,
+	}
+	if overage.PeriodStart.IsZero() {
+		overage.PeriodStart = model.EffectiveTime
+	}
+	if overage.PeriodStart.IsZero() {
+		overage.PeriodStart = model.CreatedAt
+	}
+	if overage.PeriodEnd.IsZero() {
+		overage.PeriodEnd = model.ExpireTime
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "quota_id"}, {Name: "period_start"}},
+		DoUpdates: clause.AssignmentColumns([]string{"overage", "period_end", "updated_at"}),
+	}).Create(overage).Error
+}
+
+// ListOverages 列出计费超额
+func (r *quotaRepo) ListOverages(ctx context.Context, filter *biz.OverageFilter) ([]*biz.QuotaOverage, error) {
+	var models []*QuotaOverageModel
+
+	query := r.data.DB(ctx).Model(&QuotaOverageModel{}).Where("overage > 0")
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.QuotaType != biz.QuotaTypeUnspecified {
+		query = query.Where("quota_type = ?", convertQuotaTypeToString(filter.QuotaType))
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("period_start >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("period_start < ?", filter.EndTime)
+	}
+	if err := query.Order("period_start DESC, tenant_id ASC, quota_id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	overages := make([]*biz.QuotaOverage, 0, len(models))
+	for _, model := range models {
+		overages = append(overages, &biz.QuotaOverage{
+			QuotaID:     model.QuotaID,
+			TenantID:    model.TenantID,
+			QuotaType:   convertQuotaTypeToEnum(model.QuotaType),
+			LimitType:   convertLimitTypeToEnum(model.LimitType),
+			PeriodStart: model.PeriodStart,
+			PeriodEnd:   model.PeriodEnd,
+			Overage:     model.Overage,
+			UpdatedAt:   model.UpdatedAt,
+		})
+	}
+
+	return overages, nil
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"tenant-service/internal/biz"
+)
+
+// newOverageTestQuota 创建租户EN_acme和OVERAGE模式的短信月配额
+func newOverageTestQuota(t *testing.T, d *Data, model *QuotaModel) (*quotaRepo, *QuotaModel) {
+	t.Helper()
+	createTestTenant(t, d, "EN_acme")
+	model.TenantID = "EN_acme"
+	model.EnforcementMode = "OVERAGE"
+	return NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger).(*quotaRepo), createTestQuota(t, d, model)
+}
+
+// loadTestOverages 读取配额各周期的超额
+func loadTestOverages(t *testing.T, d *Data, quotaID int64) []*QuotaOverageModel {
+	t.Helper()
+	var overages []*QuotaOverageModel
+	if err := d.db.Where("quota_id = ?", quotaID).Order("period_start ASC").Find(&overages).Error; err != nil {
+		t.Fatalf("load overages: %v", err)
+	}
+	return overages
+}
+
+func TestOverageFollowsUsageAndLimitChanges(t *testing.T) {
+	d := newTestData(t)
+	repo, quota := newOverageTestQuota(t, d, &QuotaModel{HardLimit: 100, UsedCount: 90})
+	ctx := context.Background()
+	expectOverage := func(step string, want int32) {
+		t.Helper()
+		overages := loadTestOverages(t, d, quota.QuotaID)
+		if len(overages) != 1 || overages[0].Overage != want {
+			t.Fatalf("%s: overages = %+v, want one period with %d", step, overages, want)
+		}
+		if !overages[0].PeriodStart.Equal(quota.ResetTime) {
+			t.Fatalf("%s: period start = %s, want reset time %s", step, overages[0].PeriodStart, quota.ResetTime)
+		}
+	}
+
+	if _, err := repo.ConsumeQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 30, "", "order-1", "order"); err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+	expectOverage("consume", 20)
+
+	if _, err := repo.ReleaseQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 5, "", "order-1"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	expectOverage("release", 15)
+
+	// 提高硬限制冲减超额，降低则增加
+	hardLimit := int32(110)
+	if _, err := repo.AdjustQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, &biz.QuotaAdjustment{HardLimit: &hardLimit}); err != nil {
+		t.Fatalf("adjust hard limit: %v", err)
+	}
+	expectOverage("raise hard limit", 5)
+
+	usedCount := int32(130)
+	if _, err := repo.AdjustQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, &biz.QuotaAdjustment{UsedCount: &usedCount}); err != nil {
+		t.Fatalf("adjust used count: %v", err)
+	}
+	expectOverage("adjust used count", 20)
+
+	// 计划变更按新的硬限制和折算后的已用量重算
+	hardLimit = 125
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		_, err := applyLimitChange(tx, &biz.QuotaChange{
+			TenantID:  "EN_acme",
+			QuotaType: biz.QuotaTypeSMS,
+			LimitType: biz.LimitTypeMonthly,
+			HardLimit: &hardLimit,
+			Proration: biz.ProrationCarryOver,
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("apply scheduled change: %v", err)
+	}
+	expectOverage("scheduled change", 5)
+}
+
+func TestOveragePeriodStartWithoutResetOrEffectiveTime(t *testing.T) {
+	d := newTestData(t)
+	repo, quota := newOverageTestQuota(t, d, &QuotaModel{HardLimit: 10, UsedCount: 10})
+	if err := d.db.Model(&QuotaModel{}).Where("quota_id = ?", quota.QuotaID).
+		UpdateColumns(map[string]interface{}{"reset_time": time.Time{}, "effective_time": time.Time{}}).Error; err != nil {
+		t.Fatalf("clear period times: %v", err)
+	}
+
+	if _, err := repo.ConsumeQuota(context.Background(), "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 3, "", "order-1", "order"); err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+	overages := loadTestOverages(t, d, quota.QuotaID)
+	if len(overages) != 1 || overages[0].Overage != 3 {
+		t.Fatalf("overages = %+v, want one period with 3", overages)
+	}
+	if overages[0].PeriodStart.IsZero() || !overages[0].PeriodStart.Equal(quota.CreatedAt) {
+		t.Fatalf("period start = %s, want quota creation time %s", overages[0].PeriodStart, quota.CreatedAt)
+	}
+}
@@ -0,0 +1,508 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// PlanModel 配额套餐数据模型
+type PlanModel struct {
+	PlanCode    string    `gorm:"column:plan_code;primaryKey"`
+	PlanName    string    `gorm:"column:plan_name;not null"`
+	Description string    `gorm:"column:description"`
+	Version     int32     `gorm:"column:version;not null"`
+	CreatedBy   string    `gorm:"column:created_by"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (PlanModel) TableName() string {
+	return "quota_plans"
+}
+
+// PlanQuotaModel 套餐配额定义数据模型
+type PlanQuotaModel struct {
+	ID           int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	PlanCode     string `gorm:"column:plan_code;not null"`
+	QuotaType    string `gorm:"column:quota_type;not null"`
+	LimitType    string `gorm:"column:limit_type;not null"`
+	HardLimit    int32  `gorm:"column:hard_limit;not null"`
+	SoftLimit    int32  `gorm:"column:soft_limit"`
+	ProductCodes string `gorm:"column:product_codes;type:json"`
+}
+
+// TableName 表名
+func (PlanQuotaModel) TableName() string {
+	return "quota_plan_items"
+}
+
+// PlanEntitlementModel 套餐授权项数据模型
+type PlanEntitlementModel struct {
+	PlanCode       string `gorm:"column:plan_code;primaryKey"`
+	ProductCode    string `gorm:"column:product_code;primaryKey"`
+	EntitlementKey string `gorm:"column:entitlement_key;primaryKey"`
+	Value          string `gorm:"column:value;not null"`
+}
+
+// TableName 表名
+func (PlanEntitlementModel) TableName() string {
+	return "quota_plan_entitlements"
+}
+
+// TenantPlanModel 租户套餐订阅数据模型
+type TenantPlanModel struct {
+	TenantID    string    `gorm:"column:tenant_id;primaryKey"`
+	PlanCode    string    `gorm:"column:plan_code;not null"`
+	PlanVersion int32     `gorm:"column:plan_version;not null"`
+	AssignedBy  string    `gorm:"column:assigned_by"`
+	AssignedAt  time.Time `gorm:"column:assigned_at;not null"`
+}
+
+// TableName 表名
+func (TenantPlanModel) TableName() string {
+	return "tenant_plans"
+}
+
+// QuotaOverrideModel 租户级配额覆盖数据模型
+type QuotaOverrideModel struct {
+	TenantID  string    `gorm:"column:tenant_id;primaryKey"`
+	QuotaType string    `gorm:"column:quota_type;primaryKey"`
+	LimitType string    `gorm:"column:limit_type;primaryKey"`
+	HardLimit *int32    `gorm:"column:hard_limit"`
+	SoftLimit *int32    `gorm:"column:soft_limit"`
+	UpdatedBy string    `gorm:"column:updated_by"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (QuotaOverrideModel) TableName() string {
+	return "tenant_quota_overrides"
+}
+
+// planRepo 套餐仓库实现
+type planRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPlanRepo 创建套餐仓库
+func NewPlanRepo(data *Data, logger log.Logger) biz.PlanRepo {
+	return &planRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// convertPlanModelToBiz 转换套餐数据模型到业务模型
+func convertPlanModelToBiz(model *PlanModel, items []*PlanQuotaModel, entitlements []*PlanEntitlementModel) (*biz.QuotaPlan, error) {
+	plan := &biz.QuotaPlan{
+		PlanCode:    model.PlanCode,
+		PlanName:    model.PlanName,
+		Description: model.Description,
+		Version:     model.Version,
+		CreatedBy:   model.CreatedBy,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	for _, item := range items {
+		var productCodes []string
+		if item.ProductCodes != "" {
+			if err := json.Unmarshal([]byte(item.ProductCodes), &productCodes); err != nil {
+				return nil, err
+			}
+		}
+		plan.Quotas = append(plan.Quotas, &biz.PlanQuota{
+			QuotaType:    convertQuotaTypeToEnum(item.QuotaType),
+			LimitType:    convertLimitTypeToEnum(item.LimitType),
+			HardLimit:    item.HardLimit,
+			SoftLimit:    item.SoftLimit,
+			ProductCodes: productCodes,
+		})
+	}
+	for _, entitlement := range entitlements {
+		plan.Entitlements = append(plan.Entitlements, &biz.PlanEntitlement{
+			Key:         entitlement.EntitlementKey,
+			ProductCode: entitlement.ProductCode,
+			Value:       entitlement.Value,
+		})
+	}
+	return plan, nil
+}
+
+// convertTenantPlanModelToBiz 转换租户套餐订阅数据模型到业务模型
+func convertTenantPlanModelToBiz(model *TenantPlanModel) *biz.TenantPlan {
+	return &biz.TenantPlan{
+		TenantID:    model.TenantID,
+		PlanCode:    model.PlanCode,
+		PlanVersion: model.PlanVersion,
+		AssignedBy:  model.AssignedBy,
+		AssignedAt:  model.AssignedAt,
+	}
+}
+
+// SavePlan 创建或更新套餐
+func (r *planRepo) SavePlan(ctx context.Context, plan *biz.QuotaPlan) (*biz.QuotaPlan, error) {
+	var model PlanModel
+	var items []*PlanQuotaModel
+	var entitlements []*PlanEntitlementModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定套餐，并发更新时版本依次递增
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("plan_code = ?", plan.PlanCode).First(&model).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			model = PlanModel{
+				PlanCode:  plan.PlanCode,
+				PlanName:  plan.PlanName,
+				Version:   1,
+				CreatedBy: plan.CreatedBy,
+			}
+		case err != nil:
+			return err
+		default:
+			model.Version++
+		}
+		model.PlanName = plan.PlanName
+		model.Description = plan.Description
+		if err := tx.Save(&model).Error; err != nil {
+			return err
+		}
+
+		// 替换配额定义
+		if err := tx.Where("plan_code = ?", plan.PlanCode).Delete(&PlanQuotaModel{}).Error; err != nil {
+			return err
+		}
+		for _, quota := range plan.Quotas {
+			productCodesJSON, err := json.Marshal(quota.ProductCodes)
+			if err != nil {
+				return err
+			}
+			items = append(items, &PlanQuotaModel{
+				PlanCode:     plan.PlanCode,
+				QuotaType:    convertQuotaTypeToString(quota.QuotaType),
+				LimitType:    convertLimitTypeToString(quota.LimitType),
+				HardLimit:    quota.HardLimit,
+				SoftLimit:    quota.SoftLimit,
+				ProductCodes: string(productCodesJSON),
+			})
+		}
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return err
+			}
+		}
+
+		// 替换授权项
+		if err := tx.Where("plan_code = ?", plan.PlanCode).Delete(&PlanEntitlementModel{}).Error; err != nil {
+			return err
+		}
+		for _, entitlement := range plan.Entitlements {
+			entitlements = append(entitlements, &PlanEntitlementModel{
+				PlanCode:       plan.PlanCode,
+				ProductCode:    entitlement.ProductCode,
+				EntitlementKey: entitlement.Key,
+				Value:          entitlement.Value,
+			})
+		}
+		if len(entitlements) == 0 {
+			return nil
+		}
+		return tx.Create(&entitlements).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPlanModelToBiz(&model, items, entitlements)
+}
+
+// GetPlan 获取套餐
+func (r *planRepo) GetPlan(ctx context.Context, planCode string) (*biz.QuotaPlan, error) {
+	var model PlanModel
+	err := r.data.DB(ctx).Where("plan_code = ?", planCode).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var items []*PlanQuotaModel
+	if err := r.data.DB(ctx).Where("plan_code = ?", planCode).Order("id ASC").Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	var entitlements []*PlanEntitlementModel
+	err = r.data.DB(ctx).Where("plan_code = ?", planCode).
+		Order("entitlement_key ASC, product_code ASC").Find(&entitlements).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPlanModelToBiz(&model, items, entitlements)
+}
+
+// ListPlans 列出套餐
+func (r *planRepo) ListPlans(ctx context.Context) ([]*biz.QuotaPlan, error) {
+	var models []*PlanModel
+	if err := r.data.DB(ctx).Order("plan_code ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var items []*PlanQuotaModel
+	if err := r.data.DB(ctx).Order("id ASC").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	itemsByPlan := make(map[string][]*PlanQuotaModel)
+	for _, item := range items {
+		itemsByPlan[item.PlanCode] = append(itemsByPlan[item.PlanCode], item)
+	}
+
+	var entitlements []*PlanEntitlementModel
+	if err := r.data.DB(ctx).Order("entitlement_key ASC, product_code ASC").Find(&entitlements).Error; err != nil {
+		return nil, err
+	}
+	entitlementsByPlan := make(map[string][]*PlanEntitlementModel)
+	for _, entitlement := range entitlements {
+		entitlementsByPlan[entitlement.PlanCode] = append(entitlementsByPlan[entitlement.PlanCode], entitlement)
+	}
+
+	plans := make([]*biz.QuotaPlan, 0, len(models))
+	for _, model := range models {
+		plan, err := convertPlanModelToBiz(model, itemsByPlan[model.PlanCode], entitlementsByPlan[model.PlanCode])
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// ListStaleSubscribers 列出应用版本落后的订阅租户
+func (r *planRepo) ListStaleSubscribers(ctx context.Context, planCode string, version int32) ([]string, error) {
+	var tenantIDs []string
+	err := r.data.DB(ctx).Model(&TenantPlanModel{}).
+		Where("plan_code = ? AND plan_version < ?", planCode, version).
+		Order("tenant_id ASC").
+		Pluck("tenant_id", &tenantIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tenantIDs, nil
+}
+
+// GetSubscription 获取租户的套餐订阅
+func (r *planRepo) GetSubscription(ctx context.Context, tenantID string) (*biz.TenantPlan, error) {
+	var model TenantPlanModel
+	err := r.data.DB(ctx).Where("tenant_id = ?", tenantID).First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convertTenantPlanModelToBiz(&model), nil
+}
+
+// ApplyPlan 按套餐创建或替换租户配额
+func (r *planRepo) ApplyPlan(ctx context.Context, assignment *biz.PlanAssignment) (*biz.TenantPlan, []*biz.QuotaInfo, error) {
+	var subscription *TenantPlanModel
+	var applied []*QuotaModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		subscription, applied, err = applyPlan(tx, assignment)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quotas, err := convertQuotaModelsToBiz(applied)
+	if err != nil {
+		return nil, nil, err
+	}
+	return convertTenantPlanModelToBiz(subscription), quotas, nil
+}
+
+// applyPlan 在事务中按套餐和租户级覆盖创建或替换租户配额并记录订阅
+func applyPlan(tx *gorm.DB, assignment *biz.PlanAssignment) (*TenantPlanModel, []*QuotaModel, error) {
+	plan := assignment.Plan
+	var subscription TenantPlanModel
+	var applied []*QuotaModel
+
+	// 锁定订阅，同一租户的套餐应用串行执行
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("tenant_id = ?", assignment.TenantID).First(&subscription).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+
+	if assignment.ClearOverrides {
+		if err := tx.Where("tenant_id = ?", assignment.TenantID).Delete(&QuotaOverrideModel{}).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+	var overrideModels []*QuotaOverrideModel
+	if err := tx.Where("tenant_id = ?", assignment.TenantID).Find(&overrideModels).Error; err != nil {
+		return nil, nil, err
+	}
+	overrides := make([]*biz.QuotaOverride, 0, len(overrideModels))
+	for _, model := range overrideModels {
+		overrides = append(overrides, &biz.QuotaOverride{
+			TenantID:  model.TenantID,
+			QuotaType: convertQuotaTypeToEnum(model.QuotaType),
+			LimitType: convertLimitTypeToEnum(model.LimitType),
+			HardLimit: model.HardLimit,
+			SoftLimit: model.SoftLimit,
+		})
+	}
+	desired, err := plan.Resolve(assignment.TenantID, overrides)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var existing []*QuotaModel
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND is_global = ?", assignment.TenantID, false).Find(&existing).Error
+	if err != nil {
+		return nil, nil, err
+	}
+	existingByKey := make(map[string]*QuotaModel, len(existing))
+	for _, model := range existing {
+		existingByKey[model.QuotaType+"/"+model.LimitType] = model
+	}
+
+	now := time.Now()
+	remark := fmt.Sprintf("apply plan %s v%d, proration %s", plan.PlanCode, plan.Version, assignment.Proration)
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, quota := range desired {
+		productCodesJSON, err := json.Marshal(quota.ProductCodes)
+		if err != nil {
+			return nil, nil, err
+		}
+		key := convertQuotaTypeToString(quota.QuotaType) + "/" + convertLimitTypeToString(quota.LimitType)
+		desiredKeys[key] = true
+
+		// 已有配额（含单独配置的）由套餐接管，硬限制变化时按折算策略处理已用量
+		model, ok := existingByKey[key]
+		if !ok {
+			model = &QuotaModel{
+				TenantID:      assignment.TenantID,
+				QuotaType:     convertQuotaTypeToString(quota.QuotaType),
+				LimitType:     convertLimitTypeToString(quota.LimitType),
+				NextResetTime: biz.NextResetTime(quota.LimitType, now),
+				EffectiveTime: now,
+				CreatedBy:     assignment.Operator,
+			}
+		}
+		changed := !ok || model.HardLimit != quota.HardLimit || model.SoftLimit != quota.SoftLimit ||
+			model.ProductCodes != string(productCodesJSON) || model.PlanCode != plan.PlanCode
+		if !changed {
+			applied = append(applied, model)
+			continue
+		}
+		if ok {
+			if err := foldShards(tx, model); err != nil {
+				return nil, nil, err
+			}
+		}
+		oldUsed, oldHard := model.UsedCount, model.HardLimit
+		if ok {
+			model.UsedCount = assignment.Proration.Apply(model.UsedCount, oldHard, quota.HardLimit)
+		}
+		model.HardLimit = quota.HardLimit
+		model.SoftLimit = quota.SoftLimit
+		model.ProductCodes = string(productCodesJSON)
+		model.PlanCode = plan.PlanCode
+		if err := tx.Save(model).Error; err != nil {
+			return nil, nil, err
+		}
+		if err := recordOverage(tx, model, oldUsed, oldHard); err != nil {
+			return nil, nil, err
+		}
+
+		// 记录调整操作
+		usageRecord := &QuotaUsageModel{
+			QuotaID:       model.QuotaID,
+			TenantID:      model.TenantID,
+			OperationType: convertOperationTypeToString(biz.OperationTypeAdjust),
+			DeltaValue:    model.UsedCount - oldUsed,
+			CurrentUsed:   model.UsedCount,
+			Operator:      assignment.Operator,
+			Remark:        remark,
+		}
+		if err := tx.Create(usageRecord).Error; err != nil {
+			return nil, nil, err
+		}
+		applied = append(applied, model)
+	}
+
+	// 套餐不再包含的套餐管理配额删除，单独配置的配额保留
+	for key, model := range existingByKey {
+		if model.PlanCode == "" || desiredKeys[key] {
+			continue
+		}
+		if err := tx.Delete(model).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+
+	subscription.TenantID = assignment.TenantID
+	subscription.PlanCode = plan.PlanCode
+	subscription.PlanVersion = plan.Version
+	subscription.AssignedBy = assignment.Operator
+	subscription.AssignedAt = now
+	if err := tx.Save(&subscription).Error; err != nil {
+		return nil, nil, err
+	}
+	return &subscription, applied, nil
+}
+
+// convertQuotaModelsToBiz 批量转换配额数据模型到业务模型
+func convertQuotaModelsToBiz(models []*QuotaModel) ([]*biz.QuotaInfo, error) {
+	quotas := make([]*biz.QuotaInfo, 0, len(models))
+	for _, model := range models {
+		quota, err := convertQuotaModelToBiz(model)
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas, nil
+}
+
+// saveQuotaOverride 将套餐管理配额的限制调整记录为租户级覆盖，只更新调整中指定的字段
+func saveQuotaOverride(tx *gorm.DB, quota *QuotaModel, adjustment *biz.QuotaAdjustment) error {
+	override := QuotaOverrideModel{
+		TenantID:  quota.TenantID,
+		QuotaType: quota.QuotaType,
+		LimitType: quota.LimitType,
+	}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND quota_type = ? AND limit_type = ?", quota.TenantID, quota.QuotaType, quota.LimitType).
+		First(&override).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if adjustment.HardLimit != nil {
+		override.HardLimit = adjustment.HardLimit
+	}
+	if adjustment.SoftLimit != nil {
+		override.SoftLimit = adjustment.SoftLimit
+	}
+	override.UpdatedBy = adjustment.Operator
+	return tx.Save(&override).Error
+}
@@ -70,7 +70,7 @@ func (r *productRepo) CreateProduct(ctx context.Context, product *biz.Product) (
 	}
 
 	// 创建产品记录
-	if err := r.data.db.Create(model).Error; err != nil {
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,7 @@ func (r *productRepo) CreateProduct(ctx context.Context, product *biz.Product) (
 // GetProduct 获取产品
 func (r *productRepo) GetProduct(ctx context.Context, code string) (*biz.Product, error) {
 	var model ProductModel
-	err := r.data.db.Where("product_code = ?", code).First(&model).Error
+	err := r.data.DB(ctx).Where("product_code = ?", code).First(&model).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -95,7 +95,7 @@ func (r *productRepo) GetProduct(ctx context.Context, code string) (*biz.Product
 func (r *productRepo) UpdateProduct(ctx context.Context, product *biz.Product) (*biz.Product, error) {
 	// 查询产品是否存在
 	var model ProductModel
-	err := r.data.db.Where("product_code = ?", product.ProductCode).First(&model).Error
+	err := r.data.DB(ctx).Where("product_code = ?", product.ProductCode).First(&model).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("product not found: %s", product.ProductCode)
@@ -107,7 +107,7 @@ func (r *productRepo) UpdateProduct(ctx context.Context, product *biz.Product) (
 	model.ProductName = product.ProductName
 	model.Description = product.Description
 
-	err = r.data.db.Save(&model).Error
+	err = r.data.DB(ctx).Save(&model).Error
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +118,7 @@ func (r *productRepo) UpdateProduct(ctx context.Context, product *biz.Product) (
 // DeleteProduct 删除产品
 func (r *productRepo) DeleteProduct(ctx context.Context, code string) error {
 	// 开启事务
-	return r.data.db.Transaction(func(tx *gorm.DB) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		// 删除租户产品关联
 		if err := tx.Where("product_code = ?", code).Delete(&TenantProductModel{}).Error; err != nil {
 			return err
@@ -138,7 +138,7 @@ func (r *productRepo) ListProducts(ctx context.Context) ([]*biz.Product, error)
 	var models []*ProductModel
 
 	// 查询产品列表
-	if err := r.data.db.Find(&models).Error; err != nil {
+	if err := r.data.DB(ctx).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
@@ -156,7 +156,7 @@ func (r *productRepo) ListProductsByTenant(ctx context.Context, tenantID string)
 	var products []*biz.Product
 
 	// 查询租户关联的产品
-	err := r.data.db.Raw(
+	err := r.data.DB(ctx).Raw(
 		`SELECT p.product_code, p.product_name, p.description 
 		FROM products p 
 		JOIN tenant_products tp ON p.product_code = tp.product_code 
@@ -175,7 +175,7 @@ func (r *productRepo) ListProductsByTenant(ctx context.Context, tenantID string)
 func (r *productRepo) AssociateProductToTenant(ctx context.Context, tenantID, productCode string) error {
 	// 检查产品是否存在
 	var productCount int64
-	err := r.data.db.Model(&ProductModel{}).Where("product_code = ?", productCode).Count(&productCount).Error
+	err := r.data.DB(ctx).Model(&ProductModel{}).Where("product_code = ?", productCode).Count(&productCount).Error
 	if err != nil {
 		return err
 	}
@@ -186,7 +186,7 @@ func (r *productRepo) AssociateProductToTenant(ctx context.Context, tenantID, pr
 
 	// 检查租户是否存在
 	var tenantCount int64
-	err = r.data.db.Model(&TenantModel{}).Where("tenant_id = ?", tenantID).Count(&tenantCount).Error
+	err = r.data.DB(ctx).Model(&TenantModel{}).Where("tenant_id = ?", tenantID).Count(&tenantCount).Error
 	if err != nil {
 		return err
 	}
@@ -203,7 +203,7 @@ func (r *productRepo) AssociateProductToTenant(ctx context.Context, tenantID, pr
 
 	// 检查是否已存在关联
 	var count int64
-	err = r.data.db.Model(&TenantProductModel{}).Where("tenant_id = ? AND product_code = ?", tenantID, productCode).Count(&count).Error
+	err = r.data.DB(ctx).Model(&TenantProductModel{}).Where("tenant_id = ? AND product_code = ?", tenantID, productCode).Count(&count).Error
 	if err != nil {
 		return err
 	}
@@ -212,10 +212,10 @@ func (r *productRepo) AssociateProductToTenant(ctx context.Context, tenantID, pr
 		return nil // 已存在关联，不需要重复创建
 	}
 
-	return r.data.db.Create(association).Error
+	return r.data.DB(ctx).Create(association).Error
 }
 
 // DisassociateProductFromTenant 解除产品与租户的关联
 func (r *productRepo) DisassociateProductFromTenant(ctx context.Context, tenantID, productCode string) error {
-	return r.data.db.Where("tenant_id = ? AND product_code = ?", tenantID, productCode).Delete(&TenantProductModel{}).Error
+	return r.data.DB(ctx).Where("tenant_id = ? AND product_code = ?", tenantID, productCode).Delete(&TenantProductModel{}).Error
 }
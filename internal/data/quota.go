@@ -3,7 +3,9 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -28,9 +30,22 @@ type QuotaModel struct {
 	IsGlobal      bool      `gorm:"column:is_global;default:0;index"`
 	ProductCodes  string    `gorm:"column:product_codes;type:json"`
 	ExtraConfig   string    `gorm:"column:extra_config;type:json"`
+	PlanCode      string    `gorm:"column:plan_code"`
 	CreatedBy     string    `gorm:"column:created_by"`
 	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	// 结转额度
+	RolloverGranted    int32     `gorm:"column:rollover_granted;default:0"`
+	RolloverUsed       int32     `gorm:"column:rollover_used;default:0"`
+	RolloverExpireTime time.Time `gorm:"column:rollover_expire_time"`
+
+	// 超额
+	EnforcementMode string `gorm:"column:enforcement_mode;default:HARD"`
+	MaxOverage      int32  `gorm:"column:max_overage;default:0"`
+
+	// 产品线划分额度已用量，JSON对象：产品线 -> 已用量
+	AllocationUsed string `gorm:"column:allocation_used"`
 }
 
 // TableName 表名
@@ -52,6 +67,11 @@ type QuotaUsageModel struct {
 	OperationTime time.Time `gorm:"column:operation_time;autoCreateTime;index"`
 	ExpireTime    time.Time `gorm:"column:expire_time"`
 	Remark        string    `gorm:"column:remark"`
+
+	// 哈希链，链序号由定序任务在写入提交后分配，分配前为NULL
+	ChainSeq   *int64 `gorm:"column:chain_seq"`
+	PrevHash   string `gorm:"column:prev_hash"`
+	RecordHash string `gorm:"column:record_hash"`
 }
 
 // TableName 表名
@@ -61,15 +81,17 @@ func (QuotaUsageModel) TableName() string {
 
 // quotaRepo 配额仓库实现
 type quotaRepo struct {
-	data *Data
-	log  *log.Helper
+	data    *Data
+	metrics biz.QuotaMetrics
+	log     *log.Helper
 }
 
 // NewQuotaRepo 创建配额仓库
-func NewQuotaRepo(data *Data, logger log.Logger) biz.QuotaRepo {
+func NewQuotaRepo(data *Data, metrics biz.QuotaMetrics, logger log.Logger) biz.QuotaRepo {
 	return &quotaRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:    data,
+		metrics: metrics,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -161,8 +183,8 @@ func convertOperationTypeToString(operationType biz.OperationType) string {
 	}
 }
 
-// convertModelToBiz 转换数据模型到业务模型
-func (r *quotaRepo) convertModelToBiz(model *QuotaModel) (*biz.QuotaInfo, error) {
+// convertQuotaModelToBiz 转换配额数据模型到业务模型
+func convertQuotaModelToBiz(model *QuotaModel) (*biz.QuotaInfo, error) {
 	if model == nil {
 		return nil, nil
 	}
@@ -175,6 +197,11 @@ func (r *quotaRepo) convertModelToBiz(model *QuotaModel) (*biz.QuotaInfo, error)
 		}
 	}
 
+	var allocations []*biz.QuotaAllocation
+	if limits, used := quotaAllocations(model); len(limits) > 0 {
+		allocations = biz.BuildAllocations(limits, used)
+	}
+
 	return &biz.QuotaInfo{
 		QuotaID:       model.QuotaID,
 		TenantID:      model.TenantID,
@@ -190,6 +217,18 @@ func (r *quotaRepo) convertModelToBiz(model *QuotaModel) (*biz.QuotaInfo, error)
 		IsGlobal:      model.IsGlobal,
 		ProductCodes:  productCodes,
 		ExtraConfig:   model.ExtraConfig,
+		PlanCode:      model.PlanCode,
+
+		RolloverGranted:    model.RolloverGranted,
+		RolloverUsed:       model.RolloverUsed,
+		RolloverExpireTime: model.RolloverExpireTime,
+
+		EnforcementMode: convertEnforcementModeToEnum(model.EnforcementMode),
+		MaxOverage:      model.MaxOverage,
+
+		Allocations: allocations,
+
+		Shards: quotaShards(model),
 	}, nil
 }
 
@@ -217,97 +256,37 @@ func (r *quotaRepo) CreateQuota(ctx context.Context, quota *biz.QuotaInfo) (*biz
 		ProductCodes:  string(productCodesJSON),
 		ExtraConfig:   quota.ExtraConfig,
 	}
+	if err := checkQuotaAllocations(model); err != nil {
+		return nil, err
+	}
 
 	// 创建配额记录
-	if err := r.data.db.Create(model).Error; err != nil {
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
 		return nil, err
 	}
 
-	return r.convertModelToBiz(model)
+	return convertQuotaModelToBiz(model)
 }
 
-// GetQuota 获取配额
+// GetQuota 获取配额，按租户、上级租户、全局的顺序解析
 func (r *quotaRepo) GetQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, productCode string) (*biz.QuotaInfo, error) {
-	var model QuotaModel
-
-	// 构建查询条件
-	query := r.data.db.Where("tenant_id = ? AND quota_type = ? AND limit_type = ?",
-		tenantID, convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType))
-
-	// 如果指定了产品代码，则添加产品代码条件
-	if productCode != "" {
-		query = query.Where("JSON_CONTAINS(product_codes, ?)", fmt.Sprintf("\"%s\"", productCode))
-	}
-
-	// 查询配额
-	err := query.First(&model).Error
+	resolution, err := resolveQuota(r.data.DB(ctx), tenantID, quotaType, limitType, productCode)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			// 尝试查找全局默认配额
-			err = r.data.db.Where("is_global = ? AND quota_type = ? AND limit_type = ?",
-				true, convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType)).First(&model).Error
-			if err != nil {
-				if err == gorm.ErrRecordNotFound {
-					return nil, nil
-				}
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
-	}
-
-	return r.convertModelToBiz(&model)
-}
-
-// UpdateQuota 更新配额
-func (r *quotaRepo) UpdateQuota(ctx context.Context, quota *biz.QuotaInfo) (*biz.QuotaInfo, error) {
-	// 查询配额是否存在
-	var model QuotaModel
-	err := r.data.db.Where("quota_id = ?", quota.QuotaID).First(&model).Error
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("quota not found: %d", quota.QuotaID)
-		}
 		return nil, err
 	}
-
-	// 序列化产品代码为JSON
-	productCodesJSON, err := json.Marshal(quota.ProductCodes)
-	if err != nil {
-		return nil, err
-	}
-
-	// 更新配额信息
-	model.HardLimit = quota.HardLimit
-	model.SoftLimit = quota.SoftLimit
-	model.UsedCount = quota.UsedCount
-	model.ResetTime = quota.ResetTime
-	model.NextResetTime = quota.NextResetTime
-	model.EffectiveTime = quota.EffectiveTime
-	model.ExpireTime = quota.ExpireTime
-	model.IsGlobal = quota.IsGlobal
-	model.ProductCodes = string(productCodesJSON)
-	model.ExtraConfig = quota.ExtraConfig
-
-	err = r.data.db.Save(&model).Error
-	if err != nil {
-		return nil, err
-	}
-
-	return r.convertModelToBiz(&model)
+	return resolution.Selected, nil
 }
 
 // DeleteQuota 删除配额
 func (r *quotaRepo) DeleteQuota(ctx context.Context, quotaID int64) error {
-	return r.data.db.Where("quota_id = ?", quotaID).Delete(&QuotaModel{}).Error
+	return r.data.DB(ctx).Where("quota_id = ?", quotaID).Delete(&QuotaModel{}).Error
 }
 
 // ListQuotas 列出配额
 func (r *quotaRepo) ListQuotas(ctx context.Context, tenantID string, quotaType biz.QuotaType) ([]*biz.QuotaInfo, error) {
 	var models []*QuotaModel
 
-	query := r.data.db.Model(&QuotaModel{})
+	query := r.data.DB(ctx).Model(&QuotaModel{})
 
 	// 添加查询条件
 	if tenantID != "" {
@@ -326,63 +305,96 @@ func (r *quotaRepo) ListQuotas(ctx context.Context, tenantID string, quotaType b
 	// 转换为业务模型
 	quotas := make([]*biz.QuotaInfo, 0, len(models))
 	for _, model := range models {
-		quota, err := r.convertModelToBiz(model)
+		quota, err := convertQuotaModelToBiz(model)
 		if err != nil {
 			return nil, err
 		}
 		quotas = append(quotas, quota)
 	}
+	if err := fillShardUsage(r.data.DB(ctx), quotas); err != nil {
+		return nil, err
+	}
 
 	return quotas, nil
 }
 
-// ConsumeQuota 消费配额
-func (r *quotaRepo) ConsumeQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, amount int32, productCode, bizID, bizType string) (bool, int32, error) {
-	// 开启事务
-	var success bool
-	var remainingQuota int32
+// ConsumeQuota 消费配额，分片计数的配额扣减分片，其余先尝试条件更新，不满足快速路径条件或更新未命中时回退到行锁
+func (r *quotaRepo) ConsumeQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, amount int32, productCode, bizID, bizType string) (*biz.QuotaInfo, error) {
+	resolution, err := resolveQuota(r.data.DB(ctx), tenantID, quotaType, limitType, productCode)
+	if err != nil {
+		return nil, err
+	}
+	var quota *biz.QuotaInfo
+	switch selected := resolution.Selected; {
+	case selected != nil && selected.Shards > 0:
+		quota, err = r.consumeSharded(ctx, selected, tenantID, amount, bizID, bizType)
+	case selected != nil && optimisticEligible(selected):
+		quota, err = r.consumeOptimistic(ctx, selected, tenantID, amount, bizID, bizType)
+	}
+	if err != nil || quota != nil {
+		return quota, err
+	}
+	return r.consumeLocked(ctx, tenantID, quotaType, limitType, amount, productCode, bizID, bizType)
+}
 
-	err := r.data.db.Transaction(func(tx *gorm.DB) error {
-		// 查询配额并锁定
-		var model QuotaModel
-		query := tx.Where("tenant_id = ? AND quota_type = ? AND limit_type = ?",
-			tenantID, convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType)).Clauses(clause.Locking{Strength: "UPDATE"})
+// consumeLocked 锁定配额行后消费，处理产品线划分额度、结转额度和各执行模式
+func (r *quotaRepo) consumeLocked(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, amount int32, productCode, bizID, bizType string) (*biz.QuotaInfo, error) {
+	var model QuotaModel
 
-		// 如果指定了产品代码，则添加产品代码条件
-		if productCode != "" {
-			query = query.Where("JSON_CONTAINS(product_codes, ?)", fmt.Sprintf("\"%s\"", productCode))
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 解析配额并锁定，记录锁等待时间
+		lockStart := time.Now()
+		if err := lockResolvedQuota(tx, tenantID, quotaType, limitType, productCode, &model); err != nil {
+			return err
+		}
+		r.metrics.LockWait(ctx, quotaType, limitType, time.Since(lockStart))
+		if err := foldShards(tx, &model); err != nil {
+			return err
 		}
 
-		// 查询配额
-		err := query.First(&model).Error
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				// 尝试查找全局默认配额
-				err = tx.Where("is_global = ? AND quota_type = ? AND limit_type = ?",
-					true, convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType)).Clauses(clause.Locking{Strength: "UPDATE"}).First(&model).Error
-				if err != nil {
-					if err == gorm.ErrRecordNotFound {
-						success = false
-						remainingQuota = 0
-						return fmt.Errorf("quota not found")
-					}
-					return err
-				}
-			} else {
-				return err
+		// 产品线优先使用自己的划分额度，其余部分先用结转额度，不足部分计入共享额度
+		limits, allocUsed := quotaAllocations(&model)
+		fromAllocation := int32(0)
+		if limit, ok := limits[productCode]; ok && productCode != "" && allocUsed[productCode] < limit {
+			fromAllocation = limit - allocUsed[productCode]
+			if fromAllocation > amount {
+				fromAllocation = amount
 			}
 		}
+		fromRollover := biz.RolloverRemaining(model.RolloverGranted, model.RolloverUsed, model.RolloverExpireTime, time.Now())
+		if fromRollover > amount-fromAllocation {
+			fromRollover = amount - fromAllocation
+		}
 
-		// 检查配额是否足够
-		if model.UsedCount+amount > model.HardLimit {
-			success = false
-			remainingQuota = model.HardLimit - model.UsedCount
-			return fmt.Errorf("quota exceeded: %d/%d", model.UsedCount, model.HardLimit)
+		// 按执行模式检查配额是否足够，HARD模式下共享部分不能超出共享额度
+		oldUsed := model.UsedCount
+		newUsed := model.UsedCount + amount - fromRollover
+		switch convertEnforcementModeToEnum(model.EnforcementMode) {
+		case biz.EnforcementModeSoftOnly:
+		case biz.EnforcementModeOverage:
+			if model.MaxOverage > 0 && newUsed > model.HardLimit+model.MaxOverage {
+				return biz.ErrQuotaExceeded
+			}
+		default:
+			sharedUsed, sharedLimit := sharedUsage(&model, limits, allocUsed)
+			if sharedUsed+amount-fromAllocation-fromRollover > sharedLimit {
+				return biz.ErrQuotaExceeded
+			}
 		}
 
 		// 更新使用量
-		model.UsedCount += amount
-		if err := tx.Save(&model).Error; err != nil {
+		model.RolloverUsed += fromRollover
+		model.UsedCount = newUsed
+		if fromAllocation > 0 {
+			allocUsed[productCode] += fromAllocation
+			if err := setAllocationUsed(&model, limits, allocUsed); err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&model).Select("used_count", "rollover_used", "allocation_used", "updated_at").Updates(&model).Error; err != nil {
+			return err
+		}
+		if err := recordOverage(tx, &model, oldUsed, model.HardLimit); err != nil {
 			return err
 		}
 
@@ -396,50 +408,67 @@ func (r *quotaRepo) ConsumeQuota(ctx context.Context, tenantID string, quotaType
 			BizID:         bizID,
 			BizType:       bizType,
 		}
-
-		if err := tx.Create(usageRecord).Error; err != nil {
-			return err
+		var remarks []string
+		if fromAllocation > 0 {
+			remarks = append(remarks, fmt.Sprintf("allocation %s %d", productCode, fromAllocation))
+		}
+		if fromRollover > 0 {
+			remarks = append(remarks, fmt.Sprintf("rollover %d", fromRollover))
 		}
+		usageRecord.Remark = strings.Join(remarks, ", ")
 
-		success = true
-		remainingQuota = model.HardLimit - model.UsedCount
-		return nil
+		return tx.Create(usageRecord).Error
 	})
+	if err != nil {
+		// 配额不足时返回当前配额，便于调用方计算剩余量
+		if errors.Is(err, biz.ErrQuotaExceeded) {
+			quota, convErr := convertQuotaModelToBiz(&model)
+			if convErr != nil {
+				return nil, convErr
+			}
+			return quota, err
+		}
+		return nil, err
+	}
 
-	return success, remainingQuota, err
+	return convertQuotaModelToBiz(&model)
 }
 
 // ReleaseQuota 释放配额
-func (r *quotaRepo) ReleaseQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, amount int32, productCode, bizID string) (bool, int32, error) {
-	// 开启事务
-	var success bool
-	var remainingQuota int32
-
-	err := r.data.db.Transaction(func(tx *gorm.DB) error {
-		// 查询配额并锁定
-		var model QuotaModel
-		query := tx.Where("tenant_id = ? AND quota_type = ? AND limit_type = ?",
-			tenantID, convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType)).Clauses(clause.Locking{Strength: "UPDATE"})
+func (r *quotaRepo) ReleaseQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, amount int32, productCode, bizID string) (*biz.QuotaInfo, error) {
+	var model QuotaModel
 
-		// 如果指定了产品代码，则添加产品代码条件
-		if productCode != "" {
-			query = query.Where("JSON_CONTAINS(product_codes, ?)", fmt.Sprintf("\"%s\"", productCode))
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 解析配额并锁定，与消费使用同一解析顺序
+		if err := lockResolvedQuota(tx, tenantID, quotaType, limitType, productCode, &model); err != nil {
+			return err
 		}
-
-		// 查询配额
-		err := query.First(&model).Error
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				success = false
-				remainingQuota = 0
-				return fmt.Errorf("quota not found")
-			}
+		// 分片计数的配额合并分片后释放，分片在下次消费时重建
+		if err := foldShards(tx, &model); err != nil {
 			return err
 		}
 
-		// 更新使用量（不能小于0）
+		// 更新使用量（不能小于0），先退回共享额度，再退回产品线划分额度，已用量不足时退回结转额度
+		oldUsed := model.UsedCount
+		limits, allocUsed := quotaAllocations(&model)
+		sharedUsed, _ := sharedUsage(&model, limits, allocUsed)
+		if toAllocation := amount - sharedUsed; toAllocation > 0 && allocUsed[productCode] > 0 {
+			if toAllocation > allocUsed[productCode] {
+				toAllocation = allocUsed[productCode]
+			}
+			allocUsed[productCode] -= toAllocation
+			if err := setAllocationUsed(&model, limits, allocUsed); err != nil {
+				return err
+			}
+		}
 		if model.UsedCount < amount {
+			returned := amount - model.UsedCount
 			model.UsedCount = 0
+			if model.RolloverUsed < returned {
+				model.RolloverUsed = 0
+			} else {
+				model.RolloverUsed -= returned
+			}
 		} else {
 			model.UsedCount -= amount
 		}
@@ -447,6 +476,9 @@ func (r *quotaRepo) ReleaseQuota(ctx context.Context, tenantID string, quotaType
 		if err := tx.Save(&model).Error; err != nil {
 			return err
 		}
+		if err := recordOverage(tx, &model, oldUsed, model.HardLimit); err != nil {
+			return err
+		}
 
 		// 记录使用记录
 		usageRecord := &QuotaUsageModel{
@@ -458,63 +490,287 @@ func (r *quotaRepo) ReleaseQuota(ctx context.Context, tenantID string, quotaType
 			BizID:         bizID,
 		}
 
-		if err := tx.Create(usageRecord).Error; err != nil {
-			return err
-		}
-
-		success = true
-		remainingQuota = model.HardLimit - model.UsedCount
-		return nil
+		return tx.Create(usageRecord).Error
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return success, remainingQuota, err
+	return convertQuotaModelToBiz(&model)
 }
 
-// ResetQuotas 重置配额
-func (r *quotaRepo) ResetQuotas(ctx context.Context, limitType biz.LimitType) error {
-	// 查询需要重置的配额
-	var models []*QuotaModel
-	err := r.data.db.Where("limit_type = ? AND next_reset_time <= ?",
-		convertLimitTypeToString(limitType), time.Now()).Find(&models).Error
+// ResetQuotas 重置到期的配额，每个配额在单独的事务中锁定并重新确认到期后重置，多个副本同时执行时只有一个副本重置
+func (r *quotaRepo) ResetQuotas(ctx context.Context, limitType biz.LimitType) (*biz.QuotaResetResult, error) {
+	// 查询到期的配额，只作为候选，重置前加锁重新确认
+	var due []*QuotaModel
+	err := r.data.DB(ctx).Select("quota_id", "next_reset_time").Where("limit_type = ? AND next_reset_time <= ?",
+		convertLimitTypeToString(limitType), time.Now()).Order("quota_id ASC").Find(&due).Error
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// 开启事务
-	return r.data.db.Transaction(func(tx *gorm.DB) error {
-		for _, model := range models {
-			// 重置使用量
-			model.UsedCount = 0
-			model.ResetTime = time.Now()
-
-			// 计算下次重置时间
-			switch limitType {
-			case biz.LimitTypeDaily:
-				model.NextResetTime = time.Now().AddDate(0, 0, 1)
-			case biz.LimitTypeMonthly:
-				model.NextResetTime = time.Now().AddDate(0, 1, 0)
+	result := &biz.QuotaResetResult{}
+	for _, model := range due {
+		if result.OldestDue.IsZero() || model.NextResetTime.Before(result.OldestDue) {
+			result.OldestDue = model.NextResetTime
+		}
+	}
+
+	for _, candidate := range due {
+		model, err := r.resetQuota(ctx, candidate.QuotaID, limitType)
+		if err != nil {
+			return nil, err
+		}
+		if model == nil {
+			continue
+		}
+		quota, err := convertQuotaModelToBiz(model)
+		if err != nil {
+			return nil, err
+		}
+		result.Quotas = append(result.Quotas, quota)
+	}
+	return result, nil
+}
+
+// resetQuota 锁定配额行，仍然到期时清零使用量、计算结转额度并写入重置记录，只更新重置相关的列；
+// 配额已不存在或已被其他副本重置时返回nil
+func (r *quotaRepo) resetQuota(ctx context.Context, quotaID int64, limitType biz.LimitType) (*QuotaModel, error) {
+	var model QuotaModel
+	reset := false
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", quotaID).First(&model).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
 			}
+			return err
+		}
+		now := time.Now()
+		if model.NextResetTime.After(now) {
+			return nil
+		}
+		if err := foldShards(tx, &model); err != nil {
+			return err
+		}
 
-			// 保存更新
-			if err := tx.Save(model).Error; err != nil {
-				return err
+		// 重置使用量
+		usedCount := model.UsedCount
+		model.UsedCount = 0
+		model.AllocationUsed = ""
+		model.ResetTime = now
+
+		// 计算下次重置时间
+		switch limitType {
+		case biz.LimitTypeDaily:
+			model.NextResetTime = now.AddDate(0, 0, 1)
+		case biz.LimitTypeMonthly:
+			model.NextResetTime = now.AddDate(0, 1, 0)
+		}
+
+		// 按结转规则将本周期未用完的基础额度结转到下一周期，已用量取加锁并合并分片后的值，
+		// 扫描后的并发消费都计入；上一周期的结转额度不再结转
+		remark := fmt.Sprintf("Scheduled reset for %s quota", convertLimitTypeToString(limitType))
+		model.RolloverGranted, model.RolloverUsed, model.RolloverExpireTime = 0, 0, time.Time{}
+		config, err := biz.ParseQuotaExtraConfig(limitType, model.ExtraConfig)
+		if err != nil {
+			r.log.WithContext(ctx).Warnf("quota %d has invalid extra_config, skip rollover: %v", model.QuotaID, err)
+		} else if config.Rollover != nil {
+			model.RolloverGranted = config.Rollover.Grant(model.HardLimit, usedCount)
+			if model.RolloverGranted > 0 {
+				model.RolloverExpireTime = config.Rollover.ExpireTime(model.ResetTime, model.NextResetTime)
+				remark += fmt.Sprintf(", rollover %d until %s", model.RolloverGranted, model.RolloverExpireTime.Format(time.RFC3339))
+			}
+		}
+
+		// 只更新重置相关的列，不覆盖其他字段
+		model.UpdatedAt = now
+		if err := tx.Model(&model).UpdateColumns(map[string]interface{}{
+			"used_count":           model.UsedCount,
+			"allocation_used":      model.AllocationUsed,
+			"reset_time":           model.ResetTime,
+			"next_reset_time":      model.NextResetTime,
+			"rollover_granted":     model.RolloverGranted,
+			"rollover_used":        model.RolloverUsed,
+			"rollover_expire_time": model.RolloverExpireTime,
+			"updated_at":           model.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		// 记录重置操作
+		reset = true
+		return tx.Create(&QuotaUsageModel{
+			QuotaID:       model.QuotaID,
+			TenantID:      model.TenantID,
+			OperationType: "RESET",
+			DeltaValue:    -usedCount, // 负数表示重置
+			CurrentUsed:   0,
+			Remark:        remark,
+		}).Error
+	})
+	if err != nil || !reset {
+		return nil, err
+	}
+	return &model, nil
+}
+
+// AdjustQuota 调整配额
+func (r *quotaRepo) AdjustQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, adjustment *biz.QuotaAdjustment) (*biz.QuotaInfo, error) {
+	var model QuotaModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 查询配额并锁定
+		err := tx.Where("tenant_id = ? AND quota_type = ? AND limit_type = ?",
+			tenantID, convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType)).Clauses(clause.Locking{Strength: "UPDATE"}).First(&model).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrQuotaNotFound
 			}
+			return err
+		}
+		// 分片计数的配额先合并分片，调整后按新的硬限制和分片数重建
+		if err := foldShards(tx, &model); err != nil {
+			return err
+		}
 
-			// 记录重置操作
-			usageRecord := &QuotaUsageModel{
-				QuotaID:       model.QuotaID,
-				TenantID:      model.TenantID,
-				OperationType: "RESET",
-				DeltaValue:    -model.UsedCount, // 负数表示重置
-				CurrentUsed:   0,
-				Remark:        fmt.Sprintf("Scheduled reset for %s quota", convertLimitTypeToString(limitType)),
+		// 更新配额
+		oldUsed, oldHard := model.UsedCount, model.HardLimit
+		if adjustment.HardLimit != nil {
+			model.HardLimit = *adjustment.HardLimit
+		}
+		if adjustment.SoftLimit != nil {
+			model.SoftLimit = *adjustment.SoftLimit
+		}
+		if adjustment.UsedCount != nil {
+			model.UsedCount = *adjustment.UsedCount
+			if *adjustment.UsedCount == 0 {
+				model.AllocationUsed = ""
+				model.ResetTime = time.Now()
+			}
+		}
+		if adjustment.ExtraConfig != nil {
+			model.ExtraConfig = *adjustment.ExtraConfig
+		}
+		if adjustment.EnforcementMode != nil {
+			model.EnforcementMode = convertEnforcementModeToString(*adjustment.EnforcementMode)
+		}
+		if adjustment.MaxOverage != nil {
+			model.MaxOverage = *adjustment.MaxOverage
+		}
+		if adjustment.EffectiveTime != nil {
+			model.EffectiveTime = *adjustment.EffectiveTime
+		}
+		if adjustment.ExpireTime != nil {
+			model.ExpireTime = *adjustment.ExpireTime
+		}
+		if adjustment.ProductCodes != nil {
+			productCodesJSON, err := json.Marshal(adjustment.ProductCodes)
+			if err != nil {
+				return err
 			}
+			model.ProductCodes = string(productCodesJSON)
+		}
+		if model.SoftLimit > model.HardLimit {
+			return fmt.Errorf("soft limit %d exceeds hard limit %d", model.SoftLimit, model.HardLimit)
+		}
+		if adjustment.HardLimit != nil || adjustment.ExtraConfig != nil {
+			if err := checkQuotaAllocations(&model); err != nil {
+				return err
+			}
+		}
+		if mode := convertEnforcementModeToEnum(model.EnforcementMode); quotaShards(&model) > 0 && mode != biz.EnforcementModeHard && mode != biz.EnforcementModeUnspecified {
+			return biz.ErrQuotaConfigInvalid.WithMetadata(map[string]string{"reason": "shards require HARD enforcement mode"})
+		}
 
-			if err := tx.Create(usageRecord).Error; err != nil {
+		if err := tx.Save(&model).Error; err != nil {
+			return err
+		}
+		if err := recordOverage(tx, &model, oldUsed, oldHard); err != nil {
+			return err
+		}
+
+		// 套餐管理的配额调整限制时记录为租户级覆盖，套餐变更时保留
+		if model.PlanCode != "" && (adjustment.HardLimit != nil || adjustment.SoftLimit != nil) {
+			if err := saveQuotaOverride(tx, &model, adjustment); err != nil {
 				return err
 			}
 		}
 
-		return nil
+		// 记录调整操作
+		remark := adjustment.Remark
+		if remark == "" {
+			remark = fmt.Sprintf("adjust limits: hard=%d soft=%d", model.HardLimit, model.SoftLimit)
+		}
+		usageRecord := &QuotaUsageModel{
+			QuotaID:       model.QuotaID,
+			TenantID:      model.TenantID,
+			OperationType: convertOperationTypeToString(biz.OperationTypeAdjust),
+			DeltaValue:    model.UsedCount - oldUsed,
+			CurrentUsed:   model.UsedCount,
+			Operator:      adjustment.Operator,
+			Remark:        remark,
+		}
+
+		return tx.Create(usageRecord).Error
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertQuotaModelToBiz(&model)
+}
+
+// ListUsageRecords 列出配额使用记录
+func (r *quotaRepo) ListUsageRecords(ctx context.Context, filter *biz.UsageRecordFilter) ([]*biz.QuotaUsageRecord, error) {
+	var models []*QuotaUsageModel
+
+	query := r.data.DB(ctx).Model(&QuotaUsageModel{}).Where("tenant_id = ?", filter.TenantID)
+
+	// 添加查询条件
+	if filter.QuotaType != biz.QuotaTypeUnspecified {
+		query = query.Where("quota_id IN (?)", r.data.db.Model(&QuotaModel{}).Select("quota_id").
+			Where("quota_type = ?", convertQuotaTypeToString(filter.QuotaType)))
+	}
+
+	if filter.AfterRecordID > 0 {
+		query = query.Where("record_id > ?", filter.AfterRecordID)
+	}
+
+	// 查询使用记录
+	if err := query.Order("record_id ASC").Limit(int(filter.Limit)).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	// 转换为业务模型
+	records := make([]*biz.QuotaUsageRecord, 0, len(models))
+	for _, model := range models {
+		records = append(records, convertUsageModelToBiz(model))
+	}
+
+	return records, nil
+}
+
+// convertUsageModelToBiz 转换使用记录数据模型到业务模型
+func convertUsageModelToBiz(model *QuotaUsageModel) *biz.QuotaUsageRecord {
+	record := &biz.QuotaUsageRecord{
+		RecordID:      model.RecordID,
+		QuotaID:       model.QuotaID,
+		TenantID:      model.TenantID,
+		OperationType: convertOperationTypeToEnum(model.OperationType),
+		DeltaValue:    model.DeltaValue,
+		CurrentUsed:   model.CurrentUsed,
+		BizID:         model.BizID,
+		BizType:       model.BizType,
+		Operator:      model.Operator,
+		OperationTime: model.OperationTime,
+		ExpireTime:    model.ExpireTime,
+		Remark:        model.Remark,
+	}
+	if model.ChainSeq != nil {
+		record.ChainSeq = *model.ChainSeq
+		record.PrevHash = model.PrevHash
+		record.RecordHash = model.RecordHash
+	}
+	return record
 }
@@ -0,0 +1,341 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// QuotaChangeModel 计划配额变更数据模型
+type QuotaChangeModel struct {
+	ChangeID  int64      `gorm:"column:change_id;primaryKey;autoIncrement"`
+	TenantID  string     `gorm:"column:tenant_id;not null"`
+	QuotaType string     `gorm:"column:quota_type;not null"`
+	LimitType string     `gorm:"column:limit_type;not null"`
+	HardLimit *int32     `gorm:"column:hard_limit"`
+	SoftLimit *int32     `gorm:"column:soft_limit"`
+	PlanCode  string     `gorm:"column:plan_code"`
+	ApplyAt   time.Time  `gorm:"column:apply_at;not null"`
+	Proration string     `gorm:"column:proration;not null"`
+	Status    string     `gorm:"column:status;not null"`
+	Error     string     `gorm:"column:error"`
+	Operator  string     `gorm:"column:operator"`
+	Remark    string     `gorm:"column:remark"`
+	AppliedAt *time.Time `gorm:"column:applied_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (QuotaChangeModel) TableName() string {
+	return "quota_scheduled_changes"
+}
+
+// quotaChangeRepo 计划配额变更仓库实现
+type quotaChangeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewQuotaChangeRepo 创建计划配额变更仓库
+func NewQuotaChangeRepo(data *Data, logger log.Logger) biz.QuotaChangeRepo {
+	return &quotaChangeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// convertQuotaChangeStatusToString 转换变更状态到字符串
+func convertQuotaChangeStatusToString(status biz.QuotaChangeStatus) string {
+	switch status {
+	case biz.QuotaChangeStatusPending:
+		return "PENDING"
+	case biz.QuotaChangeStatusApplied:
+		return "APPLIED"
+	case biz.QuotaChangeStatusCanceled:
+		return "CANCELED"
+	case biz.QuotaChangeStatusFailed:
+		return "FAILED"
+	default:
+		return ""
+	}
+}
+
+// convertQuotaChangeStatusToEnum 转换字符串到变更状态
+func convertQuotaChangeStatusToEnum(status string) biz.QuotaChangeStatus {
+	switch status {
+	case "PENDING":
+		return biz.QuotaChangeStatusPending
+	case "APPLIED":
+		return biz.QuotaChangeStatusApplied
+	case "CANCELED":
+		return biz.QuotaChangeStatusCanceled
+	case "FAILED":
+		return biz.QuotaChangeStatusFailed
+	default:
+		return biz.QuotaChangeStatusUnspecified
+	}
+}
+
+// convertQuotaChangeModelToBiz 转换计划配额变更数据模型到业务模型
+func convertQuotaChangeModelToBiz(model *QuotaChangeModel) *biz.QuotaChange {
+	proration, _ := biz.ParseProrationPolicy(model.Proration)
+	change := &biz.QuotaChange{
+		ChangeID:  model.ChangeID,
+		TenantID:  model.TenantID,
+		QuotaType: convertQuotaTypeToEnum(model.QuotaType),
+		LimitType: convertLimitTypeToEnum(model.LimitType),
+		HardLimit: model.HardLimit,
+		SoftLimit: model.SoftLimit,
+		PlanCode:  model.PlanCode,
+		ApplyAt:   model.ApplyAt,
+		Proration: proration,
+		Status:    convertQuotaChangeStatusToEnum(model.Status),
+		Operator:  model.Operator,
+		Remark:    model.Remark,
+		Error:     model.Error,
+		CreatedAt: model.CreatedAt,
+	}
+	if model.AppliedAt != nil {
+		change.AppliedAt = *model.AppliedAt
+	}
+	return change
+}
+
+// CreateQuotaChange 创建计划配额变更
+func (r *quotaChangeRepo) CreateQuotaChange(ctx context.Context, change *biz.QuotaChange) (*biz.QuotaChange, error) {
+	model := &QuotaChangeModel{
+		TenantID:  change.TenantID,
+		HardLimit: change.HardLimit,
+		SoftLimit: change.SoftLimit,
+		PlanCode:  change.PlanCode,
+		ApplyAt:   change.ApplyAt,
+		Proration: change.Proration.String(),
+		Status:    convertQuotaChangeStatusToString(change.Status),
+		Operator:  change.Operator,
+		Remark:    change.Remark,
+	}
+	// 套餐切换不涉及单个配额维度
+	if change.PlanCode == "" {
+		model.QuotaType = convertQuotaTypeToString(change.QuotaType)
+		model.LimitType = convertLimitTypeToString(change.LimitType)
+	}
+
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return convertQuotaChangeModelToBiz(model), nil
+}
+
+// ListQuotaChanges 列出计划配额变更
+func (r *quotaChangeRepo) ListQuotaChanges(ctx context.Context, filter *biz.QuotaChangeFilter) ([]*biz.QuotaChange, error) {
+	var models []*QuotaChangeModel
+
+	query := r.data.DB(ctx).Where("tenant_id = ?", filter.TenantID)
+	if filter.Status != biz.QuotaChangeStatusUnspecified {
+		query = query.Where("status = ?", convertQuotaChangeStatusToString(filter.Status))
+	}
+	if err := query.Order("apply_at DESC, change_id DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	changes := make([]*biz.QuotaChange, 0, len(models))
+	for _, model := range models {
+		changes = append(changes, convertQuotaChangeModelToBiz(model))
+	}
+
+	return changes, nil
+}
+
+// ListDueQuotaChanges 列出到期的待生效变更
+func (r *quotaChangeRepo) ListDueQuotaChanges(ctx context.Context, now time.Time, limit int) ([]*biz.QuotaChange, error) {
+	var models []*QuotaChangeModel
+
+	err := r.data.DB(ctx).
+		Where("status = ? AND apply_at <= ?", convertQuotaChangeStatusToString(biz.QuotaChangeStatusPending), now).
+		Order("apply_at ASC, change_id ASC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*biz.QuotaChange, 0, len(models))
+	for _, model := range models {
+		changes = append(changes, convertQuotaChangeModelToBiz(model))
+	}
+
+	return changes, nil
+}
+
+// lockPendingChange 锁定待生效的变更
+func lockPendingChange(tx *gorm.DB, changeID int64, model *QuotaChangeModel) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("change_id = ?", changeID).First(model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.ErrQuotaChangeNotFound
+		}
+		return err
+	}
+	if model.Status != convertQuotaChangeStatusToString(biz.QuotaChangeStatusPending) {
+		return biz.ErrQuotaChangeNotPending.WithMetadata(map[string]string{"status": model.Status})
+	}
+	return nil
+}
+
+// CancelQuotaChange 取消待生效的变更
+func (r *quotaChangeRepo) CancelQuotaChange(ctx context.Context, tenantID string, changeID int64, operator string) (*biz.QuotaChange, error) {
+	var model QuotaChangeModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockPendingChange(tx, changeID, &model); err != nil {
+			return err
+		}
+		if model.TenantID != tenantID {
+			return biz.ErrQuotaChangeNotFound
+		}
+
+		model.Status = convertQuotaChangeStatusToString(biz.QuotaChangeStatusCanceled)
+		model.Error = fmt.Sprintf("canceled by %s", operator)
+		return tx.Save(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertQuotaChangeModelToBiz(&model), nil
+}
+
+// FailQuotaChange 标记变更生效失败
+func (r *quotaChangeRepo) FailQuotaChange(ctx context.Context, changeID int64, reason string) error {
+	return r.data.DB(ctx).Model(&QuotaChangeModel{}).
+		Where("change_id = ? AND status = ?", changeID, convertQuotaChangeStatusToString(biz.QuotaChangeStatusPending)).
+		Updates(map[string]interface{}{
+			"status": convertQuotaChangeStatusToString(biz.QuotaChangeStatusFailed),
+			"error":  reason,
+		}).Error
+}
+
+// ApplyQuotaChange 应用变更并标记为已生效
+func (r *quotaChangeRepo) ApplyQuotaChange(ctx context.Context, change *biz.QuotaChange, plan *biz.QuotaPlan) ([]*biz.QuotaInfo, error) {
+	var applied []*QuotaModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定变更，多实例同时处理时只应用一次
+		var model QuotaChangeModel
+		if err := lockPendingChange(tx, change.ChangeID, &model); err != nil {
+			return err
+		}
+
+		if plan != nil {
+			_, quotas, err := applyPlan(tx, &biz.PlanAssignment{
+				TenantID:  change.TenantID,
+				Plan:      plan,
+				Operator:  change.Operator,
+				Proration: change.Proration,
+			})
+			if err != nil {
+				return err
+			}
+			applied = quotas
+		} else {
+			quota, err := applyLimitChange(tx, change)
+			if err != nil {
+				return err
+			}
+			applied = []*QuotaModel{quota}
+		}
+
+		now := time.Now()
+		model.Status = convertQuotaChangeStatusToString(biz.QuotaChangeStatusApplied)
+		model.AppliedAt = &now
+		if err := tx.Save(&model).Error; err != nil {
+			return err
+		}
+		change.AppliedAt = now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertQuotaModelsToBiz(applied)
+}
+
+// applyLimitChange 在事务中修改单个配额的限制，按折算策略处理已用量并记录调整操作
+func applyLimitChange(tx *gorm.DB, change *biz.QuotaChange) (*QuotaModel, error) {
+	var model QuotaModel
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND quota_type = ? AND limit_type = ? AND is_global = ?",
+			change.TenantID, convertQuotaTypeToString(change.QuotaType), convertLimitTypeToString(change.LimitType), false).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrQuotaNotFound
+		}
+		return nil, err
+	}
+	if err := foldShards(tx, &model); err != nil {
+		return nil, err
+	}
+
+	oldUsed := model.UsedCount
+	oldHard := model.HardLimit
+	if change.HardLimit != nil {
+		model.HardLimit = *change.HardLimit
+	}
+	if change.SoftLimit != nil {
+		model.SoftLimit = *change.SoftLimit
+	}
+	if model.SoftLimit > model.HardLimit {
+		return nil, biz.ErrQuotaChangeInvalid.WithMetadata(map[string]string{
+			"reason": fmt.Sprintf("soft limit %d exceeds hard limit %d", model.SoftLimit, model.HardLimit),
+		})
+	}
+	model.UsedCount = change.Proration.Apply(model.UsedCount, oldHard, model.HardLimit)
+	if model.UsedCount == 0 && oldUsed != 0 {
+		model.ResetTime = time.Now()
+	}
+	if err := tx.Save(&model).Error; err != nil {
+		return nil, err
+	}
+	if err := recordOverage(tx, &model, oldUsed, oldHard); err != nil {
+		return nil, err
+	}
+
+	// 套餐管理的配额记录为租户级覆盖，套餐变更时保留
+	if model.PlanCode != "" {
+		adjustment := &biz.QuotaAdjustment{
+			HardLimit: change.HardLimit,
+			SoftLimit: change.SoftLimit,
+			Operator:  change.Operator,
+		}
+		if err := saveQuotaOverride(tx, &model, adjustment); err != nil {
+			return nil, err
+		}
+	}
+
+	// 记录调整操作
+	usageRecord := &QuotaUsageModel{
+		QuotaID:       model.QuotaID,
+		TenantID:      model.TenantID,
+		OperationType: convertOperationTypeToString(biz.OperationTypeAdjust),
+		DeltaValue:    model.UsedCount - oldUsed,
+		CurrentUsed:   model.UsedCount,
+		Operator:      change.Operator,
+		Remark: fmt.Sprintf("scheduled change #%d: hard=%d soft=%d, proration %s",
+			change.ChangeID, model.HardLimit, model.SoftLimit, change.Proration),
+	}
+	if err := tx.Create(usageRecord).Error; err != nil {
+		return nil, err
+	}
+
+	return &model, nil
+}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"tenant-service/internal/biz"
+)
+
+func TestResetQuotasResetsDueQuotasOnce(t *testing.T) {
+	d := newTestData(t)
+	repo := NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger).(*quotaRepo)
+	ctx := context.Background()
+	due := createTestQuota(t, d, &QuotaModel{TenantID: "EN_acme", HardLimit: 100, UsedCount: 40, NextResetTime: time.Now().Add(-time.Minute)})
+	notDue := createTestQuota(t, d, &QuotaModel{TenantID: "EN_beta", HardLimit: 100, UsedCount: 40})
+
+	result, err := repo.ResetQuotas(ctx, biz.LimitTypeMonthly)
+	if err != nil {
+		t.Fatalf("reset quotas: %v", err)
+	}
+	if len(result.Quotas) != 1 || result.Quotas[0].QuotaID != due.QuotaID || result.Quotas[0].UsedCount != 0 {
+		t.Fatalf("reset quotas = %+v, want only quota %d reset to 0", result.Quotas, due.QuotaID)
+	}
+	if model := loadTestQuota(t, d, due.QuotaID); model.UsedCount != 0 || !model.NextResetTime.After(time.Now()) {
+		t.Fatalf("due quota used=%d next_reset=%s, want 0 and a future reset", model.UsedCount, model.NextResetTime)
+	}
+	if model := loadTestQuota(t, d, notDue.QuotaID); model.UsedCount != 40 {
+		t.Fatalf("not due quota used = %d, want 40", model.UsedCount)
+	}
+
+	// 另一个副本以扫描时的候选重置同一配额，加锁后发现已重置，不再写入重置记录
+	again, err := repo.resetQuota(ctx, due.QuotaID, biz.LimitTypeMonthly)
+	if err != nil {
+		t.Fatalf("reset quota again: %v", err)
+	}
+	if again != nil {
+		t.Fatalf("second reset = %+v, want skipped", again)
+	}
+	if n := countUsageRecords(t, d, due.QuotaID, "RESET"); n != 1 {
+		t.Fatalf("RESET records = %d, want 1", n)
+	}
+}
+
+func TestResetQuotasKeepsConcurrentWrites(t *testing.T) {
+	d := newTestData(t)
+	repo := NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger).(*quotaRepo)
+	due := createTestQuota(t, d, &QuotaModel{TenantID: "EN_acme", HardLimit: 100, SoftLimit: 80, UsedCount: 40, NextResetTime: time.Now().Add(-time.Minute)})
+
+	// 扫描出候选后、重置加锁前，配额被调整且继续消费
+	if err := d.db.Model(&QuotaModel{}).Where("quota_id = ?", due.QuotaID).
+		UpdateColumns(map[string]interface{}{"hard_limit": 200, "soft_limit": 150, "used_count": 55, "enforcement_mode": "OVERAGE", "max_overage": 20}).Error; err != nil {
+		t.Fatalf("adjust quota: %v", err)
+	}
+	if _, err := repo.ResetQuotas(context.Background(), biz.LimitTypeMonthly); err != nil {
+		t.Fatalf("reset quotas: %v", err)
+	}
+
+	model := loadTestQuota(t, d, due.QuotaID)
+	if model.HardLimit != 200 || model.SoftLimit != 150 || model.EnforcementMode != "OVERAGE" || model.MaxOverage != 20 || model.UsedCount != 0 {
+		t.Fatalf("quota after reset = %+v, want adjusted limits kept and used_count 0", model)
+	}
+	var record QuotaUsageModel
+	if err := d.db.Where("quota_id = ? AND operation_type = ?", due.QuotaID, "RESET").First(&record).Error; err != nil {
+		t.Fatalf("load reset record: %v", err)
+	}
+	if record.DeltaValue != -55 {
+		t.Fatalf("reset delta = %d, want -55 from the locked row", record.DeltaValue)
+	}
+}
+
+func TestResetQuotasGrantsRolloverFromLockedUsage(t *testing.T) {
+	d := newTestData(t)
+	repo := NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger).(*quotaRepo)
+	ctx := context.Background()
+	due := createTestQuota(t, d, &QuotaModel{
+		TenantID:      "EN_acme",
+		HardLimit:     100,
+		UsedCount:     30,
+		NextResetTime: time.Now().Add(-time.Minute),
+		ExtraConfig:   `{"rollover":{"max_amount":60}}`,
+	})
+
+	// 扫描出候选后、重置加锁前又消费了20，结转按锁定后的50计算
+	var candidates []*QuotaModel
+	if err := d.db.Where("next_reset_time <= ?", time.Now()).Find(&candidates).Error; err != nil || len(candidates) != 1 {
+		t.Fatalf("scan candidates = %d (%v), want 1", len(candidates), err)
+	}
+	if err := d.db.Model(&QuotaModel{}).Where("quota_id = ?", due.QuotaID).UpdateColumn("used_count", gorm.Expr("used_count + ?", 20)).Error; err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+	reset, err := repo.resetQuota(ctx, candidates[0].QuotaID, biz.LimitTypeMonthly)
+	if err != nil {
+		t.Fatalf("reset quota: %v", err)
+	}
+	if reset == nil || reset.RolloverGranted != 50 {
+		t.Fatalf("reset = %+v, want rollover 50 (hard 100 - used 50)", reset)
+	}
+
+	// 另一个副本用同一候选重置时跳过，不会再次结转
+	again, err := repo.resetQuota(ctx, candidates[0].QuotaID, biz.LimitTypeMonthly)
+	if err != nil || again != nil {
+		t.Fatalf("second reset = %+v (%v), want skipped", again, err)
+	}
+	model := loadTestQuota(t, d, due.QuotaID)
+	if model.RolloverGranted != 50 || model.RolloverUsed != 0 || !model.RolloverExpireTime.Equal(model.NextResetTime) {
+		t.Fatalf("quota rollover granted=%d used=%d expire=%s, want 50 unused until next reset %s",
+			model.RolloverGranted, model.RolloverUsed, model.RolloverExpireTime, model.NextResetTime)
+	}
+}
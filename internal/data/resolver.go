@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// maxTenantDepth 解析配额时向上查找的最大租户层级，防止parent_tenant_id成环
+const maxTenantDepth = 8
+
+// loadAncestors 按parent_tenant_id由近及远查找上级租户
+func loadAncestors(db *gorm.DB, tenantID string) ([]string, error) {
+	var ancestors []string
+	seen := map[string]struct{}{tenantID: {}}
+	for current := tenantID; len(ancestors) < maxTenantDepth; {
+		var tenant TenantModel
+		err := db.Select("tenant_id", "parent_tenant_id").Where("tenant_id = ?", current).First(&tenant).Error
+		if err == gorm.ErrRecordNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[tenant.ParentTenantID]; ok || tenant.ParentTenantID == "" {
+			break
+		}
+		seen[tenant.ParentTenantID] = struct{}{}
+		ancestors = append(ancestors, tenant.ParentTenantID)
+		current = tenant.ParentTenantID
+	}
+	return ancestors, nil
+}
+
+// resolveQuota 加载租户、上级租户和全局的候选配额，按biz.ResolveQuota的顺序解析
+func resolveQuota(db *gorm.DB, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, productCode string) (*biz.QuotaResolution, error) {
+	ancestors, err := loadAncestors(db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []*QuotaModel
+	if err := db.Where("quota_type = ? AND limit_type = ? AND (tenant_id IN ? OR is_global = ?)",
+		convertQuotaTypeToString(quotaType), convertLimitTypeToString(limitType), append([]string{tenantID}, ancestors...), true).
+		Order("quota_id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	quotas := make([]*biz.QuotaInfo, 0, len(models))
+	for _, model := range models {
+		quota, err := convertQuotaModelToBiz(model)
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+	if err := fillShardUsage(db, quotas); err != nil {
+		return nil, err
+	}
+	return biz.ResolveQuota(tenantID, ancestors, productCode, quotas), nil
+}
+
+// lockResolvedQuota 在事务中解析配额并锁定选中的配额行，没有匹配时返回ErrQuotaNotFound
+func lockResolvedQuota(tx *gorm.DB, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, productCode string, model *QuotaModel) error {
+	resolution, err := resolveQuota(tx, tenantID, quotaType, limitType, productCode)
+	if err != nil {
+		return err
+	}
+	if resolution.Selected == nil {
+		return biz.ErrQuotaNotFound
+	}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", resolution.Selected.QuotaID).First(model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return biz.ErrQuotaNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ResolveQuota 解析租户在产品线下使用的配额
+func (r *quotaRepo) ResolveQuota(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, productCode string) (*biz.QuotaResolution, error) {
+	return resolveQuota(r.data.DB(ctx), tenantID, quotaType, limitType, productCode)
+}
@@ -0,0 +1,248 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// QuotaShardModel 配额分片计数数据模型，各分片容量之和等于配额的硬限制
+type QuotaShardModel struct {
+	QuotaID   int64     `gorm:"column:quota_id;primaryKey"`
+	ShardNo   int32     `gorm:"column:shard_no;primaryKey"`
+	Capacity  int32     `gorm:"column:capacity"`
+	UsedCount int32     `gorm:"column:used_count"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// TableName 表名
+func (QuotaShardModel) TableName() string {
+	return "quota_shards"
+}
+
+// errShardMiss 分片额度不足或分片已被合并，回滚后尝试下一个分片
+var errShardMiss = errors.New("quota shard missed")
+
+// quotaShards 解析配额的分片数，extra_config不合法时视为不分片
+func quotaShards(model *QuotaModel) int32 {
+	config, err := biz.ParseQuotaExtraConfig(convertLimitTypeToEnum(model.LimitType), model.ExtraConfig)
+	if err != nil {
+		return 0
+	}
+	return config.Shards
+}
+
+// shardUsage 按配额汇总分片的已用量，没有分片行的配额不在结果中
+func shardUsage(db *gorm.DB, quotaIDs []int64) (map[int64]int32, error) {
+	var rows []struct {
+		QuotaID int64
+		Used    int32
+	}
+	if err := db.Model(&QuotaShardModel{}).Select("quota_id, SUM(used_count) AS used").
+		Where("quota_id IN ?", quotaIDs).Group("quota_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	usage := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		usage[row.QuotaID] = row.Used
+	}
+	return usage, nil
+}
+
+// fillShardUsage 分片计数的配额以各分片已用量之和作为已用量
+func fillShardUsage(db *gorm.DB, quotas []*biz.QuotaInfo) error {
+	var quotaIDs []int64
+	for _, quota := range quotas {
+		if quota.Shards > 0 {
+			quotaIDs = append(quotaIDs, quota.QuotaID)
+		}
+	}
+	if len(quotaIDs) == 0 {
+		return nil
+	}
+	usage, err := shardUsage(db, quotaIDs)
+	if err != nil {
+		return err
+	}
+	for _, quota := range quotas {
+		if used, ok := usage[quota.QuotaID]; ok {
+			quota.UsedCount = used
+		}
+	}
+	return nil
+}
+
+// foldShards 将分片已用量合并回配额行并删除分片，调用方需已锁定配额行；
+// 分片在下次消费时按配额行的已用量重新建立
+func foldShards(tx *gorm.DB, model *QuotaModel) error {
+	var shards []*QuotaShardModel
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", model.QuotaID).
+		Order("shard_no ASC").Find(&shards).Error; err != nil {
+		return err
+	}
+	if len(shards) == 0 {
+		return nil
+	}
+	used := int32(0)
+	for _, shard := range shards {
+		used += shard.UsedCount
+	}
+	if err := tx.Where("quota_id = ?", model.QuotaID).Delete(&QuotaShardModel{}).Error; err != nil {
+		return err
+	}
+	model.UsedCount = used
+	return tx.Model(model).UpdateColumn("used_count", used).Error
+}
+
+// consumeSharded 消费分片计数的配额：从随机分片开始依次以条件UPDATE扣减，不锁配额行；
+// 所有分片都不足时加锁重新分配剩余额度后消费
+func (r *quotaRepo) consumeSharded(ctx context.Context, quota *biz.QuotaInfo, tenantID string, amount int32, bizID, bizType string) (*biz.QuotaInfo, error) {
+	db := r.data.DB(ctx)
+	start := rand.Int31n(quota.Shards)
+	for i := int32(0); i < quota.Shards; i++ {
+		shardNo := (start + i) % quota.Shards
+		var used int32
+		err := db.Transaction(func(tx *gorm.DB) error {
+			lockStart := time.Now()
+			result := tx.Model(&QuotaShardModel{}).
+				Where("quota_id = ? AND shard_no = ? AND used_count + ? <= capacity", quota.QuotaID, shardNo, amount).
+				UpdateColumns(map[string]interface{}{
+					"used_count": gorm.Expr("used_count + ?", amount),
+					"updated_at": time.Now(),
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			r.metrics.LockWait(ctx, quota.QuotaType, quota.LimitType, time.Since(lockStart))
+			if result.RowsAffected == 0 {
+				return errShardMiss
+			}
+
+			usage, err := shardUsage(tx, []int64{quota.QuotaID})
+			if err != nil {
+				return err
+			}
+			used = usage[quota.QuotaID]
+			return tx.Create(&QuotaUsageModel{
+				QuotaID:       quota.QuotaID,
+				TenantID:      tenantID,
+				OperationType: convertOperationTypeToString(biz.OperationTypeConsume),
+				DeltaValue:    amount,
+				CurrentUsed:   used,
+				BizID:         bizID,
+				BizType:       bizType,
+				Remark:        fmt.Sprintf("shard %d", shardNo),
+			}).Error
+		})
+		if errors.Is(err, errShardMiss) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		consumed := *quota
+		consumed.UsedCount = used
+		return &consumed, nil
+	}
+	return r.rebalanceShards(ctx, quota.QuotaID, tenantID, amount, start, bizID, bizType)
+}
+
+// rebalanceShards 锁定配额行和全部分片，在目标分片上消费后将硬限制内的剩余额度平均分配到各分片；
+// 分片数与配置不一致或尚未建立时，按现有已用量重建分片
+func (r *quotaRepo) rebalanceShards(ctx context.Context, quotaID int64, tenantID string, amount, target int32, bizID, bizType string) (*biz.QuotaInfo, error) {
+	var model QuotaModel
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		lockStart := time.Now()
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", quotaID).First(&model).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrQuotaNotFound
+			}
+			return err
+		}
+		var shards []*QuotaShardModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("quota_id = ?", quotaID).
+			Order("shard_no ASC").Find(&shards).Error; err != nil {
+			return err
+		}
+		r.metrics.LockWait(ctx, convertQuotaTypeToEnum(model.QuotaType), convertLimitTypeToEnum(model.LimitType), time.Since(lockStart))
+
+		n := quotaShards(&model)
+		if n == 0 {
+			// 分片已关闭，回退到加锁路径，由consumeLocked合并分片后消费
+			return errShardMiss
+		}
+
+		used := make([]int32, n)
+		if int32(len(shards)) == n {
+			for i, shard := range shards {
+				used[i] = shard.UsedCount
+			}
+		} else if len(shards) > 0 {
+			for _, shard := range shards {
+				used[0] += shard.UsedCount
+			}
+		} else {
+			used[0] = model.UsedCount
+		}
+		total := int32(0)
+		for _, u := range used {
+			total += u
+		}
+		if total+amount > model.HardLimit {
+			model.UsedCount = total
+			return biz.ErrQuotaExceeded
+		}
+		used[target%n] += amount
+		total += amount
+
+		if err := tx.Where("quota_id = ?", quotaID).Delete(&QuotaShardModel{}).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		capacities := biz.SplitShardCapacity(model.HardLimit, used)
+		rebuilt := make([]*QuotaShardModel, n)
+		for i := range rebuilt {
+			rebuilt[i] = &QuotaShardModel{QuotaID: quotaID, ShardNo: int32(i), Capacity: capacities[i], UsedCount: used[i], UpdatedAt: now}
+		}
+		if err := tx.Create(&rebuilt).Error; err != nil {
+			return err
+		}
+
+		// 配额行的已用量作为快照，热路径不更新
+		model.UsedCount = total
+		if err := tx.Model(&model).UpdateColumns(map[string]interface{}{"used_count": total, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&QuotaUsageModel{
+			QuotaID:       quotaID,
+			TenantID:      tenantID,
+			OperationType: convertOperationTypeToString(biz.OperationTypeConsume),
+			DeltaValue:    amount,
+			CurrentUsed:   total,
+			BizID:         bizID,
+			BizType:       bizType,
+			Remark:        fmt.Sprintf("shard %d, rebalanced %d shards", target%n, n),
+		}).Error
+	})
+	if errors.Is(err, errShardMiss) {
+		return nil, nil
+	}
+	if err != nil {
+		// 配额不足时返回当前配额，便于调用方计算剩余量
+		if errors.Is(err, biz.ErrQuotaExceeded) {
+			quota, convErr := convertQuotaModelToBiz(&model)
+			if convErr != nil {
+				return nil, convErr
+			}
+			return quota, err
+		}
+		return nil, err
+	}
+	return convertQuotaModelToBiz(&model)
+}
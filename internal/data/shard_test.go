@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"tenant-service/internal/biz"
+)
+
+// newShardTestQuota 创建租户EN_acme和分片计数的短信月配额
+func newShardTestQuota(t *testing.T, d *Data, hardLimit int32, shards int) (*quotaRepo, *QuotaModel) {
+	t.Helper()
+	createTestTenant(t, d, "EN_acme")
+	model := createTestQuota(t, d, &QuotaModel{TenantID: "EN_acme", HardLimit: hardLimit, ExtraConfig: `{"shards":` + strconv.Itoa(shards) + `}`})
+	return NewQuotaRepo(d, newTestQuotaMetrics(t), testLogger).(*quotaRepo), model
+}
+
+// loadTestShards 按分片号读取配额的分片
+func loadTestShards(t *testing.T, d *Data, quotaID int64) []*QuotaShardModel {
+	t.Helper()
+	var shards []*QuotaShardModel
+	if err := d.db.Where("quota_id = ?", quotaID).Order("shard_no ASC").Find(&shards).Error; err != nil {
+		t.Fatalf("load shards: %v", err)
+	}
+	return shards
+}
+
+// checkTestShards 分片容量之和等于硬限制，各分片已用量不超过容量，返回已用量之和
+func checkTestShards(t *testing.T, shards []*QuotaShardModel, n int, hardLimit int32) int32 {
+	t.Helper()
+	if len(shards) != n {
+		t.Fatalf("%d shards, want %d", len(shards), n)
+	}
+	var capacity, used int32
+	for _, shard := range shards {
+		if shard.UsedCount > shard.Capacity {
+			t.Fatalf("shard %d used %d exceeds capacity %d", shard.ShardNo, shard.UsedCount, shard.Capacity)
+		}
+		capacity += shard.Capacity
+		used += shard.UsedCount
+	}
+	if capacity != hardLimit {
+		t.Fatalf("shard capacity sum = %d, want hard limit %d", capacity, hardLimit)
+	}
+	return used
+}
+
+func TestShardedConsumeStopsAtHardLimit(t *testing.T) {
+	d := newTestData(t)
+	repo, quota := newShardTestQuota(t, d, 10, 4)
+	ctx := context.Background()
+
+	// 第一次消费建立分片，之后在分片上扣减，直到硬限制
+	for i := 0; i < 10; i++ {
+		if _, err := repo.ConsumeQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 1, "", "order-"+strconv.Itoa(i), "order"); err != nil {
+			t.Fatalf("consume %d: %v", i, err)
+		}
+	}
+	consumed, err := repo.ConsumeQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 1, "", "order-10", "order")
+	if errors.Reason(err) != "QUOTA_EXCEEDED" {
+		t.Fatalf("consume beyond the limit error = %v, want QUOTA_EXCEEDED", err)
+	}
+	if consumed == nil || consumed.UsedCount != 10 {
+		t.Fatalf("exceeded quota = %+v, want used 10", consumed)
+	}
+	if used := checkTestShards(t, loadTestShards(t, d, quota.QuotaID), 4, 10); used != 10 {
+		t.Fatalf("shard used sum = %d, want 10", used)
+	}
+	if n := countUsageRecords(t, d, quota.QuotaID, "CONSUME"); n != 10 {
+		t.Fatalf("CONSUME records = %d, want 10", n)
+	}
+}
+
+func TestShardedConsumeRebalancesFragmentedShards(t *testing.T) {
+	d := newTestData(t)
+	repo, quota := newShardTestQuota(t, d, 10, 4)
+	ctx := context.Background()
+
+	// 剩余3个单位分散在三个分片上，任何一个分片都不够扣减3
+	capacities, used := []int32{3, 3, 2, 2}, []int32{2, 2, 1, 2}
+	for i := range capacities {
+		if err := d.db.Create(&QuotaShardModel{QuotaID: quota.QuotaID, ShardNo: int32(i), Capacity: capacities[i], UsedCount: used[i]}).Error; err != nil {
+			t.Fatalf("create shard: %v", err)
+		}
+	}
+
+	consumed, err := repo.ConsumeQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 3, "", "order-1", "order")
+	if err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+	if consumed.UsedCount != 10 {
+		t.Fatalf("consumed used = %d, want 10", consumed.UsedCount)
+	}
+	if total := checkTestShards(t, loadTestShards(t, d, quota.QuotaID), 4, 10); total != 10 {
+		t.Fatalf("shard used sum = %d, want 10", total)
+	}
+	if model := loadTestQuota(t, d, quota.QuotaID); model.UsedCount != 10 {
+		t.Fatalf("quota row used = %d, want snapshot 10", model.UsedCount)
+	}
+	var record QuotaUsageModel
+	if err := d.db.Where("quota_id = ? AND biz_id = ?", quota.QuotaID, "order-1").First(&record).Error; err != nil {
+		t.Fatalf("load usage record: %v", err)
+	}
+	if record.CurrentUsed != 10 || !strings.Contains(record.Remark, "rebalanced 4 shards") {
+		t.Fatalf("usage record current_used=%d remark=%q, want 10 and a rebalance", record.CurrentUsed, record.Remark)
+	}
+}
+
+func TestReleaseFoldsShards(t *testing.T) {
+	d := newTestData(t)
+	repo, quota := newShardTestQuota(t, d, 100, 4)
+	ctx := context.Background()
+
+	for i := 0; i < 6; i++ {
+		if _, err := repo.ConsumeQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 5, "", "order-"+strconv.Itoa(i), "order"); err != nil {
+			t.Fatalf("consume %d: %v", i, err)
+		}
+	}
+	// 热路径不更新配额行，读取配额时以分片已用量之和为准
+	quotas, err := repo.ListQuotas(ctx, "EN_acme", biz.QuotaTypeSMS)
+	if err != nil {
+		t.Fatalf("list quotas: %v", err)
+	}
+	if len(quotas) != 1 || quotas[0].UsedCount != 30 {
+		t.Fatalf("quotas = %+v, want used 30 from shards", quotas)
+	}
+
+	released, err := repo.ReleaseQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 8, "", "order-0")
+	if err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if released.UsedCount != 22 {
+		t.Fatalf("released used = %d, want 22", released.UsedCount)
+	}
+	if shards := loadTestShards(t, d, quota.QuotaID); len(shards) != 0 {
+		t.Fatalf("%d shards left after release, want folded", len(shards))
+	}
+	if model := loadTestQuota(t, d, quota.QuotaID); model.UsedCount != 22 {
+		t.Fatalf("quota row used = %d, want 22", model.UsedCount)
+	}
+
+	// 合并后的下一次消费按配额行的已用量重建分片
+	if _, err := repo.ConsumeQuota(ctx, "EN_acme", biz.QuotaTypeSMS, biz.LimitTypeMonthly, 1, "", "order-6", "order"); err != nil {
+		t.Fatalf("consume after fold: %v", err)
+	}
+	if used := checkTestShards(t, loadTestShards(t, d, quota.QuotaID), 4, 100); used != 23 {
+		t.Fatalf("rebuilt shard used sum = %d, want 23", used)
+	}
+}
@@ -2,12 +2,15 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"tenant-service/internal/biz"
 )
 
@@ -21,6 +24,8 @@ type TenantModel struct {
 	QuotaConfig    string    `gorm:"column:quota_config;type:json"`
 	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	Attributes *string `gorm:"column:attributes;type:json"`
 }
 
 // TableName 表名
@@ -100,6 +105,11 @@ func (r *tenantRepo) convertModelToBiz(model *TenantModel) (*biz.Tenant, error)
 	quotaConfig := make(map[string]string)
 	// 这里应该实现JSON解析，简化处理
 
+	attributes, err := unmarshalAttributes(model.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	return &biz.Tenant{
 		TenantID:       model.TenantID,
 		TenantName:     model.TenantName,
@@ -109,17 +119,16 @@ func (r *tenantRepo) convertModelToBiz(model *TenantModel) (*biz.Tenant, error)
 		QuotaConfig:    quotaConfig,
 		CreatedAt:      model.CreatedAt,
 		UpdatedAt:      model.UpdatedAt,
+		Attributes:     attributes,
 	}, nil
 }
 
 // Create 创建租户
 func (r *tenantRepo) Create(ctx context.Context, tenant *biz.Tenant) (*biz.Tenant, error) {
-	// 生成租户ID
-	tenantID := fmt.Sprintf("TN_%s", uuid.New().String()[:8])
-	if tenant.TenantType == biz.TenantTypeChannel {
-		tenantID = fmt.Sprintf("CH_%s", uuid.New().String()[:8])
-	} else if tenant.TenantType == biz.TenantTypeEnterprise {
-		tenantID = fmt.Sprintf("EN_%s", uuid.New().String()[:8])
+	// 租户ID由TenantIDGenerator生成
+	tenantID := tenant.TenantID
+	if tenantID == "" {
+		return nil, biz.ErrTenantIDRequired
 	}
 
 	// 创建租户模型
@@ -131,14 +140,24 @@ func (r *tenantRepo) Create(ctx context.Context, tenant *biz.Tenant) (*biz.Tenan
 		Status:         tenant.Status,
 		QuotaConfig:    "", // 应该序列化为JSON
 	}
+	attributes, err := marshalAttributes(tenant.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	model.Attributes = &attributes
 
 	// 开启事务
-	err := r.data.db.Transaction(func(tx *gorm.DB) error {
+	err = r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		// 创建租户记录
 		if err := tx.Create(model).Error; err != nil {
 			return err
 		}
 
+		// 写入标签
+		if err := saveLabels(tx, tenantID, tenant.Labels); err != nil {
+			return err
+		}
+
 		// 如果是渠道类型，创建渠道扩展信息
 		if tenant.TenantType == biz.TenantTypeChannel {
 			channel := &ChannelModel{
@@ -155,6 +174,9 @@ func (r *tenantRepo) Create(ctx context.Context, tenant *biz.Tenant) (*biz.Tenan
 	})
 
 	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, biz.ErrTenantIDConflict
+		}
 		return nil, err
 	}
 
@@ -165,7 +187,7 @@ func (r *tenantRepo) Create(ctx context.Context, tenant *biz.Tenant) (*biz.Tenan
 // Get 获取租户
 func (r *tenantRepo) Get(ctx context.Context, id string) (*biz.Tenant, error) {
 	var model TenantModel
-	err := r.data.db.Where("tenant_id = ?", id).First(&model).Error
+	err := r.data.DB(ctx).Where("tenant_id = ?", id).First(&model).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -173,14 +195,21 @@ func (r *tenantRepo) Get(ctx context.Context, id string) (*biz.Tenant, error) {
 		return nil, err
 	}
 
-	return r.convertModelToBiz(&model)
+	tenant, err := r.convertModelToBiz(&model)
+	if err != nil {
+		return nil, err
+	}
+	if err := fillLabels(r.data.DB(ctx), []*biz.Tenant{tenant}); err != nil {
+		return nil, err
+	}
+	return tenant, nil
 }
 
 // Update 更新租户
 func (r *tenantRepo) Update(ctx context.Context, tenant *biz.Tenant) (*biz.Tenant, error) {
 	// 查询租户是否存在
 	var model TenantModel
-	err := r.data.db.Where("tenant_id = ?", tenant.TenantID).First(&model).Error
+	err := r.data.DB(ctx).Where("tenant_id = ?", tenant.TenantID).First(&model).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("tenant not found: %s", tenant.TenantID)
@@ -192,24 +221,57 @@ func (r *tenantRepo) Update(ctx context.Context, tenant *biz.Tenant) (*biz.Tenan
 	model.TenantName = tenant.TenantName
 	model.Status = tenant.Status
 	model.QuotaConfig = "" // 应该序列化为JSON
+	attributes, err := marshalAttributes(tenant.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	model.Attributes = &attributes
 
-	err = r.data.db.Save(&model).Error
+	err = r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&model).Error; err != nil {
+			return err
+		}
+		return saveLabels(tx, model.TenantID, tenant.Labels)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return r.convertModelToBiz(&model)
+	updated, err := r.convertModelToBiz(&model)
+	if err != nil {
+		return nil, err
+	}
+	updated.Labels = tenant.Labels
+	return updated, nil
 }
 
 // Delete 删除租户
 func (r *tenantRepo) Delete(ctx context.Context, id string) error {
 	// 开启事务
-	return r.data.db.Transaction(func(tx *gorm.DB) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		// 删除渠道扩展信息
 		if err := tx.Where("tenant_id = ?", id).Delete(&ChannelModel{}).Error; err != nil {
 			return err
 		}
 
+		// 删除标签
+		if err := tx.Where("tenant_id = ?", id).Delete(&TenantLabelModel{}).Error; err != nil {
+			return err
+		}
+
+		// 删除成员和邀请
+		if err := tx.Where("tenant_id = ?", id).Delete(&MemberModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tenant_id = ?", id).Delete(&InvitationModel{}).Error; err != nil {
+			return err
+		}
+
+		// 删除授权项
+		if err := tx.Where("tenant_id = ?", id).Delete(&EntitlementModel{}).Error; err != nil {
+			return err
+		}
+
 		// 删除租户
 		if err := tx.Where("tenant_id = ?", id).Delete(&TenantModel{}).Error; err != nil {
 			return err
@@ -219,20 +281,128 @@ func (r *tenantRepo) Delete(ctx context.Context, id string) error {
 	})
 }
 
+// GetChannel 获取渠道扩展信息
+func (r *tenantRepo) GetChannel(ctx context.Context, tenantID string) (*biz.ChannelProfile, error) {
+	var model ChannelModel
+	err := r.data.DB(ctx).Where("tenant_id = ?", tenantID).First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convertChannelModelToBiz(&model), nil
+}
+
+// SaveChannel 创建或更新渠道扩展信息
+func (r *tenantRepo) SaveChannel(ctx context.Context, channel *biz.ChannelProfile) (*biz.ChannelProfile, error) {
+	var model ChannelModel
+	err := r.data.DB(ctx).Where("tenant_id = ?", channel.TenantID).First(&model).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	model.TenantID = channel.TenantID
+	if channel.ChannelCode != "" {
+		model.ChannelCode = channel.ChannelCode
+	}
+	if model.ChannelCode == "" {
+		model.ChannelCode = fmt.Sprintf("CH%s", uuid.New().String()[:6])
+	}
+	model.ChannelName = channel.ChannelName
+	model.ContactName = channel.ContactName
+	model.ContactPhone = channel.ContactPhone
+	model.CommissionRate = channel.CommissionRate
+	model.SalesTarget = channel.SalesTarget
+
+	if err := r.data.DB(ctx).Save(&model).Error; err != nil {
+		return nil, err
+	}
+
+	return convertChannelModelToBiz(&model), nil
+}
+
+// convertChannelModelToBiz 转换渠道数据模型到业务模型
+func convertChannelModelToBiz(model *ChannelModel) *biz.ChannelProfile {
+	return &biz.ChannelProfile{
+		TenantID:       model.TenantID,
+		ChannelCode:    model.ChannelCode,
+		ChannelName:    model.ChannelName,
+		ContactName:    model.ContactName,
+		ContactPhone:   model.ContactPhone,
+		CommissionRate: model.CommissionRate,
+		SalesTarget:    model.SalesTarget,
+	}
+}
+
+// tenantSortColumns 排序字段对应的列，与biz.TenantSortFields一致
+var tenantSortColumns = map[string]string{
+	"tenant_id":   "tenant_id",
+	"tenant_name": "tenant_name",
+	"tenant_type": "tenant_type",
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+}
+
+// escapeLike 转义LIKE通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
 // List 列出租户
-func (r *tenantRepo) List(ctx context.Context, tenantType biz.TenantType, parentID string, status bool, pageNum, pageSize int32) ([]*biz.Tenant, int32, error) {
+func (r *tenantRepo) List(ctx context.Context, filter *biz.TenantFilter, page *biz.PageQuery) ([]*biz.Tenant, int32, error) {
 	var models []*TenantModel
 	var count int64
 
-	query := r.data.db.Model(&TenantModel{})
+	// 排序字段已在用例中按biz.TenantSortFields校验
+	orderColumn := "created_at"
+	if page.SortBy != "" {
+		column, ok := tenantSortColumns[page.SortBy]
+		if !ok {
+			return nil, 0, biz.ErrSortFieldInvalid
+		}
+		orderColumn = column
+	}
+
+	query := r.data.DB(ctx).Model(&TenantModel{})
 
 	// 添加查询条件
-	if tenantType != biz.TenantTypeUnspecified {
-		query = query.Where("tenant_type = ?", convertTenantTypeToString(tenantType))
+	if len(filter.TenantTypes) > 0 {
+		types := make([]string, 0, len(filter.TenantTypes))
+		for _, tenantType := range filter.TenantTypes {
+			types = append(types, convertTenantTypeToString(tenantType))
+		}
+		query = query.Where("tenant_type IN ?", types)
+	}
+
+	if filter.ParentTenantID != "" {
+		query = query.Where("parent_tenant_id = ?", filter.ParentTenantID)
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if filter.Name != "" {
+		query = query.Where("tenant_name LIKE ?", "%"+escapeLike(filter.Name)+"%")
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedAfter)
 	}
 
-	if parentID != "" {
-		query = query.Where("parent_tenant_id = ?", parentID)
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+
+	query, err := applyLabelSelector(query, filter.LabelSelector)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, value := range filter.Attributes {
+		query = query.Where("JSON_UNQUOTE(JSON_EXTRACT(attributes, ?)) = ?", fmt.Sprintf("$.%q", key), value)
 	}
 
 	// 查询总数
@@ -240,9 +410,15 @@ func (r *tenantRepo) List(ctx context.Context, tenantType biz.TenantType, parent
 		return nil, 0, err
 	}
 
+	// 排序，tenant_id作为次级排序保证分页稳定
+	query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: orderColumn}, Desc: page.SortDesc})
+	if orderColumn != "tenant_id" {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: "tenant_id"}, Desc: page.SortDesc})
+	}
+
 	// 分页查询
-	offset := (pageNum - 1) * pageSize
-	if err := query.Offset(int(offset)).Limit(int(pageSize)).Find(&models).Error; err != nil {
+	offset := (page.Page - 1) * page.PageSize
+	if err := query.Offset(int(offset)).Limit(int(page.PageSize)).Find(&models).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -255,6 +431,9 @@ func (r *tenantRepo) List(ctx context.Context, tenantType biz.TenantType, parent
 		}
 		tenants = append(tenants, tenant)
 	}
+	if err := fillLabels(r.data.DB(ctx), tenants); err != nil {
+		return nil, 0, err
+	}
 
 	return tenants, int32(count), nil
 }
@@ -0,0 +1,278 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+)
+
+// 租户ID生成策略
+const (
+	TenantIDStrategyUUID      = "uuid"
+	TenantIDStrategyULID      = "ulid"
+	TenantIDStrategySnowflake = "snowflake"
+	TenantIDStrategySequence  = "sequence"
+	TenantIDStrategyCustom    = "custom"
+)
+
+// tenantIDPattern 调用方指定租户ID的格式
+var tenantIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// statefulSetOrdinalPattern StatefulSet的Pod名称，以-序号结尾
+var statefulSetOrdinalPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]*[a-z0-9])?-(0|[1-9][0-9]*)$`)
+
+// uuidEncoding UUID编码，不带填充的base32，128位编码为26个字符
+var uuidEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TenantIDSequenceModel 租户ID序列数据模型
+type TenantIDSequenceModel struct {
+	Prefix    string    `gorm:"column:prefix;primaryKey"`
+	NextValue int64     `gorm:"column:next_value;not null;default:1"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (TenantIDSequenceModel) TableName() string {
+	return "tenant_id_sequences"
+}
+
+// tenantIDPrefix 根据租户类型返回ID前缀
+func tenantIDPrefix(tenantType biz.TenantType) string {
+	switch tenantType {
+	case biz.TenantTypeChannel:
+		return "CH_"
+	case biz.TenantTypeEnterprise:
+		return "EN_"
+	default:
+		return "TN_"
+	}
+}
+
+// NewTenantIDGenerator 根据配置创建租户ID生成器
+func NewTenantIDGenerator(c *conf.Tenant, data *Data, logger log.Logger) (biz.TenantIDGenerator, error) {
+	cfg := c.GetIdGenerator()
+	if cfg.GetStrategy() != TenantIDStrategyCustom {
+		return newTenantIDGenerator(cfg.GetStrategy(), cfg, data, logger)
+	}
+
+	// custom策略：调用方指定ID，未指定时使用后备策略
+	var fallback biz.TenantIDGenerator
+	if cfg.GetFallbackStrategy() != "" {
+		if cfg.GetFallbackStrategy() == TenantIDStrategyCustom {
+			return nil, fmt.Errorf("invalid tenant id fallback strategy: %s", cfg.GetFallbackStrategy())
+		}
+		var err error
+		fallback, err = newTenantIDGenerator(cfg.GetFallbackStrategy(), cfg, data, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &customTenantIDGenerator{data: data, fallback: fallback}, nil
+}
+
+// newTenantIDGenerator 创建非custom策略的租户ID生成器
+func newTenantIDGenerator(strategy string, cfg *conf.Tenant_IDGenerator, data *Data, logger log.Logger) (biz.TenantIDGenerator, error) {
+	switch strategy {
+	case "", TenantIDStrategyULID:
+		return &ulidTenantIDGenerator{}, nil
+	case TenantIDStrategyUUID:
+		return &uuidTenantIDGenerator{}, nil
+	case TenantIDStrategySnowflake:
+		nodeID := cfg.GetNodeId()
+		if cfg.NodeId == nil {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return nil, err
+			}
+			if nodeID, err = snowflakeNodeIDFromHostname(hostname); err != nil {
+				return nil, err
+			}
+		}
+		if nodeID < 0 || nodeID > snowflakeMaxNodeID {
+			return nil, fmt.Errorf("snowflake node id out of range: %d", nodeID)
+		}
+		log.NewHelper(logger).Infof("tenant id snowflake node id: %d", nodeID)
+		return &snowflakeTenantIDGenerator{nodeID: nodeID}, nil
+	case TenantIDStrategySequence:
+		width := cfg.GetSequenceWidth()
+		if width <= 0 {
+			width = 8
+		}
+		return &sequenceTenantIDGenerator{data: data, width: int(width)}, nil
+	default:
+		return nil, fmt.Errorf("unknown tenant id strategy: %s", strategy)
+	}
+}
+
+// uuidTenantIDGenerator 前缀+完整的随机UUID，base32编码为26个字符以适应32位的租户ID
+type uuidTenantIDGenerator struct{}
+
+// Generate 生成租户ID
+func (g *uuidTenantIDGenerator) Generate(ctx context.Context, tenant *biz.Tenant) (string, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	return tenantIDPrefix(tenant.TenantType) + uuidEncoding.EncodeToString(id[:]), nil
+}
+
+// ulidTenantIDGenerator 前缀+ULID，按时间有序
+type ulidTenantIDGenerator struct {
+	mu      sync.Mutex
+	entropy *ulid.MonotonicEntropy
+}
+
+// Generate 生成租户ID
+func (g *ulidTenantIDGenerator) Generate(ctx context.Context, tenant *biz.Tenant) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.entropy == nil {
+		g.entropy = ulid.Monotonic(rand.Reader, 0)
+	}
+	id, err := ulid.New(ulid.Timestamp(time.Now()), g.entropy)
+	if err != nil {
+		return "", err
+	}
+
+	return tenantIDPrefix(tenant.TenantType) + id.String(), nil
+}
+
+const (
+	snowflakeNodeBits     = 10
+	snowflakeSequenceBits = 12
+	snowflakeMaxNodeID    = -1 ^ (-1 << snowflakeNodeBits)
+	snowflakeMaxSequence  = -1 ^ (-1 << snowflakeSequenceBits)
+)
+
+// snowflakeEpoch snowflake起始时间 2024-01-01 00:00:00 UTC
+var snowflakeEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// snowflakeNodeIDFromHostname 从StatefulSet的Pod名称（如tenant-service-3）取序号作为节点ID；
+// 主机名不是StatefulSet的Pod名称或序号超出范围时返回错误，不使用哈希推导，避免不同实例得到相同节点ID
+func snowflakeNodeIDFromHostname(hostname string) (int64, error) {
+	m := statefulSetOrdinalPattern.FindStringSubmatch(hostname)
+	if m == nil {
+		return 0, fmt.Errorf("cannot derive snowflake node id from hostname %q: not a StatefulSet pod name, configure node_id", hostname)
+	}
+	ordinal, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil || ordinal > snowflakeMaxNodeID {
+		return 0, fmt.Errorf("cannot derive snowflake node id from hostname %q: ordinal exceeds %d, configure node_id", hostname, snowflakeMaxNodeID)
+	}
+	return ordinal, nil
+}
+
+// snowflakeTenantIDGenerator 前缀+snowflake ID（41位毫秒时间戳/10位节点/12位序号）
+type snowflakeTenantIDGenerator struct {
+	mu       sync.Mutex
+	nodeID   int64
+	lastTime int64
+	sequence int64
+}
+
+// Generate 生成租户ID
+func (g *snowflakeTenantIDGenerator) Generate(ctx context.Context, tenant *biz.Tenant) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Since(snowflakeEpoch).Milliseconds()
+	if now < g.lastTime {
+		return "", fmt.Errorf("clock moved backwards: %dms", g.lastTime-now)
+	}
+
+	if now == g.lastTime {
+		g.sequence = (g.sequence + 1) & snowflakeMaxSequence
+		if g.sequence == 0 {
+			// 当前毫秒序号用尽，等待下一毫秒
+			for now <= g.lastTime {
+				time.Sleep(100 * time.Microsecond)
+				now = time.Since(snowflakeEpoch).Milliseconds()
+			}
+		}
+	} else {
+		g.sequence = 0
+	}
+	g.lastTime = now
+
+	id := now<<(snowflakeNodeBits+snowflakeSequenceBits) | g.nodeID<<snowflakeSequenceBits | g.sequence
+	return tenantIDPrefix(tenant.TenantType) + strconv.FormatInt(id, 10), nil
+}
+
+// sequenceTenantIDGenerator 前缀+数据库序号
+type sequenceTenantIDGenerator struct {
+	data  *Data
+	width int
+}
+
+// Generate 生成租户ID
+func (g *sequenceTenantIDGenerator) Generate(ctx context.Context, tenant *biz.Tenant) (string, error) {
+	prefix := tenantIDPrefix(tenant.TenantType)
+
+	var value int64
+	err := g.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 初始化序列
+		seq := &TenantIDSequenceModel{Prefix: prefix, NextValue: 1}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(seq).Error; err != nil {
+			return err
+		}
+
+		// 锁定并递增
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("prefix = ?", prefix).First(seq).Error; err != nil {
+			return err
+		}
+		value = seq.NextValue
+
+		return tx.Model(seq).Where("prefix = ?", prefix).Update("next_value", gorm.Expr("next_value + 1")).Error
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%0*d", prefix, g.width, value), nil
+}
+
+// customTenantIDGenerator 调用方指定租户ID，校验格式与唯一性
+type customTenantIDGenerator struct {
+	data     *Data
+	fallback biz.TenantIDGenerator
+}
+
+// Generate 生成租户ID
+func (g *customTenantIDGenerator) Generate(ctx context.Context, tenant *biz.Tenant) (string, error) {
+	if tenant.TenantID == "" {
+		if g.fallback == nil {
+			return "", biz.ErrTenantIDRequired
+		}
+		return g.fallback.Generate(ctx, tenant)
+	}
+
+	if !tenantIDPattern.MatchString(tenant.TenantID) {
+		return "", biz.ErrTenantIDInvalid
+	}
+
+	// 检查租户ID是否已存在，并发创建由主键约束兜底
+	var count int64
+	if err := g.data.db.WithContext(ctx).Model(&TenantModel{}).Where("tenant_id = ?", tenant.TenantID).Count(&count).Error; err != nil {
+		return "", err
+	}
+	if count > 0 {
+		return "", biz.ErrTenantIDConflict
+	}
+
+	return tenant.TenantID, nil
+}
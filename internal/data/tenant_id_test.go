@@ -0,0 +1,96 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+)
+
+func TestSnowflakeNodeIDFromHostname(t *testing.T) {
+	cases := []struct {
+		hostname string
+		want     int64
+		wantErr  bool
+	}{
+		{hostname: "tenant-service-0", want: 0},
+		{hostname: "tenant-service-17", want: 17},
+		{hostname: "tenant-service-1023", want: 1023},
+		{hostname: "tenant-service-1024", wantErr: true},
+		{hostname: "tenant-service-007", wantErr: true},
+		{hostname: "tenant-service-7d9f8c6b5-x2kzq", wantErr: true},
+		{hostname: "tenant-service", wantErr: true},
+		{hostname: "localhost", wantErr: true},
+		{hostname: "", wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := snowflakeNodeIDFromHostname(c.hostname)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("%q: expected error, got node id %d", c.hostname, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", c.hostname, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%q: node id = %d, want %d", c.hostname, got, c.want)
+		}
+	}
+}
+
+func TestTenantIDGeneratorDefaultsToULID(t *testing.T) {
+	gen, err := NewTenantIDGenerator(&conf.Tenant{}, nil, log.NewStdLogger(&bytes.Buffer{}))
+	if err != nil {
+		t.Fatalf("new generator: %v", err)
+	}
+	if _, ok := gen.(*ulidTenantIDGenerator); !ok {
+		t.Fatalf("default generator = %T, want ULID", gen)
+	}
+}
+
+func TestUUIDTenantIDGenerator(t *testing.T) {
+	gen := &uuidTenantIDGenerator{}
+	tenant := &biz.Tenant{TenantType: biz.TenantTypeEnterprise}
+	seen := make(map[string]struct{})
+	for i := 0; i < 10000; i++ {
+		id, err := gen.Generate(context.Background(), tenant)
+		if err != nil {
+			t.Fatalf("generate: %v", err)
+		}
+		if !strings.HasPrefix(id, "EN_") || len(id) != len("EN_")+26 {
+			t.Fatalf("unexpected id %q", id)
+		}
+		if !tenantIDPattern.MatchString(id) {
+			t.Fatalf("id %q does not fit the tenant id column", id)
+		}
+		if _, ok := seen[id]; ok {
+			t.Fatalf("duplicate id %q", id)
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func TestSnowflakeTenantIDGeneratorExplicitNodeID(t *testing.T) {
+	logger := log.NewStdLogger(&bytes.Buffer{})
+	cfg := &conf.Tenant{IdGenerator: &conf.Tenant_IDGenerator{Strategy: TenantIDStrategySnowflake, NodeId: proto.Int64(5)}}
+	gen, err := NewTenantIDGenerator(cfg, nil, logger)
+	if err != nil {
+		t.Fatalf("new generator: %v", err)
+	}
+	if g, ok := gen.(*snowflakeTenantIDGenerator); !ok || g.nodeID != 5 {
+		t.Fatalf("generator = %#v, want snowflake node 5", gen)
+	}
+
+	cfg.IdGenerator.NodeId = proto.Int64(snowflakeMaxNodeID + 1)
+	if _, err := NewTenantIDGenerator(cfg, nil, logger); err == nil {
+		t.Fatal("expected out of range node id to fail")
+	}
+}
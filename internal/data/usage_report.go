@@ -0,0 +1,312 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// dailyRollupName 日汇总任务在进度表中的名称
+const dailyRollupName = "daily"
+
+// UsageDailyModel 配额日用量汇总数据模型
+type UsageDailyModel struct {
+	StatDate     time.Time `gorm:"column:stat_date;type:date;primaryKey"`
+	QuotaID      int64     `gorm:"column:quota_id;primaryKey"`
+	TenantID     string    `gorm:"column:tenant_id;not null"`
+	QuotaType    string    `gorm:"column:quota_type;not null"`
+	LimitType    string    `gorm:"column:limit_type;not null"`
+	Consumed     int64     `gorm:"column:consumed;not null"`
+	Released     int64     `gorm:"column:released;not null"`
+	ConsumeCount int64     `gorm:"column:consume_count;not null"`
+	PeakUsed     int32     `gorm:"column:peak_used;not null"`
+	EndUsed      int32     `gorm:"column:end_used;not null"`
+	HardLimit    int32     `gorm:"column:hard_limit;not null"`
+	SoftLimit    int32     `gorm:"column:soft_limit"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (UsageDailyModel) TableName() string {
+	return "quota_usage_daily"
+}
+
+// UsageRollupStateModel 用量汇总进度数据模型
+type UsageRollupStateModel struct {
+	Name         string    `gorm:"column:name;primaryKey"`
+	LastRecordID int64     `gorm:"column:last_record_id;not null"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (UsageRollupStateModel) TableName() string {
+	return "quota_usage_rollup_state"
+}
+
+// usageReportRepo 用量报表仓库实现
+type usageReportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUsageReportRepo 创建用量报表仓库
+func NewUsageReportRepo(data *Data, logger log.Logger) biz.UsageReportRepo {
+	return &usageReportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// usageDailyKey 日汇总主键
+type usageDailyKey struct {
+	date    time.Time
+	quotaID int64
+}
+
+// RollupUsage 汇总一批使用记录，汇总结果与进度在同一事务中提交
+func (r *usageReportRepo) RollupUsage(ctx context.Context, before time.Time, batchSize int) (*biz.UsageRollupResult, error) {
+	result := &biz.UsageRollupResult{}
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定进度行，多实例同时汇总时串行执行
+		var state UsageRollupStateModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", dailyRollupName).First(&state).Error
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			state = UsageRollupStateModel{Name: dailyRollupName}
+			if err := tx.Create(&state).Error; err != nil {
+				return err
+			}
+		}
+		result.LastRecordID = state.LastRecordID
+
+		var records []*QuotaUsageModel
+		if err := tx.Where("record_id > ?", state.LastRecordID).Order("record_id ASC").Limit(batchSize).Find(&records).Error; err != nil {
+			return err
+		}
+		// 遇到未沉淀的记录即停止，保证进度之前的记录都已处理
+		for i, record := range records {
+			if !record.OperationTime.Before(before) {
+				records = records[:i]
+				break
+			}
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		// 汇总维度和限制取自配额当前值，已删除配额的记录跳过
+		quotaIDs := make([]int64, 0, len(records))
+		for _, record := range records {
+			quotaIDs = append(quotaIDs, record.QuotaID)
+		}
+		var quotaModels []*QuotaModel
+		if err := tx.Where("quota_id IN ?", quotaIDs).Find(&quotaModels).Error; err != nil {
+			return err
+		}
+		quotas := make(map[int64]*QuotaModel, len(quotaModels))
+		for _, quota := range quotaModels {
+			quotas[quota.QuotaID] = quota
+		}
+
+		rows := make(map[usageDailyKey]*UsageDailyModel)
+		var ordered []*UsageDailyModel
+		for _, record := range records {
+			quota, ok := quotas[record.QuotaID]
+			if !ok {
+				continue
+			}
+			y, m, d := record.OperationTime.Date()
+			key := usageDailyKey{date: time.Date(y, m, d, 0, 0, 0, 0, record.OperationTime.Location()), quotaID: record.QuotaID}
+			row, ok := rows[key]
+			if !ok {
+				row = &UsageDailyModel{
+					StatDate:  key.date,
+					QuotaID:   quota.QuotaID,
+					TenantID:  quota.TenantID,
+					QuotaType: quota.QuotaType,
+					LimitType: quota.LimitType,
+					HardLimit: quota.HardLimit,
+					SoftLimit: quota.SoftLimit,
+				}
+				rows[key] = row
+				ordered = append(ordered, row)
+			}
+
+			switch convertOperationTypeToEnum(record.OperationType) {
+			case biz.OperationTypeConsume:
+				row.Consumed += int64(record.DeltaValue)
+				row.ConsumeCount++
+			case biz.OperationTypeRelease:
+				row.Released -= int64(record.DeltaValue)
+			}
+			if record.CurrentUsed > row.PeakUsed {
+				row.PeakUsed = record.CurrentUsed
+			}
+			row.EndUsed = record.CurrentUsed
+		}
+
+		if len(ordered) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "stat_date"}, {Name: "quota_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"consumed":      gorm.Expr("consumed + VALUES(consumed)"),
+					"released":      gorm.Expr("released + VALUES(released)"),
+					"consume_count": gorm.Expr("consume_count + VALUES(consume_count)"),
+					"peak_used":     gorm.Expr("GREATEST(peak_used, VALUES(peak_used))"),
+					"end_used":      gorm.Expr("VALUES(end_used)"),
+					"hard_limit":    gorm.Expr("VALUES(hard_limit)"),
+					"soft_limit":    gorm.Expr("VALUES(soft_limit)"),
+					"updated_at":    gorm.Expr("VALUES(updated_at)"),
+				}),
+			}).Create(&ordered).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		state.LastRecordID = records[len(records)-1].RecordID
+		if err := tx.Save(&state).Error; err != nil {
+			return err
+		}
+		result.Records = len(records)
+		result.LastRecordID = state.LastRecordID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetRollupState 获取汇总进度，尚未汇总时返回零值
+func (r *usageReportRepo) GetRollupState(ctx context.Context) (*biz.UsageRollupState, error) {
+	var state UsageRollupStateModel
+	err := r.data.DB(ctx).Where("name = ?", dailyRollupName).First(&state).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return &biz.UsageRollupState{
+		LastRecordID: state.LastRecordID,
+		UpdatedAt:    state.UpdatedAt,
+	}, nil
+}
+
+// applyUsageFilter 添加日汇总查询条件
+func applyUsageFilter(query *gorm.DB, filter *biz.UsageReportFilter) *gorm.DB {
+	query = query.Where("stat_date BETWEEN ? AND ?", filter.StartDate, filter.EndDate)
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.QuotaType != biz.QuotaTypeUnspecified {
+		query = query.Where("quota_type = ?", convertQuotaTypeToString(filter.QuotaType))
+	}
+	if filter.LimitType != biz.LimitTypeUnspecified {
+		query = query.Where("limit_type = ?", convertLimitTypeToString(filter.LimitType))
+	}
+	return query
+}
+
+// ListDailyUsage 列出日汇总
+func (r *usageReportRepo) ListDailyUsage(ctx context.Context, filter *biz.UsageReportFilter) ([]*biz.DailyUsage, error) {
+	var models []*UsageDailyModel
+
+	query := applyUsageFilter(r.data.DB(ctx).Model(&UsageDailyModel{}), filter)
+	if err := query.Order("quota_id ASC, stat_date ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	usages := make([]*biz.DailyUsage, 0, len(models))
+	for _, model := range models {
+		usages = append(usages, &biz.DailyUsage{
+			Date:         model.StatDate,
+			QuotaID:      model.QuotaID,
+			TenantID:     model.TenantID,
+			QuotaType:    convertQuotaTypeToEnum(model.QuotaType),
+			LimitType:    convertLimitTypeToEnum(model.LimitType),
+			Consumed:     model.Consumed,
+			Released:     model.Released,
+			ConsumeCount: model.ConsumeCount,
+			PeakUsed:     model.PeakUsed,
+			EndUsed:      model.EndUsed,
+			HardLimit:    model.HardLimit,
+			SoftLimit:    model.SoftLimit,
+		})
+	}
+
+	return usages, nil
+}
+
+// TopConsumers 按消耗量降序列出租户
+func (r *usageReportRepo) TopConsumers(ctx context.Context, filter *biz.UsageReportFilter, limit int) ([]*biz.TenantConsumption, error) {
+	var rows []struct {
+		TenantID     string
+		Consumed     int64
+		Released     int64
+		ConsumeCount int64
+	}
+
+	query := applyUsageFilter(r.data.DB(ctx).Model(&UsageDailyModel{}), filter).
+		Select("tenant_id, SUM(consumed) AS consumed, SUM(released) AS released, SUM(consume_count) AS consume_count").
+		Group("tenant_id").
+		Having("SUM(consumed) > 0").
+		Order("consumed DESC, tenant_id ASC").
+		Limit(limit)
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	consumers := make([]*biz.TenantConsumption, 0, len(rows))
+	for _, row := range rows {
+		consumers = append(consumers, &biz.TenantConsumption{
+			TenantID:     row.TenantID,
+			Consumed:     row.Consumed,
+			Released:     row.Released,
+			ConsumeCount: row.ConsumeCount,
+		})
+	}
+
+	return consumers, nil
+}
+
+// CountSoftLimitUtilization 按当日峰值已用量/软限制的区间统计配额日数，未设置软限制的不计入
+func (r *usageReportRepo) CountSoftLimitUtilization(ctx context.Context, filter *biz.UsageReportFilter, bounds []int32) ([]int64, error) {
+	var bucketExpr strings.Builder
+	bucketExpr.WriteString("CASE")
+	for i, bound := range bounds {
+		fmt.Fprintf(&bucketExpr, " WHEN peak_used * 100 < soft_limit * %d THEN %d", bound, i)
+	}
+	fmt.Fprintf(&bucketExpr, " ELSE %d END", len(bounds))
+
+	var rows []struct {
+		Bucket int
+		Total  int64
+	}
+	query := applyUsageFilter(r.data.DB(ctx).Model(&UsageDailyModel{}), filter).
+		Where("soft_limit > 0").
+		Select(bucketExpr.String() + " AS bucket, COUNT(*) AS total").
+		Group("bucket")
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make([]int64, len(bounds)+1)
+	for _, row := range rows {
+		if row.Bucket >= 0 && row.Bucket < len(counts) {
+			counts[row.Bucket] = row.Total
+		}
+	}
+
+	return counts, nil
+}
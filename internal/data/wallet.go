@@ -0,0 +1,383 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"tenant-service/internal/biz"
+)
+
+// WalletModel 租户钱包数据模型
+type WalletModel struct {
+	TenantID            string    `gorm:"column:tenant_id;primaryKey"`
+	Balance             int64     `gorm:"column:balance;not null"`
+	LowBalanceThreshold int64     `gorm:"column:low_balance_threshold;not null"`
+	CreatedAt           time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt           time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName 表名
+func (WalletModel) TableName() string {
+	return "tenant_wallets"
+}
+
+// WalletTransactionModel 钱包交易数据模型，只插入不更新
+type WalletTransactionModel struct {
+	TxID           int64     `gorm:"column:tx_id;primaryKey;autoIncrement"`
+	TenantID       string    `gorm:"column:tenant_id;not null"`
+	TxType         string    `gorm:"column:tx_type;not null"`
+	Amount         int64     `gorm:"column:amount;not null"`
+	BalanceAfter   int64     `gorm:"column:balance_after;not null"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;not null"`
+	QuotaType      string    `gorm:"column:quota_type"`
+	ProductCode    string    `gorm:"column:product_code"`
+	Quantity       int32     `gorm:"column:quantity"`
+	UnitPrice      int64     `gorm:"column:unit_price"`
+	RefTxID        int64     `gorm:"column:ref_tx_id"`
+	BizID          string    `gorm:"column:biz_id"`
+	Operator       string    `gorm:"column:operator"`
+	Remark         string    `gorm:"column:remark"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName 表名
+func (WalletTransactionModel) TableName() string {
+	return "wallet_transactions"
+}
+
+// WalletLedgerEntryModel 钱包记账分录数据模型，只插入不更新
+type WalletLedgerEntryModel struct {
+	EntryID   int64     `gorm:"column:entry_id;primaryKey;autoIncrement"`
+	TxID      int64     `gorm:"column:tx_id;not null"`
+	Account   string    `gorm:"column:account;not null"`
+	Direction string    `gorm:"column:direction;not null"`
+	Amount    int64     `gorm:"column:amount;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName 表名
+func (WalletLedgerEntryModel) TableName() string {
+	return "wallet_ledger_entries"
+}
+
+type walletRepo struct {
+	data    *Data
+	metrics biz.WalletMetrics
+	log     *log.Helper
+}
+
+// NewWalletRepo 创建钱包仓库
+func NewWalletRepo(data *Data, metrics biz.WalletMetrics, logger log.Logger) biz.WalletRepo {
+	return &walletRepo{
+		data:    data,
+		metrics: metrics,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// convertWalletTransactionTypeToString 转换钱包交易类型为字符串
+func convertWalletTransactionTypeToString(txType biz.WalletTransactionType) string {
+	switch txType {
+	case biz.WalletTransactionTypeTopUp:
+		return "TOPUP"
+	case biz.WalletTransactionTypeDebit:
+		return "DEBIT"
+	case biz.WalletTransactionTypeRefund:
+		return "REFUND"
+	default:
+		return ""
+	}
+}
+
+// convertWalletTransactionTypeToEnum 转换钱包交易类型为枚举
+func convertWalletTransactionTypeToEnum(txType string) biz.WalletTransactionType {
+	switch txType {
+	case "TOPUP":
+		return biz.WalletTransactionTypeTopUp
+	case "DEBIT":
+		return biz.WalletTransactionTypeDebit
+	case "REFUND":
+		return biz.WalletTransactionTypeRefund
+	default:
+		return biz.WalletTransactionTypeUnspecified
+	}
+}
+
+// convertLedgerDirectionToString 转换分录方向为字符串
+func convertLedgerDirectionToString(direction biz.LedgerDirection) string {
+	if direction == biz.LedgerDirectionCredit {
+		return "CREDIT"
+	}
+	return "DEBIT"
+}
+
+// convertLedgerDirectionToEnum 转换分录方向为枚举
+func convertLedgerDirectionToEnum(direction string) biz.LedgerDirection {
+	switch direction {
+	case "DEBIT":
+		return biz.LedgerDirectionDebit
+	case "CREDIT":
+		return biz.LedgerDirectionCredit
+	default:
+		return biz.LedgerDirectionUnspecified
+	}
+}
+
+// convertWalletModelToBiz 转换钱包模型为业务对象
+func convertWalletModelToBiz(model *WalletModel) *biz.Wallet {
+	return &biz.Wallet{
+		TenantID:            model.TenantID,
+		Balance:             model.Balance,
+		LowBalanceThreshold: model.LowBalanceThreshold,
+		CreatedAt:           model.CreatedAt,
+		UpdatedAt:           model.UpdatedAt,
+	}
+}
+
+// convertWalletTransactionModelToBiz 转换钱包交易模型为业务对象
+func convertWalletTransactionModelToBiz(model *WalletTransactionModel, entries []*WalletLedgerEntryModel) *biz.WalletTransaction {
+	tx := &biz.WalletTransaction{
+		TxID:           model.TxID,
+		TenantID:       model.TenantID,
+		Type:           convertWalletTransactionTypeToEnum(model.TxType),
+		Amount:         model.Amount,
+		BalanceAfter:   model.BalanceAfter,
+		IdempotencyKey: model.IdempotencyKey,
+		QuotaType:      convertQuotaTypeToEnum(model.QuotaType),
+		ProductCode:    model.ProductCode,
+		Quantity:       model.Quantity,
+		UnitPrice:      model.UnitPrice,
+		RefTxID:        model.RefTxID,
+		BizID:          model.BizID,
+		Operator:       model.Operator,
+		Remark:         model.Remark,
+		CreatedAt:      model.CreatedAt,
+		Entries:        make([]*biz.LedgerEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		tx.Entries = append(tx.Entries, &biz.LedgerEntry{
+			EntryID:   entry.EntryID,
+			TxID:      entry.TxID,
+			Account:   entry.Account,
+			Direction: convertLedgerDirectionToEnum(entry.Direction),
+			Amount:    entry.Amount,
+			CreatedAt: entry.CreatedAt,
+		})
+	}
+	return tx
+}
+
+// GetWallet 获取钱包
+func (r *walletRepo) GetWallet(ctx context.Context, tenantID string) (*biz.Wallet, error) {
+	var model WalletModel
+	if err := r.data.DB(ctx).Where("tenant_id = ?", tenantID).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return convertWalletModelToBiz(&model), nil
+}
+
+// SetLowBalanceThreshold 设置低余额阈值，钱包不存在时创建
+func (r *walletRepo) SetLowBalanceThreshold(ctx context.Context, tenantID string, threshold int64) (*biz.Wallet, error) {
+	var model WalletModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		wallet := &WalletModel{TenantID: tenantID, LowBalanceThreshold: threshold}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"low_balance_threshold": threshold, "updated_at": time.Now()}),
+		}).Create(wallet).Error; err != nil {
+			return err
+		}
+		return tx.Where("tenant_id = ?", tenantID).First(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertWalletModelToBiz(&model), nil
+}
+
+// EnsureWallet 钱包不存在时按低余额阈值创建，已存在时不修改
+func (r *walletRepo) EnsureWallet(ctx context.Context, tenantID string, threshold int64) error {
+	return r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&WalletModel{TenantID: tenantID, LowBalanceThreshold: threshold}).Error
+}
+
+// ApplyTransaction 锁定钱包后写入交易和分录并更新余额，同一租户的交易按钱包行锁串行执行
+func (r *walletRepo) ApplyTransaction(ctx context.Context, walletTx *biz.WalletTransaction) (*biz.WalletTransaction, *biz.Wallet, bool, error) {
+	// 金额必须为正，负数会使扣费增加余额、充值减少余额
+	if walletTx.Amount <= 0 {
+		return nil, nil, false, biz.ErrWalletTransactionInvalid.WithMetadata(map[string]string{"reason": "amount must be positive"})
+	}
+	var (
+		wallet   WalletModel
+		model    *WalletTransactionModel
+		entries  []*WalletLedgerEntryModel
+		replayed bool
+	)
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 充值时钱包不存在则先创建，避免并发首次充值时无行可锁
+		if walletTx.Type == biz.WalletTransactionTypeTopUp {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&WalletModel{TenantID: walletTx.TenantID}).Error; err != nil {
+				return err
+			}
+		}
+
+		// 查询钱包并锁定，记录锁等待时间
+		lockStart := time.Now()
+		if err := tx.Where("tenant_id = ?", walletTx.TenantID).Clauses(clause.Locking{Strength: "UPDATE"}).First(&wallet).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrWalletNotFound
+			}
+			return err
+		}
+		r.metrics.LockWait(ctx, time.Since(lockStart))
+
+		// 幂等键已存在时返回已有交易
+		var existing WalletTransactionModel
+		err := tx.Where("tenant_id = ? AND idempotency_key = ?", walletTx.TenantID, walletTx.IdempotencyKey).First(&existing).Error
+		if err == nil {
+			model, replayed = &existing, true
+			return tx.Where("tx_id = ?", existing.TxID).Order("entry_id ASC").Find(&entries).Error
+		}
+		if err != gorm.ErrRecordNotFound {
+			return err
+		}
+
+		switch walletTx.Type {
+		case biz.WalletTransactionTypeTopUp:
+			wallet.Balance += walletTx.Amount
+		case biz.WalletTransactionTypeDebit:
+			if wallet.Balance < walletTx.Amount {
+				return biz.ErrInsufficientBalance.WithMetadata(map[string]string{"balance": strconv.FormatInt(wallet.Balance, 10)})
+			}
+			wallet.Balance -= walletTx.Amount
+		case biz.WalletTransactionTypeRefund:
+			if err := checkRefundable(tx, walletTx); err != nil {
+				return err
+			}
+			wallet.Balance += walletTx.Amount
+		}
+
+		// 更新余额
+		if err := tx.Model(&wallet).Update("balance", wallet.Balance).Error; err != nil {
+			return err
+		}
+
+		// 写入交易和分录
+		model = &WalletTransactionModel{
+			TenantID:       walletTx.TenantID,
+			TxType:         convertWalletTransactionTypeToString(walletTx.Type),
+			Amount:         walletTx.Amount,
+			BalanceAfter:   wallet.Balance,
+			IdempotencyKey: walletTx.IdempotencyKey,
+			ProductCode:    walletTx.ProductCode,
+			Quantity:       walletTx.Quantity,
+			UnitPrice:      walletTx.UnitPrice,
+			RefTxID:        walletTx.RefTxID,
+			BizID:          walletTx.BizID,
+			Operator:       walletTx.Operator,
+			Remark:         walletTx.Remark,
+		}
+		if walletTx.QuotaType != biz.QuotaTypeUnspecified {
+			model.QuotaType = convertQuotaTypeToString(walletTx.QuotaType)
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		for _, entry := range walletTx.Entries {
+			entries = append(entries, &WalletLedgerEntryModel{
+				TxID:      model.TxID,
+				Account:   entry.Account,
+				Direction: convertLedgerDirectionToString(entry.Direction),
+				Amount:    entry.Amount,
+			})
+		}
+		return tx.Create(&entries).Error
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	return convertWalletTransactionModelToBiz(model, entries), convertWalletModelToBiz(&wallet), replayed, nil
+}
+
+// checkRefundable 检查退款金额不超过扣费金额减去已退款金额，调用方需持有钱包行锁
+func checkRefundable(tx *gorm.DB, refund *biz.WalletTransaction) error {
+	var debit WalletTransactionModel
+	if err := tx.Where("tx_id = ? AND tenant_id = ? AND tx_type = ?", refund.RefTxID, refund.TenantID, "DEBIT").First(&debit).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return biz.ErrWalletTransactionNotFound
+		}
+		return err
+	}
+
+	var refunded int64
+	if err := tx.Model(&WalletTransactionModel{}).
+		Where("ref_tx_id = ? AND tx_type = ?", refund.RefTxID, "REFUND").
+		Select("COALESCE(SUM(amount), 0)").Scan(&refunded).Error; err != nil {
+		return err
+	}
+	if refunded+refund.Amount > debit.Amount {
+		return biz.ErrRefundExceeded.WithMetadata(map[string]string{"refundable": strconv.FormatInt(debit.Amount-refunded, 10)})
+	}
+	return nil
+}
+
+// GetTransaction 获取租户的交易
+func (r *walletRepo) GetTransaction(ctx context.Context, tenantID string, txID int64) (*biz.WalletTransaction, error) {
+	var model WalletTransactionModel
+	if err := r.data.DB(ctx).Where("tx_id = ? AND tenant_id = ?", txID, tenantID).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []*WalletLedgerEntryModel
+	if err := r.data.DB(ctx).Where("tx_id = ?", txID).Order("entry_id ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return convertWalletTransactionModelToBiz(&model, entries), nil
+}
+
+// ListTransactions 列出钱包交易及分录
+func (r *walletRepo) ListTransactions(ctx context.Context, filter *biz.WalletTransactionFilter) ([]*biz.WalletTransaction, error) {
+	var models []*WalletTransactionModel
+
+	query := r.data.DB(ctx).Where("tenant_id = ? AND tx_id > ?", filter.TenantID, filter.AfterTxID)
+	if filter.Type != biz.WalletTransactionTypeUnspecified {
+		query = query.Where("tx_type = ?", convertWalletTransactionTypeToString(filter.Type))
+	}
+	if err := query.Order("tx_id ASC").Limit(int(filter.Limit)).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return []*biz.WalletTransaction{}, nil
+	}
+
+	txIDs := make([]int64, 0, len(models))
+	for _, model := range models {
+		txIDs = append(txIDs, model.TxID)
+	}
+	var entries []*WalletLedgerEntryModel
+	if err := r.data.DB(ctx).Where("tx_id IN ?", txIDs).Order("entry_id ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	entriesByTx := make(map[int64][]*WalletLedgerEntryModel, len(models))
+	for _, entry := range entries {
+		entriesByTx[entry.TxID] = append(entriesByTx[entry.TxID], entry)
+	}
+
+	txs := make([]*biz.WalletTransaction, 0, len(models))
+	for _, model := range models {
+		txs = append(txs, convertWalletTransactionModelToBiz(model, entriesByTx[model.TxID]))
+	}
+	return txs, nil
+}
@@ -0,0 +1,223 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/metrics"
+)
+
+// newTestWallets 创建短信单价为5、默认低余额阈值为300的钱包用例
+func newTestWallets(t *testing.T) (*biz.WalletUsecase, *Data) {
+	t.Helper()
+	d := newTestData(t)
+	createTestTenant(t, d, "EN_acme")
+	walletMetrics, err := metrics.NewWalletMetrics(&conf.Metrics{}, testMeter)
+	if err != nil {
+		t.Fatalf("new wallet metrics: %v", err)
+	}
+	c := &conf.Tenant{Wallet: &conf.Tenant_Wallet{
+		Prices:                     []*conf.Tenant_Wallet_Price{{QuotaType: "sms", UnitPrice: 5}},
+		DefaultLowBalanceThreshold: 300,
+	}}
+	wallets, err := biz.NewWalletUsecase(c, NewWalletRepo(d, walletMetrics, testLogger), NewTenantRepo(d, testLogger), walletMetrics, newTestAudit(d), testLogger)
+	if err != nil {
+		t.Fatalf("new wallet usecase: %v", err)
+	}
+	return wallets, d
+}
+
+// smsDebit 扣费quantity条短信
+func smsDebit(quantity int32, idempotencyKey string) *biz.WalletDebit {
+	return &biz.WalletDebit{TenantID: "EN_acme", QuotaType: biz.QuotaTypeSMS, Quantity: quantity, IdempotencyKey: idempotencyKey}
+}
+
+// wantReason 核对kratos错误原因
+func wantReason(t *testing.T, err error, reason string) {
+	t.Helper()
+	if errors.Reason(err) != reason {
+		t.Fatalf("err = %v, want reason %s", err, reason)
+	}
+}
+
+func TestWalletDoubleEntry(t *testing.T) {
+	wallets, d := newTestWallets(t)
+	ctx := context.Background()
+
+	if _, err := wallets.TopUp(ctx, "EN_acme", 1000, "topup-1", "ops", ""); err != nil {
+		t.Fatalf("top up: %v", err)
+	}
+	debit, err := wallets.Debit(ctx, smsDebit(10, "debit-1"))
+	if err != nil {
+		t.Fatalf("debit: %v", err)
+	}
+	if debit.Transaction.Amount != 50 || debit.Wallet.Balance != 950 {
+		t.Fatalf("debit amount=%d balance=%d, want 50 and 950", debit.Transaction.Amount, debit.Wallet.Balance)
+	}
+	if _, err := wallets.Refund(ctx, "EN_acme", debit.Transaction.TxID, 20, "refund-1", "ops", ""); err != nil {
+		t.Fatalf("refund: %v", err)
+	}
+
+	// 每笔交易一借一贷金额相等，借贷总额平衡，钱包科目贷方减借方等于余额
+	txs, err := wallets.ListTransactions(ctx, &biz.WalletTransactionFilter{TenantID: "EN_acme"})
+	if err != nil {
+		t.Fatalf("list transactions: %v", err)
+	}
+	if len(txs) != 3 {
+		t.Fatalf("got %d transactions, want 3", len(txs))
+	}
+	var debits, credits, walletNet int64
+	for _, tx := range txs {
+		if len(tx.Entries) != 2 || tx.Entries[0].Amount != tx.Amount || tx.Entries[1].Amount != tx.Amount ||
+			tx.Entries[0].Direction != biz.LedgerDirectionDebit || tx.Entries[1].Direction != biz.LedgerDirectionCredit {
+			t.Fatalf("transaction %d entries = %+v, want one debit and one credit of %d", tx.TxID, tx.Entries, tx.Amount)
+		}
+		for _, e := range tx.Entries {
+			if e.Direction == biz.LedgerDirectionDebit {
+				debits += e.Amount
+			} else {
+				credits += e.Amount
+			}
+			if e.Account == biz.WalletAccount("EN_acme") {
+				if e.Direction == biz.LedgerDirectionCredit {
+					walletNet += e.Amount
+				} else {
+					walletNet -= e.Amount
+				}
+			}
+		}
+	}
+	wallet, err := wallets.GetWallet(ctx, "EN_acme")
+	if err != nil {
+		t.Fatalf("get wallet: %v", err)
+	}
+	if debits != credits || walletNet != wallet.Balance || wallet.Balance != 970 {
+		t.Fatalf("debits=%d credits=%d walletNet=%d balance=%d, want balanced ledger and balance 970", debits, credits, walletNet, wallet.Balance)
+	}
+
+	// 首次充值在审计事务中按默认阈值创建钱包
+	if wallet.LowBalanceThreshold != 300 {
+		t.Fatalf("low balance threshold = %d, want default 300", wallet.LowBalanceThreshold)
+	}
+	var events int64
+	if err := d.db.Model(&AuditEventModel{}).Where("action = ?", string(biz.AuditActionWalletTopUp)).Count(&events).Error; err != nil || events != 1 {
+		t.Fatalf("top up audit events = %d (%v), want 1", events, err)
+	}
+}
+
+func TestWalletIdempotentReplay(t *testing.T) {
+	wallets, _ := newTestWallets(t)
+	ctx := context.Background()
+
+	first, err := wallets.TopUp(ctx, "EN_acme", 1000, "topup-1", "ops", "")
+	if err != nil {
+		t.Fatalf("top up: %v", err)
+	}
+	again, err := wallets.TopUp(ctx, "EN_acme", 1000, "topup-1", "ops", "")
+	if err != nil {
+		t.Fatalf("replay top up: %v", err)
+	}
+	if !again.Replayed || again.Transaction.TxID != first.Transaction.TxID || again.Wallet.Balance != 1000 {
+		t.Fatalf("replay = %+v, want replay of tx %d with balance 1000", again, first.Transaction.TxID)
+	}
+	_, err = wallets.TopUp(ctx, "EN_acme", 500, "topup-1", "ops", "")
+	wantReason(t, err, "IDEMPOTENCY_KEY_CONFLICT")
+
+	debit, err := wallets.Debit(ctx, smsDebit(10, "debit-1"))
+	if err != nil {
+		t.Fatalf("debit: %v", err)
+	}
+	replayed, err := wallets.Debit(ctx, smsDebit(10, "debit-1"))
+	if err != nil {
+		t.Fatalf("replay debit: %v", err)
+	}
+	if !replayed.Replayed || replayed.Transaction.TxID != debit.Transaction.TxID || replayed.Wallet.Balance != 950 {
+		t.Fatalf("replay debit = %+v, want replay of tx %d with balance 950", replayed, debit.Transaction.TxID)
+	}
+	_, err = wallets.Debit(ctx, smsDebit(11, "debit-1"))
+	wantReason(t, err, "IDEMPOTENCY_KEY_CONFLICT")
+}
+
+func TestWalletRefundCap(t *testing.T) {
+	wallets, _ := newTestWallets(t)
+	ctx := context.Background()
+
+	if _, err := wallets.TopUp(ctx, "EN_acme", 1000, "topup-1", "ops", ""); err != nil {
+		t.Fatalf("top up: %v", err)
+	}
+	debit, err := wallets.Debit(ctx, smsDebit(10, "debit-1"))
+	if err != nil {
+		t.Fatalf("debit: %v", err)
+	}
+	txID := debit.Transaction.TxID
+
+	if _, err := wallets.Refund(ctx, "EN_acme", txID, 20, "refund-1", "ops", ""); err != nil {
+		t.Fatalf("partial refund: %v", err)
+	}
+	// 全额退款按扣费金额计算，已部分退款时超过可退金额
+	_, err = wallets.Refund(ctx, "EN_acme", txID, 0, "refund-2", "ops", "")
+	wantReason(t, err, "REFUND_EXCEEDED")
+	rest, err := wallets.Refund(ctx, "EN_acme", txID, 30, "refund-3", "ops", "")
+	if err != nil {
+		t.Fatalf("refund remainder: %v", err)
+	}
+	if rest.Wallet.Balance != 1000 {
+		t.Fatalf("balance = %d, want 1000", rest.Wallet.Balance)
+	}
+	_, err = wallets.Refund(ctx, "EN_acme", txID, 1, "refund-4", "ops", "")
+	wantReason(t, err, "REFUND_EXCEEDED")
+
+	// 退款只能对应本租户的扣费交易
+	_, err = wallets.Refund(ctx, "EN_acme", rest.Transaction.TxID, 1, "refund-5", "ops", "")
+	wantReason(t, err, "WALLET_TRANSACTION_NOT_FOUND")
+}
+
+func TestWalletRejectsInvalidAmounts(t *testing.T) {
+	wallets, d := newTestWallets(t)
+	ctx := context.Background()
+	if _, err := wallets.TopUp(ctx, "EN_acme", 1000, "topup-1", "ops", ""); err != nil {
+		t.Fatalf("top up: %v", err)
+	}
+	debit, err := wallets.Debit(ctx, smsDebit(10, "debit-1"))
+	if err != nil {
+		t.Fatalf("debit: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		call func() error
+	}{
+		{"zero top up", func() error { _, err := wallets.TopUp(ctx, "EN_acme", 0, "k1", "ops", ""); return err }},
+		{"negative top up", func() error { _, err := wallets.TopUp(ctx, "EN_acme", -500, "k2", "ops", ""); return err }},
+		{"top up without idempotency key", func() error { _, err := wallets.TopUp(ctx, "EN_acme", 100, "", "ops", ""); return err }},
+		{"zero debit", func() error { _, err := wallets.Debit(ctx, smsDebit(0, "k3")); return err }},
+		{"negative debit", func() error { _, err := wallets.Debit(ctx, smsDebit(-100, "k4")); return err }},
+		{"debit without idempotency key", func() error { _, err := wallets.Debit(ctx, smsDebit(1, "")); return err }},
+		{"negative refund", func() error {
+			_, err := wallets.Refund(ctx, "EN_acme", debit.Transaction.TxID, -10, "k5", "ops", "")
+			return err
+		}},
+		{"negative transaction in repo", func() error {
+			_, _, _, err := NewWalletRepo(d, nil, testLogger).ApplyTransaction(ctx, &biz.WalletTransaction{
+				TenantID: "EN_acme", Type: biz.WalletTransactionTypeDebit, Amount: -50, IdempotencyKey: "k6",
+			})
+			return err
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wantReason(t, tc.call(), "WALLET_TRANSACTION_INVALID")
+		})
+	}
+
+	wallet, err := wallets.GetWallet(ctx, "EN_acme")
+	if err != nil {
+		t.Fatalf("get wallet: %v", err)
+	}
+	if wallet.Balance != 950 {
+		t.Fatalf("balance = %d, want 950 unchanged by rejected transactions", wallet.Balance)
+	}
+}
@@ -0,0 +1,115 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet is health providers.
+var ProviderSet = wire.NewSet(NewChecker)
+
+// defaultCheckTimeout 单项检查超时
+const defaultCheckTimeout = 2 * time.Second
+
+// 检查状态
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// CheckFunc 依赖检查，返回nil表示正常
+type CheckFunc func(ctx context.Context) error
+
+// Result 单项检查结果
+type Result struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report 就绪检查报告
+type Report struct {
+	Ready  bool      `json:"ready"`
+	Checks []*Result `json:"checks"`
+}
+
+// Checker 依赖检查注册表，各组件在创建时注册自己的检查项
+type Checker struct {
+	timeout time.Duration
+
+	mu     sync.RWMutex
+	checks map[string]CheckFunc
+}
+
+// NewChecker 创建依赖检查注册表
+func NewChecker() *Checker {
+	return &Checker{
+		timeout: defaultCheckTimeout,
+		checks:  make(map[string]CheckFunc),
+	}
+}
+
+// Register 注册检查项，同名覆盖
+func (c *Checker) Register(name string, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = fn
+}
+
+// Check 并发执行全部检查项，任一失败即未就绪
+func (c *Checker) Check(ctx context.Context) *Report {
+	c.mu.RLock()
+	checks := make(map[string]CheckFunc, len(c.checks))
+	for name, fn := range c.checks {
+		checks[name] = fn
+	}
+	c.mu.RUnlock()
+
+	report := &Report{Ready: true, Checks: make([]*Result, 0, len(checks))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, fn := range checks {
+		wg.Add(1)
+		go func(name string, fn CheckFunc) {
+			defer wg.Done()
+			result := c.run(ctx, name, fn)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks = append(report.Checks, result)
+			if result.Status != StatusOK {
+				report.Ready = false
+			}
+		}(name, fn)
+	}
+	wg.Wait()
+
+	sort.Slice(report.Checks, func(i, j int) bool {
+		return report.Checks[i].Name < report.Checks[j].Name
+	})
+	return report
+}
+
+// run 执行单项检查
+func (c *Checker) run(ctx context.Context, name string, fn CheckFunc) *Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(ctx)
+	result := &Result{
+		Name:     name,
+		Status:   StatusOK,
+		Duration: time.Since(start).String(),
+	}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
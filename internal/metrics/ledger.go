@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"tenant-service/internal/biz"
+)
+
+// ledgerMetrics 哈希链监控指标实现
+type ledgerMetrics struct {
+	sequenceLag         metric.Float64Gauge
+	sequenceLagExceeded metric.Int64Counter
+}
+
+// NewLedgerMetrics 创建哈希链监控指标
+func NewLedgerMetrics(meter metric.Meter) (biz.LedgerMetrics, error) {
+	m := &ledgerMetrics{}
+	var err error
+	if m.sequenceLag, err = meter.Float64Gauge("tenant_ledger_sequence_lag_seconds",
+		metric.WithDescription("Age of the oldest usage record not yet sequenced into the hash chain"),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if m.sequenceLagExceeded, err = meter.Int64Counter("tenant_ledger_sequence_lag_exceeded_total",
+		metric.WithDescription("Sequencer runs that left a usage record unsequenced longer than max_sequence_lag")); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SequenceLag 记录最早一条未入链使用记录已等待的时长
+func (m *ledgerMetrics) SequenceLag(ctx context.Context, lag time.Duration, exceeded bool) {
+	m.sequenceLag.Record(ctx, lag.Seconds())
+	if exceeded {
+		m.sequenceLagExceeded.Add(ctx, 1)
+	}
+}
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/google/wire"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"tenant-service/internal/conf"
+)
+
+// ProviderSet is metrics providers.
+var ProviderSet = wire.NewSet(NewMeter, NewQuotaMetrics, NewWalletMetrics, NewLedgerMetrics)
+
+// meterName 指标作用域名称
+const meterName = "tenant-service"
+
+// DefaultPath 默认指标暴露路径
+const DefaultPath = "/metrics"
+
+// NewMeter 创建基于Prometheus导出的Meter，指标注册到prometheus默认Registry
+func NewMeter() (metric.Meter, func(), error) {
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter))
+	otel.SetMeterProvider(provider)
+
+	return provider.Meter(meterName), func() {
+		_ = provider.Shutdown(context.Background())
+	}, nil
+}
+
+// Path 指标暴露路径
+func Path(c *conf.Metrics) string {
+	if c.GetPath() != "" {
+		return c.GetPath()
+	}
+	return DefaultPath
+}
@@ -0,0 +1,205 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+)
+
+// 租户标签模式
+const (
+	TenantLabelFull    = "full"    // 每个租户独立
+	TenantLabelLimited = "limited" // 最多max_tenants个租户独立，其余归入other
+	TenantLabelNone    = "none"    // 不输出租户标签
+)
+
+// tenantLabelOther limited模式下超出上限的租户标签值
+const tenantLabelOther = "other"
+
+// defaultMaxTenants limited模式下默认独立统计的租户数
+const defaultMaxTenants = 100
+
+// 指标标签
+const (
+	labelTenant    = "tenant_id"
+	labelQuotaType = "quota_type"
+	labelLimitType = "limit_type"
+	labelReason    = "reason"
+)
+
+// tenantLabeler 控制租户标签的基数
+type tenantLabeler struct {
+	mode  string
+	max   int
+	allow map[string]struct{}
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+// newTenantLabeler 创建租户标签控制器
+func newTenantLabeler(c *conf.Metrics) (*tenantLabeler, error) {
+	l := &tenantLabeler{
+		mode:  c.GetTenantLabel(),
+		max:   int(c.GetMaxTenants()),
+		allow: make(map[string]struct{}, len(c.GetTenantAllowlist())),
+		seen:  make(map[string]struct{}),
+	}
+	switch l.mode {
+	case "":
+		l.mode = TenantLabelFull
+	case TenantLabelFull, TenantLabelLimited, TenantLabelNone:
+	default:
+		return nil, fmt.Errorf("unsupported metrics tenant_label: %s", l.mode)
+	}
+	if l.max <= 0 {
+		l.max = defaultMaxTenants
+	}
+	for _, tenantID := range c.GetTenantAllowlist() {
+		l.allow[tenantID] = struct{}{}
+	}
+	return l, nil
+}
+
+// attrs 返回租户标签，none模式下不输出
+func (l *tenantLabeler) attrs(tenantID string) []attribute.KeyValue {
+	switch l.mode {
+	case TenantLabelNone:
+		return nil
+	case TenantLabelLimited:
+		return []attribute.KeyValue{attribute.String(labelTenant, l.limited(tenantID))}
+	default:
+		return []attribute.KeyValue{attribute.String(labelTenant, tenantID)}
+	}
+}
+
+// limited 先到先得，超出上限的租户归入other
+func (l *tenantLabeler) limited(tenantID string) string {
+	if _, ok := l.allow[tenantID]; ok {
+		return tenantID
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.seen[tenantID]; ok {
+		return tenantID
+	}
+	if len(l.seen) < l.max {
+		l.seen[tenantID] = struct{}{}
+		return tenantID
+	}
+	return tenantLabelOther
+}
+
+// quotaMetrics 配额监控指标实现
+type quotaMetrics struct {
+	tenants *tenantLabeler
+
+	used          metric.Int64Gauge
+	remaining     metric.Int64Gauge
+	denied        metric.Int64Counter
+	softCrossed   metric.Int64Counter
+	resetDuration metric.Float64Histogram
+	resetLag      metric.Float64Gauge
+	resetCount    metric.Int64Counter
+	lockWait      metric.Float64Histogram
+}
+
+// NewQuotaMetrics 创建配额监控指标
+func NewQuotaMetrics(c *conf.Metrics, meter metric.Meter) (biz.QuotaMetrics, error) {
+	tenants, err := newTenantLabeler(c)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &quotaMetrics{tenants: tenants}
+	if m.used, err = meter.Int64Gauge("tenant_quota_used",
+		metric.WithDescription("Used count of a tenant quota")); err != nil {
+		return nil, err
+	}
+	if m.remaining, err = meter.Int64Gauge("tenant_quota_remaining",
+		metric.WithDescription("Remaining count (hard limit minus used) of a tenant quota")); err != nil {
+		return nil, err
+	}
+	if m.denied, err = meter.Int64Counter("tenant_quota_consume_denied_total",
+		metric.WithDescription("Denied quota consumptions by reason")); err != nil {
+		return nil, err
+	}
+	if m.softCrossed, err = meter.Int64Counter("tenant_quota_soft_limit_crossed_total",
+		metric.WithDescription("Times a quota crossed its soft limit")); err != nil {
+		return nil, err
+	}
+	if m.resetDuration, err = meter.Float64Histogram("tenant_quota_reset_duration_seconds",
+		metric.WithDescription("Duration of quota reset jobs"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60)); err != nil {
+		return nil, err
+	}
+	if m.resetLag, err = meter.Float64Gauge("tenant_quota_reset_lag_seconds",
+		metric.WithDescription("Delay between the oldest due reset time and the reset job start"),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if m.resetCount, err = meter.Int64Counter("tenant_quota_reset_total",
+		metric.WithDescription("Quotas reset by reset jobs")); err != nil {
+		return nil, err
+	}
+	if m.lockWait, err = meter.Float64Histogram("tenant_quota_lock_wait_seconds",
+		metric.WithDescription("Time spent acquiring the quota row lock in ConsumeQuota"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// quotaAttrs 配额维度标签
+func (m *quotaMetrics) quotaAttrs(tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, extra ...attribute.KeyValue) metric.MeasurementOption {
+	attrs := append(m.tenants.attrs(tenantID),
+		attribute.String(labelQuotaType, quotaType.String()),
+		attribute.String(labelLimitType, limitType.String()),
+	)
+	return metric.WithAttributes(append(attrs, extra...)...)
+}
+
+// ObserveQuota 记录配额的已用量和剩余量
+func (m *quotaMetrics) ObserveQuota(ctx context.Context, quota *biz.QuotaInfo) {
+	if quota == nil {
+		return
+	}
+	attrs := m.quotaAttrs(quota.TenantID, quota.QuotaType, quota.LimitType)
+	m.used.Record(ctx, int64(quota.UsedCount), attrs)
+	m.remaining.Record(ctx, int64(quota.Remaining()), attrs)
+}
+
+// ConsumeDenied 记录被拒绝的配额消费
+func (m *quotaMetrics) ConsumeDenied(ctx context.Context, tenantID string, quotaType biz.QuotaType, limitType biz.LimitType, reason string) {
+	m.denied.Add(ctx, 1, m.quotaAttrs(tenantID, quotaType, limitType, attribute.String(labelReason, reason)))
+}
+
+// SoftLimitCrossed 记录已用量越过软限制
+func (m *quotaMetrics) SoftLimitCrossed(ctx context.Context, quota *biz.QuotaInfo) {
+	m.softCrossed.Add(ctx, 1, m.quotaAttrs(quota.TenantID, quota.QuotaType, quota.LimitType))
+}
+
+// ResetFinished 记录配额重置任务的耗时和延迟
+func (m *quotaMetrics) ResetFinished(ctx context.Context, limitType biz.LimitType, count int, duration, lag time.Duration) {
+	attrs := metric.WithAttributes(attribute.String(labelLimitType, limitType.String()))
+	m.resetDuration.Record(ctx, duration.Seconds(), attrs)
+	m.resetLag.Record(ctx, lag.Seconds(), attrs)
+	m.resetCount.Add(ctx, int64(count), attrs)
+}
+
+// LockWait 记录配额行锁等待时间
+func (m *quotaMetrics) LockWait(ctx context.Context, quotaType biz.QuotaType, limitType biz.LimitType, wait time.Duration) {
+	m.lockWait.Record(ctx, wait.Seconds(), metric.WithAttributes(
+		attribute.String(labelQuotaType, quotaType.String()),
+		attribute.String(labelLimitType, limitType.String()),
+	))
+}
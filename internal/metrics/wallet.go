@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+)
+
+// walletMetrics 钱包监控指标实现
+type walletMetrics struct {
+	tenants *tenantLabeler
+
+	balance    metric.Int64Gauge
+	lowBalance metric.Int64Counter
+	lockWait   metric.Float64Histogram
+}
+
+// NewWalletMetrics 创建钱包监控指标
+func NewWalletMetrics(c *conf.Metrics, meter metric.Meter) (biz.WalletMetrics, error) {
+	tenants, err := newTenantLabeler(c)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &walletMetrics{tenants: tenants}
+	if m.balance, err = meter.Int64Gauge("tenant_wallet_balance",
+		metric.WithDescription("Balance of a tenant wallet in minor units")); err != nil {
+		return nil, err
+	}
+	if m.lowBalance, err = meter.Int64Counter("tenant_wallet_low_balance_crossed_total",
+		metric.WithDescription("Times a wallet balance dropped below its low balance threshold")); err != nil {
+		return nil, err
+	}
+	if m.lockWait, err = meter.Float64Histogram("tenant_wallet_lock_wait_seconds",
+		metric.WithDescription("Time spent acquiring the wallet row lock"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ObserveWallet 记录钱包余额
+func (m *walletMetrics) ObserveWallet(ctx context.Context, wallet *biz.Wallet) {
+	if wallet == nil {
+		return
+	}
+	m.balance.Record(ctx, wallet.Balance, metric.WithAttributes(m.tenants.attrs(wallet.TenantID)...))
+}
+
+// LowBalanceCrossed 记录余额降到低余额阈值以下
+func (m *walletMetrics) LowBalanceCrossed(ctx context.Context, wallet *biz.Wallet) {
+	m.lowBalance.Add(ctx, 1, metric.WithAttributes(m.tenants.attrs(wallet.TenantID)...))
+}
+
+// LockWait 记录钱包行锁等待时间
+func (m *walletMetrics) LockWait(ctx context.Context, wait time.Duration) {
+	m.lockWait.Record(ctx, wait.Seconds())
+}
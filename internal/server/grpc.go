@@ -3,17 +3,33 @@ package server
 import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	pb "tenant-service/api/tenant_service/v1"
 	"tenant-service/internal/conf"
 	"tenant-service/internal/service"
+	"tenant-service/pkg/tenantctx"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, tenant *service.TenantService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, meter metric.Meter, tp trace.TracerProvider, probe *HealthProbe, tenant *service.TenantService, resolver tenantctx.Resolver, proxies service.TrustedProxies, logger log.Logger) (*grpc.Server, error) {
+	metricsMiddleware, err := newMetricsMiddleware(meter)
+	if err != nil {
+		return nil, err
+	}
 	var opts = []grpc.ServerOption{
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			recovery.Recovery(),
+			tracing.Server(tracing.WithTracerProvider(tp)),
+			metricsMiddleware,
+			validate.Validator(),
+			tenantctx.Server(resolver, tenantctx.WithOptional()),
+			service.NewAuditMiddleware(proxies),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -26,6 +42,7 @@ func NewGRPCServer(c *conf.Server, tenant *service.TenantService, logger log.Log
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
+	grpc_health_v1.RegisterHealthServer(srv, probe.grpc)
 	pb.RegisterTenantServer(srv, tenant)
-	return srv
+	return srv, nil
 }
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/health"
+)
+
+// healthProbeInterval gRPC健康状态刷新间隔
+const healthProbeInterval = 5 * time.Second
+
+// HealthProbe 依据依赖检查维护gRPC健康状态，并提供HTTP存活/就绪探针
+type HealthProbe struct {
+	checker *health.Checker
+	grpc    *grpchealth.Server
+	log     *log.Helper
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewHealthProbe 创建健康探针，就绪前gRPC健康状态为NOT_SERVING
+func NewHealthProbe(checker *health.Checker, logger log.Logger) *HealthProbe {
+	p := &HealthProbe{
+		checker: checker,
+		grpc:    grpchealth.NewServer(),
+		log:     log.NewHelper(logger),
+		stop:    make(chan struct{}),
+	}
+	p.setServing(false)
+	return p
+}
+
+// Start 周期性执行依赖检查并更新gRPC健康状态，实现transport.Server
+func (p *HealthProbe) Start(ctx context.Context) error {
+	ticker := time.NewTicker(healthProbeInterval)
+	defer ticker.Stop()
+
+	ready := false
+	for {
+		report := p.checker.Check(ctx)
+		if report.Ready != ready {
+			ready = report.Ready
+			p.setServing(ready)
+			if ready {
+				p.log.Info("service is ready")
+			} else {
+				p.log.Warnf("service is not ready: %s", failedChecks(report))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop 停止探测，gRPC健康状态置为NOT_SERVING，实现transport.Server
+func (p *HealthProbe) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+		p.grpc.Shutdown()
+	})
+	return nil
+}
+
+// setServing 设置整体及租户服务的gRPC健康状态
+func (p *HealthProbe) setServing(serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	p.grpc.SetServingStatus("", status)
+	p.grpc.SetServingStatus(pb.Tenant_ServiceDesc.ServiceName, status)
+}
+
+// Liveness 存活探针，进程可响应即存活
+func (p *HealthProbe) Liveness(w nethttp.ResponseWriter, r *nethttp.Request) {
+	writeJSON(w, nethttp.StatusOK, map[string]string{"status": health.StatusOK})
+}
+
+// Readiness 就绪探针，依赖检查全部通过返回200，否则返回503
+func (p *HealthProbe) Readiness(w nethttp.ResponseWriter, r *nethttp.Request) {
+	report := p.checker.Check(r.Context())
+	code := nethttp.StatusOK
+	if !report.Ready {
+		code = nethttp.StatusServiceUnavailable
+	}
+	writeJSON(w, code, report)
+}
+
+// failedChecks 未通过的检查项
+func failedChecks(report *health.Report) string {
+	var failed []string
+	for _, result := range report.Checks {
+		if result.Status != health.StatusOK {
+			failed = append(failed, result.Name+": "+result.Error)
+		}
+	}
+	return strings.Join(failed, "; ")
+}
+
+// writeJSON 输出JSON响应
+func writeJSON(w nethttp.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
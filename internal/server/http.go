@@ -3,17 +3,33 @@ package server
 import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	pb "tenant-service/api/tenant_service/v1"
 	"tenant-service/internal/conf"
+	"tenant-service/internal/metrics"
 	"tenant-service/internal/service"
+	"tenant-service/pkg/tenantctx"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, tenant *service.TenantService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, mc *conf.Metrics, meter metric.Meter, tp trace.TracerProvider, probe *HealthProbe, tenant *service.TenantService, resolver tenantctx.Resolver, proxies service.TrustedProxies, logger log.Logger) (*http.Server, error) {
+	metricsMiddleware, err := newMetricsMiddleware(meter)
+	if err != nil {
+		return nil, err
+	}
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(tracing.WithTracerProvider(tp)),
+			metricsMiddleware,
+			validate.Validator(),
+			tenantctx.Server(resolver, tenantctx.WithOptional()),
+			service.NewAuditMiddleware(proxies),
 		),
 	}
 	if c.Http.Network != "" {
@@ -26,6 +42,9 @@ func NewHTTPServer(c *conf.Server, tenant *service.TenantService, logger log.Log
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle(metrics.Path(mc), promhttp.Handler())
+	srv.HandleFunc("/healthz", probe.Liveness)
+	srv.HandleFunc("/readyz", probe.Readiness)
 	pb.RegisterTenantHTTPServer(srv, tenant)
-	return srv
+	return srv, nil
 }
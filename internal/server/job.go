@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"tenant-service/internal/health"
+)
+
+// periodicJob 周期任务，按间隔执行并注册心跳检查，实现transport.Server
+type periodicJob struct {
+	name     string
+	interval time.Duration
+	disabled bool
+	run      func(ctx context.Context)
+	log      *log.Helper
+
+	heartbeat atomic.Int64 // 最近一次执行开始时间（UnixNano）
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+// newPeriodicJob 创建周期任务，启用时注册名为name的健康检查
+func newPeriodicJob(name string, interval time.Duration, disabled bool, run func(ctx context.Context), checker *health.Checker, logger log.Logger) *periodicJob {
+	j := &periodicJob{
+		name:     name,
+		interval: interval,
+		disabled: disabled,
+		run:      run,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+	}
+	if !disabled {
+		checker.Register(name, j.check)
+	}
+	return j
+}
+
+// Start 启动周期任务，实现transport.Server
+func (j *periodicJob) Start(ctx context.Context) error {
+	if j.disabled {
+		j.log.Infof("%s disabled", j.name)
+		return nil
+	}
+	j.log.Infof("%s started: interval=%v", j.name, j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.tick(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-j.stop:
+			return nil
+		case <-ticker.C:
+			j.tick(ctx)
+		}
+	}
+}
+
+// Stop 停止周期任务，实现transport.Server
+func (j *periodicJob) Stop(ctx context.Context) error {
+	j.stopOnce.Do(func() { close(j.stop) })
+	return nil
+}
+
+// tick 记录心跳并执行一轮任务
+func (j *periodicJob) tick(ctx context.Context) {
+	j.heartbeat.Store(time.Now().UnixNano())
+	j.run(ctx)
+}
+
+// check 超过3个间隔未执行视为任务停滞
+func (j *periodicJob) check(ctx context.Context) error {
+	last := j.heartbeat.Load()
+	if last == 0 {
+		return fmt.Errorf("%s not started", j.name)
+	}
+	if idle := time.Since(time.Unix(0, last)); idle > 3*j.interval {
+		return fmt.Errorf("%s stalled for %v", j.name, idle.Truncate(time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/health"
+)
+
+// defaultLeaseReclaimInterval 默认过期租约回收间隔
+const defaultLeaseReclaimInterval = 30 * time.Second
+
+// QuotaLeaseReclaimJob 过期租约回收任务，回收崩溃或未归还客户端持有的租约并退回未用额度
+type QuotaLeaseReclaimJob struct {
+	*periodicJob
+
+	lu  *biz.QuotaLeaseUsecase
+	log *log.Helper
+}
+
+// NewQuotaLeaseReclaimJob 创建过期租约回收任务
+func NewQuotaLeaseReclaimJob(c *conf.Tenant, lu *biz.QuotaLeaseUsecase, checker *health.Checker, logger log.Logger) *QuotaLeaseReclaimJob {
+	j := &QuotaLeaseReclaimJob{
+		lu:  lu,
+		log: log.NewHelper(logger),
+	}
+	interval := defaultLeaseReclaimInterval
+	if c.GetQuotaLease().GetInterval() != nil {
+		interval = c.GetQuotaLease().GetInterval().AsDuration()
+	}
+	j.periodicJob = newPeriodicJob("quota_lease_reclaim_job", interval, c.GetQuotaLease().GetDisabled(), j.run, checker, logger)
+	return j
+}
+
+// run 执行一轮回收
+func (j *QuotaLeaseReclaimJob) run(ctx context.Context) {
+	reclaimed, err := j.lu.ReclaimExpiredLeases(ctx)
+	if err != nil {
+		j.log.WithContext(ctx).Errorf("reclaim expired leases error: %v", err)
+		return
+	}
+	if reclaimed > 0 {
+		j.log.WithContext(ctx).Infof("reclaimed %d expired quota leases", reclaimed)
+	}
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/health"
+)
+
+const (
+	// defaultLedgerSequenceInterval 默认定序间隔
+	defaultLedgerSequenceInterval = 5 * time.Second
+	// ledgerSequenceMaxBatches 每轮最多定序的批数，剩余的记录留到下一轮
+	ledgerSequenceMaxBatches = 50
+	// defaultLedgerCheckpointInterval 默认签发检查点间隔
+	defaultLedgerCheckpointInterval = time.Hour
+	// ledgerCheckpointMaxBatches 每轮最多签发的批数，剩余的配额留到下一轮
+	ledgerCheckpointMaxBatches = 20
+)
+
+// LedgerSequencerJob 哈希链定序任务，为提交后尚未入链的使用记录分配链序号，写入路径不再争用链头行
+type LedgerSequencerJob struct {
+	*periodicJob
+
+	lg  *biz.LedgerUsecase
+	log *log.Helper
+}
+
+// NewLedgerSequencerJob 创建哈希链定序任务
+func NewLedgerSequencerJob(c *conf.Tenant, lg *biz.LedgerUsecase, checker *health.Checker, logger log.Logger) *LedgerSequencerJob {
+	j := &LedgerSequencerJob{
+		lg:  lg,
+		log: log.NewHelper(logger),
+	}
+	interval := defaultLedgerSequenceInterval
+	if c.GetLedger().GetSequenceInterval() != nil {
+		interval = c.GetLedger().GetSequenceInterval().AsDuration()
+	}
+	j.periodicJob = newPeriodicJob("ledger_sequencer_job", interval, c.GetLedger().GetDisabled(), j.run, checker, logger)
+	return j
+}
+
+// run 执行一轮定序
+func (j *LedgerSequencerJob) run(ctx context.Context) {
+	sequenced, err := j.lg.SequenceRecords(ctx, ledgerSequenceMaxBatches)
+	if err != nil {
+		j.log.WithContext(ctx).Errorf("sequence ledger records error: %v", err)
+	}
+	if sequenced > 0 {
+		j.log.WithContext(ctx).Infof("sequenced %d ledger records", sequenced)
+	}
+}
+
+// LedgerCheckpointJob 签名检查点任务，为自上次检查点以来有新使用记录的配额签发链头检查点
+type LedgerCheckpointJob struct {
+	*periodicJob
+
+	lg  *biz.LedgerUsecase
+	log *log.Helper
+}
+
+// NewLedgerCheckpointJob 创建签名检查点任务，未配置签名密钥时不启动
+func NewLedgerCheckpointJob(c *conf.Tenant, lg *biz.LedgerUsecase, checker *health.Checker, logger log.Logger) *LedgerCheckpointJob {
+	j := &LedgerCheckpointJob{
+		lg:  lg,
+		log: log.NewHelper(logger),
+	}
+	interval := defaultLedgerCheckpointInterval
+	if c.GetLedger().GetCheckpointInterval() != nil {
+		interval = c.GetLedger().GetCheckpointInterval().AsDuration()
+	}
+	disabled := c.GetLedger().GetDisabled() || !lg.SigningEnabled()
+	j.periodicJob = newPeriodicJob("ledger_checkpoint_job", interval, disabled, j.run, checker, logger)
+	return j
+}
+
+// run 执行一轮签发
+func (j *LedgerCheckpointJob) run(ctx context.Context) {
+	created, err := j.lg.CreateCheckpoints(ctx, ledgerCheckpointMaxBatches)
+	if err != nil {
+		j.log.WithContext(ctx).Errorf("create ledger checkpoints error: %v", err)
+	}
+	if created > 0 {
+		j.log.WithContext(ctx).Infof("created %d ledger checkpoints", created)
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/health"
+)
+
+// defaultResetInterval 默认配额重置扫描间隔
+const defaultResetInterval = time.Minute
+
+// QuotaResetScheduler 配额定时重置任务，按间隔应用到期的计划配额变更，再扫描到期的日/月配额并重置
+// 每个副本都会运行该任务，变更和配额都在加锁后重新确认状态，同一变更只应用一次，同一配额每周期只重置一次
+type QuotaResetScheduler struct {
+	*periodicJob
+
+	qu  *biz.QuotaUsecase
+	cu  *biz.QuotaChangeUsecase
+	log *log.Helper
+}
+
+// NewQuotaResetScheduler 创建配额定时重置任务
+func NewQuotaResetScheduler(c *conf.Tenant, qu *biz.QuotaUsecase, cu *biz.QuotaChangeUsecase, checker *health.Checker, logger log.Logger) *QuotaResetScheduler {
+	s := &QuotaResetScheduler{
+		qu:  qu,
+		cu:  cu,
+		log: log.NewHelper(logger),
+	}
+	interval := defaultResetInterval
+	if c.GetQuotaReset().GetInterval() != nil {
+		interval = c.GetQuotaReset().GetInterval().AsDuration()
+	}
+	s.periodicJob = newPeriodicJob("quota_reset_scheduler", interval, c.GetQuotaReset().GetDisabled(), s.run, checker, logger)
+	return s
+}
+
+// run 执行一轮重置
+func (s *QuotaResetScheduler) run(ctx context.Context) {
+	// 先应用计划变更，下次重置时生效的变更在重置前切换到新限制
+	if _, err := s.cu.ApplyDueQuotaChanges(ctx); err != nil {
+		s.log.WithContext(ctx).Errorf("apply due quota changes error: %v", err)
+	}
+	for _, limitType := range []biz.LimitType{biz.LimitTypeDaily, biz.LimitTypeMonthly} {
+		if err := s.qu.ResetQuotas(ctx, limitType); err != nil {
+			s.log.WithContext(ctx).Errorf("reset %s quotas error: %v", limitType, err)
+		}
+	}
+}
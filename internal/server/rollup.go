@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+	"tenant-service/internal/health"
+)
+
+const (
+	// defaultRollupInterval 默认用量汇总间隔
+	defaultRollupInterval = time.Minute
+	// defaultRollupBatchSize 默认每批汇总的使用记录数
+	defaultRollupBatchSize = 1000
+	// defaultRollupSettleDelay 默认只汇总早于该时长的使用记录
+	defaultRollupSettleDelay = 30 * time.Second
+	// maxRollupBatches 每轮最多汇总的批数，积压时分多轮追平
+	maxRollupBatches = 100
+)
+
+// UsageRollupJob 配额用量日汇总任务，按间隔将新增使用记录累加到日汇总表
+type UsageRollupJob struct {
+	*periodicJob
+
+	batchSize   int
+	settleDelay time.Duration
+	ur          *biz.UsageReportUsecase
+	log         *log.Helper
+}
+
+// NewUsageRollupJob 创建用量日汇总任务
+func NewUsageRollupJob(c *conf.Tenant, ur *biz.UsageReportUsecase, checker *health.Checker, logger log.Logger) *UsageRollupJob {
+	rc := c.GetUsageRollup()
+	j := &UsageRollupJob{
+		batchSize:   defaultRollupBatchSize,
+		settleDelay: defaultRollupSettleDelay,
+		ur:          ur,
+		log:         log.NewHelper(logger),
+	}
+	if rc.GetBatchSize() > 0 {
+		j.batchSize = int(rc.GetBatchSize())
+	}
+	if rc.GetSettleDelay() != nil {
+		j.settleDelay = rc.GetSettleDelay().AsDuration()
+	}
+	interval := defaultRollupInterval
+	if rc.GetInterval() != nil {
+		interval = rc.GetInterval().AsDuration()
+	}
+	j.periodicJob = newPeriodicJob("usage_rollup_job", interval, rc.GetDisabled(), j.run, checker, logger)
+	return j
+}
+
+// run 执行一轮汇总
+func (j *UsageRollupJob) run(ctx context.Context) {
+	records, err := j.ur.RollupUsage(ctx, j.settleDelay, j.batchSize, maxRollupBatches)
+	if err != nil {
+		j.log.WithContext(ctx).Errorf("rollup usage error: %v", err)
+		return
+	}
+	if records > 0 {
+		j.log.WithContext(ctx).Infof("rolled up %d usage records", records)
+	}
+}
@@ -1,8 +1,27 @@
 package server
 
 import (
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/google/wire"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewHealthProbe, NewQuotaResetScheduler, NewUsageRollupJob, NewQuotaLeaseReclaimJob, NewLedgerSequencerJob, NewLedgerCheckpointJob)
+
+// newMetricsMiddleware 请求量与耗时指标中间件
+func newMetricsMiddleware(meter metric.Meter) (middleware.Middleware, error) {
+	requests, err := metrics.DefaultRequestsCounter(meter, metrics.DefaultServerRequestsCounterName)
+	if err != nil {
+		return nil, err
+	}
+	seconds, err := metrics.DefaultSecondsHistogram(meter, metrics.DefaultServerSecondsHistogramName)
+	if err != nil {
+		return nil, err
+	}
+	return metrics.Server(
+		metrics.WithRequests(requests),
+		metrics.WithSeconds(seconds),
+	), nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"go.opentelemetry.io/otel/trace"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	status "google.golang.org/grpc/status"
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+	"tenant-service/internal/conf"
+)
+
+// 审计相关请求头
+const (
+	auditOperatorHeader  = "x-operator"
+	auditRequestIDHeader = "x-request-id"
+	forwardedForHeader   = "x-forwarded-for"
+)
+
+// TrustedProxies 可信反向代理的网段
+type TrustedProxies []*net.IPNet
+
+// NewTrustedProxies 解析配置的可信代理，支持IP和CIDR
+func NewTrustedProxies(c *conf.Server) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(c.GetTrustedProxies()))
+	for _, item := range c.GetTrustedProxies() {
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", item)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %s", item)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// trusted 地址是否为可信代理
+func (p TrustedProxies) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceIP 来源IP：对端为可信代理时，从X-Forwarded-For右侧起跳过可信代理取第一个地址，否则为对端地址
+func (p TrustedProxies) sourceIP(ctx context.Context, forwarded string) string {
+	addr := remoteIP(ctx)
+	if forwarded == "" || !p.trusted(addr) {
+		return addr
+	}
+	hops := strings.Split(forwarded, ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			break
+		}
+		addr = hop
+		if !p.trusted(hop) {
+			break
+		}
+	}
+	return addr
+}
+
+// NewAuditMiddleware 创建审计中间件，将请求头中的操作人、请求ID和来源IP放入上下文，供用例记录审计事件
+func NewAuditMiddleware(proxies TrustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(withAuditMetadata(ctx, proxies), req)
+		}
+	}
+}
+
+// withAuditMetadata 从请求头提取审计元数据，请求ID缺省时使用链路追踪ID
+func withAuditMetadata(ctx context.Context, proxies TrustedProxies) context.Context {
+	md := &biz.AuditMetadata{}
+	var forwarded string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		header := tr.RequestHeader()
+		md.Actor = header.Get(auditOperatorHeader)
+		md.RequestID = header.Get(auditRequestIDHeader)
+		forwarded = header.Get(forwardedForHeader)
+	}
+	if md.RequestID == "" {
+		if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+			md.RequestID = sc.TraceID().String()
+		}
+	}
+	md.SourceIP = proxies.sourceIP(ctx, forwarded)
+	return biz.NewAuditContext(ctx, md)
+}
+
+// remoteIP 连接的对端IP
+func remoteIP(ctx context.Context) string {
+	var addr string
+	if req, ok := http.RequestFromServerContext(ctx); ok {
+		addr = req.RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// convertAuditEventToPB converts audit event from biz to proto
+func convertAuditEventToPB(event *biz.AuditEvent) *pb.AuditEvent {
+	changes := make([]*pb.AuditChange, 0, len(event.Changes))
+	for _, change := range event.Changes {
+		changes = append(changes, &pb.AuditChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+
+	return &pb.AuditEvent{
+		EventId:    event.EventID,
+		Actor:      event.Actor,
+		Action:     string(event.Action),
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		TenantId:   event.TenantID,
+		Before:     event.Before,
+		After:      event.After,
+		Changes:    changes,
+		RequestId:  event.RequestID,
+		SourceIp:   event.SourceIP,
+		Remark:     event.Remark,
+		CreatedAt:  event.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// parseAuditTimeRange parses the RFC3339 time range of audit event filters
+func parseAuditTimeRange(filter *biz.AuditFilter, startTime, endTime string) error {
+	var err error
+	if startTime != "" {
+		if filter.StartTime, err = time.Parse(time.RFC3339, startTime); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid start_time: %s", startTime)
+		}
+	}
+	if endTime != "" {
+		if filter.EndTime, err = time.Parse(time.RFC3339, endTime); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid end_time: %s", endTime)
+		}
+	}
+	return nil
+}
+
+// ListAuditEvents implements tenant.ListAuditEvents
+func (s *TenantService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsReply, error) {
+	s.log.WithContext(ctx).Infof("ListAuditEvents: tenantID=%v, action=%v, afterEventID=%v", req.GetTenantId(), req.GetAction(), req.GetAfterEventId())
+
+	filter := &biz.AuditFilter{
+		TenantID:     req.GetTenantId(),
+		Actor:        req.GetActor(),
+		Action:       biz.AuditAction(req.GetAction()),
+		TargetType:   req.GetTargetType(),
+		TargetID:     req.GetTargetId(),
+		AfterEventID: req.GetAfterEventId(),
+		Limit:        req.GetLimit(),
+	}
+	if err := parseAuditTimeRange(filter, req.GetStartTime(), req.GetEndTime()); err != nil {
+		return nil, err
+	}
+
+	// Call business logic
+	events, err := s.au.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.ListAuditEventsReply{
+		Events: make([]*pb.AuditEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		reply.Events = append(reply.Events, convertAuditEventToPB(event))
+	}
+	return reply, nil
+}
+
+// ExportAuditEvents implements tenant.ExportAuditEvents
+func (s *TenantService) ExportAuditEvents(req *pb.ExportAuditEventsRequest, stream pb.Tenant_ExportAuditEventsServer) error {
+	ctx := stream.Context()
+	s.log.WithContext(ctx).Infof("ExportAuditEvents: format=%v, tenantID=%v", req.GetFormat(), req.GetTenantId())
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := &biz.AuditFilter{
+		TenantID:   req.GetTenantId(),
+		Actor:      req.GetActor(),
+		Action:     biz.AuditAction(req.GetAction()),
+		TargetType: req.GetTargetType(),
+		TargetID:   req.GetTargetId(),
+	}
+	if err := parseAuditTimeRange(filter, req.GetStartTime(), req.GetEndTime()); err != nil {
+		return err
+	}
+
+	// Call business logic
+	writer := &exportStreamWriter{send: func(chunk []byte) error {
+		return stream.Send(&pb.ExportAuditEventsReply{Chunk: chunk})
+	}}
+	if err := s.au.ExportAuditEvents(ctx, writer, convertDataFormatToEnum(req.GetFormat()), filter); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
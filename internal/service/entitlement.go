@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertEntitlementToPB converts entitlement from biz to proto
+func convertEntitlementToPB(entitlement *biz.Entitlement) *pb.Entitlement {
+	if entitlement == nil {
+		return nil
+	}
+
+	return &pb.Entitlement{
+		Key:            entitlement.Key,
+		Value:          entitlement.Value,
+		ProductCode:    entitlement.ProductCode,
+		Source:         pb.EntitlementSource(entitlement.Source),
+		SourceTenantId: entitlement.SourceTenantID,
+		Inherited:      entitlement.Inherited,
+		PlanCode:       entitlement.PlanCode,
+		UpdatedBy:      entitlement.UpdatedBy,
+		UpdatedAt:      formatOptionalTime(entitlement.UpdatedAt),
+	}
+}
+
+// GetEntitlements implements tenant.GetEntitlements
+func (s *TenantService) GetEntitlements(ctx context.Context, req *pb.GetEntitlementsRequest) (*pb.GetEntitlementsReply, error) {
+	s.log.WithContext(ctx).Infof("GetEntitlements: tenantID=%v, productCode=%v, keys=%v", req.GetTenantId(), req.GetProductCode(), req.GetKeys())
+
+	// Call business logic
+	entitlements, err := s.eu.GetEntitlements(ctx, req.GetTenantId(), req.GetProductCode(), req.GetKeys())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.GetEntitlementsReply{
+		Entitlements: make([]*pb.Entitlement, 0, len(entitlements)),
+	}
+	for _, entitlement := range entitlements {
+		reply.Entitlements = append(reply.Entitlements, convertEntitlementToPB(entitlement))
+	}
+	return reply, nil
+}
+
+// SetEntitlement implements tenant.SetEntitlement
+func (s *TenantService) SetEntitlement(ctx context.Context, req *pb.SetEntitlementRequest) (*pb.SetEntitlementReply, error) {
+	s.log.WithContext(ctx).Infof("SetEntitlement: tenantID=%v, productCode=%v, key=%v, value=%v, unset=%v",
+		req.GetTenantId(), req.GetProductCode(), req.GetKey(), req.GetValue(), req.GetUnset())
+
+	// Call business logic
+	entitlement, err := s.eu.SetEntitlement(ctx, &biz.EntitlementGrant{
+		TenantID:    req.GetTenantId(),
+		ProductCode: req.GetProductCode(),
+		Key:         req.GetKey(),
+		Value:       req.GetValue(),
+		UpdatedBy:   req.GetOperator(),
+	}, req.GetUnset())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetEntitlementReply{
+		Entitlement: convertEntitlementToPB(entitlement),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertQuotaLeaseToPB converts quota lease from biz to proto
+func convertQuotaLeaseToPB(lease *biz.QuotaLease) *pb.QuotaLease {
+	if lease == nil {
+		return nil
+	}
+
+	return &pb.QuotaLease{
+		LeaseId:     lease.LeaseID,
+		TenantId:    lease.TenantID,
+		QuotaId:     lease.QuotaID,
+		QuotaType:   pb.QuotaType(lease.QuotaType),
+		LimitType:   pb.LimitType(lease.LimitType),
+		ProductCode: lease.ProductCode,
+		Holder:      lease.Holder,
+		Amount:      lease.Amount,
+		UsedCount:   lease.UsedCount,
+		Returned:    lease.Returned,
+		Status:      pb.QuotaLeaseStatus(lease.Status),
+		ExpireTime:  lease.ExpireTime.Format(time.RFC3339),
+		ClosedAt:    formatOptionalTime(lease.ClosedAt),
+		CreatedAt:   lease.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// LeaseQuotaBlock implements tenant.LeaseQuotaBlock
+func (s *TenantService) LeaseQuotaBlock(ctx context.Context, req *pb.LeaseQuotaBlockRequest) (*pb.LeaseQuotaBlockReply, error) {
+	s.log.WithContext(ctx).Infof("LeaseQuotaBlock: tenantID=%v, quotaType=%v, limitType=%v, amount=%v, holder=%v",
+		req.GetTenantId(), req.GetQuotaType(), req.GetLimitType(), req.GetAmount(), req.GetHolder())
+
+	// Call business logic
+	lease, err := s.lu.LeaseQuotaBlock(ctx, req.GetTenantId(), convertQuotaTypeToEnum(req.GetQuotaType()), convertLimitTypeToEnum(req.GetLimitType()),
+		req.GetAmount(), req.GetProductCode(), req.GetHolder(), time.Duration(req.GetTtlSeconds())*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LeaseQuotaBlockReply{
+		Lease: convertQuotaLeaseToPB(lease),
+	}, nil
+}
+
+// RenewQuotaLease implements tenant.RenewQuotaLease
+func (s *TenantService) RenewQuotaLease(ctx context.Context, req *pb.RenewQuotaLeaseRequest) (*pb.RenewQuotaLeaseReply, error) {
+	s.log.WithContext(ctx).Infof("RenewQuotaLease: leaseID=%v, usedCount=%v", req.GetLeaseId(), req.GetUsedCount())
+
+	// Call business logic
+	lease, err := s.lu.RenewQuotaLease(ctx, req.GetLeaseId(), req.GetUsedCount(), time.Duration(req.GetTtlSeconds())*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RenewQuotaLeaseReply{
+		Lease: convertQuotaLeaseToPB(lease),
+	}, nil
+}
+
+// ReturnQuotaLease implements tenant.ReturnQuotaLease
+func (s *TenantService) ReturnQuotaLease(ctx context.Context, req *pb.ReturnQuotaLeaseRequest) (*pb.ReturnQuotaLeaseReply, error) {
+	s.log.WithContext(ctx).Infof("ReturnQuotaLease: leaseID=%v, usedCount=%v", req.GetLeaseId(), req.GetUsedCount())
+
+	// Call business logic
+	lease, err := s.lu.ReturnQuotaLease(ctx, req.GetLeaseId(), req.GetUsedCount())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReturnQuotaLeaseReply{
+		Lease: convertQuotaLeaseToPB(lease),
+	}, nil
+}
+
+// ListQuotaLeases implements tenant.ListQuotaLeases
+func (s *TenantService) ListQuotaLeases(ctx context.Context, req *pb.ListQuotaLeasesRequest) (*pb.ListQuotaLeasesReply, error) {
+	s.log.WithContext(ctx).Infof("ListQuotaLeases: tenantID=%v, quotaType=%v, status=%v", req.GetTenantId(), req.GetQuotaType(), req.GetStatus())
+
+	// Call business logic
+	leases, err := s.lu.ListQuotaLeases(ctx, &biz.QuotaLeaseFilter{
+		TenantID:  req.GetTenantId(),
+		QuotaType: convertQuotaTypeToEnum(req.GetQuotaType()),
+		Status:    biz.QuotaLeaseStatus(req.GetStatus()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.ListQuotaLeasesReply{
+		Leases: make([]*pb.QuotaLease, 0, len(leases)),
+	}
+	for _, lease := range leases {
+		reply.Leases = append(reply.Leases, convertQuotaLeaseToPB(lease))
+	}
+	return reply, nil
+}
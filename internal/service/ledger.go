@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertLedgerIssueKindToProto converts ledger issue kind from biz enum to proto
+func convertLedgerIssueKindToProto(kind biz.LedgerIssueKind) pb.LedgerIssueKind {
+	switch kind {
+	case biz.LedgerIssueGap:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_GAP
+	case biz.LedgerIssueHashMismatch:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_HASH_MISMATCH
+	case biz.LedgerIssueLinkMismatch:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_LINK_MISMATCH
+	case biz.LedgerIssueHeadMismatch:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_HEAD_MISMATCH
+	case biz.LedgerIssueCheckpointMismatch:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_CHECKPOINT_MISMATCH
+	case biz.LedgerIssueCheckpointSignature:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_CHECKPOINT_SIGNATURE
+	case biz.LedgerIssueUnsequenced:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_UNSEQUENCED
+	default:
+		return pb.LedgerIssueKind_LEDGER_ISSUE_KIND_UNSPECIFIED
+	}
+}
+
+// convertLedgerVerificationToPB converts ledger verification from biz to proto
+func convertLedgerVerificationToPB(result *biz.LedgerVerification) *pb.LedgerVerification {
+	verification := &pb.LedgerVerification{
+		QuotaId:     result.QuotaID,
+		Records:     int32(result.Records),
+		HeadSeq:     result.HeadSeq,
+		HeadHash:    result.HeadHash,
+		Checkpoints: int32(result.Checkpoints),
+		Issues:      make([]*pb.LedgerIssue, 0, len(result.Issues)),
+	}
+	for _, issue := range result.Issues {
+		verification.Issues = append(verification.Issues, &pb.LedgerIssue{
+			Kind:     convertLedgerIssueKindToProto(issue.Kind),
+			ChainSeq: issue.ChainSeq,
+			RecordId: issue.RecordID,
+			Detail:   issue.Detail,
+		})
+	}
+	return verification
+}
+
+// convertLedgerCheckpointToPB converts ledger checkpoint from biz to proto
+func convertLedgerCheckpointToPB(checkpoint *biz.LedgerCheckpoint) *pb.LedgerCheckpoint {
+	return &pb.LedgerCheckpoint{
+		CheckpointId: checkpoint.CheckpointID,
+		QuotaId:      checkpoint.QuotaID,
+		TenantId:     checkpoint.TenantID,
+		ChainSeq:     checkpoint.Seq,
+		RecordHash:   checkpoint.Hash,
+		KeyId:        checkpoint.KeyID,
+		Signature:    base64.StdEncoding.EncodeToString(checkpoint.Signature),
+		CreatedAt:    checkpoint.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// VerifyLedger 校验租户配额使用记录的哈希链
+func (s *TenantService) VerifyLedger(ctx context.Context, req *pb.VerifyLedgerRequest) (*pb.VerifyLedgerReply, error) {
+	s.log.WithContext(ctx).Infof("VerifyLedger: tenantID=%v, quotaID=%v", req.GetTenantId(), req.GetQuotaId())
+
+	// Call business logic
+	results, err := s.lg.VerifyLedger(ctx, req.GetTenantId(), req.GetQuotaId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.VerifyLedgerReply{
+		Ok:      true,
+		Results: make([]*pb.LedgerVerification, 0, len(results)),
+	}
+	for _, result := range results {
+		if !result.OK() {
+			reply.Ok = false
+		}
+		reply.Results = append(reply.Results, convertLedgerVerificationToPB(result))
+	}
+	return reply, nil
+}
+
+// ListLedgerCheckpoints 查询租户配额使用记录的签名检查点
+func (s *TenantService) ListLedgerCheckpoints(ctx context.Context, req *pb.ListLedgerCheckpointsRequest) (*pb.ListLedgerCheckpointsReply, error) {
+	s.log.WithContext(ctx).Infof("ListLedgerCheckpoints: tenantID=%v, quotaID=%v, afterCheckpointID=%v", req.GetTenantId(), req.GetQuotaId(), req.GetAfterCheckpointId())
+
+	// Call business logic
+	checkpoints, err := s.lg.ListCheckpoints(ctx, &biz.LedgerCheckpointFilter{
+		TenantID:          req.GetTenantId(),
+		QuotaID:           req.GetQuotaId(),
+		AfterCheckpointID: req.GetAfterCheckpointId(),
+		Limit:             req.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	publicKey, keyID := s.lg.PublicKey()
+	reply := &pb.ListLedgerCheckpointsReply{
+		Checkpoints: make([]*pb.LedgerCheckpoint, 0, len(checkpoints)),
+		KeyId:       keyID,
+	}
+	if publicKey != nil {
+		reply.PublicKey = base64.StdEncoding.EncodeToString(publicKey)
+	}
+	for _, checkpoint := range checkpoints {
+		reply.Checkpoints = append(reply.Checkpoints, convertLedgerCheckpointToPB(checkpoint))
+	}
+	return reply, nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertMemberToPB converts tenant member from biz to proto
+func convertMemberToPB(member *biz.Member) *pb.TenantMember {
+	if member == nil {
+		return nil
+	}
+
+	return &pb.TenantMember{
+		TenantId:    member.TenantID,
+		UserId:      member.UserID,
+		Role:        pb.MemberRole(member.Role),
+		DisplayName: member.DisplayName,
+		Email:       member.Email,
+		Inherit:     member.Inherit,
+		InvitedBy:   member.InvitedBy,
+		CreatedAt:   member.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   member.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// convertInvitationToPB converts tenant invitation from biz to proto
+func convertInvitationToPB(invitation *biz.Invitation) *pb.TenantInvitation {
+	if invitation == nil {
+		return nil
+	}
+
+	return &pb.TenantInvitation{
+		InvitationId: invitation.InvitationID,
+		TenantId:     invitation.TenantID,
+		Email:        invitation.Email,
+		Role:         pb.MemberRole(invitation.Role),
+		Inherit:      invitation.Inherit,
+		Status:       pb.InvitationStatus(invitation.Status),
+		InvitedBy:    invitation.InvitedBy,
+		ExpireTime:   invitation.ExpireTime.Format(time.RFC3339),
+		AcceptedBy:   invitation.AcceptedBy,
+		AcceptedAt:   formatOptionalTime(invitation.AcceptedAt),
+		CreatedAt:    invitation.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// AddTenantMember implements tenant.AddTenantMember
+func (s *TenantService) AddTenantMember(ctx context.Context, req *pb.AddTenantMemberRequest) (*pb.AddTenantMemberReply, error) {
+	s.log.WithContext(ctx).Infof("AddTenantMember: tenantID=%v, userID=%v, role=%v", req.GetTenantId(), req.GetUserId(), req.GetRole())
+
+	// Call business logic
+	member, err := s.mu.AddMember(ctx, &biz.Member{
+		TenantID:    req.GetTenantId(),
+		UserID:      req.GetUserId(),
+		Role:        biz.MemberRole(req.GetRole()),
+		DisplayName: req.GetDisplayName(),
+		Email:       req.GetEmail(),
+		Inherit:     req.GetInherit(),
+		InvitedBy:   req.GetInvitedBy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddTenantMemberReply{
+		Member: convertMemberToPB(member),
+	}, nil
+}
+
+// UpdateTenantMember implements tenant.UpdateTenantMember
+func (s *TenantService) UpdateTenantMember(ctx context.Context, req *pb.UpdateTenantMemberRequest) (*pb.UpdateTenantMemberReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateTenantMember: tenantID=%v, userID=%v, role=%v", req.GetTenantId(), req.GetUserId(), req.GetRole())
+
+	// Call business logic
+	member, err := s.mu.UpdateMember(ctx, req.GetTenantId(), req.GetUserId(), biz.MemberRole(req.GetRole()), req.Inherit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTenantMemberReply{
+		Member: convertMemberToPB(member),
+	}, nil
+}
+
+// RemoveTenantMember implements tenant.RemoveTenantMember
+func (s *TenantService) RemoveTenantMember(ctx context.Context, req *pb.RemoveTenantMemberRequest) (*pb.RemoveTenantMemberReply, error) {
+	s.log.WithContext(ctx).Infof("RemoveTenantMember: tenantID=%v, userID=%v", req.GetTenantId(), req.GetUserId())
+
+	// Call business logic
+	if err := s.mu.RemoveMember(ctx, req.GetTenantId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveTenantMemberReply{
+		Success: true,
+	}, nil
+}
+
+// ListTenantMembers implements tenant.ListTenantMembers
+func (s *TenantService) ListTenantMembers(ctx context.Context, req *pb.ListTenantMembersRequest) (*pb.ListTenantMembersReply, error) {
+	s.log.WithContext(ctx).Infof("ListTenantMembers: tenantID=%v, role=%v", req.GetTenantId(), req.GetRole())
+
+	// Call business logic
+	members, err := s.mu.ListMembers(ctx, req.GetTenantId(), biz.MemberRole(req.GetRole()), req.GetIncludeInherited())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.ListTenantMembersReply{
+		Members: make([]*pb.TenantMember, 0, len(members)),
+	}
+	for _, member := range members {
+		reply.Members = append(reply.Members, convertMemberToPB(member))
+	}
+	return reply, nil
+}
+
+// CreateTenantInvitation implements tenant.CreateTenantInvitation
+func (s *TenantService) CreateTenantInvitation(ctx context.Context, req *pb.CreateTenantInvitationRequest) (*pb.CreateTenantInvitationReply, error) {
+	s.log.WithContext(ctx).Infof("CreateTenantInvitation: tenantID=%v, role=%v, invitedBy=%v", req.GetTenantId(), req.GetRole(), req.GetInvitedBy())
+
+	// Call business logic
+	invitation, err := s.mu.CreateInvitation(ctx, &biz.Invitation{
+		TenantID:  req.GetTenantId(),
+		Email:     req.GetEmail(),
+		Role:      biz.MemberRole(req.GetRole()),
+		Inherit:   req.GetInherit(),
+		InvitedBy: req.GetInvitedBy(),
+	}, time.Duration(req.GetTtlSeconds())*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTenantInvitationReply{
+		Invitation: convertInvitationToPB(invitation),
+		Token:      invitation.Token,
+	}, nil
+}
+
+// AcceptTenantInvitation implements tenant.AcceptTenantInvitation
+func (s *TenantService) AcceptTenantInvitation(ctx context.Context, req *pb.AcceptTenantInvitationRequest) (*pb.AcceptTenantInvitationReply, error) {
+	s.log.WithContext(ctx).Infof("AcceptTenantInvitation: userID=%v", req.GetUserId())
+
+	// Call business logic
+	member, err := s.mu.AcceptInvitation(ctx, req.GetToken(), req.GetUserId(), req.GetDisplayName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptTenantInvitationReply{
+		Member: convertMemberToPB(member),
+	}, nil
+}
+
+// RevokeTenantInvitation implements tenant.RevokeTenantInvitation
+func (s *TenantService) RevokeTenantInvitation(ctx context.Context, req *pb.RevokeTenantInvitationRequest) (*pb.RevokeTenantInvitationReply, error) {
+	s.log.WithContext(ctx).Infof("RevokeTenantInvitation: invitationID=%v", req.GetInvitationId())
+
+	// Call business logic
+	invitation, err := s.mu.RevokeInvitation(ctx, req.GetInvitationId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeTenantInvitationReply{
+		Invitation: convertInvitationToPB(invitation),
+	}, nil
+}
+
+// ListTenantInvitations implements tenant.ListTenantInvitations
+func (s *TenantService) ListTenantInvitations(ctx context.Context, req *pb.ListTenantInvitationsRequest) (*pb.ListTenantInvitationsReply, error) {
+	s.log.WithContext(ctx).Infof("ListTenantInvitations: tenantID=%v, status=%v", req.GetTenantId(), req.GetStatus())
+
+	// Call business logic
+	invitations, err := s.mu.ListInvitations(ctx, req.GetTenantId(), biz.InvitationStatus(req.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.ListTenantInvitationsReply{
+		Invitations: make([]*pb.TenantInvitation, 0, len(invitations)),
+	}
+	for _, invitation := range invitations {
+		reply.Invitations = append(reply.Invitations, convertInvitationToPB(invitation))
+	}
+	return reply, nil
+}
+
+// CheckPermission implements tenant.CheckPermission
+func (s *TenantService) CheckPermission(ctx context.Context, req *pb.CheckPermissionRequest) (*pb.CheckPermissionReply, error) {
+	s.log.WithContext(ctx).Infof("CheckPermission: tenantID=%v, userID=%v, permission=%v", req.GetTenantId(), req.GetUserId(), req.GetPermission())
+
+	// Call business logic
+	decision, err := s.mu.CheckPermission(ctx, req.GetTenantId(), req.GetUserId(), req.GetPermission())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	return &pb.CheckPermissionReply{
+		Allowed:        decision.Allowed,
+		Role:           pb.MemberRole(decision.Role),
+		SourceTenantId: decision.SourceTenantID,
+		Inherited:      decision.Inherited,
+		Reason:         decision.Reason,
+		Permissions:    decision.Role.Permissions(),
+	}, nil
+}
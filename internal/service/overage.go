@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// ListOverages implements tenant.ListOverages
+func (s *TenantService) ListOverages(ctx context.Context, req *pb.ListOveragesRequest) (*pb.ListOveragesReply, error) {
+	s.log.WithContext(ctx).Infof("ListOverages: tenantID=%v, quotaType=%v", req.GetTenantId(), req.GetQuotaType())
+
+	filter := &biz.OverageFilter{
+		TenantID:  req.GetTenantId(),
+		QuotaType: convertQuotaTypeToEnum(req.GetQuotaType()),
+	}
+	startDate, err := parseReportDate(req.GetStartDate())
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseReportDate(req.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+	filter.StartTime = startDate
+	if !endDate.IsZero() {
+		// 结束日期含当天
+		filter.EndTime = endDate.AddDate(0, 0, 1)
+	}
+
+	// Call business logic
+	overages, err := s.qu.ListOverages(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbOverages := make([]*pb.QuotaOverage, 0, len(overages))
+	for _, overage := range overages {
+		pbOverages = append(pbOverages, &pb.QuotaOverage{
+			QuotaId:     overage.QuotaID,
+			TenantId:    overage.TenantID,
+			QuotaType:   pb.QuotaType(overage.QuotaType),
+			LimitType:   pb.LimitType(overage.LimitType),
+			PeriodStart: formatOptionalTime(overage.PeriodStart),
+			PeriodEnd:   formatOptionalTime(overage.PeriodEnd),
+			Overage:     overage.Overage,
+			UpdatedAt:   overage.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &pb.ListOveragesReply{
+		Overages: pbOverages,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertPlanToPB converts quota plan from biz to proto
+func convertPlanToPB(plan *biz.QuotaPlan) *pb.QuotaPlan {
+	if plan == nil {
+		return nil
+	}
+
+	pbPlan := &pb.QuotaPlan{
+		PlanCode:    plan.PlanCode,
+		PlanName:    plan.PlanName,
+		Description: plan.Description,
+		Version:     plan.Version,
+		CreatedAt:   plan.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   plan.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, quota := range plan.Quotas {
+		pbPlan.Quotas = append(pbPlan.Quotas, &pb.PlanQuota{
+			QuotaType:    pb.QuotaType(quota.QuotaType),
+			LimitType:    pb.LimitType(quota.LimitType),
+			HardLimit:    quota.HardLimit,
+			SoftLimit:    quota.SoftLimit,
+			ProductCodes: quota.ProductCodes,
+		})
+	}
+	for _, entitlement := range plan.Entitlements {
+		pbPlan.Entitlements = append(pbPlan.Entitlements, &pb.PlanEntitlement{
+			Key:         entitlement.Key,
+			ProductCode: entitlement.ProductCode,
+			Value:       entitlement.Value,
+		})
+	}
+	return pbPlan
+}
+
+// convertTenantPlanToPB converts tenant plan subscription from biz to proto
+func convertTenantPlanToPB(assignment *biz.TenantPlan) *pb.TenantPlan {
+	if assignment == nil {
+		return nil
+	}
+
+	return &pb.TenantPlan{
+		TenantId:    assignment.TenantID,
+		PlanCode:    assignment.PlanCode,
+		PlanVersion: assignment.PlanVersion,
+		AssignedBy:  assignment.AssignedBy,
+		AssignedAt:  assignment.AssignedAt.Format(time.RFC3339),
+	}
+}
+
+// SavePlan implements tenant.SavePlan
+func (s *TenantService) SavePlan(ctx context.Context, req *pb.SavePlanRequest) (*pb.SavePlanReply, error) {
+	s.log.WithContext(ctx).Infof("SavePlan: planCode=%v, operator=%v", req.GetPlanCode(), req.GetOperator())
+
+	plan := &biz.QuotaPlan{
+		PlanCode:    req.GetPlanCode(),
+		PlanName:    req.GetPlanName(),
+		Description: req.GetDescription(),
+	}
+	for _, quota := range req.GetQuotas() {
+		plan.Quotas = append(plan.Quotas, &biz.PlanQuota{
+			QuotaType:    convertQuotaTypeToEnum(quota.GetQuotaType()),
+			LimitType:    convertLimitTypeToEnum(quota.GetLimitType()),
+			HardLimit:    quota.GetHardLimit(),
+			SoftLimit:    quota.GetSoftLimit(),
+			ProductCodes: quota.GetProductCodes(),
+		})
+	}
+	for _, entitlement := range req.GetEntitlements() {
+		plan.Entitlements = append(plan.Entitlements, &biz.PlanEntitlement{
+			Key:         entitlement.GetKey(),
+			ProductCode: entitlement.GetProductCode(),
+			Value:       entitlement.GetValue(),
+		})
+	}
+
+	// Call business logic
+	result, err := s.pl.SavePlan(ctx, plan, req.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.SavePlanReply{
+		Plan:       convertPlanToPB(result.Plan),
+		Propagated: int32(result.Propagated),
+	}
+	for _, failure := range result.Failures {
+		reply.Failures = append(reply.Failures, &pb.PlanPropagationFailure{
+			TenantId: failure.TenantID,
+			Error:    failure.Err.Error(),
+		})
+	}
+
+	return reply, nil
+}
+
+// GetPlan implements tenant.GetPlan
+func (s *TenantService) GetPlan(ctx context.Context, req *pb.GetPlanRequest) (*pb.GetPlanReply, error) {
+	s.log.WithContext(ctx).Infof("GetPlan: planCode=%v", req.GetPlanCode())
+
+	// Call business logic
+	plan, err := s.pl.GetPlan(ctx, req.GetPlanCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPlanReply{
+		Plan: convertPlanToPB(plan),
+	}, nil
+}
+
+// ListPlans implements tenant.ListPlans
+func (s *TenantService) ListPlans(ctx context.Context, req *pb.ListPlansRequest) (*pb.ListPlansReply, error) {
+	s.log.WithContext(ctx).Info("ListPlans")
+
+	// Call business logic
+	plans, err := s.pl.ListPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbPlans := make([]*pb.QuotaPlan, 0, len(plans))
+	for _, plan := range plans {
+		pbPlans = append(pbPlans, convertPlanToPB(plan))
+	}
+
+	return &pb.ListPlansReply{
+		Plans: pbPlans,
+	}, nil
+}
+
+// AssignPlan implements tenant.AssignPlan
+func (s *TenantService) AssignPlan(ctx context.Context, req *pb.AssignPlanRequest) (*pb.AssignPlanReply, error) {
+	s.log.WithContext(ctx).Infof("AssignPlan: tenantID=%v, planCode=%v", req.GetTenantId(), req.GetPlanCode())
+
+	// Call business logic
+	assignment, quotas, err := s.pl.AssignPlan(ctx, req.GetTenantId(), req.GetPlanCode(), req.GetOperator(), req.GetClearOverrides(), biz.ProrationPolicy(req.GetProration()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbQuotas := make([]*pb.QuotaInfo, 0, len(quotas))
+	for _, quota := range quotas {
+		pbQuotas = append(pbQuotas, convertQuotaInfoToPB(quota))
+	}
+
+	return &pb.AssignPlanReply{
+		Assignment: convertTenantPlanToPB(assignment),
+		Quotas:     pbQuotas,
+	}, nil
+}
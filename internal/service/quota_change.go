@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertQuotaChangeToPB converts scheduled quota change from biz to proto
+func convertQuotaChangeToPB(change *biz.QuotaChange) *pb.QuotaChange {
+	if change == nil {
+		return nil
+	}
+
+	return &pb.QuotaChange{
+		ChangeId:  change.ChangeID,
+		TenantId:  change.TenantID,
+		QuotaType: pb.QuotaType(change.QuotaType),
+		LimitType: pb.LimitType(change.LimitType),
+		HardLimit: change.HardLimit,
+		SoftLimit: change.SoftLimit,
+		PlanCode:  change.PlanCode,
+		ApplyAt:   change.ApplyAt.Format(time.RFC3339),
+		Proration: pb.ProrationPolicy(change.Proration),
+		Status:    pb.QuotaChangeStatus(change.Status),
+		Operator:  change.Operator,
+		Remark:    change.Remark,
+		Error:     change.Error,
+		AppliedAt: formatOptionalTime(change.AppliedAt),
+		CreatedAt: change.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ScheduleQuotaChange implements tenant.ScheduleQuotaChange
+func (s *TenantService) ScheduleQuotaChange(ctx context.Context, req *pb.ScheduleQuotaChangeRequest) (*pb.ScheduleQuotaChangeReply, error) {
+	s.log.WithContext(ctx).Infof("ScheduleQuotaChange: tenantID=%v, quotaType=%v, planCode=%v, applyAt=%v",
+		req.GetTenantId(), req.GetQuotaType(), req.GetPlanCode(), req.GetApplyAt())
+
+	change := &biz.QuotaChange{
+		TenantID:  req.GetTenantId(),
+		QuotaType: convertQuotaTypeToEnum(req.GetQuotaType()),
+		LimitType: convertLimitTypeToEnum(req.GetLimitType()),
+		HardLimit: req.HardLimit,
+		SoftLimit: req.SoftLimit,
+		PlanCode:  req.GetPlanCode(),
+		Proration: biz.ProrationPolicy(req.GetProration()),
+		Operator:  req.GetOperator(),
+		Remark:    req.GetRemark(),
+	}
+	if req.GetApplyAt() != "" {
+		if req.GetAtNextReset() {
+			return nil, biz.ErrQuotaChangeInvalid.WithMetadata(map[string]string{"reason": "apply_at and at_next_reset are mutually exclusive"})
+		}
+		applyAt, err := time.Parse(time.RFC3339, req.GetApplyAt())
+		if err != nil {
+			return nil, biz.ErrQuotaChangeInvalid.WithMetadata(map[string]string{"apply_at": req.GetApplyAt()})
+		}
+		change.ApplyAt = applyAt
+	}
+
+	// Call business logic
+	scheduled, quotas, err := s.cu.ScheduleQuotaChange(ctx, change, req.GetAtNextReset())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbQuotas := make([]*pb.QuotaInfo, 0, len(quotas))
+	for _, quota := range quotas {
+		pbQuotas = append(pbQuotas, convertQuotaInfoToPB(quota))
+	}
+
+	return &pb.ScheduleQuotaChangeReply{
+		Change: convertQuotaChangeToPB(scheduled),
+		Quotas: pbQuotas,
+	}, nil
+}
+
+// ListQuotaChanges implements tenant.ListQuotaChanges
+func (s *TenantService) ListQuotaChanges(ctx context.Context, req *pb.ListQuotaChangesRequest) (*pb.ListQuotaChangesReply, error) {
+	s.log.WithContext(ctx).Infof("ListQuotaChanges: tenantID=%v, status=%v", req.GetTenantId(), req.GetStatus())
+
+	// Call business logic
+	changes, err := s.cu.ListQuotaChanges(ctx, &biz.QuotaChangeFilter{
+		TenantID: req.GetTenantId(),
+		Status:   biz.QuotaChangeStatus(req.GetStatus()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbChanges := make([]*pb.QuotaChange, 0, len(changes))
+	for _, change := range changes {
+		pbChanges = append(pbChanges, convertQuotaChangeToPB(change))
+	}
+
+	return &pb.ListQuotaChangesReply{
+		Changes: pbChanges,
+	}, nil
+}
+
+// CancelQuotaChange implements tenant.CancelQuotaChange
+func (s *TenantService) CancelQuotaChange(ctx context.Context, req *pb.CancelQuotaChangeRequest) (*pb.CancelQuotaChangeReply, error) {
+	s.log.WithContext(ctx).Infof("CancelQuotaChange: tenantID=%v, changeID=%v, operator=%v", req.GetTenantId(), req.GetChangeId(), req.GetOperator())
+
+	// Call business logic
+	change, err := s.cu.CancelQuotaChange(ctx, req.GetTenantId(), req.GetChangeId(), req.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelQuotaChangeReply{
+		Change: convertQuotaChangeToPB(change),
+	}, nil
+}
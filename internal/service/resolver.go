@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// ExplainQuota implements tenant.ExplainQuota
+func (s *TenantService) ExplainQuota(ctx context.Context, req *pb.ExplainQuotaRequest) (*pb.ExplainQuotaReply, error) {
+	s.log.WithContext(ctx).Infof("ExplainQuota: tenantID=%v, quotaType=%v, limitType=%v, productCode=%v",
+		req.GetTenantId(), req.GetQuotaType(), req.GetLimitType(), req.GetProductCode())
+
+	// Call business logic
+	resolution, err := s.qu.ExplainQuota(ctx, req.GetTenantId(), convertQuotaTypeToEnum(req.GetQuotaType()),
+		convertLimitTypeToEnum(req.GetLimitType()), req.GetProductCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	candidates := make([]*pb.QuotaCandidate, 0, len(resolution.Candidates))
+	for _, candidate := range resolution.Candidates {
+		candidates = append(candidates, &pb.QuotaCandidate{
+			Quota:    convertQuotaInfoToPB(candidate.Quota),
+			Level:    pb.QuotaMatchLevel(candidate.Level),
+			Depth:    candidate.Depth,
+			Selected: candidate.Selected,
+			Reason:   candidate.Reason,
+		})
+	}
+
+	return &pb.ExplainQuotaReply{
+		Selected:   convertQuotaInfoToPB(resolution.Selected),
+		Ancestors:  resolution.Ancestors,
+		Candidates: candidates,
+	}, nil
+}
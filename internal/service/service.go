@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewTenantService)
+var ProviderSet = wire.NewSet(NewTenantService, NewTenantResolver, NewTrustedProxies)
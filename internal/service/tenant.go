@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	"tenant-service/api/base"
 	pb "tenant-service/api/tenant_service/v1"
 	"tenant-service/internal/biz"
 )
@@ -18,16 +20,40 @@ type TenantService struct {
 	tu  *biz.TenantUsecase
 	qu  *biz.QuotaUsecase
 	pu  *biz.ProductUsecase
+	tt  *biz.TenantTransferUsecase
+	ur  *biz.UsageReportUsecase
+	pl  *biz.PlanUsecase
+	cu  *biz.QuotaChangeUsecase
+	wu  *biz.WalletUsecase
+	lu  *biz.QuotaLeaseUsecase
+	mu  *biz.MemberUsecase
+	eu  *biz.EntitlementUsecase
+	au  *biz.AuditUsecase
+	lg  *biz.LedgerUsecase
 	log *log.Helper
+
+	proxies TrustedProxies
 }
 
 // NewTenantService new a tenant service.
-func NewTenantService(tu *biz.TenantUsecase, qu *biz.QuotaUsecase, pu *biz.ProductUsecase, logger log.Logger) *TenantService {
+func NewTenantService(tu *biz.TenantUsecase, qu *biz.QuotaUsecase, pu *biz.ProductUsecase, tt *biz.TenantTransferUsecase, ur *biz.UsageReportUsecase, pl *biz.PlanUsecase, cu *biz.QuotaChangeUsecase, wu *biz.WalletUsecase, lu *biz.QuotaLeaseUsecase, mu *biz.MemberUsecase, eu *biz.EntitlementUsecase, au *biz.AuditUsecase, lg *biz.LedgerUsecase, proxies TrustedProxies, logger log.Logger) *TenantService {
 	return &TenantService{
 		tu:  tu,
 		qu:  qu,
 		pu:  pu,
+		tt:  tt,
+		ur:  ur,
+		pl:  pl,
+		cu:  cu,
+		wu:  wu,
+		lu:  lu,
+		mu:  mu,
+		eu:  eu,
+		au:  au,
+		lg:  lg,
 		log: log.NewHelper(logger),
+
+		proxies: proxies,
 	}
 }
 
@@ -104,7 +130,24 @@ func convertTenantToPB(tenant *biz.Tenant) *pb.TenantInfo {
 		QuotaConfig:    tenant.QuotaConfig,
 		CreatedAt:      tenant.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:      tenant.UpdatedAt.Format(time.RFC3339),
+		Labels:         tenant.Labels,
+		Attributes:     tenant.Attributes,
+	}
+}
+
+// patchMetadata 在现有标签或属性上覆盖set中的键并删除remove中的键
+func patchMetadata(current, set map[string]string, remove []string) map[string]string {
+	patched := make(map[string]string, len(current)+len(set))
+	for key, value := range current {
+		patched[key] = value
+	}
+	for key, value := range set {
+		patched[key] = value
 	}
+	for _, key := range remove {
+		delete(patched, key)
+	}
+	return patched
 }
 
 // convertQuotaInfoToPB converts quota info from biz to proto
@@ -113,6 +156,11 @@ func convertQuotaInfoToPB(quota *biz.QuotaInfo) *pb.QuotaInfo {
 		return nil
 	}
 
+	allocations := make([]*pb.QuotaAllocation, 0, len(quota.Allocations))
+	for _, allocation := range quota.Allocations {
+		allocations = append(allocations, convertQuotaAllocationToPB(allocation))
+	}
+
 	return &pb.QuotaInfo{
 		QuotaId:       quota.QuotaID,
 		TenantId:      quota.TenantID,
@@ -127,6 +175,73 @@ func convertQuotaInfoToPB(quota *biz.QuotaInfo) *pb.QuotaInfo {
 		ExpireTime:    quota.ExpireTime.Format(time.RFC3339),
 		IsGlobal:      quota.IsGlobal,
 		ProductCodes:  quota.ProductCodes,
+		PlanCode:      quota.PlanCode,
+
+		RolloverGranted:    quota.RolloverGranted,
+		RolloverUsed:       quota.RolloverUsed,
+		RolloverExpireTime: formatOptionalTime(quota.RolloverExpireTime),
+
+		EnforcementMode: pb.EnforcementMode(quota.EnforcementMode),
+		MaxOverage:      quota.MaxOverage,
+		Overage:         quota.Overage(),
+
+		Allocations: allocations,
+		SharedLimit: quota.SharedLimit(),
+		SharedUsed:  quota.SharedUsed(),
+
+		Shards: quota.Shards,
+	}
+}
+
+// convertQuotaAllocationToPB converts quota allocation from biz to proto
+func convertQuotaAllocationToPB(allocation *biz.QuotaAllocation) *pb.QuotaAllocation {
+	if allocation == nil {
+		return nil
+	}
+
+	return &pb.QuotaAllocation{
+		ProductCode: allocation.ProductCode,
+		Limit:       allocation.Limit,
+		UsedCount:   allocation.UsedCount,
+		Remaining:   allocation.Remaining(),
+	}
+}
+
+// convertOperationTypeToProto converts operation type from biz enum to proto
+func convertOperationTypeToProto(operationType biz.OperationType) pb.OperationType {
+	switch operationType {
+	case biz.OperationTypeConsume:
+		return pb.OperationType_OPERATION_TYPE_CONSUME
+	case biz.OperationTypeRelease:
+		return pb.OperationType_OPERATION_TYPE_RELEASE
+	case biz.OperationTypeAdjust:
+		return pb.OperationType_OPERATION_TYPE_ADJUST
+	default:
+		return pb.OperationType_OPERATION_TYPE_UNSPECIFIED
+	}
+}
+
+// convertUsageRecordToPB converts quota usage record from biz to proto
+func convertUsageRecordToPB(record *biz.QuotaUsageRecord) *pb.QuotaUsageRecord {
+	if record == nil {
+		return nil
+	}
+
+	return &pb.QuotaUsageRecord{
+		RecordId:      record.RecordID,
+		QuotaId:       record.QuotaID,
+		TenantId:      record.TenantID,
+		OperationType: convertOperationTypeToProto(record.OperationType),
+		DeltaValue:    record.DeltaValue,
+		CurrentUsed:   record.CurrentUsed,
+		BizId:         record.BizID,
+		BizType:       record.BizType,
+		Operator:      record.Operator,
+		OperationTime: record.OperationTime.Format(time.RFC3339),
+		Remark:        record.Remark,
+		ChainSeq:      record.ChainSeq,
+		PrevHash:      record.PrevHash,
+		RecordHash:    record.RecordHash,
 	}
 }
 
@@ -143,17 +258,63 @@ func convertProductToPB(product *biz.Product) *pb.Product {
 	}
 }
 
+// convertPageRequest converts page request from proto to biz, falling back to legacy page_num/page_size
+func convertPageRequest(page *base.PageRequest, legacyPageNum, legacyPageSize int32) *biz.PageQuery {
+	query := &biz.PageQuery{
+		Page:     legacyPageNum,
+		PageSize: legacyPageSize,
+	}
+	if page != nil {
+		if page.GetPage() > 0 {
+			query.Page = page.GetPage()
+		}
+		if page.GetPageSize() > 0 {
+			query.PageSize = page.GetPageSize()
+		}
+		query.SortBy = page.GetSortBy()
+		query.SortDesc = page.GetSortDesc()
+	}
+
+	// Set default pagination values if not provided
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PageSize <= 0 {
+		query.PageSize = 10
+	}
+
+	return query
+}
+
+// convertPageResponse builds page response from biz page query and total count
+func convertPageResponse(page *biz.PageQuery, total int32) *base.PageResponse {
+	totalPages := total / page.PageSize
+	if total%page.PageSize != 0 {
+		totalPages++
+	}
+
+	return &base.PageResponse{
+		Total:      total,
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		TotalPages: totalPages,
+	}
+}
+
 // CreateTenant implements tenant.CreateTenant
 func (s *TenantService) CreateTenant(ctx context.Context, req *pb.CreateTenantRequest) (*pb.CreateTenantReply, error) {
 	s.log.WithContext(ctx).Infof("CreateTenant: %v", req.GetTenantName())
 
 	// Convert request to biz model
 	tenant := &biz.Tenant{
+		TenantID:       req.GetTenantId(),
 		TenantName:     req.GetTenantName(),
 		TenantType:     convertTenantTypeToEnum(req.GetTenantType()),
 		ParentTenantID: req.GetParentTenantId(),
 		Status:         true, // Default to active
 		QuotaConfig:    req.GetQuotaConfig(),
+		Labels:         req.GetLabels(),
+		Attributes:     req.GetAttributes(),
 	}
 
 	// Call business logic
@@ -186,26 +347,43 @@ func (s *TenantService) GetTenant(ctx context.Context, req *pb.GetTenantRequest)
 func (s *TenantService) ListTenants(ctx context.Context, req *pb.ListTenantsRequest) (*pb.ListTenantsReply, error) {
 	s.log.WithContext(ctx).Info("ListTenants")
 
-	// Set default pagination values if not provided
-	pageSize := req.GetPageSize()
-	if pageSize <= 0 {
-		pageSize = 10
+	// Build filter
+	filter := &biz.TenantFilter{
+		ParentTenantID: req.GetParentTenantId(),
+		Status:         req.Status,
+		Name:           req.GetName(),
+		Attributes:     req.GetAttributes(),
+	}
+	if req.GetTenantType() != pb.TenantType_TENANT_TYPE_UNSPECIFIED {
+		filter.TenantTypes = append(filter.TenantTypes, convertTenantTypeToEnum(req.GetTenantType()))
 	}
+	for _, tenantType := range req.GetTenantTypes() {
+		if tenantType != pb.TenantType_TENANT_TYPE_UNSPECIFIED {
+			filter.TenantTypes = append(filter.TenantTypes, convertTenantTypeToEnum(tenantType))
+		}
+	}
+
+	selector, err := biz.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	filter.LabelSelector = selector
 
-	pageNum := req.GetPageNum()
-	if pageNum <= 0 {
-		pageNum = 1
+	if req.GetCreatedAfter() != "" {
+		if filter.CreatedAfter, err = time.Parse(time.RFC3339, req.GetCreatedAfter()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_after: %s", req.GetCreatedAfter())
+		}
+	}
+	if req.GetCreatedBefore() != "" {
+		if filter.CreatedBefore, err = time.Parse(time.RFC3339, req.GetCreatedBefore()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_before: %s", req.GetCreatedBefore())
+		}
 	}
 
+	page := convertPageRequest(req.GetPage(), req.GetPageNum(), req.GetPageSize())
+
 	// Call business logic
-	tenants, total, err := s.tu.ListTenants(
-		ctx,
-		convertTenantTypeToEnum(req.GetTenantType()),
-		req.GetParentTenantId(),
-		req.GetStatus(),
-		pageNum,
-		pageSize,
-	)
+	tenants, total, err := s.tu.ListTenants(ctx, filter, page)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +397,7 @@ func (s *TenantService) ListTenants(ctx context.Context, req *pb.ListTenantsRequ
 	return &pb.ListTenantsReply{
 		Tenants: pbTenants,
 		Total:   total,
+		Page:    convertPageResponse(page, total),
 	}, nil
 }
 
@@ -240,6 +419,8 @@ func (s *TenantService) UpdateTenant(ctx context.Context, req *pb.UpdateTenantRe
 	existingTenant.TenantName = req.GetTenantName()
 	existingTenant.Status = req.GetStatus()
 	existingTenant.QuotaConfig = req.GetQuotaConfig()
+	existingTenant.Labels = patchMetadata(existingTenant.Labels, req.GetLabels(), req.GetRemoveLabels())
+	existingTenant.Attributes = patchMetadata(existingTenant.Attributes, req.GetAttributes(), req.GetRemoveAttributes())
 
 	// Call business logic
 	updatedTenant, err := s.tu.UpdateTenant(ctx, existingTenant)
@@ -283,11 +464,16 @@ func (s *TenantService) CheckQuota(ctx context.Context, req *pb.CheckQuotaReques
 		return nil, err
 	}
 
-	return &pb.CheckQuotaReply{
+	reply := &pb.CheckQuotaReply{
 		Quota:          convertQuotaInfoToPB(quota),
 		HasQuota:       hasQuota,
 		AvailableQuota: available,
-	}, nil
+	}
+	if quota != nil {
+		reply.Allocation = convertQuotaAllocationToPB(quota.Allocation(req.GetProductCode()))
+		reply.SharedAvailable = quota.SharedRemaining()
+	}
+	return reply, nil
 }
 
 // ConsumeQuota implements tenant.ConsumeQuota
@@ -296,7 +482,7 @@ func (s *TenantService) ConsumeQuota(ctx context.Context, req *pb.ConsumeQuotaRe
 		req.GetTenantId(), req.GetQuotaType(), req.GetAmount())
 
 	// Call business logic
-	success, remaining, err := s.qu.ConsumeQuota(
+	success, remaining, overage, err := s.qu.ConsumeQuota(
 		ctx,
 		req.GetTenantId(),
 		convertQuotaTypeToEnum(req.GetQuotaType()),
@@ -309,13 +495,14 @@ func (s *TenantService) ConsumeQuota(ctx context.Context, req *pb.ConsumeQuotaRe
 
 	message := ""
 	if err != nil {
-		message = err.Error()
+		message = errors.FromError(err).GetMessage()
 	}
 
 	return &pb.ConsumeQuotaReply{
 		Success:        success,
 		RemainingQuota: remaining,
 		Message:        message,
+		Overage:        overage,
 	}, nil
 }
 
@@ -337,7 +524,7 @@ func (s *TenantService) ReleaseQuota(ctx context.Context, req *pb.ReleaseQuotaRe
 
 	message := ""
 	if err != nil {
-		message = err.Error()
+		message = errors.FromError(err).GetMessage()
 	}
 
 	return &pb.ReleaseQuotaReply{
@@ -347,6 +534,110 @@ func (s *TenantService) ReleaseQuota(ctx context.Context, req *pb.ReleaseQuotaRe
 	}, nil
 }
 
+// ListQuotas implements tenant.ListQuotas
+func (s *TenantService) ListQuotas(ctx context.Context, req *pb.ListQuotasRequest) (*pb.ListQuotasReply, error) {
+	s.log.WithContext(ctx).Infof("ListQuotas: tenantID=%v", req.GetTenantId())
+
+	// Call business logic
+	quotas, err := s.qu.ListQuotas(ctx, req.GetTenantId(), convertQuotaTypeToEnum(req.GetQuotaType()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbQuotas := make([]*pb.QuotaInfo, 0, len(quotas))
+	for _, quota := range quotas {
+		pbQuotas = append(pbQuotas, convertQuotaInfoToPB(quota))
+	}
+
+	return &pb.ListQuotasReply{
+		Quotas: pbQuotas,
+	}, nil
+}
+
+// AdjustQuota implements tenant.AdjustQuota
+func (s *TenantService) AdjustQuota(ctx context.Context, req *pb.AdjustQuotaRequest) (*pb.AdjustQuotaReply, error) {
+	s.log.WithContext(ctx).Infof("AdjustQuota: tenantID=%v, quotaType=%v", req.GetTenantId(), req.GetQuotaType())
+
+	adjustment := &biz.QuotaAdjustment{
+		HardLimit:   req.HardLimit,
+		SoftLimit:   req.SoftLimit,
+		UsedCount:   req.UsedCount,
+		ExtraConfig: req.ExtraConfig,
+		Operator:    req.GetOperator(),
+		Remark:      req.GetRemark(),
+		MaxOverage:  req.MaxOverage,
+	}
+	if req.EnforcementMode != nil {
+		mode := biz.EnforcementMode(req.GetEnforcementMode())
+		adjustment.EnforcementMode = &mode
+	}
+
+	// Call business logic
+	quota, err := s.qu.AdjustQuota(
+		ctx,
+		req.GetTenantId(),
+		convertQuotaTypeToEnum(req.GetQuotaType()),
+		convertLimitTypeToEnum(req.GetLimitType()),
+		adjustment,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AdjustQuotaReply{
+		Quota: convertQuotaInfoToPB(quota),
+	}, nil
+}
+
+// ResetQuota implements tenant.ResetQuota
+func (s *TenantService) ResetQuota(ctx context.Context, req *pb.ResetQuotaRequest) (*pb.ResetQuotaReply, error) {
+	s.log.WithContext(ctx).Infof("ResetQuota: tenantID=%v, quotaType=%v", req.GetTenantId(), req.GetQuotaType())
+
+	// Call business logic
+	quota, err := s.qu.ResetQuota(
+		ctx,
+		req.GetTenantId(),
+		convertQuotaTypeToEnum(req.GetQuotaType()),
+		convertLimitTypeToEnum(req.GetLimitType()),
+		req.GetOperator(),
+		req.GetRemark(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetQuotaReply{
+		Quota: convertQuotaInfoToPB(quota),
+	}, nil
+}
+
+// ListUsageRecords implements tenant.ListUsageRecords
+func (s *TenantService) ListUsageRecords(ctx context.Context, req *pb.ListUsageRecordsRequest) (*pb.ListUsageRecordsReply, error) {
+	s.log.WithContext(ctx).Infof("ListUsageRecords: tenantID=%v, afterRecordID=%v", req.GetTenantId(), req.GetAfterRecordId())
+
+	// Call business logic
+	records, err := s.qu.ListUsageRecords(ctx, &biz.UsageRecordFilter{
+		TenantID:      req.GetTenantId(),
+		QuotaType:     convertQuotaTypeToEnum(req.GetQuotaType()),
+		AfterRecordID: req.GetAfterRecordId(),
+		Limit:         req.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbRecords := make([]*pb.QuotaUsageRecord, 0, len(records))
+	for _, record := range records {
+		pbRecords = append(pbRecords, convertUsageRecordToPB(record))
+	}
+
+	return &pb.ListUsageRecordsReply{
+		Records: pbRecords,
+	}, nil
+}
+
 // ListProducts implements tenant.ListProducts
 func (s *TenantService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsReply, error) {
 	s.log.WithContext(ctx).Info("ListProducts")
@@ -376,3 +667,17 @@ func (s *TenantService) ListProducts(ctx context.Context, req *pb.ListProductsRe
 		Products: pbProducts,
 	}, nil
 }
+
+// BindProduct implements tenant.BindProduct
+func (s *TenantService) BindProduct(ctx context.Context, req *pb.BindProductRequest) (*pb.BindProductReply, error) {
+	s.log.WithContext(ctx).Infof("BindProduct: tenantID=%v, productCode=%v", req.GetTenantId(), req.GetProductCode())
+
+	// Call business logic
+	if err := s.pu.BindProduct(ctx, req.GetTenantId(), req.GetProductCode()); err != nil {
+		return nil, err
+	}
+
+	return &pb.BindProductReply{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"io"
+
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// exportChunkSize 导出分片大小
+const exportChunkSize = 64 * 1024
+
+// importStreamReader adapts the import upload stream to io.Reader
+type importStreamReader struct {
+	stream pb.Tenant_ImportTenantsServer
+	buf    []byte
+}
+
+// Read implements io.Reader
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetOptions() != nil {
+			return 0, status.Error(codes.InvalidArgument, "import options must be sent only once as the first message")
+		}
+		r.buf = req.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// exportStreamWriter adapts an export download stream to io.Writer
+type exportStreamWriter struct {
+	send func(chunk []byte) error
+	buf  []byte
+}
+
+// Write implements io.Writer
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends the remaining buffered data
+func (w *exportStreamWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+// convertDataFormatToEnum converts data format from proto to biz enum
+func convertDataFormatToEnum(format pb.DataFormat) biz.DataFormat {
+	switch format {
+	case pb.DataFormat_DATA_FORMAT_CSV:
+		return biz.DataFormatCSV
+	case pb.DataFormat_DATA_FORMAT_JSONL:
+		return biz.DataFormatJSONL
+	default:
+		return biz.DataFormatUnspecified
+	}
+}
+
+// ImportTenants implements tenant.ImportTenants
+func (s *TenantService) ImportTenants(stream pb.Tenant_ImportTenantsServer) error {
+	// Stream handlers are not wrapped by the audit middleware
+	ctx := withAuditMetadata(stream.Context(), s.proxies)
+	s.log.WithContext(ctx).Info("ImportTenants")
+
+	// The first message carries the import options
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return status.Error(codes.InvalidArgument, "import options are required")
+		}
+		return err
+	}
+	options := first.GetOptions()
+	if options == nil {
+		return status.Error(codes.InvalidArgument, "import options must be the first message")
+	}
+	if err := options.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Call business logic
+	report, err := s.tt.ImportTenants(ctx, &importStreamReader{stream: stream}, &biz.ImportOptions{
+		Format: convertDataFormatToEnum(options.GetFormat()),
+		DryRun: options.GetDryRun(),
+		Upsert: options.GetUpsert(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Convert to proto response
+	results := make([]*pb.ImportRowResult, 0, len(report.Results))
+	for _, result := range report.Results {
+		results = append(results, &pb.ImportRowResult{
+			Line:     result.Line,
+			TenantId: result.TenantID,
+			Action:   result.Action,
+			Error:    result.Error,
+		})
+	}
+
+	return stream.SendAndClose(&pb.ImportTenantsReply{
+		DryRun:  report.DryRun,
+		Total:   report.Total,
+		Created: report.Created,
+		Updated: report.Updated,
+		Failed:  report.Failed,
+		Results: results,
+	})
+}
+
+// ExportTenants implements tenant.ExportTenants
+func (s *TenantService) ExportTenants(req *pb.ExportTenantsRequest, stream pb.Tenant_ExportTenantsServer) error {
+	ctx := stream.Context()
+	s.log.WithContext(ctx).Infof("ExportTenants: format=%v", req.GetFormat())
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := &biz.TenantFilter{
+		ParentTenantID: req.GetParentTenantId(),
+		Status:         req.Status,
+	}
+	for _, tenantType := range req.GetTenantTypes() {
+		if tenantType != pb.TenantType_TENANT_TYPE_UNSPECIFIED {
+			filter.TenantTypes = append(filter.TenantTypes, convertTenantTypeToEnum(tenantType))
+		}
+	}
+
+	// Call business logic
+	writer := &exportStreamWriter{send: func(chunk []byte) error {
+		return stream.Send(&pb.ExportTenantsReply{Chunk: chunk})
+	}}
+	if err := s.tt.ExportTenants(ctx, writer, convertDataFormatToEnum(req.GetFormat()), filter); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+	"tenant-service/pkg/tenantctx"
+)
+
+// tenantResolverCacheTTL 本服务校验请求头租户时的缓存有效期
+const tenantResolverCacheTTL = 30 * time.Second
+
+// tenantResolver 本服务直接通过用例查找租户，不经过RPC
+type tenantResolver struct {
+	tu *biz.TenantUsecase
+}
+
+// NewTenantResolver 创建tenantctx中间件使用的租户查找器
+func NewTenantResolver(tu *biz.TenantUsecase) tenantctx.Resolver {
+	return tenantctx.NewCachedResolver(&tenantResolver{tu: tu}, tenantctx.WithCacheTTL(tenantResolverCacheTTL))
+}
+
+// Lookup 查找租户
+func (r *tenantResolver) Lookup(ctx context.Context, tenantID string) (*tenantctx.Tenant, error) {
+	tenant, err := r.tu.GetTenant(ctx, tenantID)
+	if err != nil || tenant == nil {
+		return nil, err
+	}
+	return &tenantctx.Tenant{
+		ID:             tenant.TenantID,
+		Name:           tenant.TenantName,
+		Type:           pb.TenantType(tenant.TenantType),
+		ParentTenantID: tenant.ParentTenantID,
+		Status:         tenant.Status,
+	}, nil
+}
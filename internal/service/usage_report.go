@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// reportDateLayout 报表日期格式
+const reportDateLayout = "2006-01-02"
+
+// parseReportDate parses an optional report date in local time, empty means default
+func parseReportDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.ParseInLocation(reportDateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, biz.ErrInvalidDateRange.WithMetadata(map[string]string{"date": value})
+	}
+	return date, nil
+}
+
+// parseReportDateRange parses start and end dates into the report filter
+func parseReportDateRange(filter *biz.UsageReportFilter, startDate, endDate string) error {
+	var err error
+	if filter.StartDate, err = parseReportDate(startDate); err != nil {
+		return err
+	}
+	if filter.EndDate, err = parseReportDate(endDate); err != nil {
+		return err
+	}
+	return nil
+}
+
+// formatOptionalTime formats time as RFC3339, zero time as empty string
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// GetUsageReport implements tenant.GetUsageReport
+func (s *TenantService) GetUsageReport(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.GetUsageReportReply, error) {
+	s.log.WithContext(ctx).Infof("GetUsageReport: tenantID=%v, quotaType=%v", req.GetTenantId(), req.GetQuotaType())
+
+	filter := &biz.UsageReportFilter{
+		TenantID:  req.GetTenantId(),
+		QuotaType: convertQuotaTypeToEnum(req.GetQuotaType()),
+	}
+	if err := parseReportDateRange(filter, req.GetStartDate(), req.GetEndDate()); err != nil {
+		return nil, err
+	}
+
+	// Call business logic
+	report, err := s.ur.GetUsageReport(ctx, filter, int(req.GetTopN()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.GetUsageReportReply{
+		StartDate:      report.StartDate.Format(reportDateLayout),
+		EndDate:        report.EndDate.Format(reportDateLayout),
+		RollupRecordId: report.Rollup.LastRecordID,
+		RollupTime:     formatOptionalTime(report.Rollup.UpdatedAt),
+	}
+	for _, top := range report.TopConsumers {
+		pbTop := &pb.QuotaTypeTopConsumers{QuotaType: pb.QuotaType(top.QuotaType)}
+		for _, consumer := range top.Consumers {
+			pbTop.Consumers = append(pbTop.Consumers, &pb.TopConsumer{
+				TenantId:     consumer.TenantID,
+				Consumed:     consumer.Consumed,
+				Released:     consumer.Released,
+				ConsumeCount: consumer.ConsumeCount,
+			})
+		}
+		reply.TopConsumers = append(reply.TopConsumers, pbTop)
+	}
+	for _, bucket := range report.SoftLimitUtilization {
+		reply.SoftLimitUtilization = append(reply.SoftLimitUtilization, &pb.UtilizationBucket{
+			LowerPercent: bucket.LowerPercent,
+			UpperPercent: bucket.UpperPercent,
+			Count:        bucket.Count,
+		})
+	}
+	for _, forecast := range report.Forecasts {
+		reply.Forecasts = append(reply.Forecasts, &pb.ExhaustionForecast{
+			TenantId:           forecast.TenantID,
+			QuotaType:          pb.QuotaType(forecast.QuotaType),
+			HardLimit:          forecast.HardLimit,
+			UsedCount:          forecast.UsedCount,
+			DailyRate:          forecast.DailyRate,
+			WillExhaust:        !forecast.ExhaustTime.IsZero(),
+			ExhaustTime:        formatOptionalTime(forecast.ExhaustTime),
+			NextResetTime:      formatOptionalTime(forecast.NextResetTime),
+			ExhaustBeforeReset: forecast.ExhaustBeforeReset,
+		})
+	}
+
+	return reply, nil
+}
+
+// GetUsageTimeSeries implements tenant.GetUsageTimeSeries
+func (s *TenantService) GetUsageTimeSeries(ctx context.Context, req *pb.GetUsageTimeSeriesRequest) (*pb.GetUsageTimeSeriesReply, error) {
+	s.log.WithContext(ctx).Infof("GetUsageTimeSeries: tenantID=%v, quotaType=%v", req.GetTenantId(), req.GetQuotaType())
+
+	filter := &biz.UsageReportFilter{
+		TenantID:  req.GetTenantId(),
+		QuotaType: convertQuotaTypeToEnum(req.GetQuotaType()),
+		LimitType: convertLimitTypeToEnum(req.GetLimitType()),
+	}
+	if err := parseReportDateRange(filter, req.GetStartDate(), req.GetEndDate()); err != nil {
+		return nil, err
+	}
+
+	// Call business logic
+	result, err := s.ur.GetUsageTimeSeries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	reply := &pb.GetUsageTimeSeriesReply{
+		StartDate:      result.StartDate.Format(reportDateLayout),
+		EndDate:        result.EndDate.Format(reportDateLayout),
+		RollupRecordId: result.Rollup.LastRecordID,
+		RollupTime:     formatOptionalTime(result.Rollup.UpdatedAt),
+	}
+	for _, series := range result.Series {
+		pbSeries := &pb.UsageSeries{
+			QuotaId:   series.QuotaID,
+			QuotaType: pb.QuotaType(series.QuotaType),
+			LimitType: pb.LimitType(series.LimitType),
+		}
+		for _, point := range series.Points {
+			pbSeries.Points = append(pbSeries.Points, &pb.UsagePoint{
+				Date:         point.Date.Format(reportDateLayout),
+				Consumed:     point.Consumed,
+				Released:     point.Released,
+				ConsumeCount: point.ConsumeCount,
+				PeakUsed:     point.PeakUsed,
+				EndUsed:      point.EndUsed,
+				HardLimit:    point.HardLimit,
+				SoftLimit:    point.SoftLimit,
+			})
+		}
+		reply.Series = append(reply.Series, pbSeries)
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/internal/biz"
+)
+
+// convertWalletToPB converts wallet from biz to proto
+func convertWalletToPB(wallet *biz.Wallet) *pb.Wallet {
+	if wallet == nil {
+		return nil
+	}
+
+	return &pb.Wallet{
+		TenantId:            wallet.TenantID,
+		Balance:             wallet.Balance,
+		LowBalanceThreshold: wallet.LowBalanceThreshold,
+		LowBalance:          wallet.IsLow(),
+		CreatedAt:           wallet.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           wallet.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// convertWalletTransactionToPB converts wallet transaction from biz to proto
+func convertWalletTransactionToPB(tx *biz.WalletTransaction) *pb.WalletTransaction {
+	if tx == nil {
+		return nil
+	}
+
+	entries := make([]*pb.LedgerEntry, 0, len(tx.Entries))
+	for _, entry := range tx.Entries {
+		entries = append(entries, &pb.LedgerEntry{
+			EntryId:   entry.EntryID,
+			Account:   entry.Account,
+			Direction: pb.LedgerDirection(entry.Direction),
+			Amount:    entry.Amount,
+		})
+	}
+
+	return &pb.WalletTransaction{
+		TxId:           tx.TxID,
+		TenantId:       tx.TenantID,
+		Type:           pb.WalletTransactionType(tx.Type),
+		Amount:         tx.Amount,
+		BalanceAfter:   tx.BalanceAfter,
+		IdempotencyKey: tx.IdempotencyKey,
+		QuotaType:      pb.QuotaType(tx.QuotaType),
+		ProductCode:    tx.ProductCode,
+		Quantity:       tx.Quantity,
+		UnitPrice:      tx.UnitPrice,
+		RefTxId:        tx.RefTxID,
+		BizId:          tx.BizID,
+		Operator:       tx.Operator,
+		Remark:         tx.Remark,
+		CreatedAt:      tx.CreatedAt.Format(time.RFC3339),
+		Entries:        entries,
+	}
+}
+
+// GetWallet implements tenant.GetWallet
+func (s *TenantService) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletReply, error) {
+	s.log.WithContext(ctx).Infof("GetWallet: tenantID=%v", req.GetTenantId())
+
+	// Call business logic
+	wallet, err := s.wu.GetWallet(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetWalletReply{
+		Wallet: convertWalletToPB(wallet),
+	}, nil
+}
+
+// SetWalletThreshold implements tenant.SetWalletThreshold
+func (s *TenantService) SetWalletThreshold(ctx context.Context, req *pb.SetWalletThresholdRequest) (*pb.SetWalletThresholdReply, error) {
+	s.log.WithContext(ctx).Infof("SetWalletThreshold: tenantID=%v, threshold=%v", req.GetTenantId(), req.GetLowBalanceThreshold())
+
+	// Call business logic
+	wallet, err := s.wu.SetLowBalanceThreshold(ctx, req.GetTenantId(), req.GetLowBalanceThreshold())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetWalletThresholdReply{
+		Wallet: convertWalletToPB(wallet),
+	}, nil
+}
+
+// TopUpWallet implements tenant.TopUpWallet
+func (s *TenantService) TopUpWallet(ctx context.Context, req *pb.TopUpWalletRequest) (*pb.TopUpWalletReply, error) {
+	s.log.WithContext(ctx).Infof("TopUpWallet: tenantID=%v, amount=%v, idempotencyKey=%v", req.GetTenantId(), req.GetAmount(), req.GetIdempotencyKey())
+
+	// Call business logic
+	result, err := s.wu.TopUp(ctx, req.GetTenantId(), req.GetAmount(), req.GetIdempotencyKey(), req.GetOperator(), req.GetRemark())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	return &pb.TopUpWalletReply{
+		Transaction: convertWalletTransactionToPB(result.Transaction),
+		Wallet:      convertWalletToPB(result.Wallet),
+		Replayed:    result.Replayed,
+	}, nil
+}
+
+// DebitWallet implements tenant.DebitWallet
+func (s *TenantService) DebitWallet(ctx context.Context, req *pb.DebitWalletRequest) (*pb.DebitWalletReply, error) {
+	s.log.WithContext(ctx).Infof("DebitWallet: tenantID=%v, quotaType=%v, productCode=%v, quantity=%v, idempotencyKey=%v",
+		req.GetTenantId(), req.GetQuotaType(), req.GetProductCode(), req.GetQuantity(), req.GetIdempotencyKey())
+
+	// Call business logic
+	result, err := s.wu.Debit(ctx, &biz.WalletDebit{
+		TenantID:       req.GetTenantId(),
+		QuotaType:      convertQuotaTypeToEnum(req.GetQuotaType()),
+		ProductCode:    req.GetProductCode(),
+		Quantity:       req.GetQuantity(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		BizID:          req.GetBizId(),
+		Remark:         req.GetRemark(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	return &pb.DebitWalletReply{
+		Transaction: convertWalletTransactionToPB(result.Transaction),
+		Wallet:      convertWalletToPB(result.Wallet),
+		Replayed:    result.Replayed,
+		LowBalance:  result.Wallet.IsLow(),
+	}, nil
+}
+
+// RefundWallet implements tenant.RefundWallet
+func (s *TenantService) RefundWallet(ctx context.Context, req *pb.RefundWalletRequest) (*pb.RefundWalletReply, error) {
+	s.log.WithContext(ctx).Infof("RefundWallet: tenantID=%v, debitTxID=%v, amount=%v, idempotencyKey=%v",
+		req.GetTenantId(), req.GetDebitTxId(), req.GetAmount(), req.GetIdempotencyKey())
+
+	// Call business logic
+	result, err := s.wu.Refund(ctx, req.GetTenantId(), req.GetDebitTxId(), req.GetAmount(), req.GetIdempotencyKey(), req.GetOperator(), req.GetRemark())
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	return &pb.RefundWalletReply{
+		Transaction: convertWalletTransactionToPB(result.Transaction),
+		Wallet:      convertWalletToPB(result.Wallet),
+		Replayed:    result.Replayed,
+	}, nil
+}
+
+// ListWalletTransactions implements tenant.ListWalletTransactions
+func (s *TenantService) ListWalletTransactions(ctx context.Context, req *pb.ListWalletTransactionsRequest) (*pb.ListWalletTransactionsReply, error) {
+	s.log.WithContext(ctx).Infof("ListWalletTransactions: tenantID=%v, type=%v, afterTxID=%v", req.GetTenantId(), req.GetType(), req.GetAfterTxId())
+
+	// Call business logic
+	txs, err := s.wu.ListTransactions(ctx, &biz.WalletTransactionFilter{
+		TenantID:  req.GetTenantId(),
+		Type:      biz.WalletTransactionType(req.GetType()),
+		AfterTxID: req.GetAfterTxId(),
+		Limit:     req.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to proto response
+	pbTxs := make([]*pb.WalletTransaction, 0, len(txs))
+	for _, tx := range txs {
+		pbTxs = append(pbTxs, convertWalletTransactionToPB(tx))
+	}
+
+	return &pb.ListWalletTransactionsReply{
+		Transactions: pbTxs,
+	}, nil
+}
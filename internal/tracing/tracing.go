@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"tenant-service/internal/conf"
+)
+
+// ProviderSet is tracing providers.
+var ProviderSet = wire.NewSet(NewTracerProvider)
+
+// serviceName 上报的服务名
+const serviceName = "tenant-service"
+
+// defaultExportTimeout 默认导出超时
+const defaultExportTimeout = 10 * time.Second
+
+// NewTracerProvider 创建TracerProvider并设置为全局，未配置endpoint时只生成span不导出
+func NewTracerProvider(c *conf.Trace, logger log.Logger) (trace.TracerProvider, func(), error) {
+	logHelper := log.NewHelper(logger)
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ratio := c.GetSampleRatio()
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	}
+
+	if c.GetEndpoint() != "" {
+		timeout := defaultExportTimeout
+		if c.GetTimeout() != nil {
+			timeout = c.GetTimeout().AsDuration()
+		}
+		exporterOpts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(c.GetEndpoint()),
+			otlptracegrpc.WithTimeout(timeout),
+		}
+		if c.GetInsecure() {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+		// 导出器异步连接，接收端不可用时不影响启动
+		exporter, err := otlptracegrpc.New(context.Background(), exporterOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+		logHelper.Infof("trace exporter enabled: endpoint=%s, sample_ratio=%v", c.GetEndpoint(), ratio)
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			logHelper.Errorf("tracer provider shutdown error: %v", err)
+		}
+	}, nil
+}
@@ -0,0 +1,236 @@
+// Package entitlement 在业务服务中判断租户授权项：按（租户，产品线）整体拉取生效的授权项并缓存在本地，
+// 缓存命中时只有一次读锁和map查找，可以在每个请求上调用
+package entitlement
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/pkg/tenantctx"
+)
+
+const (
+	// defaultCacheTTL 默认授权项缓存有效期
+	defaultCacheTTL = 30 * time.Second
+	// defaultMaxCacheEntries 默认最多缓存的（租户，产品线）数
+	defaultMaxCacheEntries = 10000
+	// defaultStaleIfError 默认缓存过期后刷新出错时继续使用的最长时间
+	defaultStaleIfError = 5 * time.Minute
+	// defaultErrorBackoff 默认刷新出错后再次查询租户服务的间隔
+	defaultErrorBackoff = 5 * time.Second
+)
+
+// Set 租户在产品线上生效的授权项快照，只读
+type Set struct {
+	values map[string]string
+}
+
+// Value 授权项取值，未定义的授权项返回false
+func (s *Set) Value(key string) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	value, ok := s.values[key]
+	return value, ok
+}
+
+// Enabled 布尔授权项是否开启，未定义的授权项视为未开启
+func (s *Set) Enabled(key string) bool {
+	value, _ := s.Value(key)
+	return value == "true"
+}
+
+// Is 枚举授权项是否等于value
+func (s *Set) Is(key, value string) bool {
+	v, ok := s.Value(key)
+	return ok && v == value
+}
+
+// Option 客户端选项
+type Option func(*Client)
+
+// WithCacheTTL 授权项缓存有效期，默认30s
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.ttl = ttl
+	}
+}
+
+// WithMaxEntries 最多缓存的（租户，产品线）数，默认10000
+func WithMaxEntries(n int) Option {
+	return func(c *Client) {
+		c.maxEntries = n
+	}
+}
+
+// WithStaleIfError 缓存过期后刷新出错时继续使用过期缓存的最长时间，默认5m，超过后返回错误
+func WithStaleIfError(d time.Duration) Option {
+	return func(c *Client) {
+		c.staleIfError = d
+	}
+}
+
+// WithErrorBackoff 刷新出错后继续使用过期缓存、暂不查询租户服务的时间，默认5s
+func WithErrorBackoff(d time.Duration) Option {
+	return func(c *Client) {
+		c.errorBackoff = d
+	}
+}
+
+// cacheKey 缓存键
+type cacheKey struct {
+	tenantID    string
+	productCode string
+}
+
+// cacheEntry 缓存的授权项
+type cacheEntry struct {
+	set        *Set
+	expireAt   time.Time // 过期时间，之后的请求刷新缓存
+	staleUntil time.Time // 刷新出错时可继续使用的截止时间
+}
+
+// Client 带本地缓存的授权项客户端，授权变更最多延迟一个缓存有效期生效；
+// 同一（租户，产品线）的并发刷新合并为一次查询；刷新出错时在容忍期内继续使用过期缓存，
+// 避免租户服务抖动影响业务请求，并按退避间隔重试
+type Client struct {
+	client       pb.TenantClient
+	ttl          time.Duration
+	maxEntries   int
+	staleIfError time.Duration
+	errorBackoff time.Duration
+
+	group   singleflight.Group
+	mu      sync.RWMutex
+	entries map[cacheKey]cacheEntry
+}
+
+// NewClient 创建通过租户服务GetEntitlements获取授权项的客户端
+func NewClient(client pb.TenantClient, opts ...Option) *Client {
+	c := &Client{
+		client:       client,
+		ttl:          defaultCacheTTL,
+		maxEntries:   defaultMaxCacheEntries,
+		staleIfError: defaultStaleIfError,
+		errorBackoff: defaultErrorBackoff,
+		entries:      make(map[cacheKey]cacheEntry),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get 获取租户在产品线上生效的全部授权项，缓存未命中或过期时查询租户服务，并发的查询合并为一次
+func (c *Client) Get(ctx context.Context, tenantID, productCode string) (*Set, error) {
+	key := cacheKey{tenantID: tenantID, productCode: productCode}
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(entry.expireAt) {
+		return entry.set, nil
+	}
+
+	// 查询使用发起者的ctx，其他等待者在各自的ctx取消时提前返回
+	ch := c.group.DoChan(tenantID+"\x00"+productCode, func() (interface{}, error) {
+		return c.refresh(ctx, key)
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*Set), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// refresh 查询租户服务并更新缓存；出错时若过期缓存仍在容忍期内则返回过期缓存，并在退避间隔内不再查询
+func (c *Client) refresh(ctx context.Context, key cacheKey) (*Set, error) {
+	reply, err := c.client.GetEntitlements(ctx, &pb.GetEntitlementsRequest{TenantId: key.tenantID, ProductCode: key.productCode})
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		entry, ok := c.entries[key]
+		if !ok {
+			return nil, err
+		}
+		if !now.Before(entry.staleUntil) {
+			delete(c.entries, key)
+			return nil, err
+		}
+		entry.expireAt = now.Add(c.errorBackoff)
+		if entry.expireAt.After(entry.staleUntil) {
+			entry.expireAt = entry.staleUntil
+		}
+		c.entries[key] = entry
+		return entry.set, nil
+	}
+
+	set := &Set{values: make(map[string]string, len(reply.GetEntitlements()))}
+	for _, e := range reply.GetEntitlements() {
+		set.values[e.GetKey()] = e.GetValue()
+	}
+	if len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	expireAt := now.Add(c.ttl)
+	c.entries[key] = cacheEntry{set: set, expireAt: expireAt, staleUntil: expireAt.Add(c.staleIfError)}
+	return set, nil
+}
+
+// Enabled 布尔授权项是否开启
+func (c *Client) Enabled(ctx context.Context, tenantID, productCode, key string) (bool, error) {
+	set, err := c.Get(ctx, tenantID, productCode)
+	if err != nil {
+		return false, err
+	}
+	return set.Enabled(key), nil
+}
+
+// Value 授权项取值，未定义的授权项返回空
+func (c *Client) Value(ctx context.Context, tenantID, productCode, key string) (string, error) {
+	set, err := c.Get(ctx, tenantID, productCode)
+	if err != nil {
+		return "", err
+	}
+	value, _ := set.Value(key)
+	return value, nil
+}
+
+// FromContext 获取上下文中租户（见tenantctx）在产品线上生效的授权项，上下文中没有租户时返回空集合
+func (c *Client) FromContext(ctx context.Context, productCode string) (*Set, error) {
+	tenantID := tenantctx.TenantID(ctx)
+	if tenantID == "" {
+		return &Set{}, nil
+	}
+	return c.Get(ctx, tenantID, productCode)
+}
+
+// Invalidate 清除租户的缓存，本服务修改授权项后调用可立即生效
+func (c *Client) Invalidate(tenantID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.tenantID == tenantID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// evict 清理已超过容忍期的缓存，仍然超出上限时清空
+func (c *Client) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.staleUntil) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		c.entries = make(map[cacheKey]cacheEntry)
+	}
+}
@@ -0,0 +1,91 @@
+// Package ledger 定义配额使用记录哈希链和签名检查点的格式，服务端写入和校验、渠道方离线核对检查点共用
+package ledger
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+// GenesisHash 每个配额哈希链中第一条记录的前序哈希
+var GenesisHash = strings.Repeat("0", 64)
+
+// checkpointDomain 检查点签名内容的前缀，避免签名被用于其他用途
+const checkpointDomain = "tenant-service.ledger.checkpoint.v1"
+
+// Record 参与哈希的使用记录字段，过期时间不参与
+type Record struct {
+	QuotaID       int64  // 配额ID
+	Seq           int64  // 配额内的链序号，从1开始连续递增
+	TenantID      string // 租户ID
+	OperationType string // 操作类型：CONSUME/RELEASE/ADJUST/RESET
+	DeltaValue    int32  // 变更数值
+	CurrentUsed   int32  // 变更后已用量
+	BizID         string // 业务ID
+	BizType       string // 业务类型
+	Operator      string // 操作人
+	OperationTime int64  // 操作时间，Unix秒
+	Remark        string // 备注
+}
+
+// writeField 以“长度:值;”写入字段，字段内容中的分隔符不会造成歧义
+func writeField(h hash.Hash, v string) {
+	h.Write([]byte(strconv.Itoa(len(v))))
+	h.Write([]byte{':'})
+	h.Write([]byte(v))
+	h.Write([]byte{';'})
+}
+
+// Hash 计算记录哈希：SHA-256(前序哈希, 各字段)，返回十六进制
+func Hash(prevHash string, r *Record) string {
+	h := sha256.New()
+	writeField(h, prevHash)
+	writeField(h, strconv.FormatInt(r.QuotaID, 10))
+	writeField(h, strconv.FormatInt(r.Seq, 10))
+	writeField(h, r.TenantID)
+	writeField(h, r.OperationType)
+	writeField(h, strconv.FormatInt(int64(r.DeltaValue), 10))
+	writeField(h, strconv.FormatInt(int64(r.CurrentUsed), 10))
+	writeField(h, r.BizID)
+	writeField(h, r.BizType)
+	writeField(h, r.Operator)
+	writeField(h, strconv.FormatInt(r.OperationTime, 10))
+	writeField(h, r.Remark)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Checkpoint 签名检查点，证明配额在某一时刻的链头
+type Checkpoint struct {
+	QuotaID   int64  // 配额ID
+	TenantID  string // 租户ID
+	Seq       int64  // 链头序号
+	Hash      string // 链头记录哈希
+	KeyID     string // 签名密钥ID
+	CreatedAt int64  // 签发时间，Unix秒
+}
+
+// Message 检查点的签名内容
+func (c *Checkpoint) Message() []byte {
+	var b strings.Builder
+	for _, v := range []string{checkpointDomain, c.KeyID, strconv.FormatInt(c.QuotaID, 10), c.TenantID,
+		strconv.FormatInt(c.Seq, 10), c.Hash, strconv.FormatInt(c.CreatedAt, 10)} {
+		b.WriteString(strconv.Itoa(len(v)))
+		b.WriteByte(':')
+		b.WriteString(v)
+		b.WriteByte(';')
+	}
+	return []byte(b.String())
+}
+
+// Sign 以Ed25519私钥签名检查点
+func Sign(key ed25519.PrivateKey, c *Checkpoint) []byte {
+	return ed25519.Sign(key, c.Message())
+}
+
+// Verify 以Ed25519公钥校验检查点签名
+func Verify(key ed25519.PublicKey, c *Checkpoint, signature []byte) bool {
+	return len(key) == ed25519.PublicKeySize && ed25519.Verify(key, c.Message(), signature)
+}
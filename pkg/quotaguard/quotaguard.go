@@ -0,0 +1,257 @@
+// Package quotaguard 按接口在proto中声明的 (platform.tenant_service.v1.guard) / tenant_check 选项，
+// 在处理前校验租户并检查或消费配额，处理失败时释放已消费的配额
+package quotaguard
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	pb "tenant-service/api/tenant_service/v1"
+	"tenant-service/pkg/tenantctx"
+)
+
+var (
+	// ErrQuotaExceeded 配额不足
+	ErrQuotaExceeded = errors.Forbidden("QUOTA_EXCEEDED", "quota exceeded")
+	// ErrGuardInvalid 接口的guard选项与请求不匹配，如数量字段不存在
+	ErrGuardInvalid = errors.InternalServer("QUOTA_GUARD_INVALID", "quota guard option is invalid")
+	// ErrAmountInvalid 请求中的数量为负数或超出int32范围
+	ErrAmountInvalid = errors.BadRequest("QUOTA_AMOUNT_INVALID", "quota amount is out of range")
+)
+
+// rule 接口的控制规则，guard为nil时只校验租户
+type rule struct {
+	tenantCheck bool
+	guard       *pb.Guard
+}
+
+// Option 中间件选项
+type Option func(*Guard)
+
+// WithLogger 记录释放配额失败等日志
+func WithLogger(logger log.Logger) Option {
+	return func(g *Guard) {
+		g.log = log.NewHelper(logger)
+	}
+}
+
+// Guard 配额控制中间件
+type Guard struct {
+	client pb.TenantClient
+	log    *log.Helper
+	rules  sync.Map // operation -> *rule，nil表示接口没有声明选项
+}
+
+// New 创建配额控制中间件，client为租户服务客户端
+func New(client pb.TenantClient, opts ...Option) *Guard {
+	g := &Guard{client: client, log: log.NewHelper(log.DefaultLogger)}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Server 服务端中间件，应放在 tenantctx.Server 之后
+func (g *Guard) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			r := g.rule(tr.Operation())
+			if r == nil {
+				return handler(ctx, req)
+			}
+
+			tenantID := tenantctx.TenantID(ctx)
+			if tenantID == "" {
+				return nil, tenantctx.ErrTenantIDMissing
+			}
+			if r.guard == nil {
+				return handler(ctx, req)
+			}
+			return g.guard(ctx, tr.Operation(), tenantID, r.guard, req, handler)
+		}
+	}
+}
+
+// guard 检查或消费配额后处理请求，消费后处理失败时释放
+func (g *Guard) guard(ctx context.Context, operation, tenantID string, guard *pb.Guard, req interface{}, handler middleware.Handler) (interface{}, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, ErrGuardInvalid.WithMetadata(map[string]string{"reason": "request is not a proto message"})
+	}
+	amount, err := amountOf(msg, guard)
+	if err != nil {
+		return nil, err
+	}
+	// 只有显式的0不消耗配额
+	if amount == 0 {
+		return handler(ctx, req)
+	}
+	productCode := guard.GetProductCode()
+	if field := guard.GetProductCodeField(); field != "" {
+		if productCode, err = stringField(msg, field); err != nil {
+			return nil, err
+		}
+	}
+
+	if guard.GetMode() == pb.GuardMode_GUARD_MODE_CHECK {
+		reply, err := g.client.CheckQuota(ctx, &pb.CheckQuotaRequest{
+			TenantId:    tenantID,
+			QuotaType:   guard.GetQuotaType(),
+			LimitType:   guard.GetLimitType(),
+			ProductCode: productCode,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !reply.GetHasQuota() || reply.GetAvailableQuota() < amount {
+			return nil, ErrQuotaExceeded
+		}
+		return handler(ctx, req)
+	}
+
+	var bizID string
+	if field := guard.GetBizIdField(); field != "" {
+		if bizID, err = stringField(msg, field); err != nil {
+			return nil, err
+		}
+	}
+	consumed, err := g.client.ConsumeQuota(ctx, &pb.ConsumeQuotaRequest{
+		TenantId:    tenantID,
+		QuotaType:   guard.GetQuotaType(),
+		LimitType:   guard.GetLimitType(),
+		Amount:      amount,
+		ProductCode: productCode,
+		BizId:       bizID,
+		BizType:     operation,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !consumed.GetSuccess() {
+		return nil, ErrQuotaExceeded.WithMetadata(map[string]string{"message": consumed.GetMessage()})
+	}
+
+	reply, err := handler(ctx, req)
+	if err != nil {
+		// 请求可能已被取消，释放不受其影响
+		if _, releaseErr := g.client.ReleaseQuota(context.WithoutCancel(ctx), &pb.ReleaseQuotaRequest{
+			TenantId:    tenantID,
+			QuotaType:   guard.GetQuotaType(),
+			LimitType:   guard.GetLimitType(),
+			Amount:      amount,
+			ProductCode: productCode,
+			BizId:       bizID,
+		}); releaseErr != nil {
+			g.log.WithContext(ctx).Errorf("release %d %s quota of tenant %s after %s failed: %v",
+				amount, guard.GetQuotaType(), tenantID, operation, releaseErr)
+		}
+	}
+	return reply, err
+}
+
+// rule 读取接口的控制规则并缓存，operation形如 /package.Service/Method
+func (g *Guard) rule(operation string) *rule {
+	if cached, ok := g.rules.Load(operation); ok {
+		return cached.(*rule)
+	}
+	r := lookupRule(operation)
+	g.rules.Store(operation, r)
+	return r
+}
+
+// lookupRule 从全局注册的proto描述中查找方法选项
+func lookupRule(operation string) *rule {
+	name := strings.Replace(strings.TrimPrefix(operation, "/"), "/", ".", 1)
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil
+	}
+	opts, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok || opts == nil {
+		return nil
+	}
+	r := &rule{tenantCheck: proto.GetExtension(opts, pb.E_TenantCheck).(bool)}
+	if guard, ok := proto.GetExtension(opts, pb.E_Guard).(*pb.Guard); ok && guard != nil {
+		r.guard = guard
+	}
+	if !r.tenantCheck && r.guard == nil {
+		return nil
+	}
+	return r
+}
+
+// amountOf 读取本次数量
+func amountOf(msg proto.Message, guard *pb.Guard) (int32, error) {
+	field := guard.GetAmountField()
+	if field == "" {
+		if guard.GetAmount() > 0 {
+			return guard.GetAmount(), nil
+		}
+		return 1, nil
+	}
+	fd, value, err := fieldValue(msg, field)
+	if err != nil {
+		return 0, err
+	}
+	var amount int64
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		amount = value.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if value.Uint() > math.MaxInt32 {
+			return 0, ErrAmountInvalid.WithMetadata(map[string]string{"field": field, "value": strconv.FormatUint(value.Uint(), 10)})
+		}
+		amount = int64(value.Uint())
+	default:
+		return 0, ErrGuardInvalid.WithMetadata(map[string]string{"reason": "amount field " + field + " is not an integer"})
+	}
+	// 先检查范围再转换，避免截断为0、负数或较小的值
+	if amount < 0 || amount > math.MaxInt32 {
+		return 0, ErrAmountInvalid.WithMetadata(map[string]string{"field": field, "value": strconv.FormatInt(amount, 10)})
+	}
+	return int32(amount), nil
+}
+
+// stringField 读取字符串字段
+func stringField(msg proto.Message, field string) (string, error) {
+	fd, value, err := fieldValue(msg, field)
+	if err != nil {
+		return "", err
+	}
+	if fd.Kind() != protoreflect.StringKind {
+		return "", ErrGuardInvalid.WithMetadata(map[string]string{"reason": "field " + field + " is not a string"})
+	}
+	return value.String(), nil
+}
+
+// fieldValue 按字段名读取请求中的单值字段
+func fieldValue(msg proto.Message, field string) (protoreflect.FieldDescriptor, protoreflect.Value, error) {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.IsList() || fd.IsMap() {
+		return nil, protoreflect.Value{}, ErrGuardInvalid.WithMetadata(map[string]string{
+			"reason": "field " + field + " not found in " + string(m.Descriptor().FullName()),
+		})
+	}
+	return fd, m.Get(fd), nil
+}
@@ -0,0 +1,210 @@
+// Package quotalease 客户端在进程内使用配额租约：一次租用一块额度在本地扣减，用完、临近过期或关闭时归还未用部分
+package quotalease
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+const (
+	// reasonQuotaExceeded 配额不足的错误原因
+	reasonQuotaExceeded = "QUOTA_EXCEEDED"
+	// reasonLeaseClosed 租约已归还或已被服务端回收的错误原因
+	reasonLeaseClosed = "QUOTA_LEASE_CLOSED"
+	// leaseBizType 租约使用记录的业务类型，与服务端一致
+	leaseBizType = "quota_lease"
+)
+
+// Options 租约参数
+type Options struct {
+	TenantID    string        // 租户ID
+	QuotaType   pb.QuotaType  // 配额类型
+	LimitType   pb.LimitType  // 限制类型
+	ProductCode string        // 产品代码
+	Holder      string        // 持有方，如服务名和实例
+	BlockSize   int32         // 每次租用的额度，单次扣减超过该值时按扣减量租用
+	TTL         time.Duration // 租约有效期，0表示服务端默认值
+	RenewBefore time.Duration // 距过期不足该时长时续约，默认服务端返回的有效期的1/3
+}
+
+// Spender 在本地租约上扣减配额，并发安全
+type Spender struct {
+	client pb.TenantClient
+	opts   Options
+
+	mu          sync.Mutex
+	lease       *pb.QuotaLease
+	expire      time.Time
+	renewBefore time.Duration
+	used        int32 // 当前租约的累计已用量
+	reported    int32 // 当前租约已上报服务端的已用量
+	unreported  int32 // 已关闭租约上未能上报的用量，待以普通消费补报
+	leaseID     string
+}
+
+// NewSpender 创建本地配额扣减器
+func NewSpender(client pb.TenantClient, opts Options) *Spender {
+	if opts.BlockSize <= 0 {
+		opts.BlockSize = 1
+	}
+	return &Spender{client: client, opts: opts}
+}
+
+// Take 从本地租约扣减n个单位；租约额度不足时归还当前租约并租用新的一块，配额不足时返回QUOTA_EXCEEDED
+func (s *Spender) Take(ctx context.Context, n int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.settle(ctx); err != nil {
+		return err
+	}
+	if s.lease != nil && time.Until(s.expire) < s.renewBefore {
+		if err := s.renew(ctx); err != nil {
+			return err
+		}
+	}
+	if s.lease == nil || s.lease.GetAmount()-s.used < n {
+		if err := s.release(ctx); err != nil {
+			return err
+		}
+		if err := s.acquire(ctx, n); err != nil {
+			return err
+		}
+	}
+	s.used += n
+	return nil
+}
+
+// Flush 向服务端上报已用量并续约，长时间运行的客户端应定期调用，缩小崩溃后被回收的额度
+func (s *Spender) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lease != nil {
+		if err := s.renew(ctx); err != nil {
+			return err
+		}
+	}
+	return s.settle(ctx)
+}
+
+// Close 归还当前租约，退回未使用的额度，应在进程退出前调用
+func (s *Spender) Close(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.release(ctx); err != nil {
+		return err
+	}
+	return s.settle(ctx)
+}
+
+// acquire 租用新的一块额度，配额不足一块时按本次扣减量重试
+func (s *Spender) acquire(ctx context.Context, n int32) error {
+	amount := s.opts.BlockSize
+	if n > amount {
+		amount = n
+	}
+	for {
+		reply, err := s.client.LeaseQuotaBlock(ctx, &pb.LeaseQuotaBlockRequest{
+			TenantId:    s.opts.TenantID,
+			QuotaType:   s.opts.QuotaType,
+			LimitType:   s.opts.LimitType,
+			Amount:      amount,
+			ProductCode: s.opts.ProductCode,
+			Holder:      s.opts.Holder,
+			TtlSeconds:  int32(s.opts.TTL / time.Second),
+		})
+		if errors.Reason(err) == reasonQuotaExceeded && amount > n {
+			amount = n
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		s.set(reply.GetLease())
+		s.used = 0
+		s.reported = 0
+		return nil
+	}
+}
+
+// renew 上报已用量并续约；租约已被服务端回收时丢弃，下次扣减时重新租用，未上报的用量另行补报
+func (s *Spender) renew(ctx context.Context) error {
+	reply, err := s.client.RenewQuotaLease(ctx, &pb.RenewQuotaLeaseRequest{
+		LeaseId:    s.lease.GetLeaseId(),
+		UsedCount:  s.used,
+		TtlSeconds: int32(s.opts.TTL / time.Second),
+	})
+	if errors.Reason(err) == reasonLeaseClosed {
+		return s.drop(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	s.set(reply.GetLease())
+	s.reported = s.used
+	return nil
+}
+
+// release 归还当前租约
+func (s *Spender) release(ctx context.Context) error {
+	if s.lease == nil {
+		return nil
+	}
+	_, err := s.client.ReturnQuotaLease(ctx, &pb.ReturnQuotaLeaseRequest{
+		LeaseId:   s.lease.GetLeaseId(),
+		UsedCount: s.used,
+	})
+	if errors.Reason(err) == reasonLeaseClosed {
+		return s.drop(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	s.lease = nil
+	return nil
+}
+
+// drop 丢弃已被服务端关闭的租约；服务端按最近一次上报的已用量退回了其余额度，其后的用量以普通消费补报
+func (s *Spender) drop(ctx context.Context) error {
+	s.unreported += s.used - s.reported
+	s.leaseID = s.lease.GetLeaseId()
+	s.lease = nil
+	return s.settle(ctx)
+}
+
+// settle 补报已关闭租约上未上报的用量，失败时保留，下次扣减、上报或关闭时重试；配额已不足时无法补报，丢弃并返回错误
+func (s *Spender) settle(ctx context.Context) error {
+	if s.unreported <= 0 {
+		return nil
+	}
+	_, err := s.client.ConsumeQuota(ctx, &pb.ConsumeQuotaRequest{
+		TenantId:    s.opts.TenantID,
+		QuotaType:   s.opts.QuotaType,
+		LimitType:   s.opts.LimitType,
+		Amount:      s.unreported,
+		ProductCode: s.opts.ProductCode,
+		BizId:       s.leaseID,
+		BizType:     leaseBizType,
+	})
+	if err != nil && errors.Reason(err) != reasonQuotaExceeded {
+		return err
+	}
+	s.unreported = 0
+	return err
+}
+
+// set 记录服务端返回的租约，未指定续约提前量时取本次有效期的1/3
+func (s *Spender) set(lease *pb.QuotaLease) {
+	s.lease = lease
+	s.expire, _ = time.Parse(time.RFC3339, lease.GetExpireTime())
+	s.renewBefore = s.opts.RenewBefore
+	if s.renewBefore <= 0 {
+		s.renewBefore = time.Until(s.expire) / 3
+	}
+}
@@ -0,0 +1,144 @@
+package tenantctx
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// clientResolver 通过租户服务查找租户
+type clientResolver struct {
+	client pb.TenantClient
+}
+
+// NewClientResolver 创建通过租户服务GetTenant查找租户的Resolver
+func NewClientResolver(client pb.TenantClient) Resolver {
+	return &clientResolver{client: client}
+}
+
+// Lookup 查找租户
+func (r *clientResolver) Lookup(ctx context.Context, tenantID string) (*Tenant, error) {
+	reply, err := r.client.GetTenant(ctx, &pb.GetTenantRequest{TenantId: tenantID})
+	if err != nil {
+		return nil, err
+	}
+	info := reply.GetTenant()
+	if info == nil {
+		return nil, nil
+	}
+	return &Tenant{
+		ID:             info.GetTenantId(),
+		Name:           info.GetTenantName(),
+		Type:           info.GetTenantType(),
+		ParentTenantID: info.GetParentTenantId(),
+		Status:         info.GetStatus(),
+	}, nil
+}
+
+const (
+	// defaultCacheTTL 默认租户缓存有效期
+	defaultCacheTTL = time.Minute
+	// defaultMaxCacheEntries 默认最多缓存的租户数
+	defaultMaxCacheEntries = 10000
+)
+
+// cacheEntry 缓存的查找结果，tenant为nil表示租户不存在
+type cacheEntry struct {
+	tenant   *Tenant
+	expireAt time.Time
+}
+
+// CacheOption 缓存选项
+type CacheOption func(*cachedResolver)
+
+// WithCacheTTL 租户缓存有效期，默认1m
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(r *cachedResolver) {
+		r.ttl = ttl
+	}
+}
+
+// WithNegativeTTL 租户不存在的结果缓存有效期，默认与租户缓存相同
+func WithNegativeTTL(ttl time.Duration) CacheOption {
+	return func(r *cachedResolver) {
+		r.negativeTTL = ttl
+	}
+}
+
+// WithMaxEntries 最多缓存的租户数，默认10000
+func WithMaxEntries(n int) CacheOption {
+	return func(r *cachedResolver) {
+		r.maxEntries = n
+	}
+}
+
+// cachedResolver 带本地缓存的Resolver，查找出错时不缓存
+type cachedResolver struct {
+	next        Resolver
+	ttl         time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+// NewCachedResolver 在next之上加本地缓存，租户停用最多延迟一个缓存有效期生效
+func NewCachedResolver(next Resolver, opts ...CacheOption) Resolver {
+	r := &cachedResolver{
+		next:        next,
+		ttl:         defaultCacheTTL,
+		negativeTTL: -1,
+		maxEntries:  defaultMaxCacheEntries,
+		entries:     make(map[string]cacheEntry),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.negativeTTL < 0 {
+		r.negativeTTL = r.ttl
+	}
+	return r
+}
+
+// Lookup 查找租户，缓存未命中或过期时查询next
+func (r *cachedResolver) Lookup(ctx context.Context, tenantID string) (*Tenant, error) {
+	now := time.Now()
+	r.mu.RLock()
+	entry, ok := r.entries[tenantID]
+	r.mu.RUnlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry.tenant, nil
+	}
+
+	tenant, err := r.next.Lookup(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	ttl := r.ttl
+	if tenant == nil {
+		ttl = r.negativeTTL
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.entries) >= r.maxEntries {
+		r.evict(now)
+	}
+	r.entries[tenantID] = cacheEntry{tenant: tenant, expireAt: now.Add(ttl)}
+	return tenant, nil
+}
+
+// evict 清理过期缓存，仍然超出上限时清空
+func (r *cachedResolver) evict(now time.Time) {
+	for id, entry := range r.entries {
+		if !now.Before(entry.expireAt) {
+			delete(r.entries, id)
+		}
+	}
+	if len(r.entries) >= r.maxEntries {
+		r.entries = make(map[string]cacheEntry)
+	}
+}
@@ -0,0 +1,143 @@
+// Package tenantctx 在请求上下文中传递租户：服务端中间件从请求头 x-tenant-id 取出租户，经租户服务校验后放入上下文，
+// 客户端中间件将上下文中的租户写入下游请求头
+package tenantctx
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	pb "tenant-service/api/tenant_service/v1"
+)
+
+// HeaderTenantID 传递租户ID的请求头
+const HeaderTenantID = "x-tenant-id"
+
+var (
+	// ErrTenantIDMissing 请求未携带租户ID
+	ErrTenantIDMissing = errors.BadRequest("TENANT_ID_MISSING", "tenant id header is missing")
+	// ErrTenantNotFound 租户不存在
+	ErrTenantNotFound = errors.NotFound("TENANT_NOT_FOUND", "tenant not found")
+	// ErrTenantDisabled 租户已停用
+	ErrTenantDisabled = errors.Forbidden("TENANT_DISABLED", "tenant is disabled")
+	// ErrTenantMismatch 请求体中的租户与请求头不一致
+	ErrTenantMismatch = errors.Forbidden("TENANT_MISMATCH", "tenant id in request does not match header")
+)
+
+// Tenant 上下文中的租户
+type Tenant struct {
+	ID             string        // 租户ID
+	Name           string        // 租户名称
+	Type           pb.TenantType // 租户类型
+	ParentTenantID string        // 父租户ID
+	Status         bool          // 状态
+}
+
+type tenantKey struct{}
+
+// NewContext 将租户放入上下文
+func NewContext(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext 取出上下文中的租户
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(*Tenant)
+	return tenant, ok && tenant != nil
+}
+
+// TenantID 上下文中的租户ID，没有租户时为空
+func TenantID(ctx context.Context) string {
+	if tenant, ok := FromContext(ctx); ok {
+		return tenant.ID
+	}
+	return ""
+}
+
+// Resolver 按租户ID查找租户，不存在时返回nil
+type Resolver interface {
+	Lookup(ctx context.Context, tenantID string) (*Tenant, error)
+}
+
+// tenantRequest 请求体中带租户ID的请求
+type tenantRequest interface {
+	GetTenantId() string
+}
+
+// Option 服务端中间件选项
+type Option func(*options)
+
+type options struct {
+	header   string
+	optional bool
+}
+
+// WithHeader 自定义传递租户ID的请求头，默认x-tenant-id
+func WithHeader(header string) Option {
+	return func(o *options) {
+		o.header = header
+	}
+}
+
+// WithOptional 请求未携带租户ID时放行，携带时仍校验
+func WithOptional() Option {
+	return func(o *options) {
+		o.optional = true
+	}
+}
+
+// Server 服务端中间件：校验请求头中的租户存在且启用，请求体带tenant_id时须与请求头一致，通过后将租户放入上下文
+func Server(resolver Resolver, opts ...Option) middleware.Middleware {
+	o := &options{header: HeaderTenantID}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var tenantID string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				tenantID = tr.RequestHeader().Get(o.header)
+			}
+			if tenantID == "" {
+				if o.optional {
+					return handler(ctx, req)
+				}
+				return nil, ErrTenantIDMissing
+			}
+			if r, ok := req.(tenantRequest); ok && r.GetTenantId() != "" && r.GetTenantId() != tenantID {
+				return nil, ErrTenantMismatch.WithMetadata(map[string]string{"header": tenantID, "request": r.GetTenantId()})
+			}
+
+			tenant, err := resolver.Lookup(ctx, tenantID)
+			if err != nil {
+				return nil, err
+			}
+			if tenant == nil {
+				return nil, ErrTenantNotFound.WithMetadata(map[string]string{"tenant_id": tenantID})
+			}
+			if !tenant.Status {
+				return nil, ErrTenantDisabled.WithMetadata(map[string]string{"tenant_id": tenantID})
+			}
+			return handler(NewContext(ctx, tenant), req)
+		}
+	}
+}
+
+// Client 客户端中间件：将上下文中的租户ID写入下游请求头
+func Client(opts ...Option) middleware.Middleware {
+	o := &options{header: HeaderTenantID}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tenantID := TenantID(ctx); tenantID != "" {
+				if tr, ok := transport.FromClientContext(ctx); ok {
+					tr.RequestHeader().Set(o.header, tenantID)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}